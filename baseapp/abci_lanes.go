@@ -0,0 +1,174 @@
+package baseapp
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// LaneProposalHandler defines the ABCI PrepareProposal and ProcessProposal
+// handlers of a LaneMempool, which enforce the block space of its lanes. The
+// PrepareProposal handler is the DefaultProposalHandler's with the TxSelector
+// of NewLaneTxSelector.
+type LaneProposalHandler struct {
+	*DefaultProposalHandler
+
+	lanes *mempool.LaneMempool
+}
+
+// NewLaneProposalHandler returns the proposal handlers of the lane mempool mp.
+func NewLaneProposalHandler(mp *mempool.LaneMempool, txVerifier ProposalTxVerifier) *LaneProposalHandler {
+	h := &LaneProposalHandler{
+		DefaultProposalHandler: NewDefaultProposalHandler(mp, txVerifier),
+		lanes:                  mp,
+	}
+	h.SetTxSelector(NewLaneTxSelector(mp))
+
+	return h
+}
+
+// ProcessProposalHandler returns the implementation for processing an ABCI
+// proposal of the lanes. On top of the conditions of the DefaultProposalHandler,
+// the transactions must be ordered by lane, in the order of the lanes, and the
+// transactions of each lane must fit in its max block space.
+//
+// The max block space of the lanes is computed from the consensus params max
+// bytes rather than RequestPrepareProposal.MaxTxBytes, which is not known in
+// ProcessProposal, so it is never lower than the space the proposer selected
+// the transactions for.
+func (h *LaneProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	lanes := h.lanes.Lanes()
+
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		var maxTxBytes, maxBlockGas uint64
+		if b := ctx.ConsensusParams().Block; b != nil {
+			if b.MaxBytes > 0 {
+				maxTxBytes = uint64(b.MaxBytes)
+			}
+			if b.MaxGas > 0 {
+				maxBlockGas = uint64(b.MaxGas)
+			}
+		}
+
+		var (
+			totalTxGas  uint64
+			laneTxBytes = make([]uint64, len(lanes))
+			laneTxGas   = make([]uint64, len(lanes))
+			lastLane    int
+		)
+		for _, txBytes := range req.Txs {
+			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
+			if err != nil {
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+
+			lane := h.lanes.LaneIndex(tx)
+			if lane < lastLane {
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+			lastLane = lane
+
+			laneTxBytes[lane] += uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBytes}))
+			if maxTxBytes > 0 && laneTxBytes[lane] > lanes[lane].MaxTxBytes(maxTxBytes) {
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+
+			if maxBlockGas > 0 {
+				if gasTx, ok := tx.(GasTx); ok {
+					totalTxGas += gasTx.GetGas()
+					laneTxGas[lane] += gasTx.GetGas()
+				}
+
+				if totalTxGas > maxBlockGas || laneTxGas[lane] > lanes[lane].MaxGas(maxBlockGas) {
+					return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+				}
+			}
+		}
+
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+}
+
+// laneTxSelector is a TxSelector which selects the transactions of each lane up
+// to the max block space of the lane.
+type laneTxSelector struct {
+	lanes        *mempool.LaneMempool
+	totalTxBytes uint64
+	totalTxGas   uint64
+	laneTxBytes  []uint64
+	laneTxGas    []uint64
+	selectedTxs  [][]byte
+}
+
+// NewLaneTxSelector returns a TxSelector which selects the transactions of the
+// lanes of mp up to the max block space of their lane, and the transactions
+// matching none of the lanes not at all. The transactions are expected in the
+// order of the lanes, as the mempool iterates them.
+func NewLaneTxSelector(mp *mempool.LaneMempool) TxSelector {
+	ts := &laneTxSelector{lanes: mp}
+	ts.Clear()
+
+	return ts
+}
+
+func (ts *laneTxSelector) SelectedTxs(_ context.Context) [][]byte {
+	txs := make([][]byte, len(ts.selectedTxs))
+	copy(txs, ts.selectedTxs)
+	return txs
+}
+
+func (ts *laneTxSelector) Clear() {
+	ts.totalTxBytes = 0
+	ts.totalTxGas = 0
+	ts.laneTxBytes = make([]uint64, len(ts.lanes.Lanes()))
+	ts.laneTxGas = make([]uint64, len(ts.lanes.Lanes()))
+	ts.selectedTxs = nil
+}
+
+func (ts *laneTxSelector) SelectTxForProposal(_ context.Context, maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	lane := -1
+	if memTx != nil {
+		lane = ts.lanes.LaneIndex(memTx)
+	}
+	if lane < 0 {
+		return ts.full(maxTxBytes, maxBlockGas)
+	}
+	laneConfig := ts.lanes.Lanes()[lane]
+
+	txSize := uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+
+	var txGasLimit uint64
+	if gasTx, ok := memTx.(GasTx); ok {
+		txGasLimit = gasTx.GetGas()
+	}
+
+	// only add the transaction to the proposal if both the block and the lane
+	// have enough capacity
+	fits := txSize+ts.totalTxBytes <= maxTxBytes &&
+		txSize+ts.laneTxBytes[lane] <= laneConfig.MaxTxBytes(maxTxBytes)
+	if maxBlockGas > 0 {
+		fits = fits &&
+			txGasLimit+ts.totalTxGas <= maxBlockGas &&
+			txGasLimit+ts.laneTxGas[lane] <= laneConfig.MaxGas(maxBlockGas)
+	}
+
+	if fits {
+		ts.totalTxBytes += txSize
+		ts.totalTxGas += txGasLimit
+		ts.laneTxBytes[lane] += txSize
+		ts.laneTxGas[lane] += txGasLimit
+		ts.selectedTxs = append(ts.selectedTxs, txBz)
+	}
+
+	return ts.full(maxTxBytes, maxBlockGas)
+}
+
+// full reports whether the block is full, in which case no more transactions of
+// any lane can be selected.
+func (ts *laneTxSelector) full(maxTxBytes, maxBlockGas uint64) bool {
+	return ts.totalTxBytes >= maxTxBytes || (maxBlockGas > 0 && ts.totalTxGas >= maxBlockGas)
+}
@@ -0,0 +1,139 @@
+package baseapp_test
+
+import (
+	"bytes"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// priorityLaneMatch matches the txs of MsgKeyValue values starting with "p".
+func priorityLaneMatch(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		kv, ok := msg.(*baseapptestutil.MsgKeyValue)
+		if !ok || !bytes.HasPrefix(kv.Value, []byte("p")) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *ABCIUtilsTestSuite) TestLaneProposalHandler() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	type testTx struct {
+		tx   sdk.Tx
+		bz   []byte
+		size int64
+	}
+
+	// txs of distinct senders, the first three of the priority lane
+	values := []string{"p1", "p2", "p3", "d1", "d2", "d3"}
+	txs := make([]testTx, len(values))
+	for i, value := range values {
+		tx := buildMsg(s.T(), txConfig, []byte(value), [][]byte{[]byte("secret" + value)}, []uint64{1})
+		bz, err := txConfig.TxEncoder()(tx)
+		s.Require().NoError(err)
+		txs[i] = testTx{tx: tx, bz: bz, size: cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz})}
+	}
+	for _, tx := range txs {
+		s.Require().Equal(txs[0].size, tx.size)
+	}
+	txSize := txs[0].size
+
+	newHandler := func(ctrl *gomock.Controller) (*baseapp.LaneProposalHandler, *mempool.LaneMempool) {
+		app := mock.NewMockProposalTxVerifier(ctrl)
+		for _, tx := range txs {
+			app.EXPECT().PrepareProposalVerifyTx(tx.tx).Return(tx.bz, nil).AnyTimes()
+			app.EXPECT().ProcessProposalVerifyTx(tx.bz).Return(tx.tx, nil).AnyTimes()
+		}
+
+		mp, err := mempool.NewLaneMempool(
+			mempool.Lane{
+				Name:          "priority",
+				Mempool:       mempool.DefaultPriorityMempool(),
+				Match:         priorityLaneMatch,
+				MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1),
+			},
+			mempool.Lane{
+				Name:    "default",
+				Mempool: mempool.DefaultPriorityMempool(),
+			},
+		)
+		s.Require().NoError(err)
+
+		return baseapp.NewLaneProposalHandler(mp, app), mp
+	}
+
+	// the priority lane takes at most two txs of the four txs of the block
+	ph, mp := newHandler(gomock.NewController(s.T()))
+	// insert the default lane txs first, with a higher priority
+	for _, i := range []int{3, 4, 5, 0, 1, 2} {
+		priority := int64(1)
+		if i >= 3 {
+			priority = 10
+		}
+		s.Require().NoError(mp.Insert(s.ctx.WithPriority(priority), txs[i].tx))
+	}
+	s.Require().Equal(6, mp.CountTx())
+
+	resp, err := ph.PrepareProposalHandler()(s.ctx, &abci.RequestPrepareProposal{MaxTxBytes: 4 * txSize})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Txs, 4)
+	for i, bz := range resp.Txs {
+		tx, err := txConfig.TxDecoder()(bz)
+		s.Require().NoError(err)
+		s.Require().Equal(i < 2, priorityLaneMatch(tx), "tx %d", i)
+	}
+
+	processCtx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxBytes: 4 * txSize}})
+	testCases := map[string]struct {
+		txs    []int
+		status abci.ResponseProcessProposal_ProposalStatus
+	}{
+		"ordered by lane": {
+			txs:    []int{0, 1, 3, 4},
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"only the default lane": {
+			txs:    []int{3, 4, 5},
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"not ordered by lane": {
+			txs:    []int{0, 3, 1},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"priority lane over its block space": {
+			txs:    []int{0, 1, 2},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ph, _ := newHandler(gomock.NewController(s.T()))
+
+			req := &abci.RequestProcessProposal{}
+			for _, i := range tc.txs {
+				req.Txs = append(req.Txs, txs[i].bz)
+			}
+
+			resp, err := ph.ProcessProposalHandler()(processCtx, req)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.status, resp.Status)
+		})
+	}
+}
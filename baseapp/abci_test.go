@@ -2535,3 +2535,33 @@ func TestFinalizeBlockDeferResponseHandle(t *testing.T) {
 	require.Empty(t, res)
 	require.NotEmpty(t, err)
 }
+
+// recheckMempool records the block heights of the check states it is rechecked
+// with.
+type recheckMempool struct {
+	mempool.NoOpMempool
+	heights []int64
+}
+
+func (mp *recheckMempool) Recheck(ctx context.Context) {
+	mp.heights = append(mp.heights, sdk.UnwrapSDKContext(ctx).BlockHeight())
+}
+
+func TestABCI_Commit_RecheckMempool(t *testing.T) {
+	pool := &recheckMempool{}
+	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool))
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 3; height++ {
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, []int64{1, 2, 3}, pool.heights)
+}
@@ -0,0 +1,120 @@
+// Package blockstm implements the optimistic parallel execution of the
+// transactions of a block, following Block-STM
+// (https://arxiv.org/abs/2203.06871).
+//
+// The transactions are executed concurrently, each on a view of the state that
+// reads the values written by the lower transactions from a multi-version
+// memory and records its read and write sets. An incarnation is validated by
+// checking that its reads would return the same values now; a transaction
+// whose reads are invalidated is aborted and executed again. Once all the
+// transactions are executed and validated, the state, results and gas are the
+// same as if the transactions had been executed sequentially in block order.
+package blockstm
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// ExecuteFn executes the transaction of the block at the given index against
+// the multistore. It may be called several times per transaction, each call
+// replacing the effects of the previous one, so any result must be stored by
+// transaction index.
+type ExecuteFn func(txIndex int, ms storetypes.MultiStore)
+
+// ExecuteBlock executes the transactions of a block of the given size in
+// parallel with the given number of workers. The base store is only read; the
+// returned memory holds the writes of the transactions until written to it.
+func ExecuteBlock(ctx context.Context, blockSize, workers int, base storetypes.MultiStore, execute ExecuteFn) (*MVMemory, error) {
+	e := &executor{
+		mv:        newMVMemory(blockSize, base),
+		scheduler: newScheduler(blockSize),
+		execute:   execute,
+	}
+
+	var wg sync.WaitGroup
+	for range min(workers, blockSize) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			e.run(ctx)
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return e.mv, nil
+}
+
+type executor struct {
+	mv        *MVMemory
+	scheduler *scheduler
+	execute   ExecuteFn
+}
+
+func (e *executor) run(ctx context.Context) {
+	kind, v := taskNone, version{}
+	for !e.scheduler.done() {
+		if ctx.Err() != nil {
+			return
+		}
+
+		switch kind {
+		case taskExecution:
+			kind, v = e.tryExecute(v)
+		case taskValidation:
+			kind, v = e.needsReexecution(v)
+		}
+
+		if kind == taskNone {
+			kind, v = e.scheduler.nextTask()
+			if kind == taskNone {
+				runtime.Gosched()
+			}
+		}
+	}
+}
+
+// tryExecute executes an incarnation and records its read and write sets, or
+// suspends it until the transaction whose estimate it read is executed again.
+func (e *executor) tryExecute(v version) (taskKind, version) {
+	for {
+		view := e.executeView(v.txIndex)
+		if blocking := view.blockingTxIndex(); blocking >= 0 {
+			if e.scheduler.addDependency(v.txIndex, blocking) {
+				return taskNone, version{}
+			}
+			continue
+		}
+
+		wroteNewKey := e.mv.record(v, view.reads, view.writes)
+		return e.scheduler.finishExecution(v, wroteNewKey)
+	}
+}
+
+func (e *executor) executeView(txIndex int) (v *view) {
+	v = newView(e.mv, txIndex)
+	defer func() {
+		if r := recover(); r != nil && !IsAbort(r) {
+			panic(r)
+		}
+	}()
+
+	e.execute(txIndex, v)
+	return v
+}
+
+// needsReexecution validates an incarnation, aborting it if its reads were
+// invalidated.
+func (e *executor) needsReexecution(v version) (taskKind, version) {
+	aborted := !e.mv.validateReadSet(v.txIndex) && e.scheduler.tryValidationAbort(v)
+	if aborted {
+		e.mv.convertWritesToEstimates(v.txIndex)
+	}
+	return e.scheduler.finishValidation(v.txIndex, aborted)
+}
@@ -0,0 +1,244 @@
+package blockstm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var (
+	storeKeyA = storetypes.NewKVStoreKey("a")
+	storeKeyB = storetypes.NewKVStoreKey("b")
+)
+
+// newBaseStore returns a committed multistore with values set for half of the
+// keys of the workload.
+func newBaseStore(t *testing.T, numKeys int) storetypes.CommitMultiStore {
+	t.Helper()
+
+	cms := store.NewCommitMultiStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(storeKeyA, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(storeKeyB, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	for i := 0; i < numKeys; i += 2 {
+		cms.GetKVStore(storeKeyA).Set(workloadKey(i), encodeInt(uint64(i)))
+		cms.GetKVStore(storeKeyB).Set(workloadKey(i), encodeInt(uint64(i*7)))
+	}
+	cms.Commit()
+	return cms
+}
+
+func workloadKey(i int) []byte {
+	return fmt.Appendf(nil, "key/%03d", i)
+}
+
+func encodeInt(i uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, i)
+}
+
+func decodeInt(bz []byte) uint64 {
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// workload returns a block of transactions reading, writing, deleting and
+// iterating over a few keys, so that they conflict. Every transaction records
+// a digest of what it observed in the outputs.
+func workload(seed int64, blockSize, numKeys int, outputs [][]byte) ExecuteFn {
+	type op struct {
+		kind     int
+		storeKey storetypes.StoreKey
+		key, to  int
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	txs := make([][]op, blockSize)
+	for i := range txs {
+		for range 1 + r.Intn(6) {
+			storeKey := storeKeyA
+			if r.Intn(3) == 0 {
+				storeKey = storeKeyB
+			}
+			txs[i] = append(txs[i], op{kind: r.Intn(5), storeKey: storeKey, key: r.Intn(numKeys), to: r.Intn(numKeys)})
+		}
+	}
+
+	return func(txIndex int, ms storetypes.MultiStore) {
+		// execute on a branch like the transactions of baseapp do
+		cache := ms.CacheMultiStore()
+		digest := sha256.New()
+		for _, o := range txs[txIndex] {
+			kv := cache.GetKVStore(o.storeKey)
+			switch o.kind {
+			case 0: // transfer
+				value := decodeInt(kv.Get(workloadKey(o.key)))
+				digest.Write(encodeInt(value))
+				kv.Set(workloadKey(o.to), encodeInt(value+uint64(txIndex)+1))
+			case 1: // delete
+				digest.Write([]byte{boolByte(kv.Has(workloadKey(o.key)))})
+				kv.Delete(workloadKey(o.key))
+			case 2: // sum a range
+				start, end := min(o.key, o.to), max(o.key, o.to)+1
+				it := kv.Iterator(workloadKey(start), workloadKey(end))
+				var sum uint64
+				for ; it.Valid(); it.Next() {
+					digest.Write(it.Key())
+					sum += decodeInt(it.Value())
+				}
+				_ = it.Close()
+				kv.Set(workloadKey(o.to), encodeInt(sum))
+			case 3: // read the first keys in reverse
+				it := kv.ReverseIterator(nil, workloadKey(o.key))
+				for i := 0; it.Valid() && i < 3; it.Next() {
+					digest.Write(it.Key())
+					digest.Write(it.Value())
+					i++
+				}
+				_ = it.Close()
+			case 4: // write only
+				kv.Set(workloadKey(o.key), encodeInt(uint64(txIndex)))
+			}
+			// interleave the transactions executed concurrently
+			runtime.Gosched()
+		}
+		cache.Write()
+		outputs[txIndex] = digest.Sum(nil)
+	}
+}
+
+func boolByte(b bool) byte {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func storeContents(t *testing.T, ms storetypes.MultiStore) map[string][]byte {
+	t.Helper()
+
+	contents := map[string][]byte{}
+	for _, storeKey := range []storetypes.StoreKey{storeKeyA, storeKeyB} {
+		it := ms.GetKVStore(storeKey).Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			contents[storeKey.Name()+"/"+string(it.Key())] = it.Value()
+		}
+		require.NoError(t, it.Close())
+	}
+	return contents
+}
+
+func TestExecuteBlockMatchesSequential(t *testing.T) {
+	const numKeys = 16
+
+	for _, blockSize := range []int{1, 2, 10, 100} {
+		for _, workers := range []int{1, 2, 4, 16} {
+			for seed := range int64(10) {
+				t.Run(fmt.Sprintf("size=%d/workers=%d/seed=%d", blockSize, workers, seed), func(t *testing.T) {
+					cms := newBaseStore(t, numKeys)
+
+					sequential := cms.CacheMultiStore()
+					expectedOutputs := make([][]byte, blockSize)
+					execute := workload(seed, blockSize, numKeys, expectedOutputs)
+					for i := range blockSize {
+						execute(i, sequential)
+					}
+
+					parallel := cms.CacheMultiStore()
+					outputs := make([][]byte, blockSize)
+					mv, err := ExecuteBlock(context.Background(), blockSize, workers, parallel, workload(seed, blockSize, numKeys, outputs))
+					require.NoError(t, err)
+					// the base store is left untouched until the memory is written
+					require.Equal(t, storeContents(t, cms.CacheMultiStore()), storeContents(t, parallel))
+					mv.Write()
+
+					require.Equal(t, expectedOutputs, outputs)
+					require.Equal(t, storeContents(t, sequential), storeContents(t, parallel))
+				})
+			}
+		}
+	}
+}
+
+func TestExecuteBlockCanceled(t *testing.T) {
+	cms := newBaseStore(t, 4)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ExecuteBlock(ctx, 10, 4, cms.CacheMultiStore(), func(int, storetypes.MultiStore) {})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestViewIterator(t *testing.T) {
+	cms := newBaseStore(t, 8)
+	mv := newMVMemory(3, cms.CacheMultiStore())
+
+	// tx 0 deletes key 2 and writes key 3, tx 2 deletes key 4 and writes key 5
+	mv.record(version{txIndex: 0}, &readSet{}, writeSet{storeKeyA: {
+		string(workloadKey(2)): nil,
+		string(workloadKey(3)): encodeInt(30),
+	}})
+	mv.record(version{txIndex: 2}, &readSet{}, writeSet{storeKeyA: {
+		string(workloadKey(4)): nil,
+		string(workloadKey(5)): encodeInt(50),
+	}})
+
+	v := newView(mv, 1)
+	kv := v.GetKVStore(storeKeyA)
+	kv.Delete(workloadKey(0))
+	kv.Set(workloadKey(7), encodeInt(70))
+
+	keys := func(it storetypes.Iterator) (keys []string) {
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, fmt.Sprintf("%s=%d", it.Key(), decodeInt(it.Value())))
+		}
+		require.NoError(t, it.Close())
+		return keys
+	}
+	require.Equal(t, []string{"key/003=30", "key/004=4", "key/006=6", "key/007=70"}, keys(kv.Iterator(nil, nil)))
+	require.Equal(t, []string{"key/006=6", "key/004=4", "key/003=30"}, keys(kv.ReverseIterator(workloadKey(3), workloadKey(7))))
+
+	// the iterations are valid until a lower transaction writes in their domain
+	mv.record(version{txIndex: 1}, v.reads, v.writes)
+	require.True(t, mv.validateReadSet(1))
+	mv.record(version{txIndex: 0, incarnation: 1}, &readSet{}, writeSet{storeKeyA: {
+		string(workloadKey(2)): nil,
+		string(workloadKey(3)): encodeInt(30),
+		string(workloadKey(6)): nil,
+	}})
+	require.False(t, mv.validateReadSet(1))
+}
+
+func TestViewAbortsOnEstimate(t *testing.T) {
+	cms := newBaseStore(t, 4)
+	mv := newMVMemory(2, cms.CacheMultiStore())
+	mv.record(version{txIndex: 0}, &readSet{}, writeSet{storeKeyA: {string(workloadKey(1)): encodeInt(1)}})
+
+	v := newView(mv, 1)
+	require.Equal(t, encodeInt(1), v.GetKVStore(storeKeyA).Get(workloadKey(1)))
+	mv.record(version{txIndex: 1}, v.reads, v.writes)
+	require.True(t, mv.validateReadSet(1))
+
+	mv.convertWritesToEstimates(0)
+	require.False(t, mv.validateReadSet(1))
+
+	v = newView(mv, 1)
+	require.PanicsWithValue(t, abort{blockingTxIndex: 0}, func() { v.GetKVStore(storeKeyA).Get(workloadKey(1)) })
+	require.Equal(t, 0, v.blockingTxIndex())
+	// an incarnation recovering from the abort is aborted again
+	require.Panics(t, func() { v.GetKVStore(storeKeyA).Get(workloadKey(2)) })
+}
@@ -0,0 +1,404 @@
+package blockstm
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// version identifies the incarnation of a transaction that wrote a value.
+type version struct {
+	txIndex     int
+	incarnation int
+}
+
+// baseVersion is the version of the values read from the base store, i.e. not
+// written by any transaction of the block.
+var baseVersion = version{txIndex: -1}
+
+// entry is a value of a key written by an incarnation of a transaction. A nil
+// value is a deletion. An estimate marks the value of an aborted incarnation,
+// which is likely to be written again by the next incarnation.
+type entry struct {
+	version
+	value    []byte
+	estimate bool
+}
+
+// mvStore holds the values written by the transactions of the block to a
+// store, by key and sorted by transaction index.
+type mvStore struct {
+	mtx  sync.RWMutex
+	keys map[string][]entry
+}
+
+func newMVStore() *mvStore {
+	return &mvStore{keys: map[string][]entry{}}
+}
+
+// read returns the entry of the key written by the highest transaction lower
+// than txIndex.
+func (s *mvStore) read(key string, txIndex int) (entry, bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return latestEntry(s.keys[key], txIndex)
+}
+
+func (s *mvStore) write(key string, e entry) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	entries := s.keys[key]
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].txIndex >= e.txIndex })
+	if i < len(entries) && entries[i].txIndex == e.txIndex {
+		entries[i] = e
+		return
+	}
+	entries = append(entries, entry{})
+	copy(entries[i+1:], entries[i:])
+	entries[i] = e
+	s.keys[key] = entries
+}
+
+func (s *mvStore) remove(key string, txIndex int) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	entries := s.keys[key]
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].txIndex >= txIndex })
+	if i == len(entries) || entries[i].txIndex != txIndex {
+		return
+	}
+	if len(entries) == 1 {
+		delete(s.keys, key)
+		return
+	}
+	s.keys[key] = append(entries[:i], entries[i+1:]...)
+}
+
+func (s *mvStore) markEstimate(key string, txIndex int) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	entries := s.keys[key]
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].txIndex >= txIndex })
+	if i < len(entries) && entries[i].txIndex == txIndex {
+		entries[i].estimate = true
+	}
+}
+
+// snapshot returns the keys of the domain written by transactions lower than
+// txIndex, with their latest entry, in iteration order.
+func (s *mvStore) snapshot(start, end []byte, txIndex int, ascending bool) []keyEntry {
+	s.mtx.RLock()
+	var items []keyEntry
+	for key, entries := range s.keys {
+		if !inDomain([]byte(key), start, end) {
+			continue
+		}
+		if e, ok := latestEntry(entries, txIndex); ok {
+			items = append(items, keyEntry{key: []byte(key), entry: e})
+		}
+	}
+	s.mtx.RUnlock()
+
+	sort.Slice(items, func(i, j int) bool {
+		if ascending {
+			return bytes.Compare(items[i].key, items[j].key) < 0
+		}
+		return bytes.Compare(items[i].key, items[j].key) > 0
+	})
+	return items
+}
+
+type keyEntry struct {
+	key []byte
+	entry
+}
+
+func latestEntry(entries []entry, txIndex int) (entry, bool) {
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].txIndex >= txIndex })
+	if i == 0 {
+		return entry{}, false
+	}
+	return entries[i-1], true
+}
+
+func inDomain(key, start, end []byte) bool {
+	return (start == nil || bytes.Compare(key, start) >= 0) && (end == nil || bytes.Compare(key, end) < 0)
+}
+
+// writeSet maps the keys written by an incarnation to their values, by store.
+// A nil value is a deletion.
+type writeSet map[storetypes.StoreKey]map[string][]byte
+
+// readSet holds the reads and iterations of an incarnation, to validate that
+// it observed the same values as it would have by executing sequentially.
+type readSet struct {
+	reads      []readDescriptor
+	iterations []*iterationDescriptor
+}
+
+type readDescriptor struct {
+	storeKey storetypes.StoreKey
+	key      string
+	version  version
+}
+
+// iterationDescriptor records the keys an iterator loaded from the
+// multi-version memory and the base store, and whether it was exhausted.
+type iterationDescriptor struct {
+	storeKey   storetypes.StoreKey
+	start, end []byte
+	ascending  bool
+	keys       []iteratedKey
+	exhausted  bool
+}
+
+type iteratedKey struct {
+	key     []byte
+	version version
+}
+
+// txMemory is the last read and write sets recorded for a transaction.
+type txMemory struct {
+	reads  *readSet
+	writes writeSet
+}
+
+// MVMemory is the multi-version memory of a block, holding the values written
+// by the transactions on top of the base store.
+type MVMemory struct {
+	base storetypes.MultiStore
+
+	mtx    sync.RWMutex
+	stores map[storetypes.StoreKey]*mvStore
+
+	txs []atomic.Pointer[txMemory]
+}
+
+func newMVMemory(blockSize int, base storetypes.MultiStore) *MVMemory {
+	return &MVMemory{
+		base:   base,
+		stores: map[storetypes.StoreKey]*mvStore{},
+		txs:    make([]atomic.Pointer[txMemory], blockSize),
+	}
+}
+
+func (m *MVMemory) store(storeKey storetypes.StoreKey) *mvStore {
+	m.mtx.RLock()
+	s, ok := m.stores[storeKey]
+	m.mtx.RUnlock()
+	if ok {
+		return s
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if s, ok = m.stores[storeKey]; !ok {
+		s = newMVStore()
+		m.stores[storeKey] = s
+	}
+	return s
+}
+
+// record sets the read and write sets of an incarnation, replacing the ones of
+// the previous incarnation of the transaction. It returns whether the
+// incarnation wrote a key the previous one didn't.
+func (m *MVMemory) record(v version, reads *readSet, writes writeSet) bool {
+	for storeKey, values := range writes {
+		s := m.store(storeKey)
+		for key, value := range values {
+			s.write(key, entry{version: v, value: value})
+		}
+	}
+
+	wroteNewKey := false
+	prev := m.txs[v.txIndex].Load()
+	for storeKey, values := range writes {
+		for key := range values {
+			if prev == nil || !prev.wrote(storeKey, key) {
+				wroteNewKey = true
+			}
+		}
+	}
+	if prev != nil {
+		for storeKey, values := range prev.writes {
+			for key := range values {
+				if _, ok := writes[storeKey][key]; !ok {
+					m.store(storeKey).remove(key, v.txIndex)
+				}
+			}
+		}
+	}
+
+	m.txs[v.txIndex].Store(&txMemory{reads: reads, writes: writes})
+	return wroteNewKey
+}
+
+func (t *txMemory) wrote(storeKey storetypes.StoreKey, key string) bool {
+	_, ok := t.writes[storeKey][key]
+	return ok
+}
+
+// convertWritesToEstimates marks the values written by the last incarnation of
+// the transaction as estimates, so that higher transactions reading them wait
+// for its next incarnation.
+func (m *MVMemory) convertWritesToEstimates(txIndex int) {
+	tx := m.txs[txIndex].Load()
+	if tx == nil {
+		return
+	}
+	for storeKey, values := range tx.writes {
+		s := m.store(storeKey)
+		for key := range values {
+			s.markEstimate(key, txIndex)
+		}
+	}
+}
+
+// validateReadSet checks that the last incarnation of the transaction would
+// read the same values if executed now.
+func (m *MVMemory) validateReadSet(txIndex int) bool {
+	tx := m.txs[txIndex].Load()
+	if tx == nil {
+		return true
+	}
+
+	for _, read := range tx.reads.reads {
+		e, ok := m.store(read.storeKey).read(read.key, txIndex)
+		switch {
+		case !ok:
+			if read.version != baseVersion {
+				return false
+			}
+		case e.estimate || e.version != read.version:
+			return false
+		}
+	}
+
+	for _, it := range tx.reads.iterations {
+		if !m.validateIteration(txIndex, it) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *MVMemory) validateIteration(txIndex int, desc *iterationDescriptor) bool {
+	it := m.iterator(desc.storeKey, desc.start, desc.end, desc.ascending, txIndex)
+	defer it.close()
+
+	for _, expected := range desc.keys {
+		item, ok := it.next()
+		if !ok || item.estimate || item.version != expected.version || !bytes.Equal(item.key, expected.key) {
+			return false
+		}
+	}
+	if desc.exhausted {
+		if _, ok := it.next(); ok {
+			return false
+		}
+	}
+	return true
+}
+
+// iterator returns an iterator over the domain of the store as observed by the
+// transaction, merging the values written by the lower transactions over the
+// base store.
+func (m *MVMemory) iterator(storeKey storetypes.StoreKey, start, end []byte, ascending bool, txIndex int) *mvIterator {
+	var base storetypes.Iterator
+	if ascending {
+		base = m.base.GetKVStore(storeKey).Iterator(start, end)
+	} else {
+		base = m.base.GetKVStore(storeKey).ReverseIterator(start, end)
+	}
+	return &mvIterator{
+		base:      base,
+		written:   m.store(storeKey).snapshot(start, end, txIndex, ascending),
+		ascending: ascending,
+	}
+}
+
+// Write writes the values written by the final incarnations of the
+// transactions to the base store, in transaction order.
+func (m *MVMemory) Write() {
+	for i := range m.txs {
+		tx := m.txs[i].Load()
+		if tx == nil {
+			continue
+		}
+		for storeKey, values := range tx.writes {
+			store := m.base.GetKVStore(storeKey)
+			keys := make([]string, 0, len(values))
+			for key := range values {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				if value := values[key]; value != nil {
+					store.Set([]byte(key), value)
+				} else {
+					store.Delete([]byte(key))
+				}
+			}
+		}
+	}
+}
+
+// mvIterator merges the entries written by the transactions over a base store
+// iterator. Deleted keys are skipped, estimates are returned for the caller to
+// handle the dependency.
+type mvIterator struct {
+	base      storetypes.Iterator
+	written   []keyEntry
+	ascending bool
+}
+
+type iteratedItem struct {
+	key   []byte
+	value []byte
+	entry
+}
+
+func (it *mvIterator) next() (iteratedItem, bool) {
+	for {
+		baseValid := it.base.Valid()
+		if !baseValid && len(it.written) == 0 {
+			return iteratedItem{}, false
+		}
+
+		if len(it.written) > 0 {
+			w := it.written[0]
+			cmp := -1
+			if baseValid {
+				cmp = bytes.Compare(w.key, it.base.Key())
+				if !it.ascending {
+					cmp = -cmp
+				}
+			}
+			if cmp <= 0 {
+				it.written = it.written[1:]
+				if cmp == 0 {
+					it.base.Next()
+				}
+				if w.value == nil && !w.estimate {
+					continue
+				}
+				return iteratedItem{key: w.key, value: w.value, entry: w.entry}, true
+			}
+		}
+
+		item := iteratedItem{key: it.base.Key(), value: it.base.Value(), entry: entry{version: baseVersion}}
+		it.base.Next()
+		return item, true
+	}
+}
+
+func (it *mvIterator) close() {
+	_ = it.base.Close()
+}
@@ -0,0 +1,264 @@
+package blockstm
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type txStatus int
+
+const (
+	statusReadyToExecute txStatus = iota
+	statusExecuting
+	statusExecuted
+	statusAborting
+)
+
+type taskKind int
+
+const (
+	taskNone taskKind = iota
+	taskExecution
+	taskValidation
+)
+
+// txState is the incarnation and execution status of a transaction.
+type txState struct {
+	mtx         sync.Mutex
+	incarnation int
+	status      txStatus
+}
+
+// scheduler is the collaborative scheduler of Block-STM, handing out execution
+// and validation tasks to the workers in transaction order. A validation task
+// that fails aborts the incarnation and lowers the validation index, so that
+// the higher transactions are validated again.
+type scheduler struct {
+	blockSize int
+
+	executionIdx   atomic.Int64
+	validationIdx  atomic.Int64
+	decreaseCnt    atomic.Int64
+	numActiveTasks atomic.Int64
+	doneMarker     atomic.Bool
+
+	states []txState
+
+	// dependencies holds, for every transaction, the transactions waiting for
+	// its next incarnation.
+	depMtx       []sync.Mutex
+	dependencies [][]int
+}
+
+func newScheduler(blockSize int) *scheduler {
+	return &scheduler{
+		blockSize:    blockSize,
+		states:       make([]txState, blockSize),
+		depMtx:       make([]sync.Mutex, blockSize),
+		dependencies: make([][]int, blockSize),
+	}
+}
+
+func (s *scheduler) done() bool {
+	return s.doneMarker.Load()
+}
+
+// checkDone marks the scheduler as done once all transactions are executed and
+// validated and no worker holds a task.
+func (s *scheduler) checkDone() {
+	observedCnt := s.decreaseCnt.Load()
+	n := int64(s.blockSize)
+	if min(s.executionIdx.Load(), s.validationIdx.Load()) >= n &&
+		s.numActiveTasks.Load() == 0 &&
+		observedCnt == s.decreaseCnt.Load() {
+		s.doneMarker.Store(true)
+	}
+}
+
+func (s *scheduler) decreaseExecutionIdx(target int) {
+	decreaseIdx(&s.executionIdx, int64(target))
+	s.decreaseCnt.Add(1)
+}
+
+func (s *scheduler) decreaseValidationIdx(target int) {
+	decreaseIdx(&s.validationIdx, int64(target))
+	s.decreaseCnt.Add(1)
+}
+
+func decreaseIdx(idx *atomic.Int64, target int64) {
+	for {
+		current := idx.Load()
+		if current <= target || idx.CompareAndSwap(current, target) {
+			return
+		}
+	}
+}
+
+// tryIncarnate moves a transaction ready to execute to executing.
+func (s *scheduler) tryIncarnate(txIndex int) (version, bool) {
+	if txIndex >= s.blockSize {
+		return version{}, false
+	}
+
+	state := &s.states[txIndex]
+	state.mtx.Lock()
+	defer state.mtx.Unlock()
+	if state.status != statusReadyToExecute {
+		return version{}, false
+	}
+	state.status = statusExecuting
+	return version{txIndex: txIndex, incarnation: state.incarnation}, true
+}
+
+func (s *scheduler) nextVersionToExecute() (version, bool) {
+	if s.executionIdx.Load() >= int64(s.blockSize) {
+		s.checkDone()
+		return version{}, false
+	}
+
+	s.numActiveTasks.Add(1)
+	txIndex := int(s.executionIdx.Add(1) - 1)
+	if v, ok := s.tryIncarnate(txIndex); ok {
+		return v, true
+	}
+	s.numActiveTasks.Add(-1)
+	return version{}, false
+}
+
+func (s *scheduler) nextVersionToValidate() (version, bool) {
+	if s.validationIdx.Load() >= int64(s.blockSize) {
+		s.checkDone()
+		return version{}, false
+	}
+
+	s.numActiveTasks.Add(1)
+	txIndex := int(s.validationIdx.Add(1) - 1)
+	if txIndex < s.blockSize {
+		state := &s.states[txIndex]
+		state.mtx.Lock()
+		incarnation, status := state.incarnation, state.status
+		state.mtx.Unlock()
+		if status == statusExecuted {
+			return version{txIndex: txIndex, incarnation: incarnation}, true
+		}
+	}
+	s.numActiveTasks.Add(-1)
+	return version{}, false
+}
+
+// nextTask returns the next task, validations taking precedence over the
+// executions of higher transactions.
+func (s *scheduler) nextTask() (taskKind, version) {
+	if s.validationIdx.Load() < s.executionIdx.Load() {
+		if v, ok := s.nextVersionToValidate(); ok {
+			return taskValidation, v
+		}
+		return taskNone, version{}
+	}
+	if v, ok := s.nextVersionToExecute(); ok {
+		return taskExecution, v
+	}
+	return taskNone, version{}
+}
+
+// addDependency suspends the execution of a transaction until the next
+// incarnation of the blocking transaction is executed. It returns false if that
+// already happened, in which case the execution must be retried.
+func (s *scheduler) addDependency(txIndex, blockingTxIndex int) bool {
+	s.depMtx[blockingTxIndex].Lock()
+	defer s.depMtx[blockingTxIndex].Unlock()
+
+	blocking := &s.states[blockingTxIndex]
+	blocking.mtx.Lock()
+	executed := blocking.status == statusExecuted
+	blocking.mtx.Unlock()
+	if executed {
+		return false
+	}
+
+	state := &s.states[txIndex]
+	state.mtx.Lock()
+	state.status = statusAborting
+	state.mtx.Unlock()
+
+	s.dependencies[blockingTxIndex] = append(s.dependencies[blockingTxIndex], txIndex)
+	s.numActiveTasks.Add(-1)
+	return true
+}
+
+func (s *scheduler) setReadyStatus(txIndex int) {
+	state := &s.states[txIndex]
+	state.mtx.Lock()
+	defer state.mtx.Unlock()
+
+	state.incarnation++
+	state.status = statusReadyToExecute
+}
+
+func (s *scheduler) resumeDependencies(dependencies []int) {
+	if len(dependencies) == 0 {
+		return
+	}
+
+	minDependency := dependencies[0]
+	for _, txIndex := range dependencies {
+		s.setReadyStatus(txIndex)
+		minDependency = min(minDependency, txIndex)
+	}
+	s.decreaseExecutionIdx(minDependency)
+}
+
+// finishExecution marks the incarnation as executed and resumes the
+// transactions waiting for it. The incarnation is validated right away unless
+// the validation index is still lower than the transaction; if it wrote a new
+// key, the higher transactions are validated again as well.
+func (s *scheduler) finishExecution(v version, wroteNewKey bool) (taskKind, version) {
+	state := &s.states[v.txIndex]
+	state.mtx.Lock()
+	state.status = statusExecuted
+	state.mtx.Unlock()
+
+	s.depMtx[v.txIndex].Lock()
+	dependencies := s.dependencies[v.txIndex]
+	s.dependencies[v.txIndex] = nil
+	s.depMtx[v.txIndex].Unlock()
+	s.resumeDependencies(dependencies)
+
+	if s.validationIdx.Load() > int64(v.txIndex) {
+		if !wroteNewKey {
+			return taskValidation, v
+		}
+		s.decreaseValidationIdx(v.txIndex)
+	}
+	s.numActiveTasks.Add(-1)
+	return taskNone, version{}
+}
+
+// tryValidationAbort aborts the incarnation if it is still the executed one.
+func (s *scheduler) tryValidationAbort(v version) bool {
+	state := &s.states[v.txIndex]
+	state.mtx.Lock()
+	defer state.mtx.Unlock()
+
+	if state.incarnation == v.incarnation && state.status == statusExecuted {
+		state.status = statusAborting
+		return true
+	}
+	return false
+}
+
+// finishValidation schedules the next incarnation of an aborted transaction
+// and the validation of the higher transactions.
+func (s *scheduler) finishValidation(txIndex int, aborted bool) (taskKind, version) {
+	if aborted {
+		s.setReadyStatus(txIndex)
+		s.decreaseValidationIdx(txIndex + 1)
+		if s.executionIdx.Load() > int64(txIndex) {
+			if v, ok := s.tryIncarnate(txIndex); ok {
+				return taskExecution, v
+			}
+		}
+	}
+	s.numActiveTasks.Add(-1)
+	return taskNone, version{}
+}
@@ -0,0 +1,428 @@
+package blockstm
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"cosmossdk.io/store/cachekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// abort is the panic value of an incarnation reading a value estimated to be
+// written again by a lower transaction.
+type abort struct {
+	blockingTxIndex int
+}
+
+// IsAbort returns whether the recovered panic value aborts an incarnation, in
+// which case it must be propagated for the executor to suspend the
+// transaction.
+func IsAbort(r any) bool {
+	_, ok := r.(abort)
+	return ok
+}
+
+// view is the multistore of an incarnation. It reads the values written by the
+// lower transactions from the multi-version memory, falling back to the base
+// store, and records the read and write sets of the incarnation.
+type view struct {
+	mv      *MVMemory
+	txIndex int
+
+	stores  map[storetypes.StoreKey]*kvView
+	reads   *readSet
+	writes  writeSet
+	blocked int
+}
+
+var _ storetypes.MultiStore = (*view)(nil)
+
+func newView(mv *MVMemory, txIndex int) *view {
+	return &view{
+		mv:      mv,
+		txIndex: txIndex,
+		stores:  map[storetypes.StoreKey]*kvView{},
+		reads:   &readSet{},
+		writes:  writeSet{},
+		blocked: -1,
+	}
+}
+
+// blockingTxIndex returns the transaction whose estimate the incarnation read,
+// or -1 if it read none.
+func (v *view) blockingTxIndex() int {
+	return v.blocked
+}
+
+func (v *view) abort(blockingTxIndex int) {
+	v.blocked = blockingTxIndex
+	panic(abort{blockingTxIndex: blockingTxIndex})
+}
+
+// checkBlocked aborts again an incarnation that recovered from its abort.
+func (v *view) checkBlocked() {
+	if v.blocked >= 0 {
+		panic(abort{blockingTxIndex: v.blocked})
+	}
+}
+
+func (v *view) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+func (v *view) CacheWrap() storetypes.CacheWrap {
+	return v.CacheMultiStore().(storetypes.CacheWrap)
+}
+
+func (v *view) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return v.CacheWrap()
+}
+
+func (v *view) CacheMultiStore() storetypes.CacheMultiStore {
+	return newCacheMultiStore(v)
+}
+
+func (v *view) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	panic("cannot branch the multistore of a parallel tx execution with a version")
+}
+
+func (v *view) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return v.GetKVStore(key)
+}
+
+func (v *view) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	s, ok := v.stores[key]
+	if !ok {
+		s = &kvView{view: v, storeKey: key, mv: v.mv.store(key)}
+		v.stores[key] = s
+	}
+	return s
+}
+
+func (v *view) TracingEnabled() bool {
+	return false
+}
+
+func (v *view) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return v
+}
+
+func (v *view) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return v
+}
+
+func (v *view) LatestVersion() int64 {
+	return v.mv.base.LatestVersion()
+}
+
+// kvView is the store of a view.
+type kvView struct {
+	view     *view
+	storeKey storetypes.StoreKey
+	mv       *mvStore
+}
+
+var _ storetypes.KVStore = (*kvView)(nil)
+
+func (s *kvView) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeIAVL
+}
+
+func (s *kvView) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *kvView) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return s.CacheWrap()
+}
+
+func (s *kvView) Get(key []byte) []byte {
+	storetypes.AssertValidKey(key)
+	s.view.checkBlocked()
+
+	if value, ok := s.view.writes[s.storeKey][string(key)]; ok {
+		return value
+	}
+
+	e, ok := s.mv.read(string(key), s.view.txIndex)
+	if !ok {
+		s.recordRead(key, baseVersion)
+		return s.view.mv.base.GetKVStore(s.storeKey).Get(key)
+	}
+	if e.estimate {
+		s.view.abort(e.txIndex)
+	}
+	s.recordRead(key, e.version)
+	return e.value
+}
+
+func (s *kvView) recordRead(key []byte, v version) {
+	s.view.reads.reads = append(s.view.reads.reads, readDescriptor{storeKey: s.storeKey, key: string(key), version: v})
+}
+
+func (s *kvView) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+func (s *kvView) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+	s.write(key, value)
+}
+
+func (s *kvView) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+	s.write(key, nil)
+}
+
+func (s *kvView) write(key, value []byte) {
+	s.view.checkBlocked()
+
+	values, ok := s.view.writes[s.storeKey]
+	if !ok {
+		values = map[string][]byte{}
+		s.view.writes[s.storeKey] = values
+	}
+	values[string(key)] = value
+}
+
+func (s *kvView) Iterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, true)
+}
+
+func (s *kvView) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, false)
+}
+
+func (s *kvView) iterator(start, end []byte, ascending bool) storetypes.Iterator {
+	s.view.checkBlocked()
+
+	desc := &iterationDescriptor{
+		storeKey:  s.storeKey,
+		start:     bytes.Clone(start),
+		end:       bytes.Clone(end),
+		ascending: ascending,
+	}
+	s.view.reads.iterations = append(s.view.reads.iterations, desc)
+
+	var written []keyValue
+	for key, value := range s.view.writes[s.storeKey] {
+		if inDomain([]byte(key), start, end) {
+			written = append(written, keyValue{key: []byte(key), value: value})
+		}
+	}
+	sort.Slice(written, func(i, j int) bool {
+		if ascending {
+			return bytes.Compare(written[i].key, written[j].key) < 0
+		}
+		return bytes.Compare(written[i].key, written[j].key) > 0
+	})
+
+	it := &viewIterator{
+		view:      s.view,
+		parent:    s.view.mv.iterator(s.storeKey, start, end, ascending, s.view.txIndex),
+		desc:      desc,
+		written:   written,
+		ascending: ascending,
+		start:     start,
+		end:       end,
+	}
+	it.loadParent()
+	it.skipDeleted()
+	return it
+}
+
+type keyValue struct {
+	key, value []byte
+}
+
+// viewIterator merges the keys written by the incarnation over the keys of the
+// lower transactions and the base store, recording the latter in the iteration
+// descriptor.
+type viewIterator struct {
+	view       *view
+	parent     *mvIterator
+	desc       *iterationDescriptor
+	written    []keyValue
+	ascending  bool
+	start, end []byte
+
+	parentItem  iteratedItem
+	parentValid bool
+}
+
+var _ storetypes.Iterator = (*viewIterator)(nil)
+
+func (it *viewIterator) loadParent() {
+	item, ok := it.parent.next()
+	if !ok {
+		it.parentValid = false
+		it.desc.exhausted = true
+		return
+	}
+	if item.estimate {
+		it.view.abort(item.txIndex)
+	}
+	item.key = bytes.Clone(item.key)
+	it.desc.keys = append(it.desc.keys, iteratedKey{key: item.key, version: item.version})
+	it.parentItem, it.parentValid = item, true
+}
+
+// compare returns whether the current key is the parent one (< 0), the written
+// one (> 0) or both (0).
+func (it *viewIterator) compare() int {
+	switch {
+	case len(it.written) == 0:
+		return -1
+	case !it.parentValid:
+		return 1
+	}
+	cmp := bytes.Compare(it.parentItem.key, it.written[0].key)
+	if !it.ascending {
+		cmp = -cmp
+	}
+	return cmp
+}
+
+// skipDeleted advances over the keys deleted by the incarnation.
+func (it *viewIterator) skipDeleted() {
+	for len(it.written) > 0 && it.written[0].value == nil {
+		cmp := it.compare()
+		if cmp < 0 {
+			return
+		}
+		it.written = it.written[1:]
+		if cmp == 0 {
+			it.loadParent()
+		}
+	}
+}
+
+func (it *viewIterator) Domain() (start, end []byte) {
+	return it.start, it.end
+}
+
+func (it *viewIterator) Valid() bool {
+	return it.parentValid || len(it.written) > 0
+}
+
+func (it *viewIterator) assertValid() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+}
+
+func (it *viewIterator) Next() {
+	it.assertValid()
+	it.view.checkBlocked()
+
+	switch cmp := it.compare(); {
+	case cmp < 0:
+		it.loadParent()
+	case cmp > 0:
+		it.written = it.written[1:]
+	default:
+		it.written = it.written[1:]
+		it.loadParent()
+	}
+	it.skipDeleted()
+}
+
+func (it *viewIterator) Key() []byte {
+	it.assertValid()
+	if it.compare() < 0 {
+		return it.parentItem.key
+	}
+	return it.written[0].key
+}
+
+func (it *viewIterator) Value() []byte {
+	it.assertValid()
+	if it.compare() < 0 {
+		return it.parentItem.value
+	}
+	return it.written[0].value
+}
+
+func (it *viewIterator) Error() error {
+	return nil
+}
+
+func (it *viewIterator) Close() error {
+	it.parent.close()
+	return nil
+}
+
+// cacheMultiStore branches a multistore, wrapping its stores in cache stores
+// as they are used.
+type cacheMultiStore struct {
+	parent storetypes.MultiStore
+	stores map[storetypes.StoreKey]storetypes.CacheWrap
+}
+
+var _ storetypes.CacheMultiStore = (*cacheMultiStore)(nil)
+
+func newCacheMultiStore(parent storetypes.MultiStore) *cacheMultiStore {
+	return &cacheMultiStore{parent: parent, stores: map[storetypes.StoreKey]storetypes.CacheWrap{}}
+}
+
+func (cms *cacheMultiStore) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+func (cms *cacheMultiStore) CacheWrap() storetypes.CacheWrap {
+	return cms.CacheMultiStore().(storetypes.CacheWrap)
+}
+
+func (cms *cacheMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return cms.CacheWrap()
+}
+
+func (cms *cacheMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newCacheMultiStore(cms)
+}
+
+func (cms *cacheMultiStore) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	panic("cannot branch cached multi-store with a version")
+}
+
+func (cms *cacheMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return cms.GetKVStore(key)
+}
+
+func (cms *cacheMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, ok := cms.stores[key]
+	if !ok {
+		store = cachekv.NewStore(cms.parent.GetKVStore(key))
+		cms.stores[key] = store
+	}
+	kvStore, ok := store.(storetypes.KVStore)
+	if !ok {
+		panic(fmt.Sprintf("store with key %v is not a KVStore", key))
+	}
+	return kvStore
+}
+
+func (cms *cacheMultiStore) Write() {
+	for _, store := range cms.stores {
+		store.Write()
+	}
+}
+
+func (cms *cacheMultiStore) TracingEnabled() bool {
+	return false
+}
+
+func (cms *cacheMultiStore) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return cms
+}
+
+func (cms *cacheMultiStore) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return cms
+}
+
+func (cms *cacheMultiStore) LatestVersion() int64 {
+	return cms.parent.LatestVersion()
+}
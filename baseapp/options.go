@@ -129,6 +129,21 @@ func DisableBlockGasMeter() func(*BaseApp) {
 	return func(app *BaseApp) { app.SetDisableBlockGasMeter(true) }
 }
 
+// SetParallelTxExecution executes the txs of the finalized blocks in parallel
+// with the given number of workers, with the same results as if executed
+// sequentially. The txs are executed sequentially if lower than 2.
+//
+// The txs are still executed sequentially if store tracing is enabled. Txs
+// writing the same keys, e.g. fees accumulated in the fee collector balance or
+// in a fee market state, are executed one after the other, so the speedup
+// depends on how many of them the txs of the block share.
+//
+// NOTE: A tx may be executed several times, so the mempool must be safe for
+// concurrent use and the modules must not keep state outside of the stores.
+func SetParallelTxExecution(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetParallelTxExecution(workers) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -394,6 +409,16 @@ func (app *BaseApp) SetDisableBlockGasMeter(disableBlockGasMeter bool) {
 	app.disableBlockGasMeter = disableBlockGasMeter
 }
 
+// SetParallelTxExecution sets the number of workers executing the txs of the
+// finalized blocks in parallel.
+func (app *BaseApp) SetParallelTxExecution(workers int) {
+	if app.sealed {
+		panic("SetParallelTxExecution() on sealed BaseApp")
+	}
+
+	app.parallelTxWorkers = workers
+}
+
 // SetMsgServiceRouter sets the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) SetMsgServiceRouter(msgServiceRouter *MsgServiceRouter) {
 	app.msgServiceRouter = msgServiceRouter
@@ -0,0 +1,153 @@
+package baseapp
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/internal/blockstm"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// executeTxs executes the raw transactions of the block proposal, gathering
+// the execution results. The transactions are executed in parallel if enabled
+// with SetParallelTxExecution, with the same results as if executed
+// sequentially. They are executed sequentially if store tracing is enabled, as
+// the transaction views do not trace their reads and writes.
+//
+// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
+// vote extensions, so skip those.
+func (app *BaseApp) executeTxs(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	if app.parallelTxWorkers > 1 && len(txs) > 1 && !app.finalizeBlockState.ms.TracingEnabled() {
+		return app.executeTxsParallel(ctx, txs)
+	}
+	return app.executeTxsSequential(ctx, txs)
+}
+
+func (app *BaseApp) executeTxsSequential(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for _, rawTx := range txs {
+		var response *abci.ExecTxResult
+
+		if _, err := app.txDecoder(rawTx); err == nil {
+			response = app.deliverTx(rawTx)
+		} else {
+			response = txDecodeErrorResult()
+		}
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+
+		txResults = append(txResults, response)
+	}
+
+	return txResults, nil
+}
+
+// txDecodeErrorResult is the result of a malformed transaction included in a
+// block proposal. We still want to return a default response to comet as it
+// expects a response for each transaction included in a block proposal.
+func txDecodeErrorResult() *abci.ExecTxResult {
+	return sdkerrors.ResponseExecTxResultWithEvents(
+		sdkerrors.ErrTxDecode,
+		0,
+		0,
+		nil,
+		false,
+	)
+}
+
+// executeTxsParallel executes the transactions with Block-STM: every
+// transaction runs on a view of the finalize block state holding the writes of
+// the lower transactions, and is executed again until its reads are consistent
+// with a sequential execution. The writes are only applied to the finalize
+// block state once all the transactions are executed.
+//
+// Each transaction consumes a block gas meter of its own, the gas is consumed
+// on the block gas meter afterwards in transaction order. If the block runs
+// out of gas, which changes the result of the transactions from there on, the
+// block is executed sequentially instead.
+func (app *BaseApp) executeTxsParallel(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	blockCtx := app.finalizeBlockState.Context()
+
+	decodedTxs := make([]sdk.Tx, len(txs))
+	for i, rawTx := range txs {
+		if tx, err := app.txDecoder(rawTx); err == nil {
+			decodedTxs[i] = tx
+		}
+	}
+
+	var (
+		txResults = make([]*abci.ExecTxResult, len(txs))
+		gasInfos  = make([]sdk.GasInfo, len(txs))
+		txErrs    = make([]error, len(txs))
+		blockGas  = make([]uint64, len(txs))
+	)
+	execute := func(txIndex int, ms storetypes.MultiStore) {
+		if decodedTxs[txIndex] == nil {
+			txResults[txIndex] = txDecodeErrorResult()
+			return
+		}
+
+		blockGasMeter := storetypes.NewInfiniteGasMeter()
+		txCtx := app.txContext(
+			blockCtx.
+				WithMultiStore(ms).
+				WithBlockGasMeter(blockGasMeter).
+				WithEventManager(sdk.NewEventManager()),
+			execModeFinalize,
+			txs[txIndex],
+		)
+
+		gInfo, result, anteEvents, err := app.runTxWithContext(txCtx, execModeFinalize, txs[txIndex], decodedTxs[txIndex])
+		txResults[txIndex] = app.execTxResult(gInfo, result, anteEvents, err)
+		gasInfos[txIndex], txErrs[txIndex], blockGas[txIndex] = gInfo, err, blockGasMeter.GasConsumed()
+	}
+
+	mv, err := blockstm.ExecuteBlock(ctx, len(txs), app.parallelTxWorkers, app.finalizeBlockState.ms, execute)
+	if err != nil {
+		return nil, err
+	}
+
+	blockGasMeter := blockCtx.BlockGasMeter()
+	for i := range txs {
+		if decodedTxs[i] != nil && !consumeTxBlockGas(blockGasMeter, blockGas[i]) {
+			app.logger.Info("block out of gas, executing the txs sequentially", "height", blockCtx.BlockHeight(), "tx_index", i)
+			app.finalizeBlockState.SetContext(blockCtx.WithBlockGasMeter(app.getBlockGasMeter(blockCtx)))
+			return app.executeTxsSequential(ctx, txs)
+		}
+	}
+
+	mv.Write()
+	for i := range txs {
+		if decodedTxs[i] != nil {
+			emitTxTelemetry(gasInfos[i], txErrs[i])
+		}
+	}
+
+	return txResults, nil
+}
+
+// consumeTxBlockGas consumes the block gas of a tx, returning false if the
+// block had no gas left for the tx or ran out of gas executing it.
+func consumeTxBlockGas(blockGasMeter storetypes.GasMeter, gas uint64) (ok bool) {
+	if blockGasMeter.IsOutOfGas() {
+		return false
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+	blockGasMeter.ConsumeGas(gas, "block gas meter")
+	return true
+}
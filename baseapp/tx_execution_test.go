@@ -0,0 +1,220 @@
+package baseapp_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const parallelTestNumKeys = 8
+
+func parallelTestKey(i int64) []byte {
+	return []byte(fmt.Sprintf("key/%d", i%parallelTestNumKeys))
+}
+
+// conflictingAnteHandler charges every tx a fee accumulated under the same key,
+// so that all the txs conflict.
+func conflictingAnteHandler(t *testing.T) sdk.AnteHandler {
+	t.Helper()
+
+	return func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+		counter, failOnAnte := parseTxMemo(t, tx)
+		if failOnAnte {
+			return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+		}
+
+		store := ctx.KVStore(capKey2)
+		fees := getIntFromStore(t, store, []byte("fees"))
+		setIntOnStore(store, []byte("fees"), fees+counter)
+		ctx.EventManager().EmitEvent(sdk.NewEvent("fee", sdk.NewAttribute("total", strconv.FormatInt(fees, 10))))
+		return ctx, nil
+	}
+}
+
+// conflictingCounterServer moves, deletes and sums the values of a few keys,
+// failing and consuming gas depending on the values it read.
+func conflictingCounterServer(t *testing.T) mockCounterServer {
+	t.Helper()
+
+	return mockCounterServer{incrementCounterFn: func(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		store := sdkCtx.KVStore(capKey1)
+
+		value := getIntFromStore(t, store, parallelTestKey(msg.Counter))
+		if msg.Counter%5 == 0 {
+			store.Delete(parallelTestKey(msg.Counter))
+		}
+
+		var sum int64
+		it := store.Iterator([]byte("key/"), []byte("key0"))
+		for ; it.Valid(); it.Next() {
+			sum += getIntFromStore(t, store, it.Key())
+		}
+		require.NoError(t, it.Close())
+
+		setIntOnStore(store, parallelTestKey(msg.Counter*3+1), value+sum%100+1)
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent("counter",
+			sdk.NewAttribute("value", strconv.FormatInt(value, 10)),
+			sdk.NewAttribute("sum", strconv.FormatInt(sum, 10)),
+		))
+		sdkCtx.GasMeter().ConsumeGas(uint64(sum%50), "counter")
+
+		if (value+sum)%7 == 0 {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+		}
+		return &baseapptestutil.MsgCreateCounterResponse{}, nil
+	}}
+}
+
+func TestParallelTxExecutionMatchesSequential(t *testing.T) {
+	testCases := []struct {
+		name   string
+		maxGas int64
+	}{
+		{"unlimited block gas", 0},
+		// the blocks run out of gas, so they are executed sequentially again
+		{"block out of gas", 150_000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// generate the blocks once, the txs are signed by random accounts
+			txConfig := NewBaseAppSuite(t).txConfig
+			r := rand.New(rand.NewSource(1))
+			blocks := make([][][]byte, 5)
+			for i := range blocks {
+				for j := 0; j < 40; j++ {
+					msgCounters := make([]int64, 1+r.Intn(3))
+					for k := range msgCounters {
+						msgCounters[k] = r.Int63n(100)
+					}
+					tx := newTxCounter(t, txConfig, r.Int63n(10), msgCounters...)
+					if r.Intn(10) == 0 {
+						tx = setFailOnAnte(t, txConfig, tx, true)
+					}
+
+					txBytes, err := txConfig.TxEncoder()(tx)
+					require.NoError(t, err)
+					blocks[i] = append(blocks[i], txBytes)
+				}
+				blocks[i] = append(blocks[i], []byte("not a tx"))
+			}
+
+			executeBlocks := func(workers int) []*abci.ResponseFinalizeBlock {
+				suite := NewBaseAppSuite(t,
+					baseapp.SetParallelTxExecution(workers),
+					func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(conflictingAnteHandler(t)) },
+				)
+				baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), conflictingCounterServer(t))
+
+				_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+					ConsensusParams: &cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: tc.maxGas}},
+				})
+				require.NoError(t, err)
+
+				var responses []*abci.ResponseFinalizeBlock
+				for i, txs := range blocks {
+					res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: int64(i) + 1, Txs: txs})
+					require.NoError(t, err)
+					_, err = suite.baseApp.Commit()
+					require.NoError(t, err)
+					responses = append(responses, res)
+				}
+				return responses
+			}
+
+			expected := executeBlocks(0)
+			if tc.maxGas > 0 {
+				require.Equal(t, sdkerrors.ErrOutOfGas.ABCICode(), expected[0].TxResults[len(blocks[0])-2].Code)
+			}
+
+			for _, workers := range []int{2, 4, 8} {
+				require.Equal(t, expected, executeBlocks(workers), "workers %d", workers)
+			}
+		})
+	}
+}
+
+func TestParallelTxExecutionRecoversPanics(t *testing.T) {
+	executeBlock := func(workers int) *abci.ResponseFinalizeBlock {
+		suite := NewBaseAppSuite(t, baseapp.SetParallelTxExecution(workers))
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), mockCounterServer{
+			incrementCounterFn: func(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+				store := sdk.UnwrapSDKContext(ctx).KVStore(capKey1)
+				store.Set(parallelTestKey(msg.Counter), []byte{1})
+				if msg.Counter%2 == 0 {
+					panic("panic in tx execution")
+				}
+				store.Get(parallelTestKey(msg.Counter + 1))
+				return &baseapptestutil.MsgCreateCounterResponse{}, nil
+			},
+		})
+
+		_, err := suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &cmtproto.ConsensusParams{}})
+		require.NoError(t, err)
+
+		var txs [][]byte
+		for i := int64(0); i < 10; i++ {
+			txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, i, i))
+			require.NoError(t, err)
+			txs = append(txs, txBytes)
+		}
+
+		res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: txs})
+		require.NoError(t, err)
+		return res
+	}
+
+	res := executeBlock(4)
+	for i, txResult := range res.TxResults {
+		require.Equal(t, i%2 == 0, txResult.IsErr(), "tx %d", i)
+	}
+	require.Equal(t, executeBlock(0).AppHash, res.AppHash)
+}
+
+func TestParallelTxExecutionWithTracing(t *testing.T) {
+	executeBlock := func(workers int) (*abci.ResponseFinalizeBlock, string) {
+		trace := new(bytes.Buffer)
+		suite := NewBaseAppSuite(t,
+			baseapp.SetParallelTxExecution(workers),
+			func(bapp *baseapp.BaseApp) { bapp.SetCommitMultiStoreTracer(trace) },
+			func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(conflictingAnteHandler(t)) },
+		)
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), conflictingCounterServer(t))
+
+		_, err := suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &cmtproto.ConsensusParams{}})
+		require.NoError(t, err)
+
+		var txs [][]byte
+		for i := int64(0); i < 10; i++ {
+			txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, i, i))
+			require.NoError(t, err)
+			txs = append(txs, txBytes)
+		}
+
+		res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: txs})
+		require.NoError(t, err)
+		return res, trace.String()
+	}
+
+	// the txs are executed sequentially so that every read and write is traced
+	expected, expectedTrace := executeBlock(0)
+	require.NotEmpty(t, expectedTrace)
+	res, trace := executeBlock(4)
+	require.Equal(t, expected, res)
+	require.Equal(t, expectedTrace, trace)
+}
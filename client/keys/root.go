@@ -26,6 +26,9 @@ The keyring supports the following backends:
     pass        Uses the pass command line utility to store and retrieve keys.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+    remote      Delegates listing keys and signing to a remote signer over gRPC with mutual TLS,
+                configured by the config.json file of the keyring-remote directory. See the
+                serve-signer command for a reference remote signer.
 
 kwallet and pass backends depend on external tools. Refer to their respective documentation for more
 information:
@@ -49,6 +52,7 @@ The pass backend requires GnuPG: https://gnupg.org/
 		RenameKeyCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
+		ServeSignerCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagOutput, "text", "Output format (text|json)")
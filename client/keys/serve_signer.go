@@ -0,0 +1,120 @@
+package keys
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const (
+	flagListenAddress = "listen-address"
+	flagCACert        = "ca-cert"
+	flagTLSCert       = "tls-cert"
+	flagTLSKey        = "tls-key"
+	flagPolicies      = "policies"
+)
+
+// ServeSignerCommand serves the keys of the keyring to the remote keyring backend.
+func ServeSignerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve-signer",
+		Short: "Serve the keys of the keyring as a remote signer",
+		Long: `Serve the keys of the keyring as the remote signer of the remote keyring backend,
+over gRPC with mutual TLS. Clients must present a certificate verified by the --ca-cert
+CA certificates. The private keys never leave the keyring, clients can only list the keys
+and sign with them.
+
+The --policies JSON file restricts the transactions signed with a key, by key name:
+
+    {
+      "validator": {
+        "allowed_msg_type_urls": ["/cosmos.staking.v1beta1.MsgDelegate"],
+        "max_fee": [{"denom": "stake", "amount": "1000"}]
+      }
+    }
+
+A key with a policy only signs SIGN_MODE_DIRECT transactions. Keys without a policy
+sign any message.
+`,
+		Args: cobra.NoArgs,
+		RunE: runServeSignerCmd,
+	}
+
+	cmd.Flags().String(flagListenAddress, "localhost:9095", "The address the remote signer listens on")
+	cmd.Flags().String(flagCACert, "", "The PEM file of the CA certificates which verify the client certificates")
+	cmd.Flags().String(flagTLSCert, "", "The PEM file of the certificate of the remote signer")
+	cmd.Flags().String(flagTLSKey, "", "The PEM file of the key of the certificate of the remote signer")
+	cmd.Flags().String(flagPolicies, "", "The JSON file of the policies of the keys")
+	_ = cmd.MarkFlagRequired(flagCACert)
+	_ = cmd.MarkFlagRequired(flagTLSCert)
+	_ = cmd.MarkFlagRequired(flagTLSKey)
+
+	return cmd
+}
+
+func runServeSignerCmd(cmd *cobra.Command, _ []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	if clientCtx.Keyring.Backend() == keyring.BackendRemote {
+		return errors.New("cannot serve the keys of the remote keyring backend")
+	}
+
+	var policies map[string]keyring.SignerPolicy
+	if file, _ := cmd.Flags().GetString(flagPolicies); file != "" {
+		if policies, err = keyring.LoadSignerPolicies(file); err != nil {
+			return err
+		}
+	}
+
+	caCert, _ := cmd.Flags().GetString(flagCACert)
+	tlsCert, _ := cmd.Flags().GetString(flagTLSCert)
+	tlsKey, _ := cmd.Flags().GetString(flagTLSKey)
+	tlsConfig, err := keyring.NewRemoteSignerServerTLSConfig(caCert, tlsCert, tlsKey)
+	if err != nil {
+		return err
+	}
+
+	listenAddr, _ := cmd.Flags().GetString(flagListenAddress)
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return err
+	}
+
+	grpcSrv := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.ForceServerCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
+	)
+	keyring.RegisterRemoteSignerServer(grpcSrv, keyring.NewRemoteSignerServer(clientCtx.Keyring, policies))
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		grpcSrv.GracefulStop()
+	}()
+
+	cmd.Printf("Serving remote signer on %s\n", listener.Addr())
+	if err := grpcSrv.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		return err
+	}
+
+	return nil
+}
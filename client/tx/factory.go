@@ -1,6 +1,7 @@
 package tx
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -22,6 +23,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 )
 
 // Factory defines a client transaction factory that facilitates generating and
@@ -46,6 +48,7 @@ type Factory struct {
 	feeGranter         sdk.AccAddress
 	feePayer           sdk.AccAddress
 	gasPrices          sdk.DecCoins
+	autoGasPrices      bool
 	extOptions         []*codectypes.Any
 	signMode           signing.SignMode
 	simulateAndExecute bool
@@ -74,6 +77,8 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		signMode = signing.SignMode_SIGN_MODE_TEXTUAL
 	case flags.SignModeEIP191:
 		signMode = signing.SignMode_SIGN_MODE_EIP_191
+	case flags.SignModeEIP712:
+		signMode = signing.SignMode_SIGN_MODE_EIP_712
 	}
 
 	var accNum, accSeq uint64
@@ -141,6 +146,7 @@ func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
+func (f Factory) AutoGasPrices() bool                       { return f.autoGasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
 func (f Factory) TimeoutTimestamp() time.Time               { return f.timeoutTimestamp }
@@ -187,13 +193,22 @@ func (f Factory) WithFees(fees string) Factory {
 }
 
 // WithGasPrices returns a copy of the Factory with updated gas prices.
+// The gas prices "auto" are set to the base gas price of the fee market when the
+// Factory is prepared.
 func (f Factory) WithGasPrices(gasPrices string) Factory {
+	if gasPrices == flags.GasFlagAuto {
+		f.gasPrices = nil
+		f.autoGasPrices = true
+		return f
+	}
+
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
 	if err != nil {
 		panic(err)
 	}
 
 	f.gasPrices = parsedGasPrices
+	f.autoGasPrices = false
 	return f
 }
 
@@ -340,6 +355,10 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 
 	fees := f.fees
 
+	if f.autoGasPrices {
+		return nil, errors.New("cannot estimate gas prices in offline mode")
+	}
+
 	if !f.gasPrices.IsZero() {
 		if !fees.IsZero() {
 			return nil, errors.New("cannot provide both fees and gas prices")
@@ -412,6 +431,13 @@ func (f Factory) PrintUnsignedTx(clientCtx client.Context, msgs ...sdk.Msg) erro
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: f.Gas()})
 	}
 
+	if f.autoGasPrices && !clientCtx.Offline {
+		var err error
+		if f, err = f.estimateGasPrices(clientCtx); err != nil {
+			return err
+		}
+	}
+
 	unsignedTx, err := f.BuildUnsignedTx(msgs...)
 	if err != nil {
 		return err
@@ -525,6 +551,13 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 	fc := f
 	from := clientCtx.FromAddress
 
+	if fc.autoGasPrices {
+		var err error
+		if fc, err = fc.estimateGasPrices(clientCtx); err != nil {
+			return fc, err
+		}
+	}
+
 	if err := fc.accountRetriever.EnsureExists(clientCtx, from); err != nil {
 		return fc, err
 	}
@@ -547,3 +580,30 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 
 	return fc, nil
 }
+
+// estimateGasPrices sets the gas prices of the Factory to the base gas price of
+// the fee market, increased by the maximum change of the base gas price in a block
+// so that the transaction is still included if the base gas price rises in the
+// meantime.
+func (f Factory) estimateGasPrices(clientCtx client.Context) (Factory, error) {
+	queryClient := feemarkettypes.NewQueryClient(clientCtx)
+
+	paramsRes, err := queryClient.Params(context.Background(), &feemarkettypes.QueryParamsRequest{})
+	if err != nil {
+		return f, fmt.Errorf("failed to query the fee market params: %w", err)
+	}
+	gasPriceRes, err := queryClient.GasPrice(context.Background(), &feemarkettypes.QueryGasPriceRequest{})
+	if err != nil {
+		return f, fmt.Errorf("failed to query the fee market gas price: %w", err)
+	}
+
+	margin := math.LegacyOneDec()
+	if denom := paramsRes.Params.BaseGasPriceChangeDenominator; denom > 0 {
+		margin = margin.Add(math.LegacyOneDec().QuoInt64(int64(denom)))
+	}
+
+	price := gasPriceRes.Price
+	f.gasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(price.Denom, price.Amount.Mul(margin)))
+	f.autoGasPrices = false
+	return f, nil
+}
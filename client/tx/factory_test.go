@@ -128,3 +128,19 @@ func TestFactory_getSimSignatureData(t *testing.T) {
 		})
 	}
 }
+
+func TestFactoryAutoGasPrices(t *testing.T) {
+	t.Parallel()
+
+	factory := Factory{}.WithGasPrices("auto")
+	require.True(t, factory.AutoGasPrices())
+	require.True(t, factory.GasPrices().IsZero())
+
+	// the gas prices cannot be estimated offline
+	_, err := factory.WithChainID("test").BuildUnsignedTx()
+	require.ErrorContains(t, err, "cannot estimate gas prices in offline mode")
+
+	factory = factory.WithGasPrices("0.1stake")
+	require.False(t, factory.AutoGasPrices())
+	require.Equal(t, "0.100000000000000000stake", factory.GasPrices().String())
+}
@@ -3,6 +3,8 @@ package hd
 import (
 	"github.com/cosmos/go-bip39"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
@@ -15,6 +17,10 @@ const (
 	MultiType = PubKeyType("multi")
 	// Secp256k1Type uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1Type = PubKeyType("secp256k1")
+	// EthSecp256k1Type uses the secp256k1 ECDSA parameters with Ethereum (Keccak256) addresses and signatures.
+	EthSecp256k1Type = PubKeyType("eth_secp256k1")
+	// BLS12381Type uses BLS signatures on the BLS12-381 curve, which can be aggregated.
+	BLS12381Type = PubKeyType("bls12_381")
 	// Ed25519Type represents the Ed25519Type signature system.
 	// It is currently not supported for end-user keys (wallets/ledgers).
 	Ed25519Type = PubKeyType("ed25519")
@@ -25,6 +31,14 @@ const (
 // Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 var Secp256k1 = secp256k1Algo{}
 
+// EthSecp256k1 uses the secp256k1 ECDSA parameters with Ethereum (Keccak256) addresses and signatures.
+// Keys are derived like Secp256k1 keys, Ethereum wallets use the EthFullPath derivation path.
+var EthSecp256k1 = ethSecp256k1Algo{}
+
+// BLS12381 uses BLS signatures on the BLS12-381 curve. Keys are generated with the BLS KeyGen procedure from the
+// secret derived like Secp256k1 keys.
+var BLS12381 = bls12381Algo{}
+
 type (
 	DeriveFn   func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error)
 	GenerateFn func(bz []byte) types.PrivKey
@@ -68,3 +82,42 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type ethSecp256k1Algo struct{}
+
+func (s ethSecp256k1Algo) Name() PubKeyType {
+	return EthSecp256k1Type
+}
+
+// Derive derives and returns the eth_secp256k1 private key for the given seed and HD path.
+func (s ethSecp256k1Algo) Derive() DeriveFn {
+	return Secp256k1.Derive()
+}
+
+// Generate generates an eth_secp256k1 private key from the given bytes.
+func (s ethSecp256k1Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		bzArr := make([]byte, ethsecp256k1.PrivKeySize)
+		copy(bzArr, bz)
+
+		return &ethsecp256k1.PrivKey{Key: bzArr}
+	}
+}
+
+type bls12381Algo struct{}
+
+func (s bls12381Algo) Name() PubKeyType {
+	return BLS12381Type
+}
+
+// Derive derives and returns the secret of the bls12_381 private key for the given seed and HD path.
+func (s bls12381Algo) Derive() DeriveFn {
+	return Secp256k1.Derive()
+}
+
+// Generate generates a bls12_381 private key from the given secret.
+func (s bls12381Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		return bls12381.GenPrivKeyFromSecret(bz)
+	}
+}
@@ -13,6 +13,13 @@ import (
 	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
 )
 
+const (
+	// EthCoinType is the BIP-0044 coin type registered for Ethereum.
+	EthCoinType = 60
+	// EthFullPath is the default derivation path of Ethereum wallets.
+	EthFullPath = "m/44'/60'/0'/0/0"
+)
+
 // NewParams creates a BIP 44 parameter object from the params:
 // m / purpose' / coinType' / account' / change / addressIndex
 func NewParams(purpose, coinType, account uint32, change bool, addressIdx uint32) *BIP44Params {
@@ -35,4 +35,9 @@ var (
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
 	ErrUnknownLegacyType = errors.New("unknown LegacyInfo type")
+	// ErrRemoteUnsupported is raised when an operation is not supported by the remote keyring backend,
+	// whose keys are managed on the remote signer.
+	ErrRemoteUnsupported = errors.New("operation not supported by the remote keyring backend")
+	// ErrSignPolicy is raised when a remote signer refuses to sign because of the policy of the key.
+	ErrSignPolicy = errors.New("sign request rejected by the key policy")
 )
@@ -22,10 +22,12 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cosmosbcrypt "github.com/cosmos/cosmos-sdk/crypto/keys/bcrypt"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -1206,6 +1208,42 @@ func TestInMemoryCreateMultisig(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestInMemoryBLS12381Multisig(t *testing.T) {
+	cdc := getCodec()
+	kb := NewInMemory(cdc, BLS12381Option())
+	msg := []byte("hello world")
+
+	multi := multisigtypes.NewMultisig(2)
+	pubKeys, pops := make([]*bls12381.PubKey, 2), make([][]byte, 2)
+	for i, uid := range []string{"bls1", "bls2"} {
+		record, _, err := kb.NewMnemonic(uid, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.BLS12381)
+		require.NoError(t, err)
+		privKey, err := extractPrivKeyFromRecord(record)
+		require.NoError(t, err)
+		require.IsType(t, &bls12381.PrivKey{}, privKey)
+		pubKeys[i] = privKey.PubKey().(*bls12381.PubKey)
+		pops[i], err = privKey.(*bls12381.PrivKey).ProofOfPossession()
+		require.NoError(t, err)
+
+		sig, pubKey, err := kb.Sign(uid, msg, signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		require.True(t, pubKey.VerifySignature(msg, sig))
+		multisigtypes.AddSignature(multi, &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig}, i)
+	}
+
+	multisigPubKey, err := bls12381.NewMultisigPubKey(2, pubKeys, pops)
+	require.NoError(t, err)
+	record, err := kb.SaveMultisig("multi", multisigPubKey)
+	require.NoError(t, err)
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, multisigPubKey.Equals(pubKey))
+
+	aggSig, err := bls12381.AggregateMultisignature(multi)
+	require.NoError(t, err)
+	require.NoError(t, multisigPubKey.VerifyMultisignature(func(signing.SignMode) ([]byte, error) { return msg, nil }, aggSig))
+}
+
 // TestInMemorySignVerify does some detailed checks on how we sign and validate
 // signatures
 func TestInMemorySignVerify(t *testing.T) {
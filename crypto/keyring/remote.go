@@ -0,0 +1,364 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	keyringRemoteDirName   = "keyring-remote"
+	remoteSignerConfigFile = "config.json"
+
+	// remoteSignerTimeout is the timeout of the requests to the remote signer.
+	remoteSignerTimeout = 30 * time.Second
+)
+
+var (
+	_ Keyring                            = &remoteKeystore{}
+	_ codectypes.UnpackInterfacesMessage = &ListResponse{}
+	_ codectypes.UnpackInterfacesMessage = &KeyResponse{}
+	_ codectypes.UnpackInterfacesMessage = &SignResponse{}
+)
+
+// RemoteSignerConfig defines the connection of the remote keyring backend to its
+// remote signer. The connection is authenticated on both sides with mutual TLS.
+type RemoteSignerConfig struct {
+	// Address is the address of the gRPC endpoint of the remote signer.
+	Address string `json:"address"`
+	// CACertFile is the PEM file of the CA certificates which verify the
+	// certificate of the remote signer.
+	CACertFile string `json:"ca_cert_file"`
+	// CertFile and KeyFile are the PEM files of the client certificate and its key.
+	CertFile string `json:"cert_file"`
+	KeyFile  string `json:"key_file"`
+	// ServerName overrides the name which the certificate of the remote signer
+	// is verified against, the host of Address by default.
+	ServerName string `json:"server_name,omitempty"`
+}
+
+// RemoteSignerOption sets the remote signer of the remote keyring backend, which
+// otherwise reads its RemoteSignerConfig from the config.json file of the
+// keyring-remote directory of the keyring root directory.
+func RemoteSignerOption(config RemoteSignerConfig) Option {
+	return func(options *Options) {
+		options.RemoteSigner = &config
+	}
+}
+
+// LoadRemoteSignerConfig reads a RemoteSignerConfig from a JSON file. The relative
+// paths of its files are relative to the directory of the file.
+func LoadRemoteSignerConfig(file string) (RemoteSignerConfig, error) {
+	var config RemoteSignerConfig
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return config, err
+	}
+	if err := json.Unmarshal(bz, &config); err != nil {
+		return config, errorsmod.Wrapf(err, "invalid remote signer config %s", file)
+	}
+
+	dir := filepath.Dir(file)
+	for _, path := range []*string{&config.CACertFile, &config.CertFile, &config.KeyFile} {
+		if *path != "" && !filepath.IsAbs(*path) {
+			*path = filepath.Join(dir, *path)
+		}
+	}
+
+	return config, nil
+}
+
+// newRemoteTLSConfig returns the TLS config of a mutually authenticated
+// connection with the certificate of certFile and keyFile, whose peer
+// certificate is verified with the CA certificates of caCertFile.
+func newRemoteTLSConfig(caCertFile, certFile, keyFile string) (*tls.Config, *x509.CertPool, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	caCerts, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCerts) {
+		return nil, nil, fmt.Errorf("no CA certificate found in %s", caCertFile)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS13,
+	}, pool, nil
+}
+
+// remoteKeystore is a Keyring whose keys are held by a remote signer. It only
+// lists the keys and signs with them, keys are managed on the remote signer.
+type remoteKeystore struct {
+	client  RemoteSignerClient
+	cdc     codec.Codec
+	options Options
+}
+
+func newRemoteKeystore(rootDir string, cdc codec.Codec, opts ...Option) (Keyring, error) {
+	options := Options{
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
+	}
+
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	config := options.RemoteSigner
+	if config == nil {
+		loaded, err := LoadRemoteSignerConfig(filepath.Join(rootDir, keyringRemoteDirName, remoteSignerConfigFile))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to load remote signer config")
+		}
+		config = &loaded
+	}
+
+	tlsConfig, pool, err := newRemoteTLSConfig(config.CACertFile, config.CertFile, config.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.RootCAs = pool
+	tlsConfig.ServerName = config.ServerName
+
+	conn, err := grpc.NewClient(
+		config.Address,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(cdc.InterfaceRegistry()).GRPCCodec())),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return remoteKeystore{
+		client:  NewRemoteSignerClient(conn),
+		cdc:     cdc,
+		options: options,
+	}, nil
+}
+
+func (ks remoteKeystore) Backend() string {
+	return BackendRemote
+}
+
+func (ks remoteKeystore) List() ([]*Record, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.List(ctx, &ListRequest{})
+	if err != nil {
+		return nil, fromRemoteError(err)
+	}
+
+	return res.Records, nil
+}
+
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks remoteKeystore) Key(uid string) (*Record, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Key(ctx, &KeyRequest{Uid: uid})
+	if err != nil {
+		return nil, fromRemoteError(err)
+	}
+
+	return res.Record, nil
+}
+
+func (ks remoteKeystore) KeyByAddress(address sdk.Address) (*Record, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.KeyByAddress(ctx, &KeyByAddressRequest{Address: address.Bytes()})
+	if err != nil {
+		return nil, fromRemoteError(err)
+	}
+
+	return res.Record, nil
+}
+
+func (ks remoteKeystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Sign(ctx, &SignRequest{Uid: uid, Msg: msg, SignMode: signMode})
+	if err != nil {
+		return nil, nil, fromRemoteError(err)
+	}
+
+	pk, ok := res.PubKey.GetCachedValue().(types.PubKey)
+	if !ok {
+		return nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", (types.PubKey)(nil), res.PubKey.GetCachedValue())
+	}
+
+	return res.Signature, pk, nil
+}
+
+func (ks remoteKeystore) SignByAddress(address sdk.Address, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ks.Sign(k.Name, msg, signMode)
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := k.GetPubKey()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := ks.cdc.MarshalInterface(key)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(bz, key.Type()), nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.ExportPubKeyArmor(k.Name)
+}
+
+func (ks remoteKeystore) MigrateAll() ([]*Record, error) {
+	return ks.List()
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "delete")
+}
+
+func (ks remoteKeystore) DeleteByAddress(sdk.Address) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "delete")
+}
+
+func (ks remoteKeystore) Rename(string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "rename")
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, string, SignatureAlgo) (*Record, string, error) {
+	return nil, "", errorsmod.Wrap(ErrRemoteUnsupported, "new mnemonic")
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "new account")
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "save ledger key")
+}
+
+func (ks remoteKeystore) SaveOfflineKey(string, types.PubKey) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "save offline key")
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "save multisig")
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import private key")
+}
+
+func (ks remoteKeystore) ImportPrivKeyHex(string, string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import private key")
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import public key")
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", errorsmod.Wrap(ErrRemoteUnsupported, "export private key")
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress(sdk.Address, string) (string, error) {
+	return "", errorsmod.Wrap(ErrRemoteUnsupported, "export private key")
+}
+
+// fromRemoteError converts the gRPC status errors of the remote signer back to
+// the errors of the keyring.
+func fromRemoteError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, st.Message())
+	case codes.PermissionDenied:
+		return errorsmod.Wrap(ErrSignPolicy, st.Message())
+	case codes.FailedPrecondition:
+		return errorsmod.Wrap(ErrOfflineSign, st.Message())
+	default:
+		return errorsmod.Wrap(err, "remote signer")
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *ListResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, record := range m.Records {
+		if err := record.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *KeyResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Record == nil {
+		return nil
+	}
+
+	return m.Record.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *SignResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk types.PubKey
+	return unpacker.UnpackAny(m.PubKey, &pk)
+}
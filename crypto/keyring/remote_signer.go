@@ -0,0 +1,219 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"os"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ RemoteSignerServer = remoteSigner{}
+
+// SignerPolicy restricts the transactions a remote signer signs with a key.
+// A key with a policy only signs SIGN_MODE_DIRECT sign docs, as the policy is
+// checked against the decoded transaction.
+type SignerPolicy struct {
+	// AllowedMsgTypeURLs are the type URLs of the messages which the transactions
+	// may contain, all messages are allowed if empty. Only the top-level messages
+	// are checked, the messages executed by a message such as MsgExec are not.
+	AllowedMsgTypeURLs []string `json:"allowed_msg_type_urls,omitempty"`
+	// MaxFee is the maximum fee of the transactions, the fee is not limited if empty.
+	MaxFee sdk.Coins `json:"max_fee,omitempty"`
+}
+
+// LoadSignerPolicies reads the signer policies of the keys, by key uid, from a
+// JSON file.
+func LoadSignerPolicies(file string) (map[string]SignerPolicy, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies map[string]SignerPolicy
+	if err := json.Unmarshal(bz, &policies); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid signer policies %s", file)
+	}
+
+	for uid, policy := range policies {
+		if err := policy.MaxFee.Validate(); err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid max fee of key %s", uid)
+		}
+	}
+
+	return policies, nil
+}
+
+// check returns an error if the sign bytes msg violate the policy.
+func (p SignerPolicy) check(msg []byte, signMode signing.SignMode) error {
+	if signMode != signing.SignMode_SIGN_MODE_DIRECT {
+		return errorsmod.Wrapf(ErrSignPolicy, "sign mode %s is not allowed, expected %s", signMode, signing.SignMode_SIGN_MODE_DIRECT)
+	}
+
+	var signDoc tx.SignDoc
+	if err := signDoc.Unmarshal(msg); err != nil {
+		return errorsmod.Wrap(ErrSignPolicy, "invalid sign doc")
+	}
+	var body tx.TxBody
+	if err := body.Unmarshal(signDoc.BodyBytes); err != nil {
+		return errorsmod.Wrap(ErrSignPolicy, "invalid tx body")
+	}
+	var authInfo tx.AuthInfo
+	if err := authInfo.Unmarshal(signDoc.AuthInfoBytes); err != nil {
+		return errorsmod.Wrap(ErrSignPolicy, "invalid auth info")
+	}
+
+	if len(p.AllowedMsgTypeURLs) > 0 {
+		for _, msg := range body.Messages {
+			if !slices.Contains(p.AllowedMsgTypeURLs, msg.TypeUrl) {
+				return errorsmod.Wrapf(ErrSignPolicy, "message %s is not allowed", msg.TypeUrl)
+			}
+		}
+	}
+
+	if !p.MaxFee.Empty() {
+		var fee sdk.Coins
+		if authInfo.Fee != nil {
+			fee = authInfo.Fee.Amount
+		}
+		if !fee.IsAllLTE(p.MaxFee) {
+			return errorsmod.Wrapf(ErrSignPolicy, "fee %s exceeds the max fee %s", fee, p.MaxFee)
+		}
+	}
+
+	return nil
+}
+
+// remoteSigner is the reference implementation of the RemoteSigner service,
+// which signs with the keys of a local keyring.
+type remoteSigner struct {
+	kr       Keyring
+	policies map[string]SignerPolicy
+}
+
+// NewRemoteSignerServer returns a RemoteSignerServer which signs with the keys of
+// kr, subject to the policies of the keys by uid. Keys without a policy sign any
+// message. The private keys of kr are never sent, its local records are served as
+// offline records.
+func NewRemoteSignerServer(kr Keyring, policies map[string]SignerPolicy) RemoteSignerServer {
+	return remoteSigner{kr: kr, policies: policies}
+}
+
+// NewRemoteSignerServerTLSConfig returns the TLS config of a remote signer
+// serving the certificate of certFile and keyFile, which requires the clients
+// to present a certificate verified by the CA certificates of caCertFile.
+func NewRemoteSignerServerTLSConfig(caCertFile, certFile, keyFile string) (*tls.Config, error) {
+	config, pool, err := newRemoteTLSConfig(caCertFile, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	config.ClientCAs = pool
+	config.ClientAuth = tls.RequireAndVerifyClientCert
+
+	return config, nil
+}
+
+func (s remoteSigner) List(context.Context, *ListRequest) (*ListResponse, error) {
+	records, err := s.kr.List()
+	if err != nil {
+		return nil, toRemoteError(err)
+	}
+
+	res := &ListResponse{Records: make([]*Record, len(records))}
+	for i, record := range records {
+		if res.Records[i], err = toRemoteRecord(record); err != nil {
+			return nil, toRemoteError(err)
+		}
+	}
+
+	return res, nil
+}
+
+func (s remoteSigner) Key(_ context.Context, req *KeyRequest) (*KeyResponse, error) {
+	record, err := s.kr.Key(req.Uid)
+	if err != nil {
+		return nil, toRemoteError(err)
+	}
+
+	return newKeyResponse(record)
+}
+
+func (s remoteSigner) KeyByAddress(_ context.Context, req *KeyByAddressRequest) (*KeyResponse, error) {
+	record, err := s.kr.KeyByAddress(sdk.AccAddress(req.Address))
+	if err != nil {
+		return nil, toRemoteError(err)
+	}
+
+	return newKeyResponse(record)
+}
+
+func (s remoteSigner) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	if policy, ok := s.policies[req.Uid]; ok {
+		if err := policy.check(req.Msg, req.SignMode); err != nil {
+			return nil, toRemoteError(err)
+		}
+	}
+
+	sig, pk, err := s.kr.Sign(req.Uid, req.Msg, req.SignMode)
+	if err != nil {
+		return nil, toRemoteError(err)
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return nil, toRemoteError(err)
+	}
+
+	return &SignResponse{Signature: sig, PubKey: pkAny}, nil
+}
+
+func newKeyResponse(record *Record) (*KeyResponse, error) {
+	record, err := toRemoteRecord(record)
+	if err != nil {
+		return nil, toRemoteError(err)
+	}
+
+	return &KeyResponse{Record: record}, nil
+}
+
+// toRemoteRecord converts a local record to an offline record, so that its
+// private key is not sent to the client.
+func toRemoteRecord(record *Record) (*Record, error) {
+	if record.GetLocal() == nil {
+		return record, nil
+	}
+
+	pk, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOfflineRecord(record.Name, pk)
+}
+
+// toRemoteError converts the errors of the keyring to gRPC status errors, which
+// are converted back by the remote keyring backend.
+func toRemoteError(err error) error {
+	switch {
+	case errors.Is(err, sdkerrors.ErrKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrSignPolicy):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrOfflineSign):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,297 @@
+package keyring
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// testCA issues the certificates of the remote signer tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	file string
+}
+
+func newTestCA(t *testing.T, dir string) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	file := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	return &testCA{cert: cert, key: key, file: file}
+}
+
+// issue writes a certificate of the CA and its key to dir, and returns their files.
+func (ca *testCA) issue(t *testing.T, dir, name string) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile := filepath.Join(dir, name+".pem"), filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+	return certFile, keyFile
+}
+
+// startRemoteSigner serves the keys of kr on a local port and returns its address.
+func startRemoteSigner(t *testing.T, cdc codec.Codec, kr Keyring, policies map[string]SignerPolicy, ca *testCA, dir string) string {
+	t.Helper()
+	certFile, keyFile := ca.issue(t, dir, "server")
+	tlsConfig, err := NewRemoteSignerServerTLSConfig(ca.file, certFile, keyFile)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.ForceServerCodec(codec.NewProtoCodec(cdc.InterfaceRegistry()).GRPCCodec()),
+	)
+	RegisterRemoteSignerServer(srv, NewRemoteSignerServer(kr, policies))
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	return listener.Addr().String()
+}
+
+func signDocBytes(t *testing.T, fee sdk.Coins, typeURLs ...string) []byte {
+	t.Helper()
+	body := tx.TxBody{}
+	for _, typeURL := range typeURLs {
+		body.Messages = append(body.Messages, &codectypes.Any{TypeUrl: typeURL})
+	}
+	bodyBz, err := body.Marshal()
+	require.NoError(t, err)
+	authInfoBz, err := (&tx.AuthInfo{Fee: &tx.Fee{Amount: fee, GasLimit: 200000}}).Marshal()
+	require.NoError(t, err)
+	bz, err := (&tx.SignDoc{BodyBytes: bodyBz, AuthInfoBytes: authInfoBz, ChainId: "test", AccountNumber: 1}).Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	cdc := getCodec()
+	dir := t.TempDir()
+	ca := newTestCA(t, dir)
+
+	local := NewInMemory(cdc)
+	record, _, err := local.NewMnemonic("alice", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = local.SaveOfflineKey("bob", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, _, err = local.NewMnemonic("policy", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	policies := map[string]SignerPolicy{
+		"policy": {
+			AllowedMsgTypeURLs: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			MaxFee:             sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1000))),
+		},
+	}
+	addr := startRemoteSigner(t, cdc, local, policies, ca, dir)
+	certFile, keyFile := ca.issue(t, dir, "client")
+
+	kr, err := New("app", BackendRemote, dir, nil, cdc, RemoteSignerOption(RemoteSignerConfig{
+		Address:    addr,
+		CACertFile: ca.file,
+		CertFile:   certFile,
+		KeyFile:    keyFile,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, BackendRemote, kr.Backend())
+
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	// the private keys are not sent
+	remoteRecord, err := kr.Key("alice")
+	require.NoError(t, err)
+	require.Nil(t, remoteRecord.GetLocal())
+	require.Equal(t, TypeOffline, remoteRecord.GetType())
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	remotePubKey, err := remoteRecord.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(remotePubKey))
+
+	remoteRecord, err = kr.KeyByAddress(sdk.AccAddress(pubKey.Address()))
+	require.NoError(t, err)
+	require.Equal(t, "alice", remoteRecord.Name)
+
+	_, err = kr.Key("carol")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	msg := []byte("hello world")
+	sig, signPubKey, err := kr.Sign("alice", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(signPubKey))
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	sig, _, err = kr.SignByAddress(sdk.AccAddress(pubKey.Address()), msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	_, _, err = kr.Sign("bob", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrOfflineSign)
+
+	armor, err := kr.ExportPubKeyArmor("alice")
+	require.NoError(t, err)
+	require.Contains(t, armor, "BEGIN TENDERMINT PUBLIC KEY")
+
+	_, _, err = kr.NewMnemonic("carol", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+	require.ErrorIs(t, kr.Delete("alice"), ErrRemoteUnsupported)
+	_, err = kr.ExportPrivKeyArmor("alice", "passphrase")
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+
+	// policies
+	fee := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(500)))
+	_, _, err = kr.Sign("policy", signDocBytes(t, fee, "/cosmos.bank.v1beta1.MsgSend"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	_, _, err = kr.Sign("policy", signDocBytes(t, fee, "/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrSignPolicy)
+	require.ErrorContains(t, err, "MsgDelegate is not allowed")
+	_, _, err = kr.Sign("policy", signDocBytes(t, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1001))), "/cosmos.bank.v1beta1.MsgSend"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrSignPolicy)
+	require.ErrorContains(t, err, "exceeds the max fee")
+	_, _, err = kr.Sign("policy", signDocBytes(t, sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(1))), "/cosmos.bank.v1beta1.MsgSend"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrSignPolicy)
+	_, _, err = kr.Sign("policy", msg, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	require.ErrorIs(t, err, ErrSignPolicy)
+	_, _, err = kr.Sign("policy", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrSignPolicy)
+}
+
+func TestRemoteKeyringConfigFile(t *testing.T) {
+	cdc := getCodec()
+	dir := t.TempDir()
+	ca := newTestCA(t, dir)
+
+	local := NewInMemory(cdc)
+	_, _, err := local.NewMnemonic("alice", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	addr := startRemoteSigner(t, cdc, local, nil, ca, dir)
+
+	// the files of the config are relative to its directory
+	configDir := filepath.Join(dir, keyringRemoteDirName)
+	require.NoError(t, os.Mkdir(configDir, 0o700))
+	ca.issue(t, configDir, "client")
+	caBz, err := os.ReadFile(ca.file)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "ca.pem"), caBz, 0o600))
+	bz, err := json.Marshal(RemoteSignerConfig{
+		Address:    addr,
+		CACertFile: "ca.pem",
+		CertFile:   "client.pem",
+		KeyFile:    "client.key",
+		ServerName: "localhost",
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, remoteSignerConfigFile), bz, 0o600))
+
+	kr, err := New("app", BackendRemote, dir, nil, cdc)
+	require.NoError(t, err)
+	_, err = kr.Key("alice")
+	require.NoError(t, err)
+
+	_, err = New("app", BackendRemote, t.TempDir(), nil, cdc)
+	require.Error(t, err)
+}
+
+func TestRemoteKeyringUntrustedClient(t *testing.T) {
+	cdc := getCodec()
+	dir := t.TempDir()
+	ca := newTestCA(t, dir)
+	addr := startRemoteSigner(t, cdc, NewInMemory(cdc), nil, ca, dir)
+
+	// a client certificate of another CA is rejected
+	otherDir := t.TempDir()
+	otherCA := newTestCA(t, otherDir)
+	certFile, keyFile := otherCA.issue(t, otherDir, "client")
+	kr, err := New("app", BackendRemote, dir, nil, cdc, RemoteSignerOption(RemoteSignerConfig{
+		Address:    addr,
+		CACertFile: ca.file,
+		CertFile:   certFile,
+		KeyFile:    keyFile,
+	}))
+	require.NoError(t, err)
+	_, err = kr.List()
+	require.Error(t, err)
+}
+
+func TestLoadSignerPolicies(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "policies.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{
+  "validator": {
+    "allowed_msg_type_urls": ["/cosmos.staking.v1beta1.MsgDelegate"],
+    "max_fee": [{"denom": "stake", "amount": "1000"}]
+  }
+}`), 0o600))
+
+	policies, err := LoadSignerPolicies(file)
+	require.NoError(t, err)
+	require.Equal(t, map[string]SignerPolicy{
+		"validator": {
+			AllowedMsgTypeURLs: []string{"/cosmos.staking.v1beta1.MsgDelegate"},
+			MaxFee:             sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1000))),
+		},
+	}, policies)
+
+	require.NoError(t, os.WriteFile(file, []byte(`{"validator": {"max_fee": [{"denom": "stake", "amount": "-1"}]}}`), 0o600))
+	_, err = LoadSignerPolicies(file)
+	require.Error(t, err)
+}
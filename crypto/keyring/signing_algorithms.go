@@ -25,6 +25,24 @@ func NewSigningAlgoFromString(str string, algoList SigningAlgoList) (SignatureAl
 	return nil, errors.Wrap(ErrUnsupportedSigningAlgo, str)
 }
 
+// EthSecp256k1Option adds the eth_secp256k1 algorithm to the keyring supported signing algorithms.
+func EthSecp256k1Option() Option {
+	return func(options *Options) {
+		if !options.SupportedAlgos.Contains(hd.EthSecp256k1) {
+			options.SupportedAlgos = append(options.SupportedAlgos, hd.EthSecp256k1)
+		}
+	}
+}
+
+// BLS12381Option adds the bls12_381 algorithm to the keyring supported signing algorithms.
+func BLS12381Option() Option {
+	return func(options *Options) {
+		if !options.SupportedAlgos.Contains(hd.BLS12381) {
+			options.SupportedAlgos = append(options.SupportedAlgos, hd.BLS12381)
+		}
+	}
+}
+
 // SigningAlgoList is a slice of signature algorithms
 type SigningAlgoList []SignatureAlgo
 
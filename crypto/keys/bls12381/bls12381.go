@@ -0,0 +1,317 @@
+package bls12381
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/cometbft/cometbft/crypto"
+	bls "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/cosmos/gogoproto/proto"
+	"golang.org/x/crypto/hkdf"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ cryptotypes.PrivKey  = &PrivKey{}
+	_ codec.AminoMarshaler = &PrivKey{}
+)
+
+const (
+	// PrivKeySize is the size of a BLS12-381 private key scalar.
+	PrivKeySize = fr.Bytes
+	// PubKeySize is the size of a compressed G1 point.
+	PubKeySize = bls.SizeOfG1AffineCompressed
+	// SignatureSize is the size of a compressed G2 point.
+	SignatureSize = bls.SizeOfG2AffineCompressed
+
+	keyType     = "bls12_381"
+	PrivKeyName = "cosmos/PrivKeyBls12381"
+	PubKeyName  = "cosmos/PubKeyBls12381"
+	// MultisigPubKeyName is the amino name of the MultisigPubKey.
+	MultisigPubKeyName = "cosmos/PubKeyBls12381Multisig"
+)
+
+var (
+	// signatureDST and popDST are the domain separation tags of the
+	// BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_ ciphersuite of the IETF BLS signature draft.
+	signatureDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	popDST       = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	keyGenSalt = []byte("BLS-SIG-KEYGEN-SALT-")
+)
+
+// GenPrivKey generates a new BLS12-381 private key using OS randomness.
+func GenPrivKey() *PrivKey {
+	secret := make([]byte, 32)
+	if _, err := io.ReadFull(crypto.CReader(), secret); err != nil {
+		panic(err)
+	}
+	return GenPrivKeyFromSecret(secret)
+}
+
+// GenPrivKeyFromSecret derives a BLS12-381 private key from a secret of at least
+// 32 bytes with the KeyGen procedure of the IETF BLS signature draft.
+func GenPrivKeyFromSecret(secret []byte) *PrivKey {
+	if len(secret) < 32 {
+		panic("secret must be at least 32 bytes")
+	}
+
+	ikm := append(append([]byte{}, secret...), 0)
+	// key_info is empty, followed by the 2 bytes output length L = 48
+	info := []byte{0, 48}
+	salt := keyGenSalt
+	sk := new(big.Int)
+	for sk.Sign() == 0 {
+		digest := sha256.Sum256(salt)
+		salt = digest[:]
+		okm := make([]byte, 48)
+		if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, salt, info), okm); err != nil {
+			panic(err)
+		}
+		sk.SetBytes(okm).Mod(sk, fr.Modulus())
+	}
+
+	return &PrivKey{Key: sk.FillBytes(make([]byte, PrivKeySize))}
+}
+
+// Bytes returns the byte representation of the Private Key.
+func (privKey *PrivKey) Bytes() []byte {
+	return privKey.Key
+}
+
+// PubKey returns the public key of the private key, sk * G1.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	var pk bls.G1Affine
+	pk.ScalarMultiplicationBase(privKey.scalar())
+	bz := pk.Bytes()
+	return &PubKey{Key: bz[:]}
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+func (privKey *PrivKey) Type() string {
+	return keyType
+}
+
+// Sign returns the signature of msg, sk * H(msg) where H hashes to G2.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	return privKey.sign(msg, signatureDST)
+}
+
+// ProofOfPossession returns the proof of possession of the private key, the
+// signature of its public key with the proof of possession domain separation tag.
+func (privKey *PrivKey) ProofOfPossession() ([]byte, error) {
+	return privKey.sign(privKey.PubKey().Bytes(), popDST)
+}
+
+func (privKey *PrivKey) sign(msg, dst []byte) ([]byte, error) {
+	if len(privKey.Key) != PrivKeySize {
+		return nil, errors.New("invalid privkey size")
+	}
+	h, err := bls.HashToG2(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	var sig bls.G2Affine
+	sig.ScalarMultiplication(&h, privKey.scalar())
+	bz := sig.Bytes()
+	return bz[:], nil
+}
+
+func (privKey *PrivKey) scalar() *big.Int {
+	return new(big.Int).SetBytes(privKey.Key)
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size")
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+//-------------------------------------
+
+var (
+	_ cryptotypes.PubKey   = &PubKey{}
+	_ codec.AminoMarshaler = &PubKey{}
+)
+
+// Address returns the ADR-28 address of the pubkey.
+func (pubKey *PubKey) Address() crypto.Address {
+	return address.Hash(proto.MessageName(pubKey), pubKey.Key)
+}
+
+// Bytes returns the pubkey byte format.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeyBls12381{%X}", pubKey.Key)
+}
+
+func (pubKey *PubKey) Type() string {
+	return keyType
+}
+
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// VerifySignature verifies a signature of msg, e(pk, H(msg)) == e(G1, sig).
+func (pubKey *PubKey) VerifySignature(msg, sig []byte) bool {
+	pk, err := pubKey.point()
+	if err != nil {
+		return false
+	}
+	return verify(pk, msg, sig, signatureDST)
+}
+
+// VerifyProofOfPossession verifies the proof of possession of the private key of the pubkey.
+func (pubKey *PubKey) VerifyProofOfPossession(pop []byte) bool {
+	pk, err := pubKey.point()
+	if err != nil {
+		return false
+	}
+	return verify(pk, pubKey.Key, pop, popDST)
+}
+
+// point returns the G1 point of the pubkey, which must be in the G1 subgroup and not the point at infinity.
+func (pubKey *PubKey) point() (*bls.G1Affine, error) {
+	if len(pubKey.Key) != PubKeySize {
+		return nil, errors.New("invalid pubkey size")
+	}
+	var pk bls.G1Affine
+	if _, err := pk.SetBytes(pubKey.Key); err != nil {
+		return nil, err
+	}
+	if pk.IsInfinity() {
+		return nil, errors.New("pubkey is the point at infinity")
+	}
+	return &pk, nil
+}
+
+func verify(pk *bls.G1Affine, msg, sigBz, dst []byte) bool {
+	if len(sigBz) != SignatureSize {
+		return false
+	}
+	var sig bls.G2Affine
+	if _, err := sig.SetBytes(sigBz); err != nil || sig.IsInfinity() {
+		return false
+	}
+	h, err := bls.HashToG2(msg, dst)
+	if err != nil {
+		return false
+	}
+
+	// e(pk, H(msg)) * e(-G1, sig) == 1
+	_, _, g1, _ := bls.Generators()
+	var negG1 bls.G1Affine
+	negG1.Neg(&g1)
+	ok, err := bls.PairingCheck([]bls.G1Affine{*pk, negG1}, []bls.G2Affine{h, sig})
+	return err == nil && ok
+}
+
+// AggregateSignatures aggregates signatures into a single signature, the sum of their G2 points.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+	var agg bls.G2Jac
+	for i, bz := range sigs {
+		if len(bz) != SignatureSize {
+			return nil, fmt.Errorf("invalid signature size %d at index %d", len(bz), i)
+		}
+		var sig bls.G2Affine
+		if _, err := sig.SetBytes(bz); err != nil {
+			return nil, fmt.Errorf("invalid signature at index %d: %w", i, err)
+		}
+		if i == 0 {
+			agg.FromAffine(&sig)
+		} else {
+			agg.AddMixed(&sig)
+		}
+	}
+	var sig bls.G2Affine
+	sig.FromJacobian(&agg)
+	bz := sig.Bytes()
+	return bz[:], nil
+}
+
+// aggregatePubKeys returns the sum of the G1 points of pubkeys.
+func aggregatePubKeys(pubKeys []*PubKey) (*bls.G1Affine, error) {
+	var agg bls.G1Jac
+	for i, pubKey := range pubKeys {
+		pk, err := pubKey.point()
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			agg.FromAffine(pk)
+		} else {
+			agg.AddMixed(pk)
+		}
+	}
+	var pk bls.G1Affine
+	pk.FromJacobian(&agg)
+	return &pk, nil
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "invalid pubkey size")
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
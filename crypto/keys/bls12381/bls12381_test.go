@@ -0,0 +1,113 @@
+package bls12381_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
+)
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	// test case 0 of EIP-2333, whose master key derivation is the KeyGen procedure
+	seed, err := hex.DecodeString("c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04")
+	require.NoError(t, err)
+	expected, ok := new(big.Int).SetString("6083874454709270928345386274498605044986640685124978867557563392430687146096", 10)
+	require.True(t, ok)
+
+	privKey := bls12381.GenPrivKeyFromSecret(seed)
+	require.Len(t, privKey.Key, bls12381.PrivKeySize)
+	require.Equal(t, expected, new(big.Int).SetBytes(privKey.Key))
+
+	require.Panics(t, func() { bls12381.GenPrivKeyFromSecret(make([]byte, 31)) })
+}
+
+func TestHDPath(t *testing.T) {
+	mnemonic := "test test test test test test test test test test test junk"
+	derivedPriv, err := hd.BLS12381.Derive()(mnemonic, "", hd.CreateHDPath(118, 0, 0).String())
+	require.NoError(t, err)
+	privKey := hd.BLS12381.Generate()(derivedPriv)
+
+	require.IsType(t, &bls12381.PrivKey{}, privKey)
+	require.Equal(t, bls12381.GenPrivKeyFromSecret(derivedPriv).Key, privKey.Bytes())
+	require.Len(t, privKey.PubKey().Bytes(), bls12381.PubKeySize)
+	require.Len(t, privKey.PubKey().Address(), 32)
+}
+
+func TestSignAndVerify(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey()
+	msg := []byte("hello world")
+
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, bls12381.SignatureSize)
+
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	require.False(t, pubKey.VerifySignature([]byte("hello"), sig))
+	require.False(t, pubKey.VerifySignature(msg, sig[:bls12381.SignatureSize-1]))
+	require.False(t, bls12381.GenPrivKey().PubKey().VerifySignature(msg, sig))
+	require.False(t, (&bls12381.PubKey{Key: make([]byte, bls12381.PubKeySize)}).VerifySignature(msg, sig))
+}
+
+func TestProofOfPossession(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey().(*bls12381.PubKey)
+
+	pop, err := privKey.ProofOfPossession()
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifyProofOfPossession(pop))
+	require.False(t, bls12381.GenPrivKey().PubKey().(*bls12381.PubKey).VerifyProofOfPossession(pop))
+
+	// proofs of possession and signatures are domain separated
+	sig, err := privKey.Sign(pubKey.Bytes())
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifyProofOfPossession(sig))
+	require.False(t, pubKey.VerifySignature(pubKey.Bytes(), pop))
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	msg := []byte("hello world")
+	privKey1, privKey2 := bls12381.GenPrivKey(), bls12381.GenPrivKey()
+	sig1, err := privKey1.Sign(msg)
+	require.NoError(t, err)
+	sig2, err := privKey2.Sign(msg)
+	require.NoError(t, err)
+
+	aggSig, err := bls12381.AggregateSignatures([][]byte{sig1, sig2})
+	require.NoError(t, err)
+	require.Len(t, aggSig, bls12381.SignatureSize)
+	require.False(t, privKey1.PubKey().VerifySignature(msg, aggSig))
+
+	_, err = bls12381.AggregateSignatures(nil)
+	require.Error(t, err)
+	_, err = bls12381.AggregateSignatures([][]byte{sig1, sig2[1:]})
+	require.Error(t, err)
+}
+
+func TestAminoMarshal(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	cdc.RegisterConcrete(&bls12381.PubKey{}, bls12381.PubKeyName, nil)
+	cdc.RegisterConcrete(&bls12381.PrivKey{}, bls12381.PrivKeyName, nil)
+
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey().(*bls12381.PubKey)
+
+	bz, err := cdc.Marshal(pubKey)
+	require.NoError(t, err)
+	var pubKey2 bls12381.PubKey
+	require.NoError(t, cdc.Unmarshal(bz, &pubKey2))
+	require.True(t, pubKey.Equals(&pubKey2))
+
+	bz, err = cdc.Marshal(privKey)
+	require.NoError(t, err)
+	var privKey2 bls12381.PrivKey
+	require.NoError(t, cdc.Unmarshal(bz, &privKey2))
+	require.True(t, privKey.Equals(&privKey2))
+
+	require.Error(t, pubKey2.UnmarshalAmino([]byte{1, 2, 3}))
+}
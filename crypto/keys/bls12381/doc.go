@@ -0,0 +1,5 @@
+// Package bls12381 implements BLS12-381 keys, in the minimal-pubkey-size variant
+// of the IETF BLS signature scheme with proof of possession, and a threshold
+// multisig pubkey whose signature is a single aggregate signature. The keys can
+// be protobuf serialized and packed in Any.
+package bls12381
@@ -0,0 +1,201 @@
+package bls12381
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cosmos/gogoproto/proto"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ multisigtypes.PubKey = &MultisigPubKey{}
+
+// NewMultisigPubKey returns a new MultisigPubKey of the given pubkeys and their
+// proofs of possession, which are verified. Unlike LegacyAminoPubKey, a key can
+// only appear once, as its signature would otherwise count several times in the
+// aggregate signature.
+func NewMultisigPubKey(threshold int, pubKeys []*PubKey, proofsOfPossession [][]byte) (*MultisigPubKey, error) {
+	m := &MultisigPubKey{Threshold: uint32(threshold), PubKeys: pubKeys, ProofsOfPossession: proofsOfPossession}
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	for i, pubKey := range pubKeys {
+		if !pubKey.VerifyProofOfPossession(proofsOfPossession[i]) {
+			return nil, fmt.Errorf("invalid proof of possession of pubkey at index %d", i)
+		}
+	}
+	return m, nil
+}
+
+func (m *MultisigPubKey) validate() error {
+	if m.Threshold == 0 {
+		return errors.New("threshold k of n multisignature: k <= 0")
+	}
+	if len(m.PubKeys) < int(m.Threshold) {
+		return errors.New("threshold k of n multisignature: len(pubKeys) < k")
+	}
+	if len(m.ProofsOfPossession) != len(m.PubKeys) {
+		return fmt.Errorf("expected %d proofs of possession, got %d", len(m.PubKeys), len(m.ProofsOfPossession))
+	}
+	seen := make(map[string]bool, len(m.PubKeys))
+	for i, pubKey := range m.PubKeys {
+		if pubKey == nil {
+			return fmt.Errorf("pubkey at index %d is nil", i)
+		}
+		if seen[string(pubKey.Key)] {
+			return fmt.Errorf("duplicate pubkey at index %d", i)
+		}
+		seen[string(pubKey.Key)] = true
+	}
+	return nil
+}
+
+// Address returns the ADR-28 address of the multisig pubkey.
+func (m *MultisigPubKey) Address() crypto.Address {
+	return address.Hash(proto.MessageName(m), m.Bytes())
+}
+
+// Bytes returns the proto encoded version of the MultisigPubKey.
+func (m *MultisigPubKey) Bytes() []byte {
+	bz, err := m.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func (m *MultisigPubKey) String() string {
+	return fmt.Sprintf("PubKeyBls12381Multisig{%d of %v}", m.Threshold, m.PubKeys)
+}
+
+// Type returns the multisig type.
+func (m *MultisigPubKey) Type() string {
+	return "PubKeyBls12381Multisig"
+}
+
+// Equals returns true if other is a MultisigPubKey with the same threshold and
+// keys in the same order.
+func (m *MultisigPubKey) Equals(other cryptotypes.PubKey) bool {
+	otherKey, ok := other.(*MultisigPubKey)
+	if !ok || m.Threshold != otherKey.Threshold || len(m.PubKeys) != len(otherKey.PubKeys) {
+		return false
+	}
+	for i := range m.PubKeys {
+		if !bytes.Equal(m.PubKeys[i].Key, otherKey.PubKeys[i].Key) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetPubKeys implements the PubKey.GetPubKeys method.
+func (m *MultisigPubKey) GetPubKeys() []cryptotypes.PubKey {
+	pubKeys := make([]cryptotypes.PubKey, len(m.PubKeys))
+	for i, pubKey := range m.PubKeys {
+		pubKeys[i] = pubKey
+	}
+	return pubKeys
+}
+
+// GetThreshold implements the PubKey.GetThreshold method.
+func (m *MultisigPubKey) GetThreshold() uint {
+	return uint(m.Threshold)
+}
+
+// VerifySignature implements cryptotypes.PubKey VerifySignature method, it always
+// returns false as a multisig signature is a MultiSignatureData.
+func (m *MultisigPubKey) VerifySignature(msg, sig []byte) bool {
+	return false
+}
+
+// VerifyMultisignature implements the multisigtypes.PubKey VerifyMultisignature
+// method. The multi-signature holds a single signature, aggregated with
+// AggregateMultisignature from the signatures of the keys set in its bit array,
+// which is verified against the sum of these keys once their proofs of possession
+// are verified.
+func (m *MultisigPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetSignBytesFunc, sig *signing.MultiSignatureData) error {
+	if err := m.validate(); err != nil {
+		return err
+	}
+	bitarray := sig.BitArray
+	if bitarray == nil || bitarray.Count() != len(m.PubKeys) {
+		return fmt.Errorf("bit array size is incorrect, expecting: %d", len(m.PubKeys))
+	}
+	signers := bitarray.NumTrueBitsBefore(bitarray.Count())
+	if signers < int(m.Threshold) {
+		return fmt.Errorf("not enough signatures set, have %d, expected %d", signers, int(m.Threshold))
+	}
+	if len(sig.Signatures) != 1 {
+		return fmt.Errorf("expected a single aggregate signature, got %d signatures", len(sig.Signatures))
+	}
+	aggSig, ok := sig.Signatures[0].(*signing.SingleSignatureData)
+	if !ok {
+		return fmt.Errorf("improper signature data type %T for an aggregate signature", sig.Signatures[0])
+	}
+
+	pubKeys := make([]*PubKey, 0, signers)
+	for i, pubKey := range m.PubKeys {
+		if !bitarray.GetIndex(i) {
+			continue
+		}
+		if !pubKey.VerifyProofOfPossession(m.ProofsOfPossession[i]) {
+			return fmt.Errorf("invalid proof of possession of pubkey at index %d", i)
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	aggPubKey, err := aggregatePubKeys(pubKeys)
+	if err != nil {
+		return err
+	}
+	if aggPubKey.IsInfinity() {
+		return errors.New("aggregate pubkey is the point at infinity")
+	}
+
+	msg, err := getSignBytes(aggSig.SignMode)
+	if err != nil {
+		return err
+	}
+	if !verify(aggPubKey, msg, aggSig.Signature, signatureDST) {
+		return errors.New("unable to verify aggregate signature")
+	}
+	return nil
+}
+
+// AggregateMultisignature returns the multi-signature of a MultisigPubKey with
+// the signatures of sig, one per key set in its bit array as added by
+// multisig.AddSignature, aggregated into a single signature. All the signatures
+// must be of the same sign mode.
+func AggregateMultisignature(sig *signing.MultiSignatureData) (*signing.MultiSignatureData, error) {
+	if len(sig.Signatures) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+	var signMode signing.SignMode
+	sigs := make([][]byte, len(sig.Signatures))
+	for i, data := range sig.Signatures {
+		single, ok := data.(*signing.SingleSignatureData)
+		if !ok {
+			return nil, fmt.Errorf("improper signature data type %T at index %d", data, i)
+		}
+		if i == 0 {
+			signMode = single.SignMode
+		} else if single.SignMode != signMode {
+			return nil, fmt.Errorf("signature at index %d is of sign mode %s, expected %s", i, single.SignMode, signMode)
+		}
+		sigs[i] = single.Signature
+	}
+
+	aggSig, err := AggregateSignatures(sigs)
+	if err != nil {
+		return nil, err
+	}
+	return &signing.MultiSignatureData{
+		BitArray:   sig.BitArray,
+		Signatures: []signing.SignatureData{&signing.SingleSignatureData{SignMode: signMode, Signature: aggSig}},
+	}, nil
+}
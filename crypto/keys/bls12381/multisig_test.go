@@ -0,0 +1,126 @@
+package bls12381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func generateKeys(t *testing.T, n int) ([]*bls12381.PrivKey, []*bls12381.PubKey, [][]byte) {
+	t.Helper()
+	privKeys := make([]*bls12381.PrivKey, n)
+	pubKeys := make([]*bls12381.PubKey, n)
+	pops := make([][]byte, n)
+	for i := range n {
+		privKeys[i] = bls12381.GenPrivKey()
+		pubKeys[i] = privKeys[i].PubKey().(*bls12381.PubKey)
+		pop, err := privKeys[i].ProofOfPossession()
+		require.NoError(t, err)
+		pops[i] = pop
+	}
+	return privKeys, pubKeys, pops
+}
+
+func TestNewMultisigPubKey(t *testing.T) {
+	_, pubKeys, pops := generateKeys(t, 3)
+
+	pk, err := bls12381.NewMultisigPubKey(2, pubKeys, pops)
+	require.NoError(t, err)
+	require.Equal(t, uint(2), pk.GetThreshold())
+	require.Len(t, pk.GetPubKeys(), 3)
+	require.Len(t, pk.Address(), 32)
+
+	_, err = bls12381.NewMultisigPubKey(0, pubKeys, pops)
+	require.Error(t, err)
+	_, err = bls12381.NewMultisigPubKey(4, pubKeys, pops)
+	require.Error(t, err)
+	_, err = bls12381.NewMultisigPubKey(2, pubKeys, pops[:2])
+	require.Error(t, err)
+	_, err = bls12381.NewMultisigPubKey(2, pubKeys, [][]byte{pops[0], pops[2], pops[1]})
+	require.ErrorContains(t, err, "invalid proof of possession")
+	_, err = bls12381.NewMultisigPubKey(2, []*bls12381.PubKey{pubKeys[0], pubKeys[0]}, [][]byte{pops[0], pops[0]})
+	require.ErrorContains(t, err, "duplicate pubkey")
+
+	other, err := bls12381.NewMultisigPubKey(3, pubKeys, pops)
+	require.NoError(t, err)
+	require.False(t, pk.Equals(other))
+	require.NotEqual(t, pk.Address(), other.Address())
+}
+
+func TestVerifyMultisignature(t *testing.T) {
+	privKeys, pubKeys, pops := generateKeys(t, 3)
+	pk, err := bls12381.NewMultisigPubKey(2, pubKeys, pops)
+	require.NoError(t, err)
+
+	msg := []byte("hello world")
+	getSignBytes := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+	sign := func(indices ...int) *signing.MultiSignatureData {
+		sig := multisig.NewMultisig(len(pubKeys))
+		for _, i := range indices {
+			bz, err := privKeys[i].Sign(msg)
+			require.NoError(t, err)
+			multisig.AddSignature(sig, &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: bz}, i)
+		}
+		return sig
+	}
+
+	sig, err := bls12381.AggregateMultisignature(sign(0, 2))
+	require.NoError(t, err)
+	require.Len(t, sig.Signatures, 1)
+	require.NoError(t, pk.VerifyMultisignature(getSignBytes, sig))
+
+	sig, err = bls12381.AggregateMultisignature(sign(0, 1, 2))
+	require.NoError(t, err)
+	require.NoError(t, pk.VerifyMultisignature(getSignBytes, sig))
+
+	// the signatures must be aggregated
+	require.ErrorContains(t, pk.VerifyMultisignature(getSignBytes, sign(0, 1)), "single aggregate signature")
+
+	// not enough signers
+	sig, err = bls12381.AggregateMultisignature(sign(1))
+	require.NoError(t, err)
+	require.ErrorContains(t, pk.VerifyMultisignature(getSignBytes, sig), "not enough signatures")
+
+	// the bit array must match the signers of the aggregate signature
+	sig, err = bls12381.AggregateMultisignature(sign(0, 1))
+	require.NoError(t, err)
+	sig.BitArray.SetIndex(1, false)
+	sig.BitArray.SetIndex(2, true)
+	require.ErrorContains(t, pk.VerifyMultisignature(getSignBytes, sig), "unable to verify aggregate signature")
+
+	// a different message
+	sig, err = bls12381.AggregateMultisignature(sign(0, 1))
+	require.NoError(t, err)
+	require.Error(t, pk.VerifyMultisignature(func(signing.SignMode) ([]byte, error) { return []byte("hello"), nil }, sig))
+
+	// the proofs of possession of the signers are verified
+	tampered := *pk
+	tampered.ProofsOfPossession = [][]byte{pops[1], pops[0], pops[2]}
+	require.ErrorContains(t, tampered.VerifyMultisignature(getSignBytes, sig), "invalid proof of possession")
+}
+
+func TestAggregateMultisignatureSignModes(t *testing.T) {
+	sig := multisig.NewMultisig(2)
+	multisig.AddSignature(sig, &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT}, 0)
+	multisig.AddSignature(sig, &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}, 1)
+	_, err := bls12381.AggregateMultisignature(sig)
+	require.ErrorContains(t, err, "sign mode")
+}
+
+func TestMultisigPubKeyAny(t *testing.T) {
+	_, pubKeys, pops := generateKeys(t, 2)
+	pk, err := bls12381.NewMultisigPubKey(1, pubKeys, pops)
+	require.NoError(t, err)
+
+	anyPk, err := codectypes.NewAnyWithValue(pk)
+	require.NoError(t, err)
+	var pk2 bls12381.MultisigPubKey
+	require.NoError(t, pk2.Unmarshal(anyPk.Value))
+	require.True(t, pk.Equals(&pk2))
+	require.Equal(t, pk.Address(), pk2.Address())
+}
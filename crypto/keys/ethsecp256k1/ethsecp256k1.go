@@ -0,0 +1,209 @@
+package ethsecp256k1
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto"
+	secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ cryptotypes.PrivKey  = &PrivKey{}
+	_ codec.AminoMarshaler = &PrivKey{}
+)
+
+const (
+	// PrivKeySize is the size of a secp256k1 private key scalar.
+	PrivKeySize = 32
+	// PubKeySize is the size of a compressed secp256k1 public key.
+	PubKeySize = 33
+	// SignatureSize is the size of a recoverable signature of the form R || S || V.
+	SignatureSize = 65
+
+	keyType     = "eth_secp256k1"
+	PrivKeyName = "cosmos/PrivKeyEthSecp256k1"
+	PubKeyName  = "cosmos/PubKeyEthSecp256k1"
+)
+
+// Keccak256 returns the legacy Keccak256 digest used by Ethereum of the given data.
+func Keccak256(data ...[]byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	for _, bz := range data {
+		hasher.Write(bz)
+	}
+	return hasher.Sum(nil)
+}
+
+// GenPrivKey generates a new secp256k1 private key using OS randomness.
+func GenPrivKey() *PrivKey {
+	priv, err := secp256k1.GeneratePrivateKeyFromRand(crypto.CReader())
+	if err != nil {
+		panic(err)
+	}
+	return &PrivKey{Key: priv.Serialize()}
+}
+
+// Bytes returns the byte representation of the Private Key.
+func (privKey *PrivKey) Bytes() []byte {
+	return privKey.Key
+}
+
+// PubKey returns the compressed public key of the private key.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	pubkeyObject := secp256k1.PrivKeyFromBytes(privKey.Key).PubKey()
+	return &PubKey{Key: pubkeyObject.SerializeCompressed()}
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+func (privKey *PrivKey) Type() string {
+	return keyType
+}
+
+// Sign creates a recoverable ECDSA signature on curve secp256k1 of the Keccak256
+// digest of msg. The returned signature is of the form R || S || V (in lower-S
+// form) where V is the recovery id, 0 or 1, as used by Ethereum.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	priv := secp256k1.PrivKeyFromBytes(privKey.Key)
+	sig := ecdsa.SignCompact(priv, Keccak256(msg), false)
+
+	// move the recovery code from the front to the back, as a 0 or 1 recovery id
+	return append(sig[1:], sig[0]-27), nil
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size")
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+//-------------------------------------
+
+var (
+	_ cryptotypes.PubKey   = &PubKey{}
+	_ codec.AminoMarshaler = &PubKey{}
+)
+
+// Address returns an Ethereum style address: the last 20 bytes of the Keccak256
+// hash of the uncompressed pubkey without its 0x04 prefix. The address of a
+// pubkey which is not a valid curve point is derived from its raw bytes instead,
+// so that no account can sign for it.
+func (pubKey *PubKey) Address() crypto.Address {
+	pub, err := secp256k1.ParsePubKey(pubKey.Key)
+	if err != nil {
+		return Keccak256(pubKey.Key)[12:]
+	}
+
+	return Keccak256(pub.SerializeUncompressed()[1:])[12:]
+}
+
+// Bytes returns the pubkey byte format.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeyEthSecp256k1{%X}", pubKey.Key)
+}
+
+func (pubKey *PubKey) Type() string {
+	return keyType
+}
+
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// VerifySignature verifies a signature of the Keccak256 digest of msg of the
+// form R || S || V, or R || S. It rejects signatures which are not in lower-S
+// form, and recoverable signatures whose recovery id does not recover the pubkey.
+func (pubKey *PubKey) VerifySignature(msg, sigStr []byte) bool {
+	if len(sigStr) != SignatureSize && len(sigStr) != SignatureSize-1 {
+		return false
+	}
+	pub, err := secp256k1.ParsePubKey(pubKey.Key)
+	if err != nil {
+		return false
+	}
+
+	var r, s secp256k1.ModNScalar
+	if r.SetByteSlice(sigStr[:32]) || s.SetByteSlice(sigStr[32:64]) || s.IsOverHalfOrder() {
+		return false
+	}
+	digest := Keccak256(msg)
+
+	if len(sigStr) == SignatureSize {
+		v := sigStr[64]
+		if v > 1 {
+			return false
+		}
+		compact := make([]byte, SignatureSize)
+		compact[0] = v + 27
+		copy(compact[1:], sigStr[:64])
+		recovered, _, err := ecdsa.RecoverCompact(compact, digest)
+		return err == nil && recovered.IsEqual(pub)
+	}
+
+	return ecdsa.NewSignature(&r, &s).Verify(digest, pub)
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errorsmod.Wrap(errors.ErrInvalidPubKey, "invalid pubkey size")
+	}
+	if _, err := secp256k1.ParsePubKey(bz); err != nil {
+		return errorsmod.Wrap(errors.ErrInvalidPubKey, err.Error())
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
@@ -0,0 +1,93 @@
+package ethsecp256k1_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
+)
+
+func TestPubKeyAddress(t *testing.T) {
+	// private key and address of the EIP-155 example transaction
+	bz, err := hex.DecodeString("4646464646464646464646464646464646464646464646464646464646464646")
+	require.NoError(t, err)
+	privKey := &ethsecp256k1.PrivKey{Key: bz}
+
+	require.Equal(t, "9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f", hex.EncodeToString(privKey.PubKey().Address()))
+}
+
+func TestHDEthPath(t *testing.T) {
+	mnemonic := "test test test test test test test test test test test junk"
+	derivedPriv, err := hd.EthSecp256k1.Derive()(mnemonic, "", hd.EthFullPath)
+	require.NoError(t, err)
+	privKey := hd.EthSecp256k1.Generate()(derivedPriv)
+
+	require.IsType(t, &ethsecp256k1.PrivKey{}, privKey)
+	require.Equal(t, "f39fd6e51aad88f6f4ce6ab8827279cfffb92266", hex.EncodeToString(privKey.PubKey().Address()))
+}
+
+func TestSignAndVerify(t *testing.T) {
+	privKey := ethsecp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	msg := []byte("hello world")
+
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, ethsecp256k1.SignatureSize)
+	require.True(t, sig[64] == 0 || sig[64] == 1)
+
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	require.True(t, pubKey.VerifySignature(msg, sig[:64]))
+	require.False(t, pubKey.VerifySignature([]byte("hello"), sig))
+	require.False(t, ethsecp256k1.GenPrivKey().PubKey().VerifySignature(msg, sig))
+
+	// a wrong recovery id recovers a different pubkey
+	flipped := append([]byte{}, sig...)
+	flipped[64] ^= 1
+	require.False(t, pubKey.VerifySignature(msg, flipped))
+	flipped[64] = 27
+	require.False(t, pubKey.VerifySignature(msg, flipped))
+}
+
+func TestAminoMarshal(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	cdc.RegisterConcrete(&ethsecp256k1.PubKey{}, ethsecp256k1.PubKeyName, nil)
+	cdc.RegisterConcrete(&ethsecp256k1.PrivKey{}, ethsecp256k1.PrivKeyName, nil)
+
+	privKey := ethsecp256k1.GenPrivKey()
+	pubKey := privKey.PubKey().(*ethsecp256k1.PubKey)
+
+	bz, err := cdc.Marshal(pubKey)
+	require.NoError(t, err)
+	var pubKey2 ethsecp256k1.PubKey
+	require.NoError(t, cdc.Unmarshal(bz, &pubKey2))
+	require.True(t, pubKey.Equals(&pubKey2))
+
+	bz, err = cdc.Marshal(privKey)
+	require.NoError(t, err)
+	var privKey2 ethsecp256k1.PrivKey
+	require.NoError(t, cdc.Unmarshal(bz, &privKey2))
+	require.True(t, privKey.Equals(&privKey2))
+
+	require.Error(t, pubKey2.UnmarshalAmino([]byte{1, 2, 3}))
+
+	// a pubkey of the right size which is not a curve point is rejected
+	invalid := make([]byte, ethsecp256k1.PubKeySize)
+	invalid[0] = 0x02
+	require.ErrorContains(t, pubKey2.UnmarshalAmino(invalid), "invalid pubkey")
+	require.True(t, pubKey.Equals(&pubKey2))
+}
+
+func TestInvalidPubKeyAddress(t *testing.T) {
+	invalid := make([]byte, ethsecp256k1.PubKeySize)
+	invalid[0] = 0x02
+	pubKey := &ethsecp256k1.PubKey{Key: invalid}
+
+	require.NotPanics(t, func() { pubKey.Address() })
+	require.Equal(t, ethsecp256k1.Keccak256(invalid)[12:], []byte(pubKey.Address()))
+	require.False(t, pubKey.VerifySignature([]byte("msg"), make([]byte, 64)))
+}
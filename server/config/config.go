@@ -91,6 +91,12 @@ type BaseConfig struct {
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
+
+	// ParallelTxWorkers defines the number of workers executing the txs of a
+	// block in parallel. The txs are executed sequentially if lower than 2 or
+	// if store tracing is enabled. Txs writing the same keys, e.g. the fee
+	// collector balance, are executed one after the other.
+	ParallelTxWorkers int `mapstructure:"parallel-tx-workers"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -233,6 +239,7 @@ func DefaultConfig() *Config {
 			IAVLCacheSize:       781250,
 			IAVLDisableFastNode: false,
 			AppDBBackend:        "",
+			ParallelTxWorkers:   0,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -86,6 +86,15 @@ iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 # The fallback is the db_backend value set in CometBFT's config.toml.
 app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
 
+# ParallelTxWorkers defines the number of workers executing the txs of a block
+# in parallel, with the same results as if executed sequentially.
+# The txs are executed sequentially if lower than 2, which is the default, or if
+# store tracing (--trace-store) is enabled.
+# Txs writing the same keys are executed one after the other: as every tx pays
+# its fees to the fee collector and, with x/feemarket, records them in the block
+# base fees, txs paying fees mostly serialize and the speedup is limited.
+parallel-tx-workers = {{ .BaseConfig.ParallelTxWorkers }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
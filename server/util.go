@@ -565,6 +565,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		defaultMempool,
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
+		baseapp.SetParallelTxExecution(cast.ToInt(appOpts.Get(FlagParallelTxWorkers))),
 	}
 }
 
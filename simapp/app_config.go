@@ -56,6 +56,8 @@ import (
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	_ "github.com/cosmos/cosmos-sdk/x/epochs" // import for side-effects
 	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	_ "github.com/cosmos/cosmos-sdk/x/feemarket/module" // import for side-effects
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -71,6 +73,8 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	_ "github.com/cosmos/cosmos-sdk/x/staking" // import for side-effects
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	_ "github.com/cosmos/cosmos-sdk/x/symrewards/module" // import for side-effects
+	symrewardstypes "github.com/cosmos/cosmos-sdk/x/symrewards/types"
 	_ "github.com/cosmos/cosmos-sdk/x/symslashing" // import for side-effects
 	symslashingtypes "github.com/cosmos/cosmos-sdk/x/symslashing/types"
 	_ "github.com/cosmos/cosmos-sdk/x/symstaking/module" // import for side-effects
@@ -89,6 +93,8 @@ var (
 		{Account: nft.ModuleName},
 		{Account: protocolpooltypes.ModuleName},
 		{Account: protocolpooltypes.ProtocolPoolEscrowAccount},
+		{Account: symrewardstypes.ModuleName},
+		{Account: feemarkettypes.ModuleName, Permissions: []string{authtypes.Burner}},
 	}
 
 	// blocked account addresses
@@ -99,6 +105,8 @@ var (
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
 		nft.ModuleName,
+		symrewardstypes.ModuleName,
+		feemarkettypes.ModuleName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 	}
@@ -119,6 +127,10 @@ var (
 				// NOTE: staking module is required if HistoricalEntries param > 0
 				BeginBlockers: []string{
 					minttypes.ModuleName,
+					// symrewards collects the fees and inflation of the symstaking validators
+					// every block, before x/distribution allocates them to the x/staking
+					// validators, and distributes them on its own interval.
+					symrewardstypes.ModuleName,
 					distrtypes.ModuleName,
 					protocolpooltypes.ModuleName,
 					slashingtypes.ModuleName,
@@ -136,6 +148,14 @@ var (
 					feegrant.ModuleName,
 					group.ModuleName,
 					protocolpooltypes.ModuleName,
+					// feemarket updates the base gas price from the gas used by the whole block,
+					// and moves the base fees out of the fee collector before x/distribution
+					// allocates it in the next block.
+					feemarkettypes.ModuleName,
+				},
+				// symstaking submits the pending signature requests to the local relay once a block is committed.
+				PrepareCheckStaters: []string{
+					symstakingtypes.ModuleName,
 				},
 				OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{
 					{
@@ -149,6 +169,8 @@ var (
 				// NOTE: The genutils module must occur after staking so that pools are
 				// properly initialized with tokens from genesis accounts.
 				// NOTE: The genutils module must also occur after auth so that it can access the params from auth.
+				// NOTE: The genutils module must also occur after feemarket so that the ante handler can access the
+				// params from feemarket when delivering the gentxs.
 				InitGenesis: []string{
 					authtypes.ModuleName,
 					banktypes.ModuleName,
@@ -157,8 +179,10 @@ var (
 					symstakingtypes.ModuleName,
 					slashingtypes.ModuleName,
 					symslashingtypes.ModuleName,
+					symrewardstypes.ModuleName,
 					govtypes.ModuleName,
 					minttypes.ModuleName,
+					feemarkettypes.ModuleName,
 					genutiltypes.ModuleName,
 					evidencetypes.ModuleName,
 					authz.ModuleName,
@@ -183,6 +207,7 @@ var (
 					symstakingtypes.ModuleName,
 					slashingtypes.ModuleName,
 					symslashingtypes.ModuleName,
+					symrewardstypes.ModuleName,
 					govtypes.ModuleName,
 					minttypes.ModuleName,
 					genutiltypes.ModuleName,
@@ -195,6 +220,7 @@ var (
 					vestingtypes.ModuleName,
 					circuittypes.ModuleName,
 					epochstypes.ModuleName,
+					feemarkettypes.ModuleName,
 				},
 				// Uncomment if you want to set a custom migration order here.
 				// OrderMigrations: []string{},
@@ -236,6 +262,14 @@ var (
 				RelayClientRpc: os.Getenv("SYMBIOTIC_RELAY_RPC"),
 			}),
 		},
+		{
+			Name:   symrewardstypes.ModuleName,
+			Config: appconfig.WrapAny(&symrewardstypes.Module{}),
+		},
+		{
+			Name:   feemarkettypes.ModuleName,
+			Config: appconfig.WrapAny(&feemarkettypes.Module{}),
+		},
 		{
 			Name:   slashingtypes.ModuleName,
 			Config: appconfig.WrapAny(&slashingmodulev1.Module{}),
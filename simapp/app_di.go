@@ -7,6 +7,7 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/x/symstaking/abci"
+	"github.com/spf13/cast"
 
 	clienthelpers "cosmossdk.io/client/v2/helpers"
 	"cosmossdk.io/depinject"
@@ -28,6 +29,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -39,12 +41,14 @@ import (
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	feemarketkeeper "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	protocolpoolkeeper "github.com/cosmos/cosmos-sdk/x/protocolpool/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	symrewardskeeper "github.com/cosmos/cosmos-sdk/x/symrewards/keeper"
 	symslashingkeeper "github.com/cosmos/cosmos-sdk/x/symslashing/keeper"
 	symstakingkeeper "github.com/cosmos/cosmos-sdk/x/symstaking/keeper"
 )
@@ -90,6 +94,8 @@ type SimApp struct {
 
 	SymStakingKeeper  *symstakingkeeper.Keeper
 	SymSlashingKeeper symslashingkeeper.Keeper
+	SymRewardsKeeper  *symrewardskeeper.Keeper
+	FeeMarketKeeper   *feemarketkeeper.Keeper
 
 	// simulation manager
 	sm *module.SimulationManager
@@ -179,6 +185,8 @@ func NewSimApp(
 		&app.SymStakingKeeper,
 		&app.SlashingKeeper,
 		&app.SymSlashingKeeper,
+		&app.SymRewardsKeeper,
+		&app.FeeMarketKeeper,
 		&app.MintKeeper,
 		&app.DistrKeeper,
 		&app.GovKeeper,
@@ -222,6 +230,20 @@ func NewSimApp(
 	// }
 	// baseAppOptions = append(baseAppOptions, prepareOpt)
 
+	// use the lane mempool of NewDefaultLaneMempool to reserve block space for
+	// the governance transactions, holding up to the mempool max-txs of the node
+	// config, unless the app-side mempool is disabled. Its proposal handlers are
+	// composed with the symstaking ones below.
+	var laneMempool *mempool.LaneMempool
+	if maxTxs := cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs)); maxTxs >= 0 {
+		var err error
+		laneMempool, err = NewDefaultLaneMempool(maxTxs)
+		if err != nil {
+			panic(err)
+		}
+		baseAppOptions = append(baseAppOptions, baseapp.SetMempool(laneMempool))
+	}
+
 	// create and set dummy vote extension handler
 	voteExtOp := func(bApp *baseapp.BaseApp) {
 		voteExtHandler := NewVoteExtensionHandler()
@@ -270,6 +292,12 @@ func NewSimApp(
 	app.setAnteHandler(app.txConfig)
 
 	proposalHandlers := abci.NewProposalHandler(logger, app.SymStakingKeeper)
+	if laneMempool != nil {
+		// The symstaking handlers inject the relay data in front of the txs the lanes
+		// select, and let the lanes process the proposals without the injected tx.
+		lanePropHandler := baseapp.NewLaneProposalHandler(laneMempool, app.App.BaseApp)
+		proposalHandlers.SetAppProposalHandlers(lanePropHandler.PrepareProposalHandler(), lanePropHandler.ProcessProposalHandler())
+	}
 	// Set the Prepare Proposal and Process Proposal handlers
 	app.SetPrepareProposal(proposalHandlers.PrepareProposal())
 	app.SetProcessProposal(proposalHandlers.ProcessProposal())
@@ -293,6 +321,7 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 				SignModeHandler: txConfig.SignModeHandler(),
 				FeegrantKeeper:  app.FeeGrantKeeper,
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+				TxFeeChecker:    app.FeeMarketKeeper.TxFeeChecker(),
 			},
 			&app.CircuitKeeper,
 		},
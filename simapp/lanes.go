@@ -0,0 +1,72 @@
+package simapp
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	// GovLaneName is the name of the lane of the governance transactions.
+	GovLaneName = "gov"
+	// DefaultLaneName is the name of the lane of the other transactions.
+	DefaultLaneName = "default"
+)
+
+// NewDefaultLaneMempool returns the default lane mempool of SimApp, holding up
+// to maxTx transactions, unbounded if zero:
+//
+//  1. the gov lane, of the transactions only made of governance proposals,
+//     deposits and votes, which takes up to 10% of the block space and is always
+//     proposed first, so that the governance is never crowded out by spam;
+//  2. the default lane, of the other transactions, which takes the rest of the
+//     block space.
+//
+// The maxTx transactions are split across the lanes as the block space is: the
+// gov lane holds up to a tenth of them and the default lane the rest, each lane
+// holding at least one transaction.
+//
+// Both lanes order the transactions by priority.
+func NewDefaultLaneMempool(maxTx int) (*mempool.LaneMempool, error) {
+	if maxTx < 0 {
+		return nil, fmt.Errorf("max txs cannot be negative: %d", maxTx)
+	}
+	govMaxTx, defaultMaxTx := 0, 0
+	if maxTx > 0 {
+		govMaxTx = max(maxTx/10, 1)
+		defaultMaxTx = max(maxTx-govMaxTx, 1)
+	}
+
+	newLaneMempool := func(maxTx int) mempool.ExtMempool {
+		cfg := mempool.DefaultPriorityNonceMempoolConfig()
+		cfg.MaxTx = maxTx
+		return mempool.NewPriorityMempool(cfg)
+	}
+
+	return mempool.NewLaneMempool(
+		mempool.Lane{
+			Name:    GovLaneName,
+			Mempool: newLaneMempool(govMaxTx),
+			Match: mempool.MatchMsgTypeURLs(
+				sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}),
+				sdk.MsgTypeURL(&govv1.MsgDeposit{}),
+				sdk.MsgTypeURL(&govv1.MsgVote{}),
+				sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+				sdk.MsgTypeURL(&govv1beta1.MsgSubmitProposal{}),
+				sdk.MsgTypeURL(&govv1beta1.MsgDeposit{}),
+				sdk.MsgTypeURL(&govv1beta1.MsgVote{}),
+				sdk.MsgTypeURL(&govv1beta1.MsgVoteWeighted{}),
+			),
+			MaxBlockSpace: math.LegacyNewDecWithPrec(1, 1),
+		},
+		mempool.Lane{
+			Name:    DefaultLaneName,
+			Mempool: newLaneMempool(defaultMaxTx),
+		},
+	)
+}
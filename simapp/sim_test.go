@@ -3,6 +3,7 @@
 package simapp
 
 import (
+	"context"
 	"encoding/binary"
 	"encoding/json"
 	"flag"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -24,10 +26,12 @@ import (
 	"cosmossdk.io/x/feegrant"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sims "github.com/cosmos/cosmos-sdk/testutil/simsx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -224,6 +228,126 @@ func TestAppStateDeterminism(t *testing.T) {
 	sims.RunWithSeeds(t, interBlockCachingAppFactory, setupStateFactory, seeds, []byte{}, captureAndCheckHash)
 }
 
+// TestAppParallelTxExecutionDeterminism replays the blocks of the simulations
+// with the txs executed sequentially and in parallel, checking that the results
+// and the app hashes are the same.
+func TestAppParallelTxExecutionDeterminism(t *testing.T) {
+	var (
+		mx        sync.Mutex
+		recorders = make(map[*SimApp]*simBlockRecorder)
+	)
+	recordingAppFactory := func(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, appOpts servertypes.AppOptions, baseAppOptions ...func(*baseapp.BaseApp)) *SimApp {
+		recorder := &simBlockRecorder{}
+		app := NewSimApp(logger, db, traceStore, loadLatest, appOpts, append(baseAppOptions, baseapp.SetMempool(recorder))...)
+		recorder.txEncoder = app.TxConfig().TxEncoder()
+		app.SetStreamingManager(storetypes.StreamingManager{ABCIListeners: []storetypes.ABCIListener{recorder}})
+
+		mx.Lock()
+		recorders[app] = recorder
+		mx.Unlock()
+		return app
+	}
+	recordingStateFactory := func(app *SimApp) sims.SimStateFactory {
+		stateFactory := setupStateFactory(app)
+		appStateFn := stateFactory.AppStateFn
+		stateFactory.AppStateFn = func(r *rand.Rand, accs []simtypes.Account, config simtypes.Config) (json.RawMessage, []simtypes.Account, string, time.Time) {
+			appState, accs, chainID, genesisTime := appStateFn(r, accs, config)
+
+			mx.Lock()
+			recorder := recorders[app]
+			mx.Unlock()
+			recorder.appState, recorder.chainID, recorder.genesisTime = appState, chainID, genesisTime
+			return appState, accs, chainID, genesisTime
+		}
+		return stateFactory
+	}
+	replayAndCompare := func(tb testing.TB, ti sims.TestInstance[*SimApp], _ []simtypes.Account) {
+		tb.Helper()
+		mx.Lock()
+		recorder := recorders[ti.App]
+		delete(recorders, ti.App)
+		mx.Unlock()
+
+		expected := recorder.replay(tb, 0)
+		for _, workers := range []int{2, 8} {
+			require.Equal(tb, expected, recorder.replay(tb, workers), "non-determinism in seed %d with %d workers", ti.Cfg.Seed, workers)
+		}
+	}
+	sims.Run(t, recordingAppFactory, recordingStateFactory, replayAndCompare)
+}
+
+// simBlockRecorder records the genesis and the blocks of a simulation, along
+// with the txs delivered in them through the mempool. The txs rejected by the
+// ante handler are not removed from the mempool, so they are not recorded.
+type simBlockRecorder struct {
+	mempool.NoOpMempool
+
+	txEncoder       sdk.TxEncoder
+	appState        json.RawMessage
+	chainID         string
+	genesisTime     time.Time
+	consensusParams *cmtproto.ConsensusParams
+	blocks          []*abci.RequestFinalizeBlock
+}
+
+func (r *simBlockRecorder) Remove(tx sdk.Tx) error {
+	txBytes, err := r.txEncoder(tx)
+	if err != nil {
+		return err
+	}
+	// the simulations deliver the txs of a block after finalizing it
+	block := r.blocks[len(r.blocks)-1]
+	block.Txs = append(block.Txs, txBytes)
+	return nil
+}
+
+func (r *simBlockRecorder) ListenFinalizeBlock(ctx context.Context, req abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	if r.consensusParams == nil {
+		consensusParams := sdk.UnwrapSDKContext(ctx).ConsensusParams()
+		r.consensusParams = &consensusParams
+	}
+	req.Txs = nil
+	r.blocks = append(r.blocks, &req)
+	return nil
+}
+
+func (r *simBlockRecorder) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	return nil
+}
+
+// replay executes the recorded blocks on a new app with the given number of
+// parallel tx workers, returning the responses.
+func (r *simBlockRecorder) replay(tb testing.TB, workers int) []*abci.ResponseFinalizeBlock {
+	tb.Helper()
+	appOptions := simtestutil.AppOptionsMap{flags.FlagHome: tb.TempDir()}
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions,
+		baseapp.SetChainID(r.chainID),
+		baseapp.SetParallelTxExecution(workers),
+	)
+	if !simcli.FlagSigverifyTxValue {
+		app.SetNotSigverifyTx()
+	}
+
+	_, err := app.InitChain(&abci.RequestInitChain{
+		AppStateBytes:   r.appState,
+		ChainId:         r.chainID,
+		ConsensusParams: r.consensusParams,
+		Time:            r.genesisTime,
+	})
+	require.NoError(tb, err)
+
+	responses := make([]*abci.ResponseFinalizeBlock, 0, len(r.blocks))
+	for _, req := range r.blocks {
+		res, err := app.FinalizeBlock(req)
+		require.NoError(tb, err)
+		_, err = app.Commit()
+		require.NoError(tb, err)
+		responses = append(responses, res)
+	}
+	require.NoError(tb, app.Close())
+	return responses
+}
+
 type ComparableStoreApp interface {
 	LastBlockHeight() int64
 	NewContextLegacy(isCheckTx bool, header cmtproto.Header) sdk.Context
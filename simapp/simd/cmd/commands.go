@@ -27,6 +27,7 @@ import (
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	symstakingcli "github.com/cosmos/cosmos-sdk/x/symstaking/client/cli"
 )
 
 // initCometBFTConfig helps to override default CometBFT Config values.
@@ -127,6 +128,7 @@ func initRootCmd(
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
+		symstakingcli.NewSymbioticCmd(),
 	)
 }
 
@@ -177,6 +179,9 @@ func txCommand() *cobra.Command {
 		authcmd.GetMultiSignCommand(),
 		authcmd.GetMultiSignBatchCmd(),
 		authcmd.GetValidateSignaturesCommand(),
+		authcmd.GetSignArbitraryCommand(),
+		authcmd.GetMultiSignArbitraryCommand(),
+		authcmd.GetVerifyArbitraryCommand(),
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
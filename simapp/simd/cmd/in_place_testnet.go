@@ -0,0 +1,59 @@
+//go:build !app_v1
+
+package cmd
+
+import (
+	"io"
+	"path/filepath"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/simapp"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// testnetRelayKeyFile is the mock relay schedule of an in-place testnet, relative to the node home.
+const testnetRelayKeyFile = "config/testnet_relay_schedule.json"
+
+// newTestnetApp creates the application of an in-place testnet, rewriting the
+// state forked from another network so that the local validator controls it.
+func newTestnetApp(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	appOpts servertypes.AppOptions,
+) servertypes.Application {
+	simApp, ok := newApp(logger, db, traceStore, appOpts).(*simapp.SimApp)
+	if !ok {
+		panic("app created from newApp is not of type *simapp.SimApp")
+	}
+
+	userPubKey, ok := appOpts.Get(server.KeyUserPubKey).(cmtcrypto.PubKey)
+	if !ok {
+		panic("expected the local validator consensus key of the in-place testnet")
+	}
+	consPubKey, err := cryptocodec.FromCmtPubKeyInterface(userPubKey)
+	if err != nil {
+		panic(err)
+	}
+	operator := cast.ToString(appOpts.Get(server.KeyNewOpAddr))
+	upgradeToTrigger := cast.ToString(appOpts.Get(server.KeyTriggerTestnetUpgrade))
+	relayKeyFile := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), testnetRelayKeyFile)
+
+	if err := simapp.InitSimAppForTestnet(simApp, consPubKey, operator, relayKeyFile, upgradeToTrigger); err != nil {
+		panic(err)
+	}
+	logger.Info(
+		"in-place testnet initialized, set SYMBIOTIC_KEY_FILE to the relay schedule to keep using the mock relay after a restart",
+		"relay_schedule", relayKeyFile,
+	)
+
+	return simApp
+}
@@ -8,6 +8,8 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -39,6 +41,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	symstakingtypes "github.com/cosmos/cosmos-sdk/x/symstaking/types"
 )
 
 var (
@@ -120,6 +123,7 @@ func NewTestnetCmd(mm module.BasicManager, genBalIterator banktypes.GenesisBalan
 
 	testnetCmd.AddCommand(testnetStartCmd())
 	testnetCmd.AddCommand(generatePrivKeysFile())
+	testnetCmd.AddCommand(generateValKeysFile())
 	testnetCmd.AddCommand(setupTestnetCmd())
 	testnetCmd.AddCommand(testnetInitFilesCmd(mm, genBalIterator))
 
@@ -288,6 +292,221 @@ func generatePrivKeysFile() *cobra.Command {
 	return cmd
 }
 
+// valKeysArgs are the arguments of the mock relay epoch schedule generation.
+type valKeysArgs struct {
+	template         string
+	initial          int
+	epochStep        uint64
+	power            string
+	keyTag           uint32
+	operatorTemplate string
+	events           []string
+}
+
+const (
+	valKeysTemplateStatic    = "static"
+	valKeysTemplateStaggered = "staggered"
+)
+
+func generateValKeysFile() *cobra.Command {
+	var (
+		keysPath string
+		path     string
+		args     valKeysArgs
+	)
+
+	cmd := &cobra.Command{
+		Use:   "gen-valkeys",
+		Short: "generates the mock relay epoch schedule from the node keys file written by gen-keys",
+		Long: fmt.Sprintf(`gen-valkeys generates the epoch schedule read by the mock relay client (SYMBIOTIC_KEY_FILE)
+from the node keys file written by gen-keys. Validators are identified by the line of their key in the keys file.
+
+The static template keeps all validators in every epoch, the staggered template starts with
+--initial-validators validators and adds one validator every --epoch-step epochs.
+
+Events are applied on top of the template, with the format <epoch>:<action>:<validator>[=<value>]:
+	join    the validator joins the set
+	leave   the validator leaves the set
+	power   the validator power changes to <value>
+	rotate  the validator consensus key changes to the key at line <value> of the keys file
+
+Example:
+	%s testnet gen-valkeys --template staggered --initial-validators 3 --event 180:leave:4 --event 200:power:0=20000
+	`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			data, err := os.ReadFile(keysPath)
+			if err != nil {
+				return err
+			}
+			var keys []string
+			for _, key := range strings.Split(string(data), "\n") {
+				if key = strings.TrimSpace(key); key != "" {
+					keys = append(keys, key)
+				}
+			}
+
+			schedule, err := buildMockRelaySchedule(keys, args)
+			if err != nil {
+				return err
+			}
+			if err := schedule.Validate(); err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(schedule, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := writeFile(filepath.Base(path), filepath.Dir(path), bz); err != nil {
+				return err
+			}
+			fmt.Printf("Wrote the schedule of %d epochs to %s\n", len(schedule), path)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&keysPath, "keys-file", "k", ".testnets/keys", "Path of the node keys file written by gen-keys")
+	cmd.Flags().StringVarP(&path, "path", "p", ".testnets/valkeys.json", "Path to save the generated schedule")
+	cmd.Flags().StringVar(&args.template, "template", valKeysTemplateStatic, "Schedule template (static|staggered)")
+	cmd.Flags().IntVar(&args.initial, "initial-validators", 0, "Number of validators in epoch 0 of the staggered template, 0 for all of them")
+	cmd.Flags().Uint64Var(&args.epochStep, "epoch-step", 40, "Number of epochs between two joins of the staggered template")
+	cmd.Flags().StringVar(&args.power, "power", symstakingtypes.DefaultMockRelayPower, "Voting power of the validators")
+	cmd.Flags().Uint32Var(&args.keyTag, "key-tag", symstakingtypes.DefaultMockRelayKeyTag, "Key tag of the validator consensus keys")
+	cmd.Flags().StringVar(&args.operatorTemplate, "operator-template", "0xValidator%d", "Template of the validator operator addresses, formatted with the validator index")
+	cmd.Flags().StringArrayVar(&args.events, "event", nil, "Schedule event <epoch>:<join|leave|power|rotate>:<validator>[=<value>], can be repeated")
+	return cmd
+}
+
+// valKeysEvent is a change of the mock relay validator set at an epoch.
+type valKeysEvent struct {
+	epoch     uint64
+	action    string
+	validator int
+	value     string
+}
+
+func parseValKeysEvent(event string, validators int) (valKeysEvent, error) {
+	parts := strings.SplitN(event, ":", 3)
+	if len(parts) != 3 {
+		return valKeysEvent{}, fmt.Errorf("invalid event %q, expected <epoch>:<action>:<validator>[=<value>]", event)
+	}
+
+	epoch, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return valKeysEvent{}, fmt.Errorf("invalid event %q epoch: %w", event, err)
+	}
+
+	target, value, hasValue := strings.Cut(parts[2], "=")
+	validator, err := strconv.Atoi(target)
+	if err != nil || validator < 0 || validator >= validators {
+		return valKeysEvent{}, fmt.Errorf("invalid event %q validator, expected an index lower than %d", event, validators)
+	}
+
+	switch parts[1] {
+	case "join", "leave":
+		if hasValue {
+			return valKeysEvent{}, fmt.Errorf("invalid event %q, %s takes no value", event, parts[1])
+		}
+	case "power", "rotate":
+		if !hasValue {
+			return valKeysEvent{}, fmt.Errorf("invalid event %q, %s requires a value", event, parts[1])
+		}
+	default:
+		return valKeysEvent{}, fmt.Errorf("invalid event %q action, expected join, leave, power or rotate", event)
+	}
+
+	return valKeysEvent{epoch: epoch, action: parts[1], validator: validator, value: value}, nil
+}
+
+// buildMockRelaySchedule applies the template and the events to the validators
+// of the keys, and snapshots the validator set at every epoch it changes.
+func buildMockRelaySchedule(keys []string, args valKeysArgs) (symstakingtypes.MockRelaySchedule, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no keys to generate the schedule from")
+	}
+
+	initial := len(keys)
+	var events []valKeysEvent
+	switch args.template {
+	case valKeysTemplateStatic:
+	case valKeysTemplateStaggered:
+		if args.initial > 0 && args.initial < len(keys) {
+			initial = args.initial
+		}
+		if args.epochStep == 0 {
+			return nil, fmt.Errorf("epoch step of the staggered template must be positive")
+		}
+		for i := initial; i < len(keys); i++ {
+			events = append(events, valKeysEvent{epoch: uint64(i-initial+1) * args.epochStep, action: "join", validator: i})
+		}
+	default:
+		return nil, fmt.Errorf("unknown template %q, expected %s or %s", args.template, valKeysTemplateStatic, valKeysTemplateStaggered)
+	}
+
+	for _, e := range args.events {
+		event, err := parseValKeysEvent(e, len(keys))
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	// events of the same epoch keep their order, template events first
+	sort.SliceStable(events, func(i, j int) bool { return events[i].epoch < events[j].epoch })
+
+	validators := make([]symstakingtypes.MockRelayValidator, len(keys))
+	active := make([]bool, len(keys))
+	for i, key := range keys {
+		validators[i] = symstakingtypes.MockRelayValidator{
+			PrivKey:  key,
+			Power:    args.power,
+			Operator: fmt.Sprintf(args.operatorTemplate, i),
+			KeyTag:   args.keyTag,
+		}
+		active[i] = i < initial
+	}
+
+	snapshot := func() []symstakingtypes.MockRelayValidator {
+		set := []symstakingtypes.MockRelayValidator{}
+		for i, val := range validators {
+			if active[i] {
+				set = append(set, val)
+			}
+		}
+		return set
+	}
+
+	schedule := symstakingtypes.MockRelaySchedule{}
+	for i := 0; i < len(events); {
+		epoch := events[i].epoch
+		if _, ok := schedule[0]; !ok && epoch > 0 {
+			schedule[0] = snapshot()
+		}
+		for ; i < len(events) && events[i].epoch == epoch; i++ {
+			event := events[i]
+			switch event.action {
+			case "join":
+				active[event.validator] = true
+			case "leave":
+				active[event.validator] = false
+			case "power":
+				validators[event.validator].Power = event.value
+			case "rotate":
+				line, err := strconv.Atoi(event.value)
+				if err != nil || line < 0 || line >= len(keys) {
+					return nil, fmt.Errorf("invalid rotation of validator %d at epoch %d, expected a key line lower than %d", event.validator, epoch, len(keys))
+				}
+				validators[event.validator].PrivKey = keys[line]
+			}
+		}
+		schedule[epoch] = snapshot()
+	}
+	if _, ok := schedule[0]; !ok {
+		schedule[0] = snapshot()
+	}
+
+	return schedule, nil
+}
+
 const nodeDirPerm = 0o755
 
 // initTestnetFiles initializes testnet files for a testnet to be run in a separate process
@@ -68,3 +68,37 @@ func Test_TestnetCmd(t *testing.T) {
 	bankGenState := banktypes.GetGenesisStateFromAppState(encodingConfig.Codec, appState)
 	require.NotEmpty(t, bankGenState.Supply.String())
 }
+
+func Test_buildMockRelaySchedule(t *testing.T) {
+	keys := []string{"key0", "key1", "key2", "key3"}
+
+	schedule, err := buildMockRelaySchedule(keys, valKeysArgs{
+		template:         valKeysTemplateStaggered,
+		initial:          2,
+		epochStep:        10,
+		power:            "100",
+		operatorTemplate: "op%d",
+		events:           []string{"15:power:0=200", "20:leave:1", "30:rotate:0=1"},
+	})
+	require.NoError(t, err)
+
+	operators := func(epoch uint64) []string {
+		var ops []string
+		for _, val := range schedule[epoch] {
+			ops = append(ops, val.Operator)
+		}
+		return ops
+	}
+	require.Len(t, schedule, 5)
+	require.Equal(t, []string{"op0", "op1"}, operators(0))
+	require.Equal(t, []string{"op0", "op1", "op2"}, operators(10))
+	require.Equal(t, "200", schedule[15][0].Power)
+	require.Equal(t, []string{"op0", "op2", "op3"}, operators(20))
+	require.Equal(t, "key1", schedule[30][0].PrivKey)
+
+	_, err = buildMockRelaySchedule(keys, valKeysArgs{template: valKeysTemplateStatic, events: []string{"5:leave:4"}})
+	require.ErrorContains(t, err, "expected an index lower than 4")
+
+	_, err = buildMockRelaySchedule(keys, valKeysArgs{template: valKeysTemplateStatic, events: []string{"5:power:1"}})
+	require.ErrorContains(t, err, "power requires a value")
+}
@@ -0,0 +1,128 @@
+//go:build !app_v1
+
+package simapp
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	symstakingtypes "github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+// TestnetValidatorPower is the voting power of the local validator of an
+// in-place testnet, the one the in-place-testnet command writes to the CometBFT
+// state.
+const TestnetValidatorPower int64 = 900000000000000
+
+// InitSimAppForTestnet rewrites the state forked from another network by the
+// in-place-testnet command, so that the local validator with the given
+// consensus key controls the testnet:
+//   - the symstaking validator set is replaced by the local validator, and the
+//     relay sync is paused to pin the current epoch,
+//   - the local validator is registered in x/staking under the operator
+//     address, as x/distribution looks up the validators of the commit votes
+//     there,
+//   - the relay client is switched to a mock relay serving the local validator,
+//     from the schedule written to relayKeyFile,
+//   - the symslashing signing infos are cleared,
+//   - the upgrade to trigger, if any, is scheduled on the first block.
+func InitSimAppForTestnet(app *SimApp, consPubKey cryptotypes.PubKey, operator, relayKeyFile, upgradeToTrigger string) error {
+	ctx := app.NewUncachedContext(true, cmtproto.Header{Height: app.LastBlockHeight()})
+
+	params, err := app.SymStakingKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	schedule := symstakingtypes.MockRelaySchedule{0: {{
+		PubKey:   hex.EncodeToString(consPubKey.Bytes()),
+		Power:    strconv.FormatInt(TestnetValidatorPower, 10),
+		Operator: operator,
+		KeyTag:   params.ValidatorKeyTag,
+	}}}
+	if err := schedule.Validate(); err != nil {
+		return fmt.Errorf("invalid testnet relay schedule: %w", err)
+	}
+	if err := symstakingtypes.WriteMockRelaySchedule(relayKeyFile, schedule); err != nil {
+		return err
+	}
+	relayClient, err := symstakingtypes.NewRelayClient(symstakingtypes.MockRelayRPCAddress, relayKeyFile)
+	if err != nil {
+		return err
+	}
+	app.SymStakingKeeper.SetRelayClient(relayClient)
+
+	if err := app.SymStakingKeeper.InitTestnet(ctx, consPubKey, TestnetValidatorPower); err != nil {
+		return fmt.Errorf("failed to init symstaking testnet: %w", err)
+	}
+	// the signing infos are cleared after the symstaking hooks updated them
+	if err := app.SymSlashingKeeper.InitTestnet(ctx, consPubKey); err != nil {
+		return fmt.Errorf("failed to init symslashing testnet: %w", err)
+	}
+
+	if err := setTestnetStakingValidator(ctx, app, consPubKey, operator); err != nil {
+		return fmt.Errorf("failed to set testnet staking validator: %w", err)
+	}
+
+	if upgradeToTrigger != "" {
+		if err := app.UpgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{
+			Name:   upgradeToTrigger,
+			Height: app.LastBlockHeight() + 1,
+		}); err != nil {
+			return fmt.Errorf("failed to schedule upgrade %s: %w", upgradeToTrigger, err)
+		}
+	}
+
+	return nil
+}
+
+// setTestnetStakingValidator sets the consensus key of the x/staking validator
+// of the operator, creating the validator if it doesn't exist.
+func setTestnetStakingValidator(ctx sdk.Context, app *SimApp, consPubKey cryptotypes.PubKey, operator string) error {
+	operatorAddr, err := app.AccountKeeper.AddressCodec().StringToBytes(operator)
+	if err != nil {
+		return fmt.Errorf("invalid operator address %s: %w", operator, err)
+	}
+	valAddr, err := app.StakingKeeper.ValidatorAddressCodec().BytesToString(operatorAddr)
+	if err != nil {
+		return err
+	}
+
+	validator, err := app.StakingKeeper.GetValidator(ctx, sdk.ValAddress(operatorAddr))
+	created := errors.Is(err, stakingtypes.ErrNoValidatorFound)
+	switch {
+	case created:
+		validator, err = stakingtypes.NewValidator(valAddr, consPubKey, stakingtypes.Description{Moniker: "testnet"})
+		if err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	default:
+		if validator.ConsensusPubkey, err = codectypes.NewAnyWithValue(consPubKey); err != nil {
+			return err
+		}
+	}
+
+	if err := app.StakingKeeper.SetValidator(ctx, validator); err != nil {
+		return err
+	}
+	if err := app.StakingKeeper.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+	if !created {
+		return nil
+	}
+	// x/distribution initializes the rewards of the validator, which it reads
+	// from the store
+	return app.StakingKeeper.Hooks().AfterValidatorCreated(ctx, sdk.ValAddress(operatorAddr))
+}
@@ -0,0 +1,226 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ ExtMempool     = (*LaneMempool)(nil)
+	_ RecheckMempool = (*LaneMempool)(nil)
+	_ Iterator       = (*laneIterator)(nil)
+)
+
+// ErrNoMatchingLane is returned when inserting a transaction which matches none
+// of the lanes of a LaneMempool.
+var ErrNoMatchingLane = errors.New("tx does not match any lane")
+
+// MatchFn reports whether a transaction belongs to a lane.
+type MatchFn func(tx sdk.Tx) bool
+
+// Lane is a class of transactions with its own mempool and share of the block
+// space.
+type Lane struct {
+	// Name identifies the lane.
+	Name string
+	// Mempool holds the transactions of the lane, in the order they are
+	// proposed.
+	Mempool ExtMempool
+	// Match reports whether a transaction belongs to the lane. A nil Match
+	// matches every transaction, as the last, default lane typically does.
+	Match MatchFn
+	// MaxBlockSpace is the maximum ratio of the block bytes and gas taken by the
+	// transactions of the lane. A nil or zero MaxBlockSpace only limits the lane
+	// by the space left in the block.
+	MaxBlockSpace math.LegacyDec
+}
+
+// MaxTxBytes returns the maximum bytes of the transactions of the lane in a
+// block of maxTxBytes transaction bytes.
+func (l Lane) MaxTxBytes(maxTxBytes uint64) uint64 {
+	return l.limit(maxTxBytes)
+}
+
+// MaxGas returns the maximum gas of the transactions of the lane in a block of
+// maxBlockGas gas.
+func (l Lane) MaxGas(maxBlockGas uint64) uint64 {
+	return l.limit(maxBlockGas)
+}
+
+func (l Lane) limit(blockLimit uint64) uint64 {
+	if l.MaxBlockSpace.IsNil() || l.MaxBlockSpace.IsZero() {
+		return blockLimit
+	}
+
+	return l.MaxBlockSpace.MulInt(math.NewIntFromUint64(blockLimit)).TruncateInt().Uint64()
+}
+
+// MatchMsgTypeURLs returns a MatchFn matching the transactions whose messages
+// all have one of the typeURLs.
+func MatchMsgTypeURLs(typeURLs ...string) MatchFn {
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+
+		for _, msg := range msgs {
+			if !slices.Contains(typeURLs, sdk.MsgTypeURL(msg)) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// LaneMempool is a mempool composed of lanes, which reserve block space for
+// classes of transactions so that, for instance, governance votes are never
+// crowded out by spam. A transaction is inserted in the first lane it matches,
+// and the mempool is iterated lane by lane, in the order of the lanes, each lane
+// in the order of its own mempool.
+//
+// The block space of the lanes is enforced by the proposal handlers, see
+// baseapp.LaneProposalHandler.
+type LaneMempool struct {
+	lanes []Lane
+}
+
+// NewLaneMempool creates a new mempool of the lanes, in order of precedence.
+func NewLaneMempool(lanes ...Lane) (*LaneMempool, error) {
+	if len(lanes) == 0 {
+		return nil, errors.New("lane mempool must have at least one lane")
+	}
+
+	names := make(map[string]struct{}, len(lanes))
+	for _, lane := range lanes {
+		if lane.Name == "" {
+			return nil, errors.New("lane name cannot be empty")
+		}
+		if _, ok := names[lane.Name]; ok {
+			return nil, fmt.Errorf("duplicate lane %s", lane.Name)
+		}
+		names[lane.Name] = struct{}{}
+
+		if lane.Mempool == nil {
+			return nil, fmt.Errorf("lane %s has no mempool", lane.Name)
+		}
+		if !lane.MaxBlockSpace.IsNil() && (lane.MaxBlockSpace.IsNegative() || lane.MaxBlockSpace.GT(math.LegacyOneDec())) {
+			return nil, fmt.Errorf("max block space of lane %s must be between 0 and 1: %s", lane.Name, lane.MaxBlockSpace)
+		}
+	}
+
+	return &LaneMempool{lanes: lanes}, nil
+}
+
+// Lanes returns the lanes of the mempool, in order of precedence.
+func (lm *LaneMempool) Lanes() []Lane {
+	return lm.lanes
+}
+
+// LaneIndex returns the index of the lane of the transaction, the first lane it
+// matches, or -1 if it matches none.
+func (lm *LaneMempool) LaneIndex(tx sdk.Tx) int {
+	for i, lane := range lm.lanes {
+		if lane.Match == nil || lane.Match(tx) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Insert inserts the transaction in its lane.
+func (lm *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	i := lm.LaneIndex(tx)
+	if i < 0 {
+		return ErrNoMatchingLane
+	}
+
+	return lm.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of the lanes, in the order
+// of the lanes.
+func (lm *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	return (&laneIterator{ctx: ctx, txs: txs, lanes: lm.lanes, lane: -1}).nextLane()
+}
+
+// SelectBy iterates over the transactions of the lanes, in the order of the
+// lanes, until the callback returns false.
+func (lm *LaneMempool) SelectBy(ctx context.Context, txs [][]byte, callback func(sdk.Tx) bool) {
+	next := true
+	for _, lane := range lm.lanes {
+		lane.Mempool.SelectBy(ctx, txs, func(tx sdk.Tx) bool {
+			next = callback(tx)
+			return next
+		})
+		if !next {
+			return
+		}
+	}
+}
+
+// CountTx returns the number of transactions of all the lanes.
+func (lm *LaneMempool) CountTx() int {
+	var count int
+	for _, lane := range lm.lanes {
+		count += lane.Mempool.CountTx()
+	}
+	return count
+}
+
+// Remove removes the transaction from its lane.
+func (lm *LaneMempool) Remove(tx sdk.Tx) error {
+	i := lm.LaneIndex(tx)
+	if i < 0 {
+		return ErrTxNotFound
+	}
+
+	return lm.lanes[i].Mempool.Remove(tx)
+}
+
+// Recheck rechecks the transactions of the lanes whose mempool is a
+// RecheckMempool.
+func (lm *LaneMempool) Recheck(ctx context.Context) {
+	for _, lane := range lm.lanes {
+		if mp, ok := lane.Mempool.(RecheckMempool); ok {
+			mp.Recheck(ctx)
+		}
+	}
+}
+
+// laneIterator chains the iterators of the lanes.
+type laneIterator struct {
+	ctx   context.Context
+	txs   [][]byte
+	lanes []Lane
+	lane  int
+	iter  Iterator
+}
+
+func (i *laneIterator) Next() Iterator {
+	if i.iter = i.iter.Next(); i.iter != nil {
+		return i
+	}
+	return i.nextLane()
+}
+
+func (i *laneIterator) Tx() sdk.Tx {
+	return i.iter.Tx()
+}
+
+// nextLane moves the iterator to the first transaction of the next non-empty
+// lane, or returns nil if there is none.
+func (i *laneIterator) nextLane() Iterator {
+	for i.lane++; i.lane < len(i.lanes); i.lane++ {
+		if i.iter = i.lanes[i.lane].Mempool.Select(i.ctx, i.txs); i.iter != nil {
+			return i
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package mempool_test
+
+import (
+	"sort"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// Property Based Testing
+// Insert the txs in a lane mempool of two sender nonce lanes, split by priority parity, and test the following properties
+// same elements input on the mempool should be in the output except for sender nonce duplicates, which are overwritten by the later duplicate entries.
+// the txs of the first lane all come before the txs of the second lane.
+// for every sender transaction of a lane tx_n, tx_0.nonce < tx_1.nonce ... < tx_n.nonce
+
+func testLaneMempoolProperties(t *rapid.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	mp, err := mempool.NewLaneMempool(
+		mempool.Lane{Name: "even", Mempool: mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000)), Match: evenPriority},
+		mempool.Lane{Name: "default", Mempool: mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000))},
+	)
+	require.NoError(t, err)
+
+	genMultipleAddress := rapid.SliceOfNDistinct(AddressGenerator(t), 1, 10, func(acc sdk.AccAddress) string {
+		return acc.String()
+	})
+
+	accounts := genMultipleAddress.Draw(t, "address")
+	genTx := rapid.Custom(func(t *rapid.T) testTx {
+		return testTx{
+			priority: rapid.Int64Range(0, 1000).Draw(t, "priority"),
+			nonce:    rapid.Uint64().Draw(t, "nonce"),
+			address:  rapid.SampledFrom(accounts).Draw(t, "acc"),
+		}
+	})
+	genMultipleTX := rapid.SliceOfN(genTx, 1, 5000)
+
+	txs := genMultipleTX.Draw(t, "txs")
+	var evenTxs, defaultTxs []testTx
+	for _, tx := range txs {
+		err := mp.Insert(ctx, tx)
+		require.NoError(t, err)
+
+		if evenPriority(tx) {
+			evenTxs = append(evenTxs, tx)
+		} else {
+			defaultTxs = append(defaultTxs, tx)
+		}
+	}
+
+	orderTx := fetchAllTxs(mp.Select(ctx, nil))
+	require.Equal(t, len(orderTx), mp.CountTx())
+
+	// the txs of the even lane come first
+	firstDefault := sort.Search(len(orderTx), func(i int) bool { return !evenPriority(orderTx[i]) })
+	for _, tx := range orderTx[firstDefault:] {
+		require.False(t, evenPriority(tx))
+	}
+
+	for lane, raw := range [][]testTx{evenTxs, defaultTxs} {
+		ordered := orderTx[:firstDefault]
+		if lane == 1 {
+			ordered = orderTx[firstDefault:]
+		}
+
+		senderTxRaw := getSenderTxMap(raw)
+		senderTxOrdered := getSenderTxMap(ordered)
+		require.Equal(t, len(senderTxRaw), len(senderTxOrdered))
+		for key, ordered := range senderTxOrdered {
+			raw, found := senderTxRaw[key]
+			require.True(t, found)
+			rawSet := mergeByNonce(raw)
+			sort.Slice(rawSet, func(i, j int) bool { return rawSet[i].nonce < rawSet[j].nonce })
+			require.Equal(t, rawSet, ordered)
+		}
+	}
+}
+
+func (s *MempoolTestSuite) TestLaneMempoolProperties() {
+	t := s.T()
+	rapid.Check(t, testLaneMempoolProperties)
+}
@@ -0,0 +1,169 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// evenPriority matches the test txs of even priority.
+func evenPriority(tx sdk.Tx) bool {
+	return tx.(testTx).priority%2 == 0
+}
+
+func newTestLaneMempool(t *testing.T) *mempool.LaneMempool {
+	t.Helper()
+
+	mp, err := mempool.NewLaneMempool(
+		mempool.Lane{Name: "even", Mempool: mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000)), Match: evenPriority},
+		mempool.Lane{Name: "default", Mempool: mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000))},
+	)
+	require.NoError(t, err)
+	return mp
+}
+
+func TestNewLaneMempool(t *testing.T) {
+	newMempool := func() mempool.ExtMempool { return mempool.NewSenderNonceMempool() }
+
+	tests := []struct {
+		desc  string
+		lanes []mempool.Lane
+		valid bool
+	}{
+		{
+			desc:  "single lane",
+			lanes: []mempool.Lane{{Name: "default", Mempool: newMempool()}},
+			valid: true,
+		},
+		{
+			desc:  "no lane",
+			lanes: nil,
+		},
+		{
+			desc:  "empty name",
+			lanes: []mempool.Lane{{Mempool: newMempool()}},
+		},
+		{
+			desc:  "duplicate name",
+			lanes: []mempool.Lane{{Name: "a", Mempool: newMempool()}, {Name: "a", Mempool: newMempool()}},
+		},
+		{
+			desc:  "no mempool",
+			lanes: []mempool.Lane{{Name: "default"}},
+		},
+		{
+			desc:  "max block space above 1",
+			lanes: []mempool.Lane{{Name: "default", Mempool: newMempool(), MaxBlockSpace: math.LegacyNewDecWithPrec(11, 1)}},
+		},
+		{
+			desc:  "negative max block space",
+			lanes: []mempool.Lane{{Name: "default", Mempool: newMempool(), MaxBlockSpace: math.LegacyNewDec(-1)}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := mempool.NewLaneMempool(tc.lanes...)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestLaneMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	mp := newTestLaneMempool(t)
+
+	txs := []testTx{
+		{id: 0, priority: 1, nonce: 0, address: accounts[0].Address},
+		{id: 1, priority: 2, nonce: 0, address: accounts[1].Address},
+		{id: 2, priority: 3, nonce: 1, address: accounts[0].Address},
+		{id: 3, priority: 4, nonce: 1, address: accounts[1].Address},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, mp.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 0, mp.LaneIndex(txs[1]))
+	require.Equal(t, 1, mp.LaneIndex(txs[0]))
+
+	// the txs of the even lane come first
+	require.Equal(t, []int{1, 3, 0, 2}, fetchTxIDs(mp.Select(ctx, nil)))
+
+	var selected []int
+	mp.SelectBy(ctx, nil, func(tx sdk.Tx) bool {
+		selected = append(selected, tx.(testTx).id)
+		return len(selected) < 3
+	})
+	require.Equal(t, []int{1, 3, 0}, selected)
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.NoError(t, mp.Remove(txs[0]))
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+	require.Equal(t, []int{3, 2}, fetchTxIDs(mp.Select(ctx, nil)))
+
+	require.NoError(t, mp.Remove(txs[3]))
+	require.Equal(t, []int{2}, fetchTxIDs(mp.Select(ctx, nil)))
+	require.NoError(t, mp.Remove(txs[2]))
+	require.Nil(t, mp.Select(ctx, nil))
+}
+
+func TestLaneMempoolNoMatchingLane(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	mp, err := mempool.NewLaneMempool(
+		mempool.Lane{Name: "even", Mempool: mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000)), Match: evenPriority},
+	)
+	require.NoError(t, err)
+
+	tx := testTx{priority: 1, address: simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)[0].Address}
+	require.Equal(t, -1, mp.LaneIndex(tx))
+	require.ErrorIs(t, mp.Insert(ctx, tx), mempool.ErrNoMatchingLane)
+	require.ErrorIs(t, mp.Remove(tx), mempool.ErrTxNotFound)
+}
+
+func TestLaneMaxBlockSpace(t *testing.T) {
+	lane := mempool.Lane{MaxBlockSpace: math.LegacyNewDecWithPrec(25, 2)}
+	require.Equal(t, uint64(250), lane.MaxTxBytes(1000))
+	require.Equal(t, uint64(2), lane.MaxGas(10))
+
+	// no max block space
+	require.Equal(t, uint64(1000), mempool.Lane{}.MaxTxBytes(1000))
+	require.Equal(t, uint64(1000), mempool.Lane{MaxBlockSpace: math.LegacyZeroDec()}.MaxGas(1000))
+}
+
+func TestMatchMsgTypeURLs(t *testing.T) {
+	match := mempool.MatchMsgTypeURLs(sdk.MsgTypeURL(&testdata.TestMsg{}))
+
+	require.True(t, match(msgsTx{&testdata.TestMsg{}}))
+	require.False(t, match(msgsTx{&testdata.TestMsg{}, &testdata.MsgCreateDog{}}))
+	require.False(t, match(msgsTx{}))
+}
+
+type msgsTx []sdk.Msg
+
+func (tx msgsTx) GetMsgs() []sdk.Msg { return tx }
+
+func (msgsTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func fetchTxIDs(iterator mempool.Iterator) []int {
+	var ids []int
+	for ; iterator != nil; iterator = iterator.Next() {
+		ids = append(ids, iterator.Tx().(testTx).id)
+	}
+	return ids
+}
@@ -34,6 +34,17 @@ type ExtMempool interface {
 	SelectBy(context.Context, [][]byte, func(sdk.Tx) bool)
 }
 
+// RecheckMempool is a Mempool which rechecks its transactions after each commit,
+// evicting those no longer valid. BaseApp calls Recheck after each commit if
+// its mempool implements RecheckMempool.
+type RecheckMempool interface {
+	Mempool
+
+	// Recheck evicts the transactions no longer valid in the check state ctx of
+	// the last commit.
+	Recheck(ctx context.Context)
+}
+
 // Iterator defines an app-side mempool iterator interface that is as minimal as
 // possible. The order of iteration is determined by the app-side mempool
 // implementation.
@@ -47,8 +58,9 @@ type Iterator interface {
 }
 
 var (
-	ErrTxNotFound           = errors.New("tx not found in mempool")
-	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+	ErrTxNotFound               = errors.New("tx not found in mempool")
+	ErrMempoolTxMaxCapacity     = errors.New("pool reached max tx capacity")
+	ErrMempoolSenderMaxCapacity = errors.New("pool reached max tx capacity of sender")
 )
 
 // SelectBy is compatible with old interface to avoid breaking api.
@@ -0,0 +1,26 @@
+package mempool
+
+import (
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// The reasons a transaction is evicted from a mempool, labelling the eviction
+// metrics.
+const (
+	EvictionReasonCapacity    = "capacity"
+	EvictionReasonSenderLimit = "sender_limit"
+	EvictionReasonExpired     = "expired"
+	EvictionReasonRecheck     = "recheck"
+)
+
+// recordEviction increments the counter of the transactions evicted from the
+// mempool for the reason.
+func recordEviction(reason string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"mempool", "evicted"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("reason", reason)},
+	)
+}
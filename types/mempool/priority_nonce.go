@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"time"
 
 	"github.com/huandu/skiplist"
 
@@ -12,8 +13,9 @@ import (
 )
 
 var (
-	_ ExtMempool = (*PriorityNonceMempool[int64])(nil)
-	_ Iterator   = (*PriorityNonceIterator[int64])(nil)
+	_ ExtMempool     = (*PriorityNonceMempool[int64])(nil)
+	_ RecheckMempool = (*PriorityNonceMempool[int64])(nil)
+	_ Iterator       = (*PriorityNonceIterator[int64])(nil)
 )
 
 type (
@@ -40,6 +42,30 @@ type (
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTx int
 
+		// EvictLowerPriority makes a full mempool, capped by MaxTx, evict its lowest
+		// priority tx to insert a tx of a strictly higher priority, rather than
+		// rejecting the tx.
+		EvictLowerPriority bool
+
+		// MaxTxsPerSender caps the number of transactions of a sender if > 0, so
+		// that one sender cannot fill the mempool with nonce-gapped txs. Inserting a
+		// tx of a sender at the cap evicts its highest nonce tx if the nonce of the
+		// inserted tx is lower, or fails otherwise.
+		MaxTxsPerSender int
+
+		// TTLBlocks is the number of blocks after which a transaction expires if > 0,
+		// counted from the block it was inserted at.
+		TTLBlocks int64
+
+		// TTL is the duration after which a transaction expires if > 0, counted in
+		// block time from the block it was inserted at.
+		TTL time.Duration
+
+		// RecheckTx is called by Recheck on each transaction after each commit, and
+		// the transactions it returns an error for are evicted, e.g. the txs of a
+		// nonce lower than the sequence of their sender.
+		RecheckTx func(ctx context.Context, tx sdk.Tx) error
+
 		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
 		SignerExtractor SignerExtractionAdapter
 	}
@@ -57,9 +83,16 @@ type (
 		priorityCounts map[C]int
 		senderIndices  map[string]*skiplist.SkipList
 		scores         map[txMeta[C]]txMeta[C]
+		insertions     map[txMeta[C]]txInsertion
 		cfg            PriorityNonceMempoolConfig[C]
 	}
 
+	// txInsertion stores the block a transaction was inserted at, for its TTL.
+	txInsertion struct {
+		height int64
+		time   time.Time
+	}
+
 	// PriorityNonceIterator defines an iterator that is used for mempool iteration
 	// on Select().
 	PriorityNonceIterator[C comparable] struct {
@@ -123,6 +156,33 @@ func DefaultPriorityNonceMempoolConfig() PriorityNonceMempoolConfig[int64] {
 	}
 }
 
+// NewFeeBumpTxReplacement returns a TxReplacement rule replacing a tx of the
+// same nonce only if the new tx pays, in each denom of the fee of the old tx, a
+// fee at least minBumpPercent percent higher. Txs which are not sdk.FeeTx are
+// never replaced.
+func NewFeeBumpTxReplacement[C comparable](minBumpPercent uint64) func(op, np C, oTx, nTx sdk.Tx) bool {
+	return func(_, _ C, oTx, nTx sdk.Tx) bool {
+		oldFeeTx, ok := oTx.(sdk.FeeTx)
+		if !ok {
+			return false
+		}
+		newFeeTx, ok := nTx.(sdk.FeeTx)
+		if !ok {
+			return false
+		}
+
+		newFee := newFeeTx.GetFee()
+		for _, coin := range oldFeeTx.GetFee() {
+			// new * 100 >= old * (100 + minBumpPercent)
+			minFee := coin.Amount.MulRaw(int64(100 + minBumpPercent))
+			if newFee.AmountOf(coin.Denom).MulRaw(100).LT(minFee) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
 // skiplistComparable is a comparator for txKeys that first compares priority,
 // then weight, then sender, then nonce, uniquely identifying a transaction.
 //
@@ -168,6 +228,7 @@ func NewPriorityMempool[C comparable](cfg PriorityNonceMempoolConfig[C]) *Priori
 		priorityCounts: make(map[C]int),
 		senderIndices:  make(map[string]*skiplist.SkipList),
 		scores:         make(map[txMeta[C]]txMeta[C]),
+		insertions:     make(map[txMeta[C]]txInsertion),
 		cfg:            cfg,
 	}
 
@@ -201,10 +262,13 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 //
 // Inserting a duplicate tx with a different priority overwrites the existing tx,
 // changing the total order of the mempool.
+//
+// Inserting a new tx in a full mempool, or for a sender at the MaxTxsPerSender
+// cap, evicts a tx or fails as configured.
 func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
-	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
+	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx && !mp.cfg.EvictLowerPriority && mp.cfg.MaxTxsPerSender <= 0 {
 		return ErrMempoolTxMaxCapacity
 	} else if mp.cfg.MaxTx < 0 {
 		return nil
@@ -228,6 +292,12 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 
 	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
 
+	if _, txExists := mp.scores[txMeta[C]{nonce: nonce, sender: sender}]; !txExists {
+		if err := mp.makeRoom(sender, nonce, priority); err != nil {
+			return err
+		}
+	}
+
 	senderIndex, ok := mp.senderIndices[sender]
 	if !ok {
 		senderIndex = skiplist.New(skiplist.LessThanFunc(func(a, b any) int {
@@ -275,6 +345,44 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	mp.scores[sk] = txMeta[C]{priority: priority}
 	mp.priorityIndex.Set(key, tx)
 
+	if mp.cfg.TTLBlocks > 0 || mp.cfg.TTL > 0 {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		mp.insertions[sk] = txInsertion{height: sdkCtx.BlockHeight(), time: sdkCtx.BlockTime()}
+	}
+
+	return nil
+}
+
+// makeRoom evicts the tx making room for a new tx of the sender, nonce and
+// priority, if the mempool or the sender is at its cap. It returns an error if
+// the tx cannot be inserted.
+func (mp *PriorityNonceMempool[C]) makeRoom(sender string, nonce uint64, priority C) error {
+	if mp.cfg.MaxTxsPerSender > 0 {
+		if senderIndex, ok := mp.senderIndices[sender]; ok && senderIndex.Len() >= mp.cfg.MaxTxsPerSender {
+			// keep the lowest nonces of the sender, which are executable first
+			highest := senderIndex.Back().Key().(txMeta[C])
+			if nonce > highest.nonce {
+				return ErrMempoolSenderMaxCapacity
+			}
+
+			mp.evict(highest.sender, highest.nonce, EvictionReasonSenderLimit)
+			return nil
+		}
+	}
+
+	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
+		if !mp.cfg.EvictLowerPriority {
+			return ErrMempoolTxMaxCapacity
+		}
+
+		lowest := mp.priorityIndex.Back().Key().(txMeta[C])
+		if mp.cfg.TxPriority.Compare(priority, lowest.priority) <= 0 {
+			return ErrMempoolTxMaxCapacity
+		}
+
+		mp.evict(lowest.sender, lowest.nonce, EvictionReasonCapacity)
+	}
+
 	return nil
 }
 
@@ -445,6 +553,61 @@ func (mp *PriorityNonceMempool[C]) CountTx() int {
 	return mp.priorityIndex.Len()
 }
 
+// Recheck evicts the transactions expired at the block of ctx, by TTLBlocks or
+// TTL, and those RecheckTx returns an error for. It is called by BaseApp after
+// each commit, with the check state of the commit.
+func (mp *PriorityNonceMempool[C]) Recheck(ctx context.Context) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if mp.cfg.TTLBlocks <= 0 && mp.cfg.TTL <= 0 && mp.cfg.RecheckTx == nil {
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	type eviction struct {
+		sender string
+		nonce  uint64
+		reason string
+	}
+	var evictions []eviction
+	for node := mp.priorityIndex.Front(); node != nil; node = node.Next() {
+		key := node.Key().(txMeta[C])
+		if mp.expired(sdkCtx, key.sender, key.nonce) {
+			evictions = append(evictions, eviction{key.sender, key.nonce, EvictionReasonExpired})
+		} else if mp.cfg.RecheckTx != nil && mp.cfg.RecheckTx(ctx, node.Value.(sdk.Tx)) != nil {
+			evictions = append(evictions, eviction{key.sender, key.nonce, EvictionReasonRecheck})
+		}
+	}
+
+	for _, e := range evictions {
+		mp.evict(e.sender, e.nonce, e.reason)
+	}
+}
+
+// expired reports whether the tx of the sender and nonce is expired at the block
+// of ctx.
+func (mp *PriorityNonceMempool[C]) expired(ctx sdk.Context, sender string, nonce uint64) bool {
+	insertion, ok := mp.insertions[txMeta[C]{nonce: nonce, sender: sender}]
+	if !ok {
+		return false
+	}
+
+	if mp.cfg.TTLBlocks > 0 && ctx.BlockHeight()-insertion.height >= mp.cfg.TTLBlocks {
+		return true
+	}
+	return mp.cfg.TTL > 0 && !ctx.BlockTime().Before(insertion.time.Add(mp.cfg.TTL))
+}
+
+// evict removes the tx of the sender and nonce from the mempool, recording the
+// eviction in the metrics.
+func (mp *PriorityNonceMempool[C]) evict(sender string, nonce uint64, reason string) {
+	if err := mp.remove(sender, nonce); err == nil {
+		recordEviction(reason)
+	}
+}
+
 // Remove removes a transaction from the mempool in O(log n) time, returning an
 // error if unsuccessful.
 func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
@@ -465,6 +628,10 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 		return err
 	}
 
+	return mp.remove(sender, nonce)
+}
+
+func (mp *PriorityNonceMempool[C]) remove(sender string, nonce uint64) error {
 	scoreKey := txMeta[C]{nonce: nonce, sender: sender}
 	score, ok := mp.scores[scoreKey]
 	if !ok {
@@ -480,6 +647,7 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	mp.priorityIndex.Remove(tk)
 	senderTxs.Remove(tk)
 	delete(mp.scores, scoreKey)
+	delete(mp.insertions, scoreKey)
 	mp.priorityCounts[score.priority]--
 
 	return nil
@@ -973,6 +973,192 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	require.Equal(t, txs[3], iter.Tx())
 }
 
+func TestNextSenderTx_EvictLowerPriority(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:         mempool.NewDefaultTxPriority(),
+			MaxTx:              2,
+			EvictLowerPriority: true,
+		},
+	)
+
+	low := testTx{id: 0, priority: 10, nonce: 1, address: sa}
+	high := testTx{id: 1, priority: 20, nonce: 1, address: sb}
+	require.NoError(t, mp.Insert(ctx.WithPriority(low.priority), low))
+	require.NoError(t, mp.Insert(ctx.WithPriority(high.priority), high))
+
+	// a tx of a priority not higher than the lowest is rejected
+	tie := testTx{id: 2, priority: 10, nonce: 1, address: sc}
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(tie.priority), tie), mempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, 2, mp.CountTx())
+
+	// a tx of a higher priority evicts the lowest priority tx
+	higher := testTx{id: 3, priority: 15, nonce: 1, address: sc}
+	require.NoError(t, mp.Insert(ctx.WithPriority(higher.priority), higher))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []int{1, 3}, txIDs(fetchTxs(mp.Select(ctx, nil), 10)))
+}
+
+func TestNextSenderTx_MaxTxsPerSender(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			MaxTxsPerSender: 2,
+		},
+	)
+
+	txs := []testTx{
+		{id: 0, priority: 10, nonce: 1, address: sa},
+		{id: 1, priority: 10, nonce: 5, address: sa},
+		{id: 2, priority: 10, nonce: 1, address: sb},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	// a higher nonce of a sender at the cap is rejected
+	gapped := testTx{id: 3, priority: 10, nonce: 9, address: sa}
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(gapped.priority), gapped), mempool.ErrMempoolSenderMaxCapacity)
+	require.Equal(t, 3, mp.CountTx())
+
+	// replacing a tx of a sender at the cap is allowed
+	replacement := testTx{id: 4, priority: 10, nonce: 5, address: sa}
+	require.NoError(t, mp.Insert(ctx.WithPriority(replacement.priority), replacement))
+	require.Equal(t, 3, mp.CountTx())
+
+	// a lower nonce evicts the highest nonce of the sender
+	lower := testTx{id: 5, priority: 10, nonce: 2, address: sa}
+	require.NoError(t, mp.Insert(ctx.WithPriority(lower.priority), lower))
+	require.Equal(t, 3, mp.CountTx())
+	require.ElementsMatch(t, []int{0, 5, 2}, txIDs(fetchTxs(mp.Select(ctx, nil), 10)))
+}
+
+func TestPriorityNonceMempool_Recheck(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+	now := time.Now()
+	ctxAt := func(height int64, blockTime time.Time) sdk.Context {
+		return sdk.NewContext(nil, cmtproto.Header{Height: height, Time: blockTime}, false, log.NewNopLogger())
+	}
+
+	testCases := map[string]struct {
+		cfg      mempool.PriorityNonceMempoolConfig[int64]
+		recheck  sdk.Context
+		expected []int
+	}{
+		"no ttl": {
+			cfg:      mempool.PriorityNonceMempoolConfig[int64]{},
+			recheck:  ctxAt(100, now.Add(time.Hour)),
+			expected: []int{0, 1},
+		},
+		"ttl blocks": {
+			cfg:      mempool.PriorityNonceMempoolConfig[int64]{TTLBlocks: 5},
+			recheck:  ctxAt(15, now),
+			expected: []int{1},
+		},
+		"ttl blocks not expired": {
+			cfg:      mempool.PriorityNonceMempoolConfig[int64]{TTLBlocks: 6},
+			recheck:  ctxAt(15, now),
+			expected: []int{0, 1},
+		},
+		"ttl duration": {
+			cfg:      mempool.PriorityNonceMempoolConfig[int64]{TTL: time.Minute},
+			recheck:  ctxAt(10, now.Add(time.Minute)),
+			expected: []int{1},
+		},
+		"recheck tx": {
+			cfg: mempool.PriorityNonceMempoolConfig[int64]{
+				RecheckTx: func(_ context.Context, tx sdk.Tx) error {
+					if tx.(testTx).address.Equals(sb) {
+						return fmt.Errorf("invalid tx")
+					}
+					return nil
+				},
+			},
+			recheck:  ctxAt(10, now),
+			expected: []int{0},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.cfg.TxPriority = mempool.NewDefaultTxPriority()
+			mp := mempool.NewPriorityMempool(tc.cfg)
+
+			// the tx of sa is inserted at height 10, the tx of sb at height 12
+			require.NoError(t, mp.Insert(ctxAt(10, now), testTx{id: 0, nonce: 1, address: sa}))
+			require.NoError(t, mp.Insert(ctxAt(12, now.Add(30*time.Second)), testTx{id: 1, nonce: 1, address: sb}))
+
+			mp.Recheck(tc.recheck)
+			require.ElementsMatch(t, tc.expected, txIDs(fetchTxs(mp.Select(tc.recheck, nil), 10)))
+			require.Equal(t, len(tc.expected), mp.CountTx())
+		})
+	}
+}
+
+// txIDs returns the ids of the testTxs.
+func txIDs(txs []sdk.Tx) []int {
+	ids := make([]int, len(txs))
+	for i, tx := range txs {
+		ids[i] = tx.(testTx).id
+	}
+	return ids
+}
+
+// feeTestTx is a testTx paying a fee.
+type feeTestTx struct {
+	testTx
+	fee sdk.Coins
+}
+
+func (tx feeTestTx) GetGas() uint64     { return 0 }
+func (tx feeTestTx) GetFee() sdk.Coins  { return tx.fee }
+func (tx feeTestTx) FeePayer() []byte   { return tx.address }
+func (tx feeTestTx) FeeGranter() []byte { return nil }
+
+func TestNewFeeBumpTxReplacement(t *testing.T) {
+	replace := mempool.NewFeeBumpTxReplacement[int64](10)
+	oldTx := feeTestTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 10))}
+
+	testCases := map[string]struct {
+		newTx    sdk.Tx
+		expected bool
+	}{
+		"bumped": {
+			newTx:    feeTestTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 110), sdk.NewInt64Coin("atom", 11))},
+			expected: true,
+		},
+		"bumped with another denom": {
+			newTx:    feeTestTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 200), sdk.NewInt64Coin("atom", 20), sdk.NewInt64Coin("foo", 1))},
+			expected: true,
+		},
+		"bump too low": {
+			newTx:    feeTestTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 109), sdk.NewInt64Coin("atom", 11))},
+			expected: false,
+		},
+		"missing denom": {
+			newTx:    feeTestTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))},
+			expected: false,
+		},
+		"not a fee tx": {
+			newTx:    testTx{},
+			expected: false,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, replace(0, 0, oldTx, tc.newTx))
+		})
+	}
+}
+
 func TestPriorityNonceMempool_UnorderedTx_FailsForSequence(t *testing.T) {
 	mp := mempool.DefaultPriorityMempool()
 	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
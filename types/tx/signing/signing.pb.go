@@ -67,6 +67,11 @@ const (
 	//
 	// Since: cosmos-sdk 0.45.2
 	SignMode_SIGN_MODE_EIP_191 SignMode = 191
+	// SIGN_MODE_EIP_712 specifies the sign mode for EIP 712 signing on the Cosmos
+	// SDK. The EIP-712 typed data is built from the SIGN_MODE_LEGACY_AMINO_JSON
+	// sign document, so it can be signed by standard Ethereum wallets.
+	// Ref: https://eips.ethereum.org/EIPS/eip-712
+	SignMode_SIGN_MODE_EIP_712 SignMode = 712
 )
 
 var SignMode_name = map[int32]string{
@@ -76,6 +81,7 @@ var SignMode_name = map[int32]string{
 	3:   "SIGN_MODE_DIRECT_AUX",
 	127: "SIGN_MODE_LEGACY_AMINO_JSON",
 	191: "SIGN_MODE_EIP_191",
+	712: "SIGN_MODE_EIP_712",
 }
 
 var SignMode_value = map[string]int32{
@@ -85,6 +91,7 @@ var SignMode_value = map[string]int32{
 	"SIGN_MODE_DIRECT_AUX":        3,
 	"SIGN_MODE_LEGACY_AMINO_JSON": 127,
 	"SIGN_MODE_EIP_191":           191,
+	"SIGN_MODE_EIP_712":           712,
 }
 
 func (x SignMode) String() string {
@@ -214,7 +221,6 @@ type SignatureDescriptor_Data struct {
 	// sum is the oneof that specifies whether this represents single or multi-signature data
 	//
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*SignatureDescriptor_Data_Single_
 	//	*SignatureDescriptor_Data_Multi_
 	Sum isSignatureDescriptor_Data_Sum `protobuf_oneof:"sum"`
@@ -422,43 +428,43 @@ func init() {
 }
 
 var fileDescriptor_9a54958ff3d0b1b9 = []byte{
-	// 573 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xc7, 0xed, 0x26, 0xad, 0xda, 0xe9, 0xa7, 0x4f, 0x66, 0x49, 0x51, 0x6a, 0x90, 0xa9, 0xca,
-	0x81, 0x0a, 0xa9, 0x6b, 0xa5, 0x3d, 0xa0, 0x72, 0x73, 0x13, 0x93, 0x9a, 0x36, 0x69, 0xb1, 0x53,
-	0xa9, 0x70, 0xb1, 0x6c, 0x67, 0x6b, 0xac, 0xc6, 0x5e, 0xe3, 0x5d, 0xa3, 0xfa, 0xc4, 0x2b, 0xf0,
-	0x12, 0x1c, 0x78, 0x0a, 0x0e, 0x5c, 0x38, 0xf6, 0xc8, 0x11, 0x25, 0xcf, 0xc0, 0x1d, 0xc5, 0x8e,
-	0x93, 0x80, 0x8a, 0x10, 0x39, 0x59, 0x33, 0xf3, 0xdf, 0xdf, 0xfc, 0x57, 0x33, 0x6b, 0x78, 0xec,
-	0x51, 0x16, 0x52, 0xa6, 0xf2, 0x6b, 0x95, 0x05, 0x7e, 0x14, 0x44, 0xbe, 0xfa, 0xae, 0xe1, 0x12,
-	0xee, 0x34, 0xca, 0x18, 0xc7, 0x09, 0xe5, 0x14, 0x6d, 0x16, 0x42, 0xcc, 0xaf, 0x71, 0x59, 0x98,
-	0x08, 0xe5, 0xdd, 0x09, 0xc3, 0x4b, 0xb2, 0x98, 0x53, 0x35, 0x4c, 0x07, 0x3c, 0x60, 0xc1, 0x0c,
-	0x54, 0x26, 0x0a, 0x92, 0xbc, 0xe9, 0x53, 0xea, 0x0f, 0x88, 0x9a, 0x47, 0x6e, 0x7a, 0xa9, 0x3a,
-	0x51, 0x56, 0x94, 0xb6, 0x2f, 0xa1, 0x66, 0x05, 0x7e, 0xe4, 0xf0, 0x34, 0x21, 0x2d, 0xc2, 0xbc,
-	0x24, 0x88, 0x39, 0x4d, 0x18, 0xea, 0x02, 0xb0, 0x32, 0xcf, 0xea, 0xe2, 0x56, 0x65, 0x67, 0x7d,
-	0x0f, 0xe3, 0x3f, 0x3a, 0xc2, 0xb7, 0x40, 0xcc, 0x39, 0xc2, 0xf6, 0x8f, 0x2a, 0xdc, 0xbd, 0x45,
-	0x83, 0xf6, 0x01, 0xe2, 0xd4, 0x1d, 0x04, 0x9e, 0x7d, 0x45, 0xb2, 0xba, 0xb8, 0x25, 0xee, 0xac,
-	0xef, 0xd5, 0x70, 0xe1, 0x17, 0x97, 0x7e, 0xb1, 0x16, 0x65, 0xe6, 0x5a, 0xa1, 0x3b, 0x26, 0x19,
-	0x6a, 0x43, 0xb5, 0xef, 0x70, 0xa7, 0xbe, 0x94, 0xcb, 0xf7, 0xff, 0xcd, 0x16, 0x6e, 0x39, 0xdc,
-	0x31, 0x73, 0x00, 0x92, 0x61, 0x95, 0x91, 0xb7, 0x29, 0x89, 0x3c, 0x52, 0xaf, 0x6c, 0x89, 0x3b,
-	0x55, 0x73, 0x1a, 0xcb, 0x5f, 0x2a, 0x50, 0x1d, 0x4b, 0x51, 0x0f, 0x56, 0x58, 0x10, 0xf9, 0x03,
-	0x32, 0xb1, 0xf7, 0x6c, 0x81, 0x7e, 0xd8, 0xca, 0x09, 0x47, 0x82, 0x39, 0x61, 0xa1, 0x97, 0xb0,
-	0x9c, 0x4f, 0x69, 0x72, 0x89, 0x83, 0x45, 0xa0, 0x9d, 0x31, 0xe0, 0x48, 0x30, 0x0b, 0x92, 0x6c,
-	0xc3, 0x4a, 0xd1, 0x06, 0x3d, 0x85, 0x6a, 0x48, 0xfb, 0x85, 0xe1, 0xff, 0xf7, 0x1e, 0xfd, 0x85,
-	0xdd, 0xa1, 0x7d, 0x62, 0xe6, 0x07, 0xd0, 0x03, 0x58, 0x9b, 0x0e, 0x2d, 0x77, 0xf6, 0x9f, 0x39,
-	0x4b, 0xc8, 0x9f, 0x44, 0x58, 0xce, 0x7b, 0xa2, 0x63, 0x58, 0x75, 0x03, 0xee, 0x24, 0x89, 0x53,
-	0x0e, 0x4d, 0x2d, 0x9b, 0x14, 0x3b, 0x89, 0xa7, 0x2b, 0x58, 0x76, 0x6a, 0xd2, 0x30, 0x76, 0x3c,
-	0x7e, 0x18, 0x70, 0x6d, 0x7c, 0xcc, 0x9c, 0x02, 0x90, 0xf5, 0xcb, 0xae, 0x2d, 0xe5, 0xbb, 0xb6,
-	0xd0, 0x50, 0xe7, 0x30, 0x87, 0xcb, 0x50, 0x61, 0x69, 0xf8, 0xe4, 0xa3, 0x08, 0xab, 0xe5, 0x1d,
-	0xd1, 0x26, 0x6c, 0x58, 0x46, 0xbb, 0x6b, 0x77, 0x4e, 0x5b, 0xba, 0x7d, 0xde, 0xb5, 0xce, 0xf4,
-	0xa6, 0xf1, 0xdc, 0xd0, 0x5b, 0x92, 0x80, 0x6a, 0x20, 0xcd, 0x4a, 0x2d, 0xc3, 0xd4, 0x9b, 0x3d,
-	0x49, 0x44, 0x1b, 0x70, 0x67, 0x96, 0xed, 0xe9, 0x17, 0xbd, 0x73, 0xed, 0x44, 0x5a, 0x42, 0x75,
-	0xa8, 0xfd, 0x2e, 0xb6, 0xb5, 0xf3, 0x0b, 0xa9, 0x82, 0x1e, 0xc2, 0xfd, 0x59, 0xe5, 0x44, 0x6f,
-	0x6b, 0xcd, 0x57, 0xb6, 0xd6, 0x31, 0xba, 0xa7, 0xf6, 0x0b, 0xeb, 0xb4, 0x2b, 0xbd, 0x47, 0xf7,
-	0xe6, 0x89, 0xba, 0x71, 0x66, 0x37, 0x0e, 0x1a, 0xd2, 0x67, 0xf1, 0xb0, 0xfd, 0x75, 0xa8, 0x88,
-	0x37, 0x43, 0x45, 0xfc, 0x3e, 0x54, 0xc4, 0x0f, 0x23, 0x45, 0xb8, 0x19, 0x29, 0xc2, 0xb7, 0x91,
-	0x22, 0xbc, 0xde, 0xf5, 0x03, 0xfe, 0x26, 0x75, 0xb1, 0x47, 0x43, 0xb5, 0x7c, 0xf6, 0xf9, 0x67,
-	0x97, 0xf5, 0xaf, 0x54, 0x9e, 0xc5, 0x64, 0xfe, 0x5f, 0xe2, 0xae, 0xe4, 0x8f, 0x66, 0xff, 0x67,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x02, 0x3d, 0xad, 0x03, 0x67, 0x04, 0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0x26, 0xa9, 0xda, 0x29, 0x42, 0x66, 0x49, 0x51, 0x6a, 0x90, 0x89, 0xca, 0x81,
+	0x0a, 0xa9, 0x6b, 0x25, 0x3d, 0x54, 0xe5, 0xe6, 0x26, 0x26, 0x35, 0x6d, 0xd2, 0x62, 0xa7, 0x52,
+	0xe1, 0x62, 0xd9, 0xce, 0xd6, 0x58, 0x8d, 0xbd, 0xc6, 0xbb, 0x46, 0xf5, 0x89, 0x57, 0xe0, 0x35,
+	0x78, 0x08, 0xc4, 0x81, 0x4b, 0x8f, 0x3d, 0x72, 0x44, 0xed, 0x33, 0x70, 0x47, 0xb5, 0xe3, 0x24,
+	0x40, 0x11, 0x22, 0x27, 0x6b, 0x66, 0xfe, 0xfd, 0xe6, 0x5f, 0xcd, 0x78, 0xe1, 0xa9, 0x4b, 0x59,
+	0x40, 0x99, 0xc2, 0xcf, 0x15, 0xe6, 0x7b, 0xa1, 0x1f, 0x7a, 0xca, 0xfb, 0xa6, 0x43, 0xb8, 0xdd,
+	0x2c, 0x62, 0x1c, 0xc5, 0x94, 0x53, 0xb4, 0x96, 0x0b, 0x31, 0x3f, 0xc7, 0x45, 0x61, 0x2c, 0x94,
+	0x36, 0xc7, 0x0c, 0x37, 0x4e, 0x23, 0x4e, 0x95, 0x20, 0x19, 0x71, 0x9f, 0xf9, 0x53, 0x50, 0x91,
+	0xc8, 0x49, 0xd2, 0x9a, 0x47, 0xa9, 0x37, 0x22, 0x4a, 0x16, 0x39, 0xc9, 0xa9, 0x62, 0x87, 0x69,
+	0x5e, 0x5a, 0x3f, 0x85, 0x9a, 0xe9, 0x7b, 0xa1, 0xcd, 0x93, 0x98, 0x74, 0x08, 0x73, 0x63, 0x3f,
+	0xe2, 0x34, 0x66, 0xa8, 0x0f, 0xc0, 0x8a, 0x3c, 0xab, 0x0b, 0x8d, 0xf2, 0xc6, 0x4a, 0x0b, 0xe3,
+	0xbf, 0x3a, 0xc2, 0xb7, 0x40, 0x8c, 0x19, 0xc2, 0xfa, 0x8f, 0x0a, 0xdc, 0xbf, 0x45, 0x83, 0xb6,
+	0x00, 0xa2, 0xc4, 0x19, 0xf9, 0xae, 0x75, 0x46, 0xd2, 0xba, 0xd0, 0x10, 0x36, 0x56, 0x5a, 0x35,
+	0x9c, 0xfb, 0xc5, 0x85, 0x5f, 0xac, 0x86, 0xa9, 0xb1, 0x9c, 0xeb, 0xf6, 0x49, 0x8a, 0xba, 0x50,
+	0x19, 0xda, 0xdc, 0xae, 0x2f, 0x64, 0xf2, 0xad, 0xff, 0xb3, 0x85, 0x3b, 0x36, 0xb7, 0x8d, 0x0c,
+	0x80, 0x24, 0x58, 0x62, 0xe4, 0x5d, 0x42, 0x42, 0x97, 0xd4, 0xcb, 0x0d, 0x61, 0xa3, 0x62, 0x4c,
+	0x62, 0xe9, 0x6b, 0x19, 0x2a, 0x37, 0x52, 0x34, 0x80, 0x45, 0xe6, 0x87, 0xde, 0x88, 0x8c, 0xed,
+	0x3d, 0x9f, 0xa3, 0x1f, 0x36, 0x33, 0xc2, 0x5e, 0xc9, 0x18, 0xb3, 0xd0, 0x2b, 0xa8, 0x66, 0x53,
+	0x1a, 0x5f, 0x62, 0x67, 0x1e, 0x68, 0xef, 0x06, 0xb0, 0x57, 0x32, 0x72, 0x92, 0x64, 0xc1, 0x62,
+	0xde, 0x06, 0x6d, 0x43, 0x25, 0xa0, 0xc3, 0xdc, 0xf0, 0xdd, 0xd6, 0x93, 0x7f, 0xb0, 0x7b, 0x74,
+	0x48, 0x8c, 0xec, 0x00, 0x7a, 0x04, 0xcb, 0x93, 0xa1, 0x65, 0xce, 0xee, 0x18, 0xd3, 0x84, 0xf4,
+	0x49, 0x80, 0x6a, 0xd6, 0x13, 0xed, 0xc3, 0x92, 0xe3, 0x73, 0x3b, 0x8e, 0xed, 0x62, 0x68, 0x4a,
+	0xd1, 0x24, 0xdf, 0x49, 0x3c, 0x59, 0xc1, 0xa2, 0x53, 0x9b, 0x06, 0x91, 0xed, 0xf2, 0x5d, 0x9f,
+	0xab, 0x37, 0xc7, 0x8c, 0x09, 0x00, 0x99, 0xbf, 0xec, 0xda, 0x42, 0xa3, 0x3c, 0xef, 0x50, 0x67,
+	0x30, 0xbb, 0x55, 0x28, 0xb3, 0x24, 0x78, 0xf6, 0x59, 0x80, 0xa5, 0xe2, 0x8e, 0x68, 0x0d, 0x56,
+	0x4d, 0xbd, 0xdb, 0xb7, 0x7a, 0x87, 0x1d, 0xcd, 0x3a, 0xee, 0x9b, 0x47, 0x5a, 0x5b, 0x7f, 0xa1,
+	0x6b, 0x1d, 0xb1, 0x84, 0x6a, 0x20, 0x4e, 0x4b, 0x1d, 0xdd, 0xd0, 0xda, 0x03, 0x51, 0x40, 0xab,
+	0x70, 0x6f, 0x9a, 0x1d, 0x68, 0x27, 0x83, 0x63, 0xf5, 0x40, 0x5c, 0x40, 0x75, 0xa8, 0xfd, 0x2e,
+	0xb6, 0xd4, 0xe3, 0x13, 0xb1, 0x8c, 0x1e, 0xc3, 0xc3, 0x69, 0xe5, 0x40, 0xeb, 0xaa, 0xed, 0xd7,
+	0x96, 0xda, 0xd3, 0xfb, 0x87, 0xd6, 0x4b, 0xf3, 0xb0, 0x2f, 0x7e, 0x40, 0x0f, 0x66, 0x89, 0x9a,
+	0x7e, 0x64, 0x35, 0x77, 0x9a, 0xe2, 0x17, 0xe1, 0xcf, 0xfc, 0x76, 0xb3, 0x25, 0x5e, 0x54, 0x77,
+	0xbb, 0x17, 0x57, 0xb2, 0x70, 0x79, 0x25, 0x0b, 0xdf, 0xaf, 0x64, 0xe1, 0xe3, 0xb5, 0x5c, 0xba,
+	0xbc, 0x96, 0x4b, 0xdf, 0xae, 0xe5, 0xd2, 0x9b, 0x4d, 0xcf, 0xe7, 0x6f, 0x13, 0x07, 0xbb, 0x34,
+	0x50, 0x8a, 0xe7, 0x20, 0xfb, 0x6c, 0xb2, 0xe1, 0x99, 0xc2, 0xd3, 0x88, 0xcc, 0xbe, 0x31, 0xce,
+	0x62, 0xf6, 0x33, 0x6d, 0xfd, 0x1c, 0x00, 0xd7, 0x99, 0x2f, 0x7e, 0x7f, 0x04, 0x00, 0x00,
 }
 
 func (m *SignatureDescriptors) Marshal() (dAtA []byte, err error) {
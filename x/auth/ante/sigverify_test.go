@@ -11,7 +11,9 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
@@ -112,6 +114,18 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	multisigKey1 := kmultisig.NewLegacyAminoPubKey(2, pkSet1)
 	multisignature1 := multisig.NewMultisig(len(pkSet1))
 	expectedCost1 := expectedGasCostByKeys(pkSet1)
+	blsKeys, blsPops := make([]*bls12381.PubKey, 3), make([][]byte, 3)
+	for i := range blsKeys {
+		sk := bls12381.GenPrivKey()
+		blsKeys[i] = sk.PubKey().(*bls12381.PubKey)
+		blsPops[i], _ = sk.ProofOfPossession()
+	}
+	blsMultisigKey, err := bls12381.NewMultisigPubKey(2, blsKeys, blsPops)
+	require.NoError(t, err)
+	blsMultisignature := multisig.NewMultisig(len(blsKeys))
+	blsMultisignature.BitArray.SetIndex(0, true)
+	blsMultisignature.BitArray.SetIndex(2, true)
+	blsMultisignature.Signatures = []signing.SignatureData{&signing.SingleSignatureData{}}
 	for i := range pkSet1 {
 		stdSig := legacytx.StdSignature{PubKey: pkSet1[i], Signature: sigSet1[i]} //nolint:staticcheck // SA1019: legacytx.StdSignature is deprecated
 		sigV2, err := legacytx.StdSignatureToSignatureV2(suite.clientCtx.LegacyAmino, stdSig)
@@ -134,8 +148,11 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	}{
 		{"PubKeyEd25519", args{storetypes.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, false},
 		{"PubKeySecp256k1", args{storetypes.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
+		{"PubKeyEthSecp256k1", args{storetypes.NewInfiniteGasMeter(), nil, ethsecp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{storetypes.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
+		{"PubKeyBls12381", args{storetypes.NewInfiniteGasMeter(), nil, bls12381.GenPrivKey().PubKey(), params}, p.SigVerifyCostBLS12381(), false},
 		{"Multisig", args{storetypes.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
+		{"Bls12381Multisig", args{storetypes.NewInfiniteGasMeter(), blsMultisignature, blsMultisigKey, params}, p.SigVerifyCostBLS12381Aggregate(2), false},
 		{"unknown key", args{storetypes.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
 	for _, tt := range tests {
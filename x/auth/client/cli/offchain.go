@@ -0,0 +1,250 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/offchain"
+)
+
+// GetSignArbitraryCommand returns the command signing arbitrary data off-chain.
+func GetSignArbitraryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-arbitrary [data]",
+		Short: "Sign arbitrary data off-chain, following ADR-036",
+		Long: `Sign arbitrary data with the key of an account, and print the JSON encoding of the
+ADR-036 off-chain transaction of the signature, which can be verified with the
+'verify-arbitrary' command. The transaction is never valid on chain.
+
+The data is signed in the amino-json sign mode unless --sign-mode is set.
+
+The --multisig=<multisig_key> flag generates a signature on behalf of a multisig account
+key, in the amino-json sign mode. It implies --signature-only. The signatures of the
+multisig keys are combined with the 'multisign-arbitrary' command.
+`,
+		Example: fmt.Sprintf(`$ %s tx sign-arbitrary "hello" --from mykey > signed.json
+$ %s tx verify-arbitrary signed.json`, version.AppName, version.AppName),
+		RunE: makeSignArbitraryCmd(),
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of private key with which to sign")
+	cmd.Flags().String(flags.FlagSignMode, "", "Choose sign mode (direct|amino-json)")
+	cmd.Flags().String(flagMultisig, "", "Address or key name of the multisig account on behalf of which the data shall be signed")
+	cmd.Flags().Bool(flagSigOnly, false, "Print only the signature")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	flags.AddKeyringFlags(cmd.Flags())
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func makeSignArbitraryCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		signMode, err := offchainSignMode(cmd)
+		if err != nil {
+			return err
+		}
+
+		signer := clientCtx.GetFromAddress()
+		printSignatureOnly, _ := cmd.Flags().GetBool(flagSigOnly)
+		multisigKey, _ := cmd.Flags().GetString(flagMultisig)
+		if multisigKey != "" {
+			if signMode != signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+				return fmt.Errorf("multisig accounts only support the amino-json sign mode")
+			}
+
+			multisigAddr, _, _, err := client.GetFromFields(clientCtx, clientCtx.Keyring, multisigKey)
+			if err != nil {
+				return fmt.Errorf("error getting account from keybase: %w", err)
+			}
+			signer = multisigAddr
+			printSignatureOnly = true
+		}
+
+		signerStr, err := clientCtx.TxConfig.SigningContext().AddressCodec().BytesToString(signer)
+		if err != nil {
+			return err
+		}
+
+		txBuilder, err := offchain.NewTxBuilder(clientCtx.TxConfig, signerStr, []byte(args[0]))
+		if err != nil {
+			return err
+		}
+
+		if err := offchain.Sign(cmd.Context(), clientCtx.TxConfig, clientCtx.Keyring, clientCtx.FromName, txBuilder, signMode); err != nil {
+			return err
+		}
+
+		json, err := marshalSignatureJSON(clientCtx.TxConfig, txBuilder, printSignatureOnly)
+		if err != nil {
+			return err
+		}
+
+		closeFunc, err := setOutputFile(cmd)
+		if err != nil {
+			return err
+		}
+		defer closeFunc()
+
+		cmd.Printf("%s\n", json)
+		return nil
+	}
+}
+
+// GetMultiSignArbitraryCommand returns the command combining the off-chain
+// signatures of the keys of a multisig account.
+func GetMultiSignArbitraryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign-arbitrary [data] [name] [[signature]...]",
+		Short: "Sign arbitrary data off-chain with a multisig account, following ADR-036",
+		Long: `Combine the signatures of arbitrary data of the keys of a multisig account, generated
+with 'sign-arbitrary --multisig', and print the JSON encoding of the ADR-036 off-chain
+transaction of the signature of the multisig account.
+`,
+		Example: fmt.Sprintf(`$ %s tx sign-arbitrary "hello" --from key1 --multisig mymultisig > sig1.json
+$ %s tx sign-arbitrary "hello" --from key2 --multisig mymultisig > sig2.json
+$ %s tx multisign-arbitrary "hello" mymultisig sig1.json sig2.json > signed.json`, version.AppName, version.AppName, version.AppName),
+		RunE: makeMultiSignArbitraryCmd(),
+		Args: cobra.MinimumNArgs(3),
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	flags.AddKeyringFlags(cmd.Flags())
+
+	return cmd
+}
+
+func makeMultiSignArbitraryCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		k, err := getMultisigRecord(clientCtx, args[1])
+		if err != nil {
+			return err
+		}
+		pubKey, err := k.GetPubKey()
+		if err != nil {
+			return err
+		}
+		multisigPub, ok := pubKey.(multisig.PubKey)
+		if !ok {
+			return fmt.Errorf("%s is not a multisig key", args[1])
+		}
+		addr, err := k.GetAddress()
+		if err != nil {
+			return err
+		}
+		signerStr, err := clientCtx.TxConfig.SigningContext().AddressCodec().BytesToString(addr)
+		if err != nil {
+			return err
+		}
+
+		txBuilder, err := offchain.NewTxBuilder(clientCtx.TxConfig, signerStr, []byte(args[0]))
+		if err != nil {
+			return err
+		}
+
+		var sigs []signingtypes.SignatureV2
+		for _, filename := range args[2:] {
+			fileSigs, err := unmarshalSignatureJSON(clientCtx, filename)
+			if err != nil {
+				return err
+			}
+			sigs = append(sigs, fileSigs...)
+		}
+
+		if err := offchain.CombineMultisig(txBuilder, multisigPub, sigs...); err != nil {
+			return err
+		}
+
+		if _, err := offchain.Verify(cmd.Context(), clientCtx.TxConfig, txBuilder.GetTx()); err != nil {
+			return err
+		}
+
+		json, err := marshalSignatureJSON(clientCtx.TxConfig, txBuilder, false)
+		if err != nil {
+			return err
+		}
+
+		closeFunc, err := setOutputFile(cmd)
+		if err != nil {
+			return err
+		}
+		defer closeFunc()
+
+		cmd.Printf("%s\n", json)
+		return nil
+	}
+}
+
+// GetVerifyArbitraryCommand returns the command verifying the off-chain
+// signature of arbitrary data.
+func GetVerifyArbitraryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-arbitrary [file]",
+		Short: "Verify the off-chain signature of arbitrary data, following ADR-036",
+		Long: `Verify the ADR-036 off-chain transaction of [file], generated with the 'sign-arbitrary'
+or 'multisign-arbitrary' commands, and print its signer and data.
+`,
+		RunE: makeVerifyArbitraryCmd(),
+		Args: cobra.ExactArgs(1),
+	}
+
+	return cmd
+}
+
+func makeVerifyArbitraryCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+		if err != nil {
+			return err
+		}
+
+		msg, err := offchain.Verify(cmd.Context(), clientCtx.TxConfig, stdTx)
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("Signer: %s\n", msg.Signer)
+		cmd.Printf("Data: %s\n", msg.Data)
+		cmd.Println("Signature: OK")
+		return nil
+	}
+}
+
+// offchainSignMode returns the sign mode of the --sign-mode flag, defaulting to
+// the off-chain default sign mode.
+func offchainSignMode(cmd *cobra.Command) (signingtypes.SignMode, error) {
+	signModeStr, _ := cmd.Flags().GetString(flags.FlagSignMode)
+	switch signModeStr {
+	case "":
+		return offchain.DefaultSignMode, nil
+	case flags.SignModeDirect:
+		return signingtypes.SignMode_SIGN_MODE_DIRECT, nil
+	case flags.SignModeLegacyAminoJSON:
+		return signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil
+	default:
+		return signingtypes.SignMode_SIGN_MODE_UNSPECIFIED, fmt.Errorf("off-chain signing only supports the %s and %s sign modes", flags.SignModeDirect, flags.SignModeLegacyAminoJSON)
+	}
+}
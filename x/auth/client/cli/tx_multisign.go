@@ -17,7 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -116,8 +116,11 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 		// the multisig key (useful for nested multisigs).
 		skipSigVerify, _ := cmd.Flags().GetBool(flagSkipSignatureVerification)
 
-		multisigPub := pubKey.(*kmultisig.LegacyAminoPubKey)
-		multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+		multisigPub, ok := pubKey.(multisig.PubKey)
+		if !ok {
+			return fmt.Errorf("%s is not a multisig key", args[1])
+		}
+		multisigSig := multisig.NewMultisig(len(multisigPub.GetPubKeys()))
 		if !clientCtx.Offline {
 			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
 			if err != nil {
@@ -175,6 +178,11 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 			}
 		}
 
+		multisigSig, err = aggregateMultisig(multisigPub, multisigSig)
+		if err != nil {
+			return err
+		}
+
 		sigV2 := signingtypes.SignatureV2{
 			PubKey:   multisigPub,
 			Data:     multisigSig,
@@ -313,8 +321,11 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 			if err != nil {
 				return err
 			}
-			multisigPub := pubKey.(*kmultisig.LegacyAminoPubKey)
-			multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+			multisigPub, ok := pubKey.(multisig.PubKey)
+			if !ok {
+				return fmt.Errorf("%s is not a multisig key", args[1])
+			}
+			multisigSig := multisig.NewMultisig(len(multisigPub.GetPubKeys()))
 
 			anyPk, err := codectypes.NewAnyWithValue(multisigPub)
 			if err != nil {
@@ -350,6 +361,11 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 				}
 			}
 
+			multisigSig, err = aggregateMultisig(multisigPub, multisigSig)
+			if err != nil {
+				return err
+			}
+
 			sigV2 := signingtypes.SignatureV2{
 				PubKey:   multisigPub,
 				Data:     multisigSig,
@@ -412,6 +428,15 @@ func readSignaturesFromFile(ctx client.Context, filename string) (sigs []signing
 	return sigs, nil
 }
 
+// aggregateMultisig aggregates the signatures of a bls12_381 multisig into a single signature, other
+// multisig signatures are returned as is.
+func aggregateMultisig(pubKey multisig.PubKey, sig *signingtypes.MultiSignatureData) (*signingtypes.MultiSignatureData, error) {
+	if _, ok := pubKey.(*bls12381.MultisigPubKey); !ok {
+		return sig, nil
+	}
+	return bls12381.AggregateMultisignature(sig)
+}
+
 func getMultisigRecord(clientCtx client.Context, name string) (*keyring.Record, error) {
 	kb := clientCtx.Keyring
 	multisigRecord, err := kb.Key(name)
@@ -0,0 +1,161 @@
+// Package offchain implements the off-chain signing of arbitrary data with the
+// keys of accounts, following ADR-036.
+//
+// The data is signed in a MsgSignData wrapped in a transaction of empty chain id,
+// zero account number and sequence, zero fee and gas and empty memo, so that the
+// signature can be verified against the account but is never valid on chain.
+// Both the direct and the amino-JSON sign modes are supported, and multisig
+// accounts sign with the signatures of their keys combined by CombineMultisig.
+package offchain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	errorsmod "cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// DefaultSignMode is the sign mode data is signed in if none is specified,
+// which is the one of ADR-036 and the only one multisig accounts support.
+const DefaultSignMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+
+// NewTxBuilder returns the builder of the transaction of signer signing data.
+func NewTxBuilder(txConfig client.TxConfig, signer string, data []byte) (client.TxBuilder, error) {
+	txBuilder := txConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(types.NewMsgSignData(signer, data)); err != nil {
+		return nil, err
+	}
+	txBuilder.SetFeeAmount(sdk.Coins{})
+	txBuilder.SetGasLimit(0)
+
+	return txBuilder, nil
+}
+
+// Sign signs the transaction of txBuilder with the key name of kr in signMode,
+// overwriting its signatures. The key is either the key of the signer or, for a
+// multisig signer, one of the keys of the multisig, whose signatures must be
+// combined with CombineMultisig.
+func Sign(ctx context.Context, txConfig client.TxConfig, kr keyring.Keyring, name string, txBuilder client.TxBuilder, signMode signing.SignMode) error {
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		signMode = DefaultSignMode
+	}
+
+	txf := clienttx.Factory{}.
+		WithTxConfig(txConfig).
+		WithKeybase(kr).
+		WithSignMode(signMode)
+
+	return clienttx.Sign(ctx, txf, name, txBuilder, true)
+}
+
+// CombineMultisig sets the signature of the multisig account of multisigPubKey
+// on the transaction of txBuilder, combining the signatures of its keys. The
+// signatures of the keys must be in the amino-JSON sign mode, since the direct
+// sign mode signs the signer infos of the transaction, which are those of the
+// keys rather than the multisig.
+func CombineMultisig(txBuilder client.TxBuilder, multisigPubKey multisig.PubKey, sigs ...signing.SignatureV2) error {
+	multisigSig := multisig.NewMultisig(len(multisigPubKey.GetPubKeys()))
+	for _, sig := range sigs {
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok || data.SignMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+			return fmt.Errorf("signature of %s must be a single signature in amino-json sign mode", sdk.AccAddress(sig.PubKey.Address()))
+		}
+
+		if err := multisig.AddSignatureV2(multisigSig, sig, multisigPubKey.GetPubKeys()); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := multisigPubKey.(*bls12381.MultisigPubKey); ok {
+		var err error
+		if multisigSig, err = bls12381.AggregateMultisignature(multisigSig); err != nil {
+			return err
+		}
+	}
+
+	return txBuilder.SetSignatures(signing.SignatureV2{PubKey: multisigPubKey, Data: multisigSig})
+}
+
+// Verify verifies that tx is the transaction of the signer of its MsgSignData
+// signing the data of the message, and returns the message.
+func Verify(ctx context.Context, txConfig client.TxConfig, tx sdk.Tx) (*types.MsgSignData, error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected authsigning.Tx, got %T", tx)
+	}
+
+	msgs := sigTx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expected a single message, got %d", len(msgs))
+	}
+	msg, ok := msgs[0].(*types.MsgSignData)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected %s, got %s", sdk.MsgTypeURL(&types.MsgSignData{}), sdk.MsgTypeURL(msgs[0]))
+	}
+
+	if sigTx.GetMemo() != "" || sigTx.GetGas() != 0 || !sigTx.GetFee().IsZero() ||
+		sigTx.GetTimeoutHeight() != 0 || sigTx.GetUnordered() || len(sigTx.FeeGranter()) != 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "off-chain transaction must have no memo, fee, gas, timeout and fee granter")
+	}
+
+	signer, err := txConfig.SigningContext().AddressCodec().StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address: %s", err)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	if len(sigs) != 1 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "expected a single signature, got %d", len(sigs))
+	}
+	sig := sigs[0]
+
+	if sig.Sequence != 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidSequence, "off-chain signature must have sequence 0, got %d", sig.Sequence)
+	}
+	if !bytes.Equal(sig.PubKey.Address(), signer) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "public key of the signature does not match the signer %s", msg.Signer)
+	}
+
+	anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	signerData := txsigning.SignerData{
+		Address: msg.Signer,
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}
+
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected authsigning.V2AdaptableTx, got %T", tx)
+	}
+
+	err = authsigning.VerifySignature(ctx, sig.PubKey, signerData, sig.Data, txConfig.SignModeHandler(), adaptableTx.GetSigningTxData())
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signature verification failed: %s", err)
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,160 @@
+package offchain_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/offchain"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func setup(t *testing.T) (client.TxConfig, keyring.Keyring) {
+	t.Helper()
+
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(encodingConfig.InterfaceRegistry), authtx.DefaultSignModes)
+
+	kr, err := keyring.New(t.Name(), keyring.BackendTest, t.TempDir(), nil, encodingConfig.Codec)
+	require.NoError(t, err)
+
+	return txConfig, kr
+}
+
+func newKey(t *testing.T, kr keyring.Keyring, name string) cryptotypes.PubKey {
+	t.Helper()
+
+	record, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+
+	return pubKey
+}
+
+// roundTrip encodes and decodes tx in JSON, as the CLI commands do.
+func roundTrip(t *testing.T, txConfig client.TxConfig, tx sdk.Tx) sdk.Tx {
+	t.Helper()
+
+	bz, err := txConfig.TxJSONEncoder()(tx)
+	require.NoError(t, err)
+	decoded, err := txConfig.TxJSONDecoder()(bz)
+	require.NoError(t, err)
+
+	return decoded
+}
+
+func TestSignVerify(t *testing.T) {
+	txConfig, kr := setup(t)
+	pubKey := newKey(t, kr, "signer")
+	signer := sdk.AccAddress(pubKey.Address()).String()
+	other := sdk.AccAddress(newKey(t, kr, "other").Address()).String()
+	data := []byte("hello")
+
+	for _, signMode := range []signing.SignMode{
+		signing.SignMode_SIGN_MODE_UNSPECIFIED,
+		signing.SignMode_SIGN_MODE_DIRECT,
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	} {
+		t.Run(signMode.String(), func(t *testing.T) {
+			txBuilder, err := offchain.NewTxBuilder(txConfig, signer, data)
+			require.NoError(t, err)
+			require.NoError(t, offchain.Sign(context.Background(), txConfig, kr, "signer", txBuilder, signMode))
+
+			msg, err := offchain.Verify(context.Background(), txConfig, roundTrip(t, txConfig, txBuilder.GetTx()))
+			require.NoError(t, err)
+			require.Equal(t, signer, msg.Signer)
+			require.Equal(t, data, msg.Data)
+
+			// the signature of other data does not verify
+			sigs, err := txBuilder.GetTx().GetSignaturesV2()
+			require.NoError(t, err)
+			tampered, err := offchain.NewTxBuilder(txConfig, signer, []byte("bye"))
+			require.NoError(t, err)
+			require.NoError(t, tampered.SetSignatures(sigs...))
+			_, err = offchain.Verify(context.Background(), txConfig, tampered.GetTx())
+			require.ErrorContains(t, err, "signature verification failed")
+
+			// the signature of another signer does not verify
+			impersonated, err := offchain.NewTxBuilder(txConfig, other, data)
+			require.NoError(t, err)
+			require.NoError(t, impersonated.SetSignatures(sigs...))
+			_, err = offchain.Verify(context.Background(), txConfig, impersonated.GetTx())
+			require.ErrorContains(t, err, "does not match the signer")
+
+			// the signature of an on-chain transaction does not verify
+			txBuilder.SetMemo("memo")
+			_, err = offchain.Verify(context.Background(), txConfig, txBuilder.GetTx())
+			require.ErrorContains(t, err, "must have no memo")
+		})
+	}
+}
+
+func TestAminoJSONSignBytes(t *testing.T) {
+	txConfig, kr := setup(t)
+	pubKey := newKey(t, kr, "signer")
+	signer := sdk.AccAddress(pubKey.Address()).String()
+
+	txBuilder, err := offchain.NewTxBuilder(txConfig, signer, []byte("hello"))
+	require.NoError(t, err)
+
+	signerData := authsigning.SignerData{Address: signer, PubKey: pubKey}
+	signBytes, err := authsigning.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(),
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	// the sign doc of ADR-036, as signed by wallets
+	expected := fmt.Sprintf(`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"",`+
+		`"msgs":[{"type":"sign/MsgSignData","value":{"data":"aGVsbG8=","signer":"%s"}}],"sequence":"0"}`, signer)
+	require.Equal(t, expected, string(signBytes))
+}
+
+func TestCombineMultisig(t *testing.T) {
+	txConfig, kr := setup(t)
+	pubKeys := []cryptotypes.PubKey{newKey(t, kr, "key1"), newKey(t, kr, "key2"), newKey(t, kr, "key3")}
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	signer := sdk.AccAddress(multisigPubKey.Address()).String()
+	data := []byte("hello")
+
+	sign := func(name string, signMode signing.SignMode) signing.SignatureV2 {
+		txBuilder, err := offchain.NewTxBuilder(txConfig, signer, data)
+		require.NoError(t, err)
+		require.NoError(t, offchain.Sign(context.Background(), txConfig, kr, name, txBuilder, signMode))
+
+		sigs, err := txBuilder.GetTx().GetSignaturesV2()
+		require.NoError(t, err)
+		require.Len(t, sigs, 1)
+		return sigs[0]
+	}
+
+	txBuilder, err := offchain.NewTxBuilder(txConfig, signer, data)
+	require.NoError(t, err)
+
+	// the signatures of the keys in direct sign mode cannot be combined
+	err = offchain.CombineMultisig(txBuilder, multisigPubKey, sign("key1", signing.SignMode_SIGN_MODE_DIRECT))
+	require.ErrorContains(t, err, "amino-json")
+
+	aminoJSON := signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	require.NoError(t, offchain.CombineMultisig(txBuilder, multisigPubKey, sign("key1", aminoJSON), sign("key3", aminoJSON)))
+	msg, err := offchain.Verify(context.Background(), txConfig, roundTrip(t, txConfig, txBuilder.GetTx()))
+	require.NoError(t, err)
+	require.Equal(t, signer, msg.Signer)
+
+	// below the threshold
+	require.NoError(t, offchain.CombineMultisig(txBuilder, multisigPubKey, sign("key2", aminoJSON)))
+	_, err = offchain.Verify(context.Background(), txConfig, txBuilder.GetTx())
+	require.ErrorContains(t, err, "signature verification failed")
+}
@@ -0,0 +1,201 @@
+package tx_test
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/eip712"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	symrewardstypes "github.com/cosmos/cosmos-sdk/x/symrewards/types"
+	symslashingtypes "github.com/cosmos/cosmos-sdk/x/symslashing/types"
+	symstakingtypes "github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+var updateGolden = flag.Bool("update-eip712", false, "update the EIP-712 golden files")
+
+const eip712ChainID = "cosmos_9000-1"
+
+func eip712Registry(t *testing.T) codectypes.InterfaceRegistry {
+	t.Helper()
+
+	registry := codectypes.NewInterfaceRegistry()
+	for _, register := range []func(codectypes.InterfaceRegistry){
+		std.RegisterInterfaces,
+		authtypes.RegisterInterfaces,
+		vestingtypes.RegisterInterfaces,
+		authz.RegisterInterfaces,
+		banktypes.RegisterInterfaces,
+		consensustypes.RegisterInterfaces,
+		crisistypes.RegisterInterfaces,
+		distrtypes.RegisterInterfaces,
+		govv1.RegisterInterfaces,
+		govv1beta1.RegisterInterfaces,
+		group.RegisterInterfaces,
+		minttypes.RegisterInterfaces,
+		protocolpooltypes.RegisterInterfaces,
+		slashingtypes.RegisterInterfaces,
+		stakingtypes.RegisterInterfaces,
+		symrewardstypes.RegisterInterfaces,
+		symslashingtypes.RegisterInterfaces,
+		symstakingtypes.RegisterInterfaces,
+	} {
+		register(registry)
+	}
+	return registry
+}
+
+// eip712Msg returns the message of a golden file, a message with representative values or an empty message.
+func eip712Msg(t *testing.T, registry codectypes.InterfaceRegistry, typeURL string) sdk.Msg {
+	t.Helper()
+
+	from := sdk.AccAddress("from________________").String()
+	to := sdk.AccAddress("to__________________").String()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uatom", 25))
+	send := &banktypes.MsgSend{FromAddress: from, ToAddress: to, Amount: coins}
+	authority := authtypes.NewModuleAddress("gov").String()
+
+	switch typeURL {
+	case sdk.MsgTypeURL(send):
+		return send
+	case sdk.MsgTypeURL(&authz.MsgExec{}):
+		msg := authz.NewMsgExec(sdk.AccAddress("grantee_____________"), []sdk.Msg{send})
+		return &msg
+	case sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}):
+		msg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{send}, coins, from, "metadata", "title", "summary", false)
+		require.NoError(t, err)
+		return msg
+	case sdk.MsgTypeURL(&symstakingtypes.MsgUpdateParams{}):
+		return &symstakingtypes.MsgUpdateParams{Authority: authority, Params: symstakingtypes.DefaultParams()}
+	case sdk.MsgTypeURL(&symslashingtypes.MsgUpdateParams{}):
+		return &symslashingtypes.MsgUpdateParams{Authority: authority, Params: symslashingtypes.DefaultParams()}
+	}
+
+	msg, err := registry.Resolve(typeURL)
+	require.NoError(t, err)
+	return msg.(sdk.Msg)
+}
+
+func TestEIP712SignModeGoldenFiles(t *testing.T) {
+	registry := eip712Registry(t)
+	cdc := codec.NewProtoCodec(registry)
+	txConfig, err := tx.NewTxConfigWithOptions(cdc, tx.ConfigOptions{
+		EnabledSignModes: []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712},
+	})
+	require.NoError(t, err)
+	handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{FileResolver: registry})
+
+	privKey := ethsecp256k1.GenPrivKey()
+	signerData := authsigning.SignerData{
+		Address:       sdk.AccAddress(privKey.PubKey().Address()).String(),
+		ChainID:       eip712ChainID,
+		AccountNumber: 7,
+		Sequence:      3,
+		PubKey:        privKey.PubKey(),
+	}
+
+	typeURLs := registry.ListImplementations(sdk.MsgInterfaceProtoName)
+	sort.Strings(typeURLs)
+	for _, typeURL := range typeURLs {
+		// the ADR-036 off-chain message is only signed in the amino-JSON and direct sign modes
+		if typeURL == sdk.MsgTypeURL(&authtypes.MsgSignData{}) {
+			continue
+		}
+
+		t.Run(typeURL, func(t *testing.T) {
+			builder := txConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(eip712Msg(t, registry, typeURL)))
+			builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 150)))
+			builder.SetGasLimit(200000)
+			builder.SetMemo("memo")
+			builder.SetTimeoutHeight(100)
+			sdkTx := builder.GetTx()
+
+			signBytes, err := authsigning.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(), signingtypes.SignMode_SIGN_MODE_EIP_712, signerData, sdkTx)
+			require.NoError(t, err)
+
+			typedData := eip712TypedData(t, handler, signerData, sdkTx)
+			bz, err := json.MarshalIndent(typedData, "", "  ")
+			require.NoError(t, err)
+
+			goldenFile := filepath.Join("testdata", "eip712", typeURL[1:]+".json")
+			if *updateGolden {
+				require.NoError(t, os.WriteFile(goldenFile, append(bz, '\n'), 0o600))
+			}
+			golden, err := os.ReadFile(goldenFile)
+			require.NoError(t, err)
+			require.JSONEq(t, string(golden), string(bz))
+
+			// the signed digest is the EIP-712 hash of the typed data as computed by Ethereum wallets
+			var ethTypedData apitypes.TypedData
+			require.NoError(t, json.Unmarshal(golden, &ethTypedData))
+			ethHash, _, err := apitypes.TypedDataAndHash(ethTypedData)
+			require.NoError(t, err)
+			require.Equal(t, ethHash, ethcrypto.Keccak256(signBytes))
+
+			sig, err := privKey.Sign(signBytes)
+			require.NoError(t, err)
+			require.True(t, privKey.PubKey().VerifySignature(signBytes, sig))
+		})
+	}
+}
+
+func eip712TypedData(t *testing.T, handler *eip712.SignModeHandler, signerData authsigning.SignerData, sdkTx sdk.Tx) eip712.TypedData {
+	t.Helper()
+
+	adaptableTx, ok := sdkTx.(authsigning.V2AdaptableTx)
+	require.True(t, ok)
+	typedData, err := handler.GetTypedData(context.Background(), txsigning.SignerData{
+		Address:       signerData.Address,
+		ChainID:       signerData.ChainID,
+		AccountNumber: signerData.AccountNumber,
+		Sequence:      signerData.Sequence,
+	}, adaptableTx.GetSigningTxData())
+	require.NoError(t, err)
+	return typedData
+}
+
+func TestEIP712SignModeRequiresEIP155ChainID(t *testing.T) {
+	registry := eip712Registry(t)
+	txConfig, err := tx.NewTxConfigWithOptions(codec.NewProtoCodec(registry), tx.ConfigOptions{})
+	require.NoError(t, err)
+	require.Contains(t, txConfig.SignModeHandler().SupportedModes(), eip712.SignMode)
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(eip712Msg(t, registry, sdk.MsgTypeURL(&banktypes.MsgSend{}))))
+	signerData := authsigning.SignerData{Address: sdk.AccAddress("from________________").String(), ChainID: "chain-xyz"}
+
+	_, err = authsigning.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(), signingtypes.SignMode_SIGN_MODE_EIP_712, signerData, builder.GetTx())
+	require.ErrorContains(t, err, "does not contain an EIP-155 chain ID")
+}
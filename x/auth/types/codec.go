@@ -21,6 +21,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSignData{}, "sign/MsgSignData")
 
 	legacytx.RegisterLegacyAminoCodec(cdc)
 }
@@ -55,7 +56,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&ModuleCredential{},
 	)
 
+	// MsgSignData has no Msg service and is never valid on chain, it is only
+	// registered so that the transactions of ADR-036 off-chain signatures can be
+	// decoded.
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSignData{},
 	)
 }
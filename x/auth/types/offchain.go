@@ -0,0 +1,10 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+var _ sdk.Msg = &MsgSignData{}
+
+// NewMsgSignData returns the ADR-036 off-chain message of signer signing data.
+func NewMsgSignData(signer string, data []byte) *MsgSignData {
+	return &MsgSignData{Signer: signer, Data: data}
+}
@@ -47,6 +47,25 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// SigVerifyCostBLS12381 returns gas fee of bls12_381 signature verification.
+// Set by benchmarking current implementation:
+//
+//	BenchmarkSig/secp256k1      200    268034 ns/op
+//	BenchmarkSig/bls12_381      200   3432468 ns/op
+//
+// Based on the results above a bls12_381 verification, which computes two pairings, is about 13x
+// slower than a secp256k1 one.
+func (p Params) SigVerifyCostBLS12381() uint64 {
+	return p.SigVerifyCostSecp256k1 * 13
+}
+
+// SigVerifyCostBLS12381Aggregate returns gas fee of the verification of a bls12_381 aggregate
+// signature of the given number of signers: the proof of possession of each signer is verified,
+// then the aggregate signature against the sum of their pubkeys.
+func (p Params) SigVerifyCostBLS12381Aggregate(signers uint64) uint64 {
+	return (signers + 1) * p.SigVerifyCostBLS12381()
+}
+
 func validateTxSigLimit(i any) error {
 	v, ok := i.(uint64)
 	if !ok {
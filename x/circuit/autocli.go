@@ -51,6 +51,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "TripCircuitBreaker",
 					Use:       "disable [msg_type_urls]",
 					Short:     "Disable a message from being executed",
+					Long:      `Disable messages from being executed, or pause features registered by other modules, such as symstaking/valset-sync.`,
 					Example:   fmt.Sprintf(`%s circuit disable "/cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend"`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "msg_type_urls", Varargs: true},
@@ -60,6 +61,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "ResetCircuitBreaker",
 					Use:       "reset [msg_type_urls]",
 					Short:     "Enable a message to be executed",
+					Long:      `Enable disabled messages to be executed, or resume paused features registered by other modules, such as symstaking/valset-sync.`,
 					Example:   fmt.Sprintf(`%s circuit reset "/cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend"`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "msg_type_urls", Varargs: true},
@@ -2,6 +2,9 @@ package keeper
 
 import (
 	context "context"
+	"fmt"
+	"slices"
+	"strings"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
@@ -23,8 +26,11 @@ type Keeper struct {
 	Schema collections.Schema
 	// Permissions contains the permissions for each account
 	Permissions collections.Map[[]byte, types.Permissions]
-	// DisableList contains the message URLs that are disabled
+	// DisableList contains the message URLs and the features that are disabled
 	DisableList collections.KeySet[string]
+
+	// features contains the registered features, shared by the copies of the keeper
+	features map[string]struct{}
 }
 
 // NewKeeper constructs a new Circuit Keeper instance
@@ -54,6 +60,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, authori
 			"disable_list",
 			collections.StringKey,
 		),
+		features: make(map[string]struct{}),
 	}
 
 	schema, err := sb.Build()
@@ -74,3 +81,43 @@ func (k *Keeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
 	has, err := k.DisableList.Has(ctx, msgURL)
 	return !has, err
 }
+
+// RegisterFeatures registers the named features of a module, such as
+// "symstaking/valset-sync", which can then be tripped and reset like message
+// URLs. Features pause behavior other than messages, such as begin and end
+// blockers, whose modules consult IsFeatureAllowed. It panics if a feature is
+// registered twice or has the form of a message URL.
+func (k *Keeper) RegisterFeatures(features ...string) {
+	for _, feature := range features {
+		if feature == "" || strings.HasPrefix(feature, "/") {
+			panic(fmt.Sprintf("invalid circuit breaker feature %q", feature))
+		}
+		if _, ok := k.features[feature]; ok {
+			panic(fmt.Sprintf("circuit breaker feature %s is already registered", feature))
+		}
+		k.features[feature] = struct{}{}
+	}
+}
+
+// IsFeature returns true when name is a registered feature.
+func (k *Keeper) IsFeature(name string) bool {
+	_, ok := k.features[name]
+	return ok
+}
+
+// Features returns the registered features, sorted.
+func (k *Keeper) Features() []string {
+	features := make([]string, 0, len(k.features))
+	for feature := range k.features {
+		features = append(features, feature)
+	}
+	slices.Sort(features)
+	return features
+}
+
+// IsFeatureAllowed returns true when the feature is not found in the DisableList
+// for given context, else false.
+func (k *Keeper) IsFeatureAllowed(ctx context.Context, feature string) (bool, error) {
+	has, err := k.DisableList.Has(ctx, feature)
+	return !has, err
+}
@@ -162,3 +162,31 @@ func TestIterateDisabledList(t *testing.T) {
 	require.Equal(t, mockMsgs[1], returnedDisabled[0])
 	require.Equal(t, mockMsgs[2], returnedDisabled[1])
 }
+
+func TestRegisterFeatures(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	f.keeper.RegisterFeatures("mod/feature2", "mod/feature1")
+	require.True(t, f.keeper.IsFeature("mod/feature1"))
+	require.False(t, f.keeper.IsFeature("mod/unknown"))
+	require.Equal(t, []string{"mod/feature1", "mod/feature2"}, f.keeper.Features())
+
+	// the copies of the keeper share the features
+	k := f.keeper
+	k.RegisterFeatures("mod/feature3")
+	require.True(t, f.keeper.IsFeature("mod/feature3"))
+
+	require.Panics(t, func() { f.keeper.RegisterFeatures("mod/feature1") })
+	require.Panics(t, func() { f.keeper.RegisterFeatures("/cosmos.bank.v1beta1.MsgSend") })
+	require.Panics(t, func() { f.keeper.RegisterFeatures("") })
+
+	allowed, err := f.keeper.IsFeatureAllowed(f.ctx, "mod/feature1")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	require.NoError(t, f.keeper.DisableList.Set(f.ctx, "mod/feature1"))
+	allowed, err = f.keeper.IsFeatureAllowed(f.ctx, "mod/feature1")
+	require.NoError(t, err)
+	require.False(t, allowed)
+}
@@ -102,6 +102,11 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 		}
 
 		switch {
+		case srv.IsFeature(msgTypeURL):
+			// features can only be tripped by the module authority, super admins or accounts permitted for the feature
+			if !bytes.Equal(address, srv.GetAuthority()) && !hasPermissionForFeature(perms, msgTypeURL) {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker for feature %s", msgTypeURL)
+			}
 		case perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || bytes.Equal(address, srv.GetAuthority()):
 			// if the sender is a super admin or the module authority, no need to check perms
 		case perms.Level == types.Permissions_LEVEL_SOME_MSGS:
@@ -162,6 +167,11 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 		}
 
 		switch {
+		case srv.IsFeature(msgTypeURL):
+			// features can only be reset by the module authority, super admins or accounts permitted for the feature
+			if !bytes.Equal(address, srv.GetAuthority()) && !hasPermissionForFeature(perms, msgTypeURL) {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker for feature %s", msgTypeURL)
+			}
 		case perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || bytes.Equal(address, srv.GetAuthority()):
 			// if the sender is a super admin or the module authority, no need to check perms
 		case perms.Level == types.Permissions_LEVEL_SOME_MSGS:
@@ -201,3 +211,17 @@ func hasPermissionForMsg(perms types.Permissions, msg string) bool {
 	}
 	return false
 }
+
+// hasPermissionForFeature returns true if the account can trip or reset the
+// feature: super admins, and accounts with permission for some messages listing
+// the feature. The permission for all messages does not extend to features.
+func hasPermissionForFeature(perms types.Permissions, feature string) bool {
+	switch perms.Level {
+	case types.Permissions_LEVEL_SUPER_ADMIN:
+		return true
+	case types.Permissions_LEVEL_SOME_MSGS:
+		return hasPermissionForMsg(perms, feature)
+	default:
+		return false
+	}
+}
@@ -373,3 +373,59 @@ func TestResetCircuitBreakerSomeMsgs(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, allowed, "circuit breaker should be reset")
 }
+
+func TestTripResetFeature(t *testing.T) {
+	ft := initFixture(t)
+
+	const feature = "mod/feature"
+	ft.keeper.RegisterFeatures(feature)
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	// grant the permissions
+	levels := []types.Permissions{
+		{Level: types.Permissions_LEVEL_SUPER_ADMIN},
+		{Level: types.Permissions_LEVEL_ALL_MSGS},
+		{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{feature}},
+		{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend}},
+	}
+	for i, perms := range levels {
+		_, err = srv.AuthorizeCircuitBreaker(ft.ctx, &types.MsgAuthorizeCircuitBreaker{Granter: authority, Grantee: addresses[i], Permissions: &perms})
+		require.NoError(t, err)
+	}
+
+	testCases := map[string]struct {
+		authority string
+		allowed   bool
+	}{
+		"module authority":          {authority: authority, allowed: true},
+		"super admin":               {authority: addresses[0], allowed: true},
+		"all msgs":                  {authority: addresses[1], allowed: false},
+		"some msgs with feature":    {authority: addresses[2], allowed: true},
+		"some msgs without feature": {authority: addresses[3], allowed: false},
+		"no permission":             {authority: addresses[4], allowed: false},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := srv.TripCircuitBreaker(ft.ctx, &types.MsgTripCircuitBreaker{Authority: tc.authority, MsgTypeUrls: []string{feature}})
+			if !tc.allowed {
+				require.ErrorContains(t, err, "permission to trip circuit breaker for feature")
+				return
+			}
+			require.NoError(t, err)
+
+			allowed, err := ft.keeper.IsFeatureAllowed(ft.ctx, feature)
+			require.NoError(t, err)
+			require.False(t, allowed, "feature should be tripped")
+
+			_, err = srv.ResetCircuitBreaker(ft.ctx, &types.MsgResetCircuitBreaker{Authority: tc.authority, MsgTypeUrls: []string{feature}})
+			require.NoError(t, err)
+
+			allowed, err = ft.keeper.IsFeatureAllowed(ft.ctx, feature)
+			require.NoError(t, err)
+			require.True(t, allowed, "feature should be reset")
+		})
+	}
+}
@@ -145,6 +145,11 @@ type ModuleOutputs struct {
 	CircuitKeeper  keeper.Keeper
 	Module         appmodule.AppModule
 	BaseappOptions runtime.BaseAppOption
+
+	// FeatureKeeper is the keeper of the modules registering features, which
+	// depend on it through an interface of its RegisterFeatures and
+	// IsFeatureAllowed methods.
+	FeatureKeeper *keeper.Keeper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -166,5 +171,5 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		app.SetCircuitBreaker(&circuitkeeper)
 	}
 
-	return ModuleOutputs{CircuitKeeper: circuitkeeper, Module: m, BaseappOptions: baseappOpt}
+	return ModuleOutputs{CircuitKeeper: circuitkeeper, Module: m, BaseappOptions: baseappOpt, FeatureKeeper: &circuitkeeper}
 }
@@ -24,6 +24,13 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+
+	FlagMaxGasPrice        = "max-gas-price"
+	FlagMaxGasPerBlock     = "max-gas-per-block"
+	FlagMaxAccountSequence = "max-account-sequence"
+	FlagMinAccountNumber   = "min-account-number"
+	FlagBalanceBelow       = "balance-below"
+	FlagMaxUsesPerGrantee  = "max-uses-per-grantee"
 )
 
 // GetTxCmd returns the transaction commands for feegrant module
@@ -39,6 +46,7 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 
 	feegrantTxCmd.AddCommand(
 		NewCmdFeeGrant(ac),
+		NewCmdRuleBasedFeeGrant(ac),
 	)
 
 	return feegrantTxCmd
@@ -60,8 +68,10 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --max-gas-price 0.025stake --max-gas-per-block 1000000
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -81,94 +91,92 @@ Examples:
 			}
 
 			granter := clientCtx.GetFromAddress()
-			sl, err := cmd.Flags().GetString(FlagSpendLimit)
+			grant, err := getAllowance(cmd)
 			if err != nil {
 				return err
 			}
 
-			// if `FlagSpendLimit` isn't set, limit will be nil.
-			// Hence, there won't be any spendlimit for the grantee.
-			limit, err := sdk.ParseCoinsNormalized(sl)
+			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
 			if err != nil {
 				return err
 			}
 
-			exp, err := cmd.Flags().GetString(FlagExpiration)
-			if err != nil {
-				return err
-			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
-			basic := feegrant.BasicAllowance{
-				SpendLimit: limit,
-			}
+	flags.AddTxFlagsToCmd(cmd)
+	addAllowanceFlags(cmd)
 
-			var expiresAtTime time.Time
-			if exp != "" {
-				expiresAtTime, err = time.Parse(time.RFC3339, exp)
-				if err != nil {
-					return err
-				}
-				basic.Expiration = &expiresAtTime
-			}
+	return cmd
+}
 
-			var grant feegrant.FeeAllowanceI
-			grant = &basic
+// NewCmdRuleBasedFeeGrant returns a CLI command handler to create a MsgGrantAllowance transaction granting a
+// RuleBasedAllowance, which sponsors every account matching its rules.
+func NewCmdRuleBasedFeeGrant(ac address.Codec) *cobra.Command {
+	ruleBasedGrantee, err := ac.BytesToString(feegrant.RuleBasedGrantee)
+	if err != nil {
+		panic(err)
+	}
 
-			periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
-			if err != nil {
+	cmd := &cobra.Command{
+		Use:   "grant-rule-based [granter_key_or_address]",
+		Short: "Grant Fee allowance to every account matching rules",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Grant authorization to pay fees from your address to every account matching the rules, the accounts
+set your address as the fee granter of their transactions. All the set rules must match. The allowance is shared by
+the matching accounts. Note, the '--from' flag is ignored as it is implied from [granter].
+
+The allowance is granted to %[3]s, and revoked with:
+%[1]s tx %[2]s revoke [granter] %[3]s
+
+Examples:
+%[1]s tx %[2]s grant-rule-based cosmos1skjw... --max-account-sequence 5 --spend-limit 100stake or
+%[1]s tx %[2]s grant-rule-based cosmos1skjw... --balance-below 1stake --max-uses-per-grantee 3 --max-gas-price 0.025stake
+				`, version.AppName, feegrant.ModuleName, ruleBasedGrantee,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
 				return err
 			}
 
-			periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			// check any of period or periodLimit flags are set,
-			// if set consider it as periodic fee allowance.
-			if periodClock > 0 || periodLimitVal != "" {
-				periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
-				if err != nil {
-					return err
-				}
-
-				if periodClock <= 0 {
-					return fmt.Errorf("period clock was not set")
-				}
-
-				if periodLimit == nil {
-					return fmt.Errorf("period limit was not set")
-				}
-
-				periodReset := getPeriodReset(periodClock)
-				if exp != "" && periodReset.Sub(expiresAtTime) > 0 {
-					return fmt.Errorf("period (%d) cannot reset after expiration (%v)", periodClock, exp)
-				}
-
-				periodic := feegrant.PeriodicAllowance{
-					Basic:            basic,
-					Period:           getPeriod(periodClock),
-					PeriodReset:      getPeriodReset(periodClock),
-					PeriodSpendLimit: periodLimit,
-					PeriodCanSpend:   periodLimit,
-				}
-
-				grant = &periodic
+			granter := clientCtx.GetFromAddress()
+			allowance, err := getAllowance(cmd)
+			if err != nil {
+				return err
 			}
 
-			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+			grant, err := feegrant.NewRuleBasedAllowance(allowance)
 			if err != nil {
 				return err
 			}
 
-			if len(allowedMsgs) > 0 {
-				grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
-				if err != nil {
-					return err
-				}
+			if grant.MaxAccountSequence, err = cmd.Flags().GetUint64(FlagMaxAccountSequence); err != nil {
+				return err
+			}
+			if grant.MinAccountNumber, err = cmd.Flags().GetUint64(FlagMinAccountNumber); err != nil {
+				return err
+			}
+			if grant.MaxUsesPerGrantee, err = cmd.Flags().GetUint64(FlagMaxUsesPerGrantee); err != nil {
+				return err
+			}
+			balanceBelow, err := cmd.Flags().GetString(FlagBalanceBelow)
+			if err != nil {
+				return err
+			}
+			if grant.BalanceBelow, err = sdk.ParseCoinsNormalized(balanceBelow); err != nil {
+				return err
 			}
 
-			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
+			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, feegrant.RuleBasedGrantee)
 			if err != nil {
 				return err
 			}
@@ -178,13 +186,146 @@ Examples:
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addAllowanceFlags(cmd)
+	cmd.Flags().Uint64(FlagMaxAccountSequence, 0, "Only sponsor the accounts that signed less transactions, e.g. 5 sponsors their first 5 transactions")
+	cmd.Flags().Uint64(FlagMinAccountNumber, 0, "Only sponsor the accounts created after the account with this number")
+	cmd.Flags().String(FlagBalanceBelow, "", "Only sponsor the accounts whose spendable balance is below these coins in one of their denoms")
+	cmd.Flags().Uint64(FlagMaxUsesPerGrantee, 0, "The maximum number of transactions sponsored for each account")
+
+	return cmd
+}
+
+// addAllowanceFlags adds the flags of the allowance built by getAllowance.
+func addAllowanceFlags(cmd *cobra.Command) {
 	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
 	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().String(FlagMaxGasPrice, "", "The maximum gas price of the transactions whose fees are paid, per fee denom (ex: 0.025stake)")
+	cmd.Flags().Uint64(FlagMaxGasPerBlock, 0, "The maximum gas of the transactions whose fees are paid in a block")
+}
 
-	return cmd
+// getAllowance builds the fee allowance from the flags: a basic or periodic allowance, optionally restricted to
+// messages, a max gas price and a max gas per block.
+func getAllowance(cmd *cobra.Command) (feegrant.FeeAllowanceI, error) {
+	sl, err := cmd.Flags().GetString(FlagSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// if `FlagSpendLimit` isn't set, limit will be nil.
+	// Hence, there won't be any spendlimit for the grantee.
+	limit, err := sdk.ParseCoinsNormalized(sl)
+	if err != nil {
+		return nil, err
+	}
+
+	exp, err := cmd.Flags().GetString(FlagExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	basic := feegrant.BasicAllowance{
+		SpendLimit: limit,
+	}
+
+	var expiresAtTime time.Time
+	if exp != "" {
+		expiresAtTime, err = time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return nil, err
+		}
+		basic.Expiration = &expiresAtTime
+	}
+
+	var grant feegrant.FeeAllowanceI
+	grant = &basic
+
+	periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// check any of period or periodLimit flags are set,
+	// if set consider it as periodic fee allowance.
+	if periodClock > 0 || periodLimitVal != "" {
+		periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
+		if err != nil {
+			return nil, err
+		}
+
+		if periodClock <= 0 {
+			return nil, fmt.Errorf("period clock was not set")
+		}
+
+		if periodLimit == nil {
+			return nil, fmt.Errorf("period limit was not set")
+		}
+
+		periodReset := getPeriodReset(periodClock)
+		if exp != "" && periodReset.Sub(expiresAtTime) > 0 {
+			return nil, fmt.Errorf("period (%d) cannot reset after expiration (%v)", periodClock, exp)
+		}
+
+		periodic := feegrant.PeriodicAllowance{
+			Basic:            basic,
+			Period:           getPeriod(periodClock),
+			PeriodReset:      getPeriodReset(periodClock),
+			PeriodSpendLimit: periodLimit,
+			PeriodCanSpend:   periodLimit,
+		}
+
+		grant = &periodic
+	}
+
+	allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allowedMsgs) > 0 {
+		grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	maxGasPriceVal, err := cmd.Flags().GetString(FlagMaxGasPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxGasPriceVal != "" {
+		maxGasPrice, err := sdk.ParseDecCoins(maxGasPriceVal)
+		if err != nil {
+			return nil, err
+		}
+
+		grant, err = feegrant.NewGasPriceCappedAllowance(grant, maxGasPrice)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	maxGasPerBlock, err := cmd.Flags().GetUint64(FlagMaxGasPerBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxGasPerBlock > 0 {
+		grant, err = feegrant.NewPerBlockRateLimitedAllowance(grant, maxGasPerBlock)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return grant, nil
 }
 
 func getPeriodReset(duration int64) time.Time {
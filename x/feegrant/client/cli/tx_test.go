@@ -394,6 +394,34 @@ func (s *CLITestSuite) TestNewCmdFeeGrant() {
 			),
 			false, 0, &sdk.TxResponse{},
 		},
+		{
+			"valid gas price capped and per block rate limited fee grant",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1vevyks8pthkscvgazc97qyfjt40m6g9xe85ry8",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%s", cli.FlagMaxGasPrice, "0.025stake"),
+					fmt.Sprintf("--%s=%d", cli.FlagMaxGasPerBlock, 1000000),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"invalid max gas price",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1vevyks8pthkscvgazc97qyfjt40m6g9xe85ry8",
+					fmt.Sprintf("--%s=%s", cli.FlagMaxGasPrice, "invalid"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
 		{
 			"invalid expiration",
 			append(
@@ -426,6 +454,45 @@ func (s *CLITestSuite) TestNewCmdFeeGrant() {
 	}
 }
 
+func (s *CLITestSuite) TestNewCmdRuleBasedFeeGrant() {
+	granter := s.accounts[0]
+	ac := codecaddress.NewBech32Codec("cosmos")
+	ruleBasedGrantee, err := ac.BytesToString(feegrant.RuleBasedGrantee)
+	s.Require().NoError(err)
+
+	args := []string{
+		granter.String(),
+		fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+		fmt.Sprintf("--%s=%d", cli.FlagMaxAccountSequence, 5),
+		fmt.Sprintf("--%s=%s", cli.FlagBalanceBelow, "1stake"),
+		fmt.Sprintf("--%s=%d", cli.FlagMaxUsesPerGrantee, 3),
+		fmt.Sprintf("--%s=%s", cli.FlagMaxGasPrice, "0.025stake"),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	}
+	out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cli.NewCmdRuleBasedFeeGrant(ac), args)
+	s.Require().NoError(err)
+
+	tx, err := s.clientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+	s.Require().NoError(err)
+	s.Require().Len(tx.GetMsgs(), 1)
+	msg, ok := tx.GetMsgs()[0].(*feegrant.MsgGrantAllowance)
+	s.Require().True(ok)
+	s.Require().Equal(ruleBasedGrantee, msg.Grantee)
+
+	allowance, err := msg.GetFeeAllowanceI()
+	s.Require().NoError(err)
+	ruleBased, ok := allowance.(*feegrant.RuleBasedAllowance)
+	s.Require().True(ok)
+	s.Require().Equal(uint64(5), ruleBased.MaxAccountSequence)
+	s.Require().Equal(uint64(3), ruleBased.MaxUsesPerGrantee)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), ruleBased.BalanceBelow)
+	s.Require().NoError(ruleBased.ValidateBasic())
+
+	capped, err := ruleBased.GetAllowance()
+	s.Require().NoError(err)
+	s.Require().IsType(&feegrant.GasPriceCappedAllowance{}, capped)
+}
+
 func (s *CLITestSuite) TestTxWithFeeGrant() {
 	clientCtx := s.clientCtx
 	granter := s.addedGranter
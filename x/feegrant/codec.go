@@ -18,6 +18,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance", nil)
 	cdc.RegisterConcrete(&PeriodicAllowance{}, "cosmos-sdk/PeriodicAllowance", nil)
 	cdc.RegisterConcrete(&AllowedMsgAllowance{}, "cosmos-sdk/AllowedMsgAllowance", nil)
+	cdc.RegisterConcrete(&GasPriceCappedAllowance{}, "cosmos-sdk/GasPriceCappedAllowance", nil)
+	cdc.RegisterConcrete(&PerBlockRateLimitedAllowance{}, "cosmos-sdk/PerBlockRateLimitedAllowance", nil)
+	cdc.RegisterConcrete(&RuleBasedAllowance{}, "cosmos-sdk/RuleBasedAllowance", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -33,6 +36,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&BasicAllowance{},
 		&PeriodicAllowance{},
 		&AllowedMsgAllowance{},
+		&GasPriceCappedAllowance{},
+		&PerBlockRateLimitedAllowance{},
+		&RuleBasedAllowance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -20,4 +20,10 @@ var (
 	ErrNoMessages = errors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = errors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrGasPriceExceeded error if the gas price of the transaction is above the allowed one
+	ErrGasPriceExceeded = errors.Register(DefaultCodespace, 8, "gas price exceeded")
+	// ErrBlockGasExceeded error if the allowance already paid for too much gas in the block
+	ErrBlockGasExceeded = errors.Register(DefaultCodespace, 9, "block gas limit exceeded")
+	// ErrGranteeNotMatched error if the fee payer doesn't match the rules of a rule based allowance
+	ErrGranteeNotMatched = errors.Register(DefaultCodespace, 10, "grantee does not match the allowance rules")
 )
@@ -2,9 +2,16 @@ package feegrant
 
 import (
 	"context"
+	"math"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // FeeAllowance implementations are tied to a given fee delegator and delegatee,
@@ -29,3 +36,30 @@ type FeeAllowanceI interface {
 	// ExpiresAt returns the expiry time of the allowance.
 	ExpiresAt() (*time.Time, error)
 }
+
+// packAllowance packs the allowance wrapped by another allowance.
+func packAllowance(allowance FeeAllowanceI) (*types.Any, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+	return types.NewAnyWithValue(msg)
+}
+
+// unpackAllowance returns the allowance wrapped by another allowance.
+func unpackAllowance(any *types.Any) (FeeAllowanceI, error) {
+	allowance, ok := any.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+	return allowance, nil
+}
+
+// txGasLimit returns the gas limit of the transaction whose fee is paid, which
+// the ante handler sets as the limit of the gas meter before deducting the fee.
+// It returns false when the gas meter is infinite, as in simulations and in the
+// genesis block.
+func txGasLimit(ctx sdk.Context) (uint64, bool) {
+	limit := ctx.GasMeter().Limit()
+	return limit, limit > 0 && limit != math.MaxUint64
+}
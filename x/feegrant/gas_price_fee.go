@@ -0,0 +1,110 @@
+package feegrant
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                 = (*GasPriceCappedAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*GasPriceCappedAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *GasPriceCappedAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewGasPriceCappedAllowance creates a new gas price capped fee allowance.
+func NewGasPriceCappedAllowance(allowance FeeAllowanceI, maxGasPrice sdk.DecCoins) (*GasPriceCappedAllowance, error) {
+	any, err := packAllowance(allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GasPriceCappedAllowance{
+		Allowance:   any,
+		MaxGasPrice: maxGasPrice,
+	}, nil
+}
+
+// GetAllowance returns the capped fee allowance.
+func (a *GasPriceCappedAllowance) GetAllowance() (FeeAllowanceI, error) {
+	return unpackAllowance(a.Allowance)
+}
+
+// SetAllowance sets the capped fee allowance.
+func (a *GasPriceCappedAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = packAllowance(allowance)
+	return err
+}
+
+// Accept rejects the fee if its gas price is above the max gas price of its
+// denom, and otherwise delegates to the capped allowance. The gas price is not
+// checked when the gas limit of the transaction is unknown, as in simulations.
+func (a *GasPriceCappedAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if gas, ok := txGasLimit(sdk.UnwrapSDKContext(ctx)); ok {
+		gasLimit := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gas))
+		for _, coin := range fee {
+			maxGasPrice := a.MaxGasPrice.AmountOf(coin.Denom)
+			if !maxGasPrice.IsPositive() {
+				return false, errorsmod.Wrapf(ErrGasPriceExceeded, "no max gas price for denom %s", coin.Denom)
+			}
+			gasPrice := sdkmath.LegacyNewDecFromInt(coin.Amount).Quo(gasLimit)
+			if gasPrice.GT(maxGasPrice) {
+				return false, errorsmod.Wrapf(ErrGasPriceExceeded, "gas price %s%s is above %s%s", gasPrice, coin.Denom, maxGasPrice, coin.Denom)
+			}
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *GasPriceCappedAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.MaxGasPrice) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "max gas price shouldn't be empty")
+	}
+	if err := a.MaxGasPrice.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "max gas price is invalid: %s", err)
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt returns the expiry time of the GasPriceCappedAllowance.
+func (a *GasPriceCappedAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
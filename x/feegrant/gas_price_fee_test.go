@@ -0,0 +1,83 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	ocproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGasPriceCappedAllowance(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(ocproto.Header{Time: time.Now(), Height: 1})
+
+	maxGasPrice := sdk.NewDecCoinsFromCoins(sdk.NewInt64Coin("atom", 2))
+	cases := map[string]struct {
+		gasMeter storetypes.GasMeter
+		fee      sdk.Coins
+		accept   bool
+		remains  sdk.Coins
+	}{
+		"gas price below the cap": {
+			gasMeter: storetypes.NewGasMeter(100),
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+			accept:   true,
+			remains:  sdk.NewCoins(sdk.NewInt64Coin("atom", 900)),
+		},
+		"gas price at the cap": {
+			gasMeter: storetypes.NewGasMeter(100),
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("atom", 200)),
+			accept:   true,
+			remains:  sdk.NewCoins(sdk.NewInt64Coin("atom", 800)),
+		},
+		"gas price above the cap": {
+			gasMeter: storetypes.NewGasMeter(100),
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("atom", 201)),
+			accept:   false,
+		},
+		"denom without cap": {
+			gasMeter: storetypes.NewGasMeter(100),
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("eth", 1)),
+			accept:   false,
+		},
+		"unknown gas limit": {
+			gasMeter: storetypes.NewInfiniteGasMeter(),
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("atom", 201)),
+			accept:   true,
+			remains:  sdk.NewCoins(sdk.NewInt64Coin("atom", 799)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			basic := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))}
+			allowance, err := feegrant.NewGasPriceCappedAllowance(basic, maxGasPrice)
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			removed, err := allowance.Accept(ctx.WithGasMeter(tc.gasMeter), tc.fee, nil)
+			require.False(t, removed)
+			if !tc.accept {
+				require.ErrorIs(t, err, feegrant.ErrGasPriceExceeded)
+				return
+			}
+			require.NoError(t, err)
+
+			capped, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, tc.remains, capped.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+
+	allowance, err := feegrant.NewGasPriceCappedAllowance(&feegrant.BasicAllowance{}, nil)
+	require.NoError(t, err)
+	require.Error(t, allowance.ValidateBasic())
+}
@@ -1,7 +1,10 @@
 package feegrant
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
@@ -25,6 +28,24 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	seenUses := make(map[string]bool, len(data.RuleBasedUses))
+	for _, u := range data.RuleBasedUses {
+		if u.Granter == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "missing rule based uses granter address")
+		}
+		if u.Grantee == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "missing rule based uses grantee address")
+		}
+		if u.Uses == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "rule based uses of grantee %s by granter %s must be positive", u.Grantee, u.Granter)
+		}
+		key := u.Granter + "/" + u.Grantee
+		if seenUses[key] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate rule based uses of grantee %s by granter %s", u.Grantee, u.Granter)
+		}
+		seenUses[key] = true
+	}
 	return nil
 }
 
@@ -5,6 +5,7 @@ package feegrant
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -27,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState contains a set of fee allowances, persisted from the store
 type GenesisState struct {
 	Allowances []Grant `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances"`
+	// rule_based_uses are the numbers of transactions paid for by rule based allowances.
+	RuleBasedUses []RuleBasedUses `protobuf:"bytes,2,rep,name=rule_based_uses,json=ruleBasedUses,proto3" json:"rule_based_uses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,8 +72,81 @@ func (m *GenesisState) GetAllowances() []Grant {
 	return nil
 }
 
+func (m *GenesisState) GetRuleBasedUses() []RuleBasedUses {
+	if m != nil {
+		return m.RuleBasedUses
+	}
+	return nil
+}
+
+// RuleBasedUses is the number of transactions of a grantee paid for by the rule
+// based allowance of a granter.
+type RuleBasedUses struct {
+	// granter is the address of the user granting the rule based allowance.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user whose transactions were paid for.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// uses is the number of transactions paid for.
+	Uses uint64 `protobuf:"varint,3,opt,name=uses,proto3" json:"uses,omitempty"`
+}
+
+func (m *RuleBasedUses) Reset()         { *m = RuleBasedUses{} }
+func (m *RuleBasedUses) String() string { return proto.CompactTextString(m) }
+func (*RuleBasedUses) ProtoMessage()    {}
+func (*RuleBasedUses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac719d2d0954d1bf, []int{1}
+}
+func (m *RuleBasedUses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuleBasedUses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuleBasedUses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuleBasedUses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleBasedUses.Merge(m, src)
+}
+func (m *RuleBasedUses) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuleBasedUses) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleBasedUses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuleBasedUses proto.InternalMessageInfo
+
+func (m *RuleBasedUses) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *RuleBasedUses) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *RuleBasedUses) GetUses() uint64 {
+	if m != nil {
+		return m.Uses
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.feegrant.v1beta1.GenesisState")
+	proto.RegisterType((*RuleBasedUses)(nil), "cosmos.feegrant.v1beta1.RuleBasedUses")
 }
 
 func init() {
@@ -78,20 +154,28 @@ func init() {
 }
 
 var fileDescriptor_ac719d2d0954d1bf = []byte{
-	// 207 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x83, 0x29, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x99, 0x0a, 0xd7, 0x0f, 0x51,
-	0x27, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x42, 0x4a, 0x91, 0x5c, 0x3c, 0xee,
-	0x10, 0xab, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x3c, 0xb9, 0xb8, 0x12, 0x73, 0x72, 0xf2, 0xcb,
-	0x13, 0xf3, 0x92, 0x53, 0x8b, 0x25, 0x18, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0xe4, 0xf4, 0x70, 0x38,
-	0x47, 0xcf, 0x1d, 0xc4, 0x73, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c,
-	0x41, 0x48, 0x9a, 0x9d, 0x0c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x0a,
-	0xea, 0xbd, 0xe2, 0x94, 0x6c, 0xbd, 0xcc, 0x7c, 0xfd, 0x0a, 0xb8, 0x33, 0x93, 0xd8, 0xc0, 0x8e,
-	0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x32, 0xdb, 0x7e, 0x13, 0x27, 0x01, 0x00, 0x00,
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xb1, 0x4e, 0x3a, 0x41,
+	0x10, 0xc6, 0x6f, 0x81, 0xfc, 0xff, 0x61, 0x95, 0x18, 0x2f, 0x24, 0x9e, 0x14, 0x2b, 0x21, 0x91,
+	0x10, 0x13, 0x6f, 0x03, 0x3e, 0x81, 0xd7, 0x10, 0xdb, 0x23, 0x16, 0xda, 0x90, 0x85, 0x1b, 0x2f,
+	0x17, 0x8f, 0x5b, 0xb3, 0xb3, 0xa8, 0xef, 0x60, 0xe3, 0x63, 0x58, 0x5a, 0xf0, 0x10, 0x94, 0xc4,
+	0xca, 0xca, 0x18, 0x28, 0x7c, 0x0d, 0xc3, 0xee, 0x81, 0x58, 0x90, 0xd8, 0x5c, 0xe6, 0xf6, 0xfb,
+	0x7d, 0xdf, 0xcc, 0xee, 0xd0, 0xe3, 0xa1, 0xc4, 0x91, 0x44, 0x7e, 0x03, 0x10, 0x2b, 0x91, 0x69,
+	0x7e, 0xdf, 0x1e, 0x80, 0x16, 0x6d, 0x1e, 0x43, 0x06, 0x98, 0xa0, 0x7f, 0xa7, 0xa4, 0x96, 0xee,
+	0x81, 0xc5, 0xfc, 0x15, 0xe6, 0xe7, 0x58, 0xad, 0x1a, 0xcb, 0x58, 0x1a, 0x86, 0x2f, 0x2b, 0x8b,
+	0xd7, 0x0e, 0x2d, 0xde, 0xb7, 0x42, 0xee, 0xb5, 0x52, 0x73, 0x5b, 0xc3, 0x75, 0xb4, 0xe5, 0xf6,
+	0xc5, 0x28, 0xc9, 0x24, 0x37, 0x5f, 0x7b, 0xd4, 0x98, 0x10, 0xba, 0xdb, 0xb5, 0x63, 0xf5, 0xb4,
+	0xd0, 0xe0, 0x5e, 0x50, 0x2a, 0xd2, 0x54, 0x3e, 0x88, 0x6c, 0x08, 0xe8, 0x91, 0x7a, 0xb1, 0xb5,
+	0xd3, 0x61, 0xfe, 0x96, 0x51, 0xfd, 0xee, 0xf2, 0x2f, 0x28, 0x4f, 0x3f, 0x8e, 0x9c, 0x97, 0xaf,
+	0xd7, 0x13, 0x12, 0x6e, 0x98, 0xdd, 0x2b, 0xba, 0xa7, 0xc6, 0x29, 0xf4, 0x07, 0x02, 0x21, 0xea,
+	0x8f, 0x11, 0xd0, 0x2b, 0x98, 0xbc, 0xe6, 0xd6, 0xbc, 0x70, 0x9c, 0x42, 0xb0, 0xc4, 0x2f, 0x11,
+	0x70, 0x33, 0xb7, 0xa2, 0x36, 0x95, 0xc6, 0x13, 0xa1, 0x95, 0x5f, 0xac, 0xdb, 0xa1, 0xff, 0x4d,
+	0x14, 0x28, 0x8f, 0xd4, 0x49, 0xab, 0x1c, 0x78, 0x6f, 0x93, 0xd3, 0x6a, 0xde, 0xe7, 0x3c, 0x8a,
+	0x14, 0x20, 0xf6, 0xb4, 0x4a, 0xb2, 0x38, 0x5c, 0x81, 0x3f, 0x1e, 0xf0, 0x0a, 0x7f, 0xf3, 0x80,
+	0xeb, 0xd2, 0x92, 0xb9, 0x49, 0xb1, 0x4e, 0x5a, 0xa5, 0xd0, 0xd4, 0x41, 0x7b, 0x3a, 0x67, 0x64,
+	0x36, 0x67, 0xe4, 0x73, 0xce, 0xc8, 0xf3, 0x82, 0x39, 0xb3, 0x05, 0x73, 0xde, 0x17, 0xcc, 0xb9,
+	0xce, 0x77, 0x8c, 0xd1, 0xad, 0x9f, 0x48, 0xfe, 0xb8, 0x5e, 0xc8, 0xe0, 0x9f, 0x79, 0xfe, 0xb3,
+	0xef, 0x01, 0x00, 0x1d, 0xfe, 0xb0, 0x36, 0x2c, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +198,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RuleBasedUses) > 0 {
+		for iNdEx := len(m.RuleBasedUses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuleBasedUses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Allowances) > 0 {
 		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -131,6 +229,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RuleBasedUses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuleBasedUses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuleBasedUses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Uses != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Uses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -154,6 +294,32 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RuleBasedUses) > 0 {
+		for _, e := range m.RuleBasedUses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RuleBasedUses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Uses != 0 {
+		n += 1 + sovGenesis(uint64(m.Uses))
+	}
 	return n
 }
 
@@ -226,6 +392,173 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleBasedUses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuleBasedUses = append(m.RuleBasedUses, RuleBasedUses{})
+			if err := m.RuleBasedUses[len(m.RuleBasedUses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuleBasedUses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuleBasedUses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuleBasedUses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+			}
+			m.Uses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
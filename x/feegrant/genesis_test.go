@@ -0,0 +1,39 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesisRuleBasedUses(t *testing.T) {
+	granter := sdk.AccAddress("granter_____________").String()
+	grantee := sdk.AccAddress("grantee_____________").String()
+
+	testCases := []struct {
+		name   string
+		uses   []feegrant.RuleBasedUses
+		expErr string
+	}{
+		{"valid", []feegrant.RuleBasedUses{{Granter: granter, Grantee: grantee, Uses: 1}, {Granter: grantee, Grantee: granter, Uses: 2}}, ""},
+		{"missing granter", []feegrant.RuleBasedUses{{Grantee: grantee, Uses: 1}}, "missing rule based uses granter address"},
+		{"missing grantee", []feegrant.RuleBasedUses{{Granter: granter, Uses: 1}}, "missing rule based uses grantee address"},
+		{"zero uses", []feegrant.RuleBasedUses{{Granter: granter, Grantee: grantee}}, "must be positive"},
+		{"duplicate", []feegrant.RuleBasedUses{{Granter: granter, Grantee: grantee, Uses: 1}, {Granter: granter, Grantee: grantee, Uses: 2}}, "duplicate rule based uses"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := feegrant.ValidateGenesis(feegrant.GenesisState{RuleBasedUses: tc.uses})
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -89,6 +89,52 @@ func TestImportExportGenesis(t *testing.T) {
 	assert.DeepEqual(t, genesis, newGenesis)
 }
 
+func TestImportExportRuleBasedUsesGenesis(t *testing.T) {
+	f := initFixture(t)
+
+	f.accountKeeper.EXPECT().GetAccount(gomock.Any(), feegrant.RuleBasedGrantee).Return(nil).AnyTimes()
+	f.accountKeeper.EXPECT().GetAccount(gomock.Any(), granteeAddr).Return(authtypes.NewBaseAccountWithAddress(granteeAddr)).AnyTimes()
+
+	coins := sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(1_000)))
+	allowance, err := feegrant.NewRuleBasedAllowance(&feegrant.BasicAllowance{SpendLimit: coins})
+	assert.NilError(t, err)
+	allowance.MaxUsesPerGrantee = 3
+	assert.NilError(t, f.feegrantKeeper.GrantAllowance(f.ctx, granterAddr, feegrant.RuleBasedGrantee, allowance))
+
+	fee := sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(1)))
+	for range 2 {
+		assert.NilError(t, f.feegrantKeeper.UseGrantedFees(f.ctx, granterAddr, granteeAddr, fee, nil))
+	}
+
+	genesis, err := f.feegrantKeeper.ExportGenesis(f.ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []feegrant.RuleBasedUses{{Granter: granterAddr.String(), Grantee: granteeAddr.String(), Uses: 2}}, genesis.RuleBasedUses)
+	assert.NilError(t, feegrant.ValidateGenesis(*genesis))
+
+	// the uses are imported in a new chain
+	f = initFixture(t)
+	f.accountKeeper.EXPECT().GetAccount(gomock.Any(), feegrant.RuleBasedGrantee).Return(nil).AnyTimes()
+	f.accountKeeper.EXPECT().GetAccount(gomock.Any(), granteeAddr).Return(authtypes.NewBaseAccountWithAddress(granteeAddr)).AnyTimes()
+	assert.NilError(t, f.feegrantKeeper.InitGenesis(f.ctx, genesis))
+
+	uses, err := f.feegrantKeeper.GetRuleBasedUses(f.ctx, granterAddr, granteeAddr)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(2), uses)
+	newGenesis, err := f.feegrantKeeper.ExportGenesis(f.ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, genesis, newGenesis)
+
+	// the third use is the last one
+	assert.NilError(t, f.feegrantKeeper.UseGrantedFees(f.ctx, granterAddr, granteeAddr, fee, nil))
+	err = f.feegrantKeeper.UseGrantedFees(f.ctx, granterAddr, granteeAddr, fee, nil)
+	assert.ErrorIs(t, err, feegrant.ErrGranteeNotMatched)
+
+	// uses without a rule based allowance are rejected
+	f = initFixture(t)
+	err = f.feegrantKeeper.InitGenesis(f.ctx, &feegrant.GenesisState{RuleBasedUses: genesis.RuleBasedUses})
+	assert.ErrorContains(t, err, "fee-grant not found")
+}
+
 func TestInitGenesis(t *testing.T) {
 	any, err := codectypes.NewAnyWithValue(&testdata.Dog{})
 	assert.NilError(t, err)
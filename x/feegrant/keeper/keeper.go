@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"time"
 
@@ -57,9 +59,15 @@ func (k Keeper) GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddr
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance already exists")
 	}
 
+	// rule based allowances are granted to the rule based grantee, which has no account
+	_, ruleBased := feeAllowance.(*feegrant.RuleBasedAllowance)
+	if ruleBased != grantee.Equals(feegrant.RuleBasedGrantee) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "rule based allowances must be granted to %s, and only them", feegrant.RuleBasedGrantee)
+	}
+
 	// create the account if it is not in account state
 	granteeAcc := k.authKeeper.GetAccount(ctx, grantee)
-	if granteeAcc == nil {
+	if granteeAcc == nil && !ruleBased {
 		if k.bankKeeper.BlockedAddr(grantee) {
 			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", grantee)
 		}
@@ -179,6 +187,12 @@ func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 		}
 	}
 
+	if grantee.Equals(feegrant.RuleBasedGrantee) {
+		if err := k.deleteRuleBasedUses(ctx, granter); err != nil {
+			return err
+		}
+	}
+
 	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
 		sdk.NewEvent(
 			feegrant.EventTypeRevokeFeeGrant,
@@ -243,9 +257,15 @@ func (k Keeper) IterateAllFeeAllowances(ctx context.Context, cb func(grant feegr
 	return nil
 }
 
-// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee.
+// Without a grant to the grantee, the rule based allowance of the granter is used if the grantee matches its rules.
 func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
 	grant, err := k.GetAllowance(ctx, granter, grantee)
+	if errors.Is(err, sdkerrors.ErrNotFound) {
+		if ruleGrant, ruleErr := k.GetAllowance(ctx, granter, feegrant.RuleBasedGrantee); ruleErr == nil {
+			return k.useRuleBasedFees(ctx, granter, grantee, ruleGrant, fee, msgs)
+		}
+	}
 	if err != nil {
 		return err
 	}
@@ -274,6 +294,95 @@ func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddr
 	return k.UpdateAllowance(ctx, granter, grantee, grant)
 }
 
+// useRuleBasedFees pays the given fee from the rule based allowance of the granter if the grantee matches its rules.
+func (k Keeper) useRuleBasedFees(ctx context.Context, granter, grantee sdk.AccAddress, grant feegrant.FeeAllowanceI, fee sdk.Coins, msgs []sdk.Msg) error {
+	allowance, ok := grant.(*feegrant.RuleBasedAllowance)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected rule based allowance, got %T", grant)
+	}
+
+	acc := k.authKeeper.GetAccount(ctx, grantee)
+	if acc == nil {
+		return errorsmod.Wrapf(feegrant.ErrGranteeNotMatched, "account %s does not exist", grantee)
+	}
+	var spendable sdk.Coins
+	if len(allowance.BalanceBelow) > 0 {
+		spendable = k.bankKeeper.SpendableCoins(ctx, grantee)
+	}
+	uses, err := k.GetRuleBasedUses(ctx, granter, grantee)
+	if err != nil {
+		return err
+	}
+	if err := allowance.MatchGrantee(acc, spendable, uses); err != nil {
+		return err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if remove {
+		// Ignoring the `revokeFeeAllowance` error, because the user has enough grants to perform this transaction.
+		_ = k.revokeAllowance(ctx, granter, feegrant.RuleBasedGrantee)
+		if err != nil {
+			return err
+		}
+
+		emitUseGrantEvent(ctx, granter.String(), grantee.String())
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	emitUseGrantEvent(ctx, granter.String(), grantee.String())
+
+	// the uses are only tracked when they are limited
+	if allowance.MaxUsesPerGrantee > 0 {
+		if err := k.setRuleBasedUses(ctx, granter, grantee, uses+1); err != nil {
+			return err
+		}
+	}
+
+	// if fee allowance is accepted, store the updated state of the allowance
+	return k.UpdateAllowance(ctx, granter, feegrant.RuleBasedGrantee, allowance)
+}
+
+// GetRuleBasedUses returns the number of transactions of the grantee paid for by the rule based allowance of the
+// granter, they are only tracked when the allowance limits them.
+func (k Keeper) GetRuleBasedUses(ctx context.Context, granter, grantee sdk.AccAddress) (uint64, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(feegrant.RuleBasedUsesKey(granter, grantee))
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+func (k Keeper) setRuleBasedUses(ctx context.Context, granter, grantee sdk.AccAddress, uses uint64) error {
+	return k.storeService.OpenKVStore(ctx).Set(feegrant.RuleBasedUsesKey(granter, grantee), binary.BigEndian.AppendUint64(nil, uses))
+}
+
+// deleteRuleBasedUses deletes the uses of the rule based allowance of the granter, once it is removed.
+func (k Keeper) deleteRuleBasedUses(ctx context.Context, granter sdk.AccAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := feegrant.RuleBasedUsesPrefixByGranter(granter)
+	iterator, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func emitUseGrantEvent(ctx context.Context, granter, grantee string) {
 	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -306,6 +415,29 @@ func (k Keeper) InitGenesis(ctx context.Context, data *feegrant.GenesisState) er
 			return err
 		}
 	}
+
+	for _, u := range data.RuleBasedUses {
+		granter, err := k.authKeeper.AddressCodec().StringToBytes(u.Granter)
+		if err != nil {
+			return err
+		}
+		grantee, err := k.authKeeper.AddressCodec().StringToBytes(u.Grantee)
+		if err != nil {
+			return err
+		}
+
+		grant, err := k.GetAllowance(ctx, granter, feegrant.RuleBasedGrantee)
+		if err != nil {
+			return errorsmod.Wrapf(err, "rule based uses of grantee %s by granter %s", u.Grantee, u.Granter)
+		}
+		if _, ok := grant.(*feegrant.RuleBasedAllowance); !ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "rule based uses of grantee %s by granter %s without rule based allowance", u.Grantee, u.Granter)
+		}
+
+		if err := k.setRuleBasedUses(ctx, granter, grantee, u.Uses); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -317,12 +449,47 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*feegrant.GenesisState, erro
 		grants = append(grants, grant)
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	uses, err := k.exportRuleBasedUses(ctx)
 
 	return &feegrant.GenesisState{
-		Allowances: grants,
+		Allowances:    grants,
+		RuleBasedUses: uses,
 	}, err
 }
 
+// exportRuleBasedUses returns the uses of all the rule based allowances.
+func (k Keeper) exportRuleBasedUses(ctx context.Context) ([]feegrant.RuleBasedUses, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(feegrant.RuleBasedUsesKeyPrefix, storetypes.PrefixEndBytes(feegrant.RuleBasedUsesKeyPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var uses []feegrant.RuleBasedUses
+	for ; iterator.Valid(); iterator.Next() {
+		granter, grantee := feegrant.ParseAddressesFromRuleBasedUsesKey(iterator.Key())
+		granterStr, err := k.authKeeper.AddressCodec().BytesToString(granter)
+		if err != nil {
+			return nil, err
+		}
+		granteeStr, err := k.authKeeper.AddressCodec().BytesToString(grantee)
+		if err != nil {
+			return nil, err
+		}
+		uses = append(uses, feegrant.RuleBasedUses{
+			Granter: granterStr,
+			Grantee: granteeStr,
+			Uses:    binary.BigEndian.Uint64(iterator.Value()),
+		})
+	}
+	return uses, nil
+}
+
 func (k Keeper) addToFeeAllowanceQueue(ctx context.Context, grantKey []byte, exp *time.Time) error {
 	store := k.storeService.OpenKVStore(ctx)
 	return store.Set(feegrant.FeeAllowancePrefixQueue(exp, grantKey), []byte{})
@@ -351,6 +518,12 @@ func (k Keeper) RemoveExpiredAllowances(ctx context.Context, limit int32) error
 			return err
 		}
 
+		if feegrant.RuleBasedGrantee.Equals(sdk.AccAddress(grantee)) {
+			if err := k.deleteRuleBasedUses(ctx, granter); err != nil {
+				return err
+			}
+		}
+
 		// limit the amount of iterations to avoid taking too much time
 		count++
 		if count == limit {
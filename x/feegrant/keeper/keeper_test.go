@@ -409,3 +409,59 @@ func (suite *KeeperTestSuite) TestPruneGrants() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUseRuleBasedFees() {
+	granter := suite.addrs[0]
+	newAcc := sdk.AccAddress("new_account_________")
+	oldAcc := sdk.AccAddress("old_account_________")
+	suite.accountKeeper.EXPECT().GetAccount(gomock.Any(), feegrant.RuleBasedGrantee).Return(nil).AnyTimes()
+	suite.accountKeeper.EXPECT().GetAccount(gomock.Any(), newAcc).Return(authtypes.NewBaseAccount(newAcc, nil, 100, 0)).AnyTimes()
+	suite.accountKeeper.EXPECT().GetAccount(gomock.Any(), oldAcc).Return(authtypes.NewBaseAccount(oldAcc, nil, 1, 0)).AnyTimes()
+
+	now := suite.ctx.BlockTime()
+	expiration := now.AddDate(0, 0, 1)
+	allowance, err := feegrant.NewRuleBasedAllowance(&feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &expiration})
+	suite.Require().NoError(err)
+	allowance.MinAccountNumber = 10
+	allowance.MaxUsesPerGrantee = 2
+
+	// rule based allowances are only granted to the rule based grantee
+	err = suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, suite.addrs[1], allowance)
+	suite.Require().ErrorContains(err, "rule based allowances must be granted")
+	err = suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, feegrant.RuleBasedGrantee, &feegrant.BasicAllowance{})
+	suite.Require().ErrorContains(err, "rule based allowances must be granted")
+	suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, feegrant.RuleBasedGrantee, allowance))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, oldAcc, fee, nil)
+	suite.Require().ErrorIs(err, feegrant.ErrGranteeNotMatched)
+
+	// a grant to the grantee takes precedence
+	suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, oldAcc, &feegrant.BasicAllowance{}))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, oldAcc, fee, nil))
+
+	// the matching accounts share the allowance, up to their max uses
+	for range 2 {
+		suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, newAcc, fee, nil))
+	}
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, newAcc, fee, nil)
+	suite.Require().ErrorIs(err, feegrant.ErrGranteeNotMatched)
+
+	uses, err := suite.feegrantKeeper.GetRuleBasedUses(suite.ctx, granter, newAcc)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), uses)
+	grant, err := suite.feegrantKeeper.GetAllowance(suite.ctx, granter, feegrant.RuleBasedGrantee)
+	suite.Require().NoError(err)
+	shared, err := grant.(*feegrant.RuleBasedAllowance).GetAllowance()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 553)), shared.(*feegrant.BasicAllowance).SpendLimit)
+
+	// the uses are pruned with the expired allowance
+	ctx := suite.ctx.WithBlockTime(expiration.AddDate(0, 0, 1))
+	suite.Require().NoError(suite.feegrantKeeper.RemoveExpiredAllowances(ctx, 5))
+	_, err = suite.feegrantKeeper.GetAllowance(ctx, granter, feegrant.RuleBasedGrantee)
+	suite.Require().ErrorContains(err, "not found")
+	uses, err = suite.feegrantKeeper.GetRuleBasedUses(ctx, granter, newAcc)
+	suite.Require().NoError(err)
+	suite.Require().Zero(uses)
+}
@@ -29,6 +29,11 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = []byte{0x01}
+
+	// RuleBasedUsesKeyPrefix is the set of the kvstore for the number of transactions
+	// paid for each grantee by rule based allowances
+	// - 0x02<granter_grantee_key_bytes>: uint64
+	RuleBasedUsesKeyPrefix = []byte{0x02}
 )
 
 // FeeAllowanceKey is the canonical key to store a grant from granter to grantee
@@ -66,6 +71,37 @@ func AllowanceByExpTimeKey(exp *time.Time) []byte {
 	return append(FeeAllowanceQueueKeyPrefix, sdk.FormatTimeBytes(*exp)...)
 }
 
+// RuleBasedUsesKey is the key to store the number of transactions of a grantee
+// paid for by the rule based allowance of a granter.
+//
+// Key format:
+// - <0x02><len(granter_address_bytes)><granter_address_bytes><len(grantee_address_bytes)><grantee_address_bytes>
+func RuleBasedUsesKey(granter, grantee sdk.AccAddress) []byte {
+	return append(RuleBasedUsesPrefixByGranter(granter), address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// RuleBasedUsesPrefixByGranter returns a prefix to scan for the uses of the rule
+// based allowance of a granter.
+//
+// Key format:
+// - <0x02><len(granter_address_bytes)><granter_address_bytes>
+func RuleBasedUsesPrefixByGranter(granter sdk.AccAddress) []byte {
+	return append(RuleBasedUsesKeyPrefix, address.MustLengthPrefix(granter.Bytes())...)
+}
+
+// ParseAddressesFromRuleBasedUsesKey extracts and returns the granter, grantee from the given key.
+func ParseAddressesFromRuleBasedUsesKey(key []byte) (granter, grantee []byte) {
+	// key is of format:
+	// 0x02<granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes>
+	granterAddrLen, granterAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, 1, 1) // ignore key[0] since it is a prefix key
+	granter, granterAddrEndIndex := sdk.ParseLengthPrefixedBytes(key, granterAddrLenEndIndex+1, int(granterAddrLen[0]))
+
+	granteeAddrLen, granteeAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, granterAddrEndIndex+1, 1)
+	grantee, _ = sdk.ParseLengthPrefixedBytes(key, granteeAddrLenEndIndex+1, int(granteeAddrLen[0]))
+
+	return granter, grantee
+}
+
 // ParseAddressesFromFeeAllowanceKey extracts and returns the granter, grantee from the given key.
 func ParseAddressesFromFeeAllowanceKey(key []byte) (granter, grantee []byte) {
 	// key is of format:
@@ -45,3 +45,19 @@ func TestMarshalAndUnmarshalFeegrantKeyQueueKey(t *testing.T) {
 	require.Equal(t, granter, granter1)
 	require.Equal(t, grantee, grantee1)
 }
+
+func TestMarshalAndUnmarshalRuleBasedUsesKey(t *testing.T) {
+	addressCodec := codecaddress.NewBech32Codec("cosmos")
+	grantee, err := addressCodec.StringToBytes("cosmos1qk93t4j0yyzgqgt6k5qf8deh8fq6smpn3ntu3x")
+	require.NoError(t, err)
+	granter, err := addressCodec.StringToBytes("cosmos1p9qh4ldfd6n0qehujsal4k7g0e37kel90rc4ts")
+	require.NoError(t, err)
+
+	key := feegrant.RuleBasedUsesKey(granter, grantee)
+	require.Len(t, key, len(grantee)+len(granter)+3)
+	require.Equal(t, feegrant.RuleBasedUsesPrefixByGranter(granter), key[:len(granter)+2])
+
+	g1, g2 := feegrant.ParseAddressesFromRuleBasedUsesKey(key)
+	require.Equal(t, granter, g1)
+	require.Equal(t, grantee, g2)
+}
@@ -0,0 +1,108 @@
+package feegrant
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                 = (*PerBlockRateLimitedAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*PerBlockRateLimitedAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *PerBlockRateLimitedAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewPerBlockRateLimitedAllowance creates a new per block rate limited fee allowance.
+func NewPerBlockRateLimitedAllowance(allowance FeeAllowanceI, maxGasPerBlock uint64) (*PerBlockRateLimitedAllowance, error) {
+	any, err := packAllowance(allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PerBlockRateLimitedAllowance{
+		Allowance:      any,
+		MaxGasPerBlock: maxGasPerBlock,
+	}, nil
+}
+
+// GetAllowance returns the rate limited fee allowance.
+func (a *PerBlockRateLimitedAllowance) GetAllowance() (FeeAllowanceI, error) {
+	return unpackAllowance(a.Allowance)
+}
+
+// SetAllowance sets the rate limited fee allowance.
+func (a *PerBlockRateLimitedAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = packAllowance(allowance)
+	return err
+}
+
+// Accept adds the gas limit of the transaction to the gas paid for in the
+// current block, rejects the fee if it goes above the max gas per block, and
+// otherwise delegates to the rate limited allowance. The gas is not counted
+// when the gas limit of the transaction is unknown, as in simulations.
+func (a *PerBlockRateLimitedAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if gas, ok := txGasLimit(sdkCtx); ok {
+		if a.BlockHeight != sdkCtx.BlockHeight() {
+			a.BlockHeight = sdkCtx.BlockHeight()
+			a.BlockGasUsed = 0
+		}
+		if gas > a.MaxGasPerBlock-a.BlockGasUsed {
+			return false, errorsmod.Wrapf(ErrBlockGasExceeded, "%d gas left in block %d, %d requested", a.MaxGasPerBlock-a.BlockGasUsed, a.BlockHeight, gas)
+		}
+		a.BlockGasUsed += gas
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *PerBlockRateLimitedAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if a.MaxGasPerBlock == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "max gas per block must be positive")
+	}
+	if a.BlockGasUsed > a.MaxGasPerBlock {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "block gas used cannot exceed max gas per block")
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt returns the expiry time of the PerBlockRateLimitedAllowance.
+func (a *PerBlockRateLimitedAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
@@ -0,0 +1,57 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	ocproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPerBlockRateLimitedAllowance(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(ocproto.Header{Time: time.Now(), Height: 10})
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+
+	allowance, err := feegrant.NewPerBlockRateLimitedAllowance(&feegrant.BasicAllowance{}, 250)
+	require.NoError(t, err)
+	require.NoError(t, allowance.ValidateBasic())
+
+	// the gas limits of the transactions are summed in the block
+	for range 2 {
+		_, err = allowance.Accept(ctx.WithGasMeter(storetypes.NewGasMeter(100)), fee, nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint64(200), allowance.BlockGasUsed)
+
+	_, err = allowance.Accept(ctx.WithGasMeter(storetypes.NewGasMeter(100)), fee, nil)
+	require.ErrorIs(t, err, feegrant.ErrBlockGasExceeded)
+
+	// the gas of simulations is not counted
+	_, err = allowance.Accept(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), fee, nil)
+	require.NoError(t, err)
+
+	// the gas is reset in the next block
+	ctx = ctx.WithBlockHeight(11).WithGasMeter(storetypes.NewGasMeter(100))
+	_, err = allowance.Accept(ctx, fee, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), allowance.BlockHeight)
+	require.Equal(t, uint64(100), allowance.BlockGasUsed)
+
+	// the rate limited allowance still applies
+	limited, err := feegrant.NewPerBlockRateLimitedAllowance(&feegrant.BasicAllowance{SpendLimit: fee}, 250)
+	require.NoError(t, err)
+	_, err = limited.Accept(ctx, fee.Add(fee...), nil)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+
+	invalid, err := feegrant.NewPerBlockRateLimitedAllowance(&feegrant.BasicAllowance{}, 0)
+	require.NoError(t, err)
+	require.Error(t, invalid.ValidateBasic())
+}
@@ -0,0 +1,127 @@
+package feegrant
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RuleBasedGrantee is the grantee of the rule based allowances. It is derived
+// from the module name, so no account can sign for it.
+var RuleBasedGrantee = sdk.AccAddress(address.Module(ModuleName, []byte("rule-based")))
+
+var (
+	_ FeeAllowanceI                 = (*RuleBasedAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*RuleBasedAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *RuleBasedAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewRuleBasedAllowance creates a new rule based fee allowance, its rules are
+// set on the returned allowance.
+func NewRuleBasedAllowance(allowance FeeAllowanceI) (*RuleBasedAllowance, error) {
+	any, err := packAllowance(allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RuleBasedAllowance{
+		Allowance: any,
+	}, nil
+}
+
+// GetAllowance returns the shared fee allowance.
+func (a *RuleBasedAllowance) GetAllowance() (FeeAllowanceI, error) {
+	return unpackAllowance(a.Allowance)
+}
+
+// SetAllowance sets the shared fee allowance.
+func (a *RuleBasedAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = packAllowance(allowance)
+	return err
+}
+
+// MatchGrantee returns an error if the account paying the fee doesn't match
+// the rules, given its spendable balance and the number of its transactions
+// already paid for by the allowance.
+func (a *RuleBasedAllowance) MatchGrantee(acc sdk.AccountI, spendable sdk.Coins, uses uint64) error {
+	if a.MaxAccountSequence > 0 && acc.GetSequence() >= a.MaxAccountSequence {
+		return errorsmod.Wrapf(ErrGranteeNotMatched, "account sequence %d is not below %d", acc.GetSequence(), a.MaxAccountSequence)
+	}
+	if a.MinAccountNumber > 0 && acc.GetAccountNumber() <= a.MinAccountNumber {
+		return errorsmod.Wrapf(ErrGranteeNotMatched, "account number %d is not above %d", acc.GetAccountNumber(), a.MinAccountNumber)
+	}
+	if len(a.BalanceBelow) > 0 && !a.isBalanceBelow(spendable) {
+		return errorsmod.Wrapf(ErrGranteeNotMatched, "balance %s is not below %s", spendable, a.BalanceBelow)
+	}
+	if a.MaxUsesPerGrantee > 0 && uses >= a.MaxUsesPerGrantee {
+		return errorsmod.Wrapf(ErrGranteeNotMatched, "%d transactions already paid for", uses)
+	}
+	return nil
+}
+
+func (a *RuleBasedAllowance) isBalanceBelow(spendable sdk.Coins) bool {
+	for _, coin := range a.BalanceBelow {
+		if spendable.AmountOf(coin.Denom).LT(coin.Amount) {
+			return true
+		}
+	}
+	return false
+}
+
+// Accept delegates to the shared allowance, the rules are matched by
+// Keeper.UseGrantedFees before.
+func (a *RuleBasedAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *RuleBasedAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if a.MaxAccountSequence == 0 && a.MinAccountNumber == 0 && len(a.BalanceBelow) == 0 && a.MaxUsesPerGrantee == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one rule must be set")
+	}
+	if len(a.BalanceBelow) > 0 && (!a.BalanceBelow.IsValid() || !a.BalanceBelow.IsAllPositive()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "balance below is invalid: %s", a.BalanceBelow)
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt returns the expiry time of the RuleBasedAllowance.
+func (a *RuleBasedAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
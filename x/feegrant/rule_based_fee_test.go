@@ -0,0 +1,89 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestRuleBasedAllowanceMatchGrantee(t *testing.T) {
+	acc := authtypes.NewBaseAccount(sdk.AccAddress("grantee"), nil, 10, 3)
+
+	cases := map[string]struct {
+		allowance feegrant.RuleBasedAllowance
+		spendable sdk.Coins
+		uses      uint64
+		match     bool
+	}{
+		"sequence below max": {
+			allowance: feegrant.RuleBasedAllowance{MaxAccountSequence: 4},
+			match:     true,
+		},
+		"sequence at max": {
+			allowance: feegrant.RuleBasedAllowance{MaxAccountSequence: 3},
+			match:     false,
+		},
+		"account number above min": {
+			allowance: feegrant.RuleBasedAllowance{MinAccountNumber: 9},
+			match:     true,
+		},
+		"account number at min": {
+			allowance: feegrant.RuleBasedAllowance{MinAccountNumber: 10},
+			match:     false,
+		},
+		"balance below in one denom": {
+			allowance: feegrant.RuleBasedAllowance{BalanceBelow: sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("eth", 5))},
+			spendable: sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+			match:     true,
+		},
+		"balance not below": {
+			allowance: feegrant.RuleBasedAllowance{BalanceBelow: sdk.NewCoins(sdk.NewInt64Coin("atom", 5))},
+			spendable: sdk.NewCoins(sdk.NewInt64Coin("atom", 5)),
+			match:     false,
+		},
+		"uses below max": {
+			allowance: feegrant.RuleBasedAllowance{MaxUsesPerGrantee: 2},
+			uses:      1,
+			match:     true,
+		},
+		"uses at max": {
+			allowance: feegrant.RuleBasedAllowance{MaxUsesPerGrantee: 2},
+			uses:      2,
+			match:     false,
+		},
+		"all rules must match": {
+			allowance: feegrant.RuleBasedAllowance{MaxAccountSequence: 4, MinAccountNumber: 10},
+			match:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.allowance.MatchGrantee(acc, tc.spendable, tc.uses)
+			if tc.match {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, feegrant.ErrGranteeNotMatched)
+			}
+		})
+	}
+}
+
+func TestRuleBasedAllowanceValidateBasic(t *testing.T) {
+	allowance, err := feegrant.NewRuleBasedAllowance(&feegrant.BasicAllowance{})
+	require.NoError(t, err)
+	require.ErrorContains(t, allowance.ValidateBasic(), "at least one rule must be set")
+
+	allowance.MaxAccountSequence = 5
+	require.NoError(t, allowance.ValidateBasic())
+
+	allowance.BalanceBelow = sdk.Coins{sdk.NewInt64Coin("atom", 0)}
+	require.Error(t, allowance.ValidateBasic())
+
+	require.Error(t, (&feegrant.RuleBasedAllowance{MaxAccountSequence: 5}).ValidateBasic())
+}
@@ -0,0 +1,1003 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feegrant/v1beta1/sponsorship.proto
+
+package feegrant
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	any "github.com/cosmos/gogoproto/types/any"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GasPriceCappedAllowance restricts an allowance to the transactions whose gas
+// price, the fee divided by the gas limit, is at most max_gas_price.
+type GasPriceCappedAllowance struct {
+	// allowance can be any of basic, periodic, allowed fee allowance.
+	Allowance *any.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// max_gas_price is the maximum gas price per fee denom, fees in denoms
+	// without a max gas price are rejected.
+	MaxGasPrice github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=max_gas_price,json=maxGasPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"max_gas_price"`
+}
+
+func (m *GasPriceCappedAllowance) Reset()         { *m = GasPriceCappedAllowance{} }
+func (m *GasPriceCappedAllowance) String() string { return proto.CompactTextString(m) }
+func (*GasPriceCappedAllowance) ProtoMessage()    {}
+func (*GasPriceCappedAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce864166007aa818, []int{0}
+}
+func (m *GasPriceCappedAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceCappedAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceCappedAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceCappedAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceCappedAllowance.Merge(m, src)
+}
+func (m *GasPriceCappedAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceCappedAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceCappedAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceCappedAllowance proto.InternalMessageInfo
+
+// PerBlockRateLimitedAllowance restricts an allowance to max_gas_per_block gas
+// per block, summed over the gas limits of the transactions it pays for.
+type PerBlockRateLimitedAllowance struct {
+	// allowance can be any of basic, periodic, allowed fee allowance.
+	Allowance *any.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// max_gas_per_block is the maximum gas of the transactions paid for in a
+	// block.
+	MaxGasPerBlock uint64 `protobuf:"varint,2,opt,name=max_gas_per_block,json=maxGasPerBlock,proto3" json:"max_gas_per_block,omitempty"`
+	// block_height is the height of the last block in which the allowance paid
+	// for a transaction.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_gas_used is the gas of the transactions paid for at block_height.
+	BlockGasUsed uint64 `protobuf:"varint,4,opt,name=block_gas_used,json=blockGasUsed,proto3" json:"block_gas_used,omitempty"`
+}
+
+func (m *PerBlockRateLimitedAllowance) Reset()         { *m = PerBlockRateLimitedAllowance{} }
+func (m *PerBlockRateLimitedAllowance) String() string { return proto.CompactTextString(m) }
+func (*PerBlockRateLimitedAllowance) ProtoMessage()    {}
+func (*PerBlockRateLimitedAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce864166007aa818, []int{1}
+}
+func (m *PerBlockRateLimitedAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerBlockRateLimitedAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerBlockRateLimitedAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerBlockRateLimitedAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerBlockRateLimitedAllowance.Merge(m, src)
+}
+func (m *PerBlockRateLimitedAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerBlockRateLimitedAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerBlockRateLimitedAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerBlockRateLimitedAllowance proto.InternalMessageInfo
+
+// RuleBasedAllowance sponsors every account matching its rules, rather than a
+// single grantee. It is granted to the RuleBasedGrantee address, and used when
+// the granter is set as the fee granter of a transaction by an account without
+// a grant of its own. All the set rules must match the fee payer.
+type RuleBasedAllowance struct {
+	// allowance can be any of basic, periodic, allowed fee allowance. It is
+	// shared by all the matching accounts.
+	Allowance *any.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// max_account_sequence, if set, only matches the accounts that signed less
+	// than max_account_sequence transactions, e.g. their first transactions.
+	MaxAccountSequence uint64 `protobuf:"varint,2,opt,name=max_account_sequence,json=maxAccountSequence,proto3" json:"max_account_sequence,omitempty"`
+	// min_account_number, if set, only matches the accounts created after the
+	// account with this number, i.e. the accounts younger than it.
+	MinAccountNumber uint64 `protobuf:"varint,3,opt,name=min_account_number,json=minAccountNumber,proto3" json:"min_account_number,omitempty"`
+	// balance_below, if set, only matches the accounts whose spendable balance
+	// is below balance_below in one of its denoms.
+	BalanceBelow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=balance_below,json=balanceBelow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance_below"`
+	// max_uses_per_grantee, if set, is the maximum number of transactions paid
+	// for each matching account.
+	MaxUsesPerGrantee uint64 `protobuf:"varint,5,opt,name=max_uses_per_grantee,json=maxUsesPerGrantee,proto3" json:"max_uses_per_grantee,omitempty"`
+}
+
+func (m *RuleBasedAllowance) Reset()         { *m = RuleBasedAllowance{} }
+func (m *RuleBasedAllowance) String() string { return proto.CompactTextString(m) }
+func (*RuleBasedAllowance) ProtoMessage()    {}
+func (*RuleBasedAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce864166007aa818, []int{2}
+}
+func (m *RuleBasedAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuleBasedAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuleBasedAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuleBasedAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleBasedAllowance.Merge(m, src)
+}
+func (m *RuleBasedAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuleBasedAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleBasedAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuleBasedAllowance proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GasPriceCappedAllowance)(nil), "cosmos.feegrant.v1beta1.GasPriceCappedAllowance")
+	proto.RegisterType((*PerBlockRateLimitedAllowance)(nil), "cosmos.feegrant.v1beta1.PerBlockRateLimitedAllowance")
+	proto.RegisterType((*RuleBasedAllowance)(nil), "cosmos.feegrant.v1beta1.RuleBasedAllowance")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/feegrant/v1beta1/sponsorship.proto", fileDescriptor_ce864166007aa818)
+}
+
+var fileDescriptor_ce864166007aa818 = []byte{
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xde, 0xee, 0xee, 0xef, 0x97, 0xd8, 0x05, 0x22, 0x0d, 0x09, 0x85, 0x60, 0x59, 0x37, 0x1a,
+	0x17, 0x94, 0x56, 0xf0, 0x62, 0xb8, 0xb1, 0x18, 0xd0, 0xc4, 0x28, 0xa9, 0x72, 0xd0, 0xc4, 0x34,
+	0xd3, 0xd9, 0x97, 0xee, 0x84, 0x76, 0x66, 0xed, 0xb4, 0xb2, 0xeb, 0x07, 0x30, 0xc6, 0x93, 0x67,
+	0x4f, 0x1e, 0x8d, 0x27, 0x0e, 0x7e, 0x08, 0x42, 0x3c, 0x70, 0xf4, 0x84, 0x06, 0x0e, 0x7c, 0x0d,
+	0x33, 0x7f, 0xb6, 0x6c, 0xa2, 0x18, 0xb8, 0x70, 0x69, 0x3b, 0xef, 0x9f, 0x67, 0x9e, 0xf7, 0x99,
+	0xa7, 0x63, 0xce, 0x61, 0xc6, 0x13, 0xc6, 0xbd, 0x2d, 0x80, 0x28, 0x45, 0x34, 0xf3, 0xde, 0x2c,
+	0x86, 0x90, 0xa1, 0x45, 0x8f, 0x77, 0x19, 0xe5, 0x2c, 0xe5, 0x1d, 0xd2, 0x75, 0xbb, 0x29, 0xcb,
+	0x98, 0x35, 0xa9, 0x4a, 0xdd, 0x41, 0xa9, 0xab, 0x4b, 0xa7, 0x27, 0x22, 0x16, 0x31, 0x59, 0xe3,
+	0x89, 0x2f, 0x55, 0x3e, 0x3d, 0x15, 0x31, 0x16, 0xc5, 0xe0, 0xc9, 0x55, 0x98, 0x6f, 0x79, 0x88,
+	0xf6, 0x07, 0x29, 0x85, 0x14, 0xa8, 0x1e, 0x0d, 0xab, 0x52, 0x8e, 0xe6, 0x13, 0x22, 0x0e, 0x05,
+	0x17, 0xcc, 0x08, 0xd5, 0xf9, 0x71, 0x94, 0x10, 0xca, 0x3c, 0xf9, 0x54, 0xa1, 0xc6, 0x7e, 0xd9,
+	0x9c, 0x5c, 0x47, 0x7c, 0x23, 0x25, 0x18, 0x56, 0x51, 0xb7, 0x0b, 0xed, 0x95, 0x38, 0x66, 0x3b,
+	0x88, 0x62, 0xb0, 0x5e, 0x99, 0x57, 0xd0, 0x60, 0x61, 0x1b, 0x75, 0xa3, 0x59, 0x5b, 0x9a, 0x70,
+	0x15, 0x31, 0x77, 0x40, 0xcc, 0x5d, 0xa1, 0xfd, 0xd6, 0xdc, 0xfe, 0xb7, 0x85, 0x9b, 0x67, 0x0c,
+	0xe8, 0xae, 0x01, 0x14, 0x90, 0x8f, 0xfc, 0x53, 0x44, 0xeb, 0xad, 0x39, 0x9a, 0xa0, 0x5e, 0x10,
+	0x21, 0x31, 0x0b, 0xc1, 0x60, 0x97, 0xeb, 0x95, 0x66, 0x6d, 0x69, 0xc6, 0xd5, 0x48, 0x62, 0x8a,
+	0x02, 0xe5, 0x01, 0xe0, 0x55, 0x46, 0x68, 0xeb, 0xfe, 0xde, 0xe1, 0x6c, 0xe9, 0xeb, 0xcf, 0xd9,
+	0xdb, 0x11, 0xc9, 0x3a, 0x79, 0xe8, 0x62, 0x96, 0x68, 0x0d, 0xf4, 0x6b, 0x81, 0xb7, 0xb7, 0xbd,
+	0xac, 0xdf, 0x05, 0x3e, 0xe8, 0xe1, 0x5f, 0x4e, 0x76, 0xe7, 0x0d, 0xbf, 0x96, 0xa0, 0xde, 0x60,
+	0xd2, 0xe5, 0xe7, 0xef, 0x3f, 0xcf, 0x96, 0xce, 0xcd, 0xfa, 0xc3, 0xc9, 0xee, 0x7c, 0x63, 0x08,
+	0xfe, 0x0c, 0xc1, 0x1a, 0xdf, 0xcb, 0xe6, 0xcc, 0x06, 0xa4, 0xad, 0x98, 0xe1, 0x6d, 0x1f, 0x65,
+	0xf0, 0x98, 0x24, 0x24, 0xbb, 0x44, 0x45, 0xe7, 0xcc, 0xf1, 0x42, 0x51, 0x48, 0x83, 0x50, 0xf0,
+	0xb0, 0xcb, 0x75, 0xa3, 0x59, 0xf5, 0xc7, 0xf4, 0xf4, 0x9a, 0x9d, 0x75, 0xdd, 0x1c, 0x91, 0xe9,
+	0xa0, 0x03, 0x24, 0xea, 0x64, 0x76, 0xa5, 0x6e, 0x34, 0x2b, 0x7e, 0x4d, 0xc6, 0x1e, 0xca, 0x90,
+	0x75, 0xc3, 0x1c, 0x53, 0x25, 0x02, 0x2f, 0xe7, 0xd0, 0xb6, 0xab, 0x12, 0x4a, 0x35, 0xae, 0x23,
+	0xbe, 0xc9, 0xa1, 0xbd, 0xfc, 0xe2, 0xc2, 0x4a, 0xde, 0x1a, 0x52, 0xf2, 0x5f, 0x6a, 0x35, 0x0e,
+	0x2b, 0xa6, 0xe5, 0xe7, 0x31, 0xb4, 0x10, 0xbf, 0x44, 0x11, 0xef, 0x9a, 0x13, 0x42, 0x44, 0x84,
+	0x31, 0xcb, 0x69, 0x16, 0x70, 0x78, 0x9d, 0x03, 0x95, 0xee, 0x14, 0xc3, 0x5b, 0x09, 0xea, 0xad,
+	0xa8, 0xd4, 0x33, 0x9d, 0xb1, 0xee, 0x98, 0x56, 0x42, 0x68, 0xd1, 0x41, 0xf3, 0x24, 0x84, 0x54,
+	0x2a, 0x5a, 0xf5, 0xaf, 0x26, 0x84, 0xea, 0xfa, 0x27, 0x32, 0x6e, 0xbd, 0x33, 0xcc, 0xd1, 0x10,
+	0xc5, 0x62, 0xaf, 0x20, 0x84, 0x98, 0xed, 0xd8, 0x55, 0xe9, 0xfb, 0xa9, 0xbf, 0xfa, 0x5e, 0x9a,
+	0x7e, 0x4d, 0x9b, 0xbe, 0x79, 0x0e, 0xd3, 0x8b, 0x06, 0xfe, 0xe9, 0x64, 0x77, 0x7e, 0x24, 0x86,
+	0x08, 0xe1, 0x7e, 0x80, 0x4f, 0x7f, 0x81, 0x11, 0xbd, 0x6f, 0x4b, 0x6c, 0x6b, 0x79, 0x6a, 0xd0,
+	0x9c, 0x83, 0xb2, 0x8b, 0xd4, 0x07, 0xc0, 0xfe, 0x4f, 0x12, 0x17, 0x4e, 0xda, 0xe4, 0x20, 0x1c,
+	0xb3, 0xae, 0x12, 0xcb, 0x4f, 0x2f, 0x7c, 0xd4, 0xd7, 0x86, 0xe8, 0xfd, 0x79, 0x92, 0xad, 0xc5,
+	0xbd, 0x23, 0xc7, 0x38, 0x38, 0x72, 0x8c, 0x5f, 0x47, 0x8e, 0xf1, 0xf1, 0xd8, 0x29, 0x1d, 0x1c,
+	0x3b, 0xa5, 0x1f, 0xc7, 0x4e, 0xe9, 0xa5, 0xbe, 0x2e, 0x79, 0x7b, 0xdb, 0x25, 0xcc, 0xeb, 0x15,
+	0x37, 0x6c, 0xf8, 0xbf, 0x3c, 0xe1, 0x7b, 0xbf, 0x07, 0x00, 0x8d, 0x88, 0xff, 0x99, 0x7b, 0x05,
+	0x00, 0x00,
+}
+
+func (m *GasPriceCappedAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceCappedAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceCappedAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxGasPrice) > 0 {
+		for iNdEx := len(m.MaxGasPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxGasPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsorship(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSponsorship(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PerBlockRateLimitedAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerBlockRateLimitedAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerBlockRateLimitedAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockGasUsed != 0 {
+		i = encodeVarintSponsorship(dAtA, i, uint64(m.BlockGasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintSponsorship(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxGasPerBlock != 0 {
+		i = encodeVarintSponsorship(dAtA, i, uint64(m.MaxGasPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSponsorship(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RuleBasedAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuleBasedAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuleBasedAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxUsesPerGrantee != 0 {
+		i = encodeVarintSponsorship(dAtA, i, uint64(m.MaxUsesPerGrantee))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BalanceBelow) > 0 {
+		for iNdEx := len(m.BalanceBelow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BalanceBelow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsorship(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MinAccountNumber != 0 {
+		i = encodeVarintSponsorship(dAtA, i, uint64(m.MinAccountNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxAccountSequence != 0 {
+		i = encodeVarintSponsorship(dAtA, i, uint64(m.MaxAccountSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSponsorship(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSponsorship(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSponsorship(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GasPriceCappedAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovSponsorship(uint64(l))
+	}
+	if len(m.MaxGasPrice) > 0 {
+		for _, e := range m.MaxGasPrice {
+			l = e.Size()
+			n += 1 + l + sovSponsorship(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PerBlockRateLimitedAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovSponsorship(uint64(l))
+	}
+	if m.MaxGasPerBlock != 0 {
+		n += 1 + sovSponsorship(uint64(m.MaxGasPerBlock))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovSponsorship(uint64(m.BlockHeight))
+	}
+	if m.BlockGasUsed != 0 {
+		n += 1 + sovSponsorship(uint64(m.BlockGasUsed))
+	}
+	return n
+}
+
+func (m *RuleBasedAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovSponsorship(uint64(l))
+	}
+	if m.MaxAccountSequence != 0 {
+		n += 1 + sovSponsorship(uint64(m.MaxAccountSequence))
+	}
+	if m.MinAccountNumber != 0 {
+		n += 1 + sovSponsorship(uint64(m.MinAccountNumber))
+	}
+	if len(m.BalanceBelow) > 0 {
+		for _, e := range m.BalanceBelow {
+			l = e.Size()
+			n += 1 + l + sovSponsorship(uint64(l))
+		}
+	}
+	if m.MaxUsesPerGrantee != 0 {
+		n += 1 + sovSponsorship(uint64(m.MaxUsesPerGrantee))
+	}
+	return n
+}
+
+func sovSponsorship(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSponsorship(x uint64) (n int) {
+	return sovSponsorship(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GasPriceCappedAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsorship
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceCappedAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceCappedAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxGasPrice = append(m.MaxGasPrice, types.DecCoin{})
+			if err := m.MaxGasPrice[len(m.MaxGasPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsorship(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerBlockRateLimitedAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsorship
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerBlockRateLimitedAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerBlockRateLimitedAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerBlock", wireType)
+			}
+			m.MaxGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasUsed", wireType)
+			}
+			m.BlockGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsorship(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuleBasedAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsorship
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuleBasedAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuleBasedAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccountSequence", wireType)
+			}
+			m.MaxAccountSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAccountSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAccountNumber", wireType)
+			}
+			m.MinAccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceBelow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceBelow = append(m.BalanceBelow, types.Coin{})
+			if err := m.BalanceBelow[len(m.BalanceBelow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUsesPerGrantee", wireType)
+			}
+			m.MaxUsesPerGrantee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUsesPerGrantee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsorship(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSponsorship(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSponsorship
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSponsorship
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSponsorship
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSponsorship
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSponsorship        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSponsorship          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSponsorship = fmt.Errorf("proto: unexpected end of group")
+)
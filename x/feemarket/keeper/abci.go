@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlock moves the base fees collected in the block out of the fee collector,
+// to the base fee recipient or burned, and updates the base gas price from the
+// gas used by the block.
+func (k *Keeper) EndBlock(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.settleBaseFees(sdkCtx, params); err != nil {
+		return err
+	}
+
+	state, err := k.State.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	var gasUsed uint64
+	if meter := sdkCtx.BlockGasMeter(); meter != nil {
+		gasUsed = meter.GasConsumed()
+	}
+
+	state.LastBlockGasUsed = gasUsed
+	if params.Enabled {
+		state.BaseGasPrice = params.NextBaseGasPrice(state.BaseGasPrice, gasUsed)
+	} else if state.BaseGasPrice.LT(params.MinBaseGasPrice) {
+		// keep the base gas price valid while the fee market is disabled, the min
+		// base gas price may have been raised by governance
+		state.BaseGasPrice = params.MinBaseGasPrice
+	}
+
+	if err := k.State.Set(ctx, state); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseGasPrice,
+			sdk.NewAttribute(types.AttributeKeyBaseGasPrice, state.BaseGasPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		),
+	)
+
+	return nil
+}
+
+// settleBaseFees moves the base fees collected in the block from the fee collector
+// to the base fee recipient, or burns them if it is not set, and clears them.
+func (k *Keeper) settleBaseFees(ctx sdk.Context, params types.Params) error {
+	var baseFees sdk.Coins
+	err := k.BlockBaseFees.Walk(ctx, nil, func(denom string, amount sdkmath.Int) (bool, error) {
+		baseFees = baseFees.Add(sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := k.BlockBaseFees.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	if baseFees.IsZero() {
+		return nil
+	}
+
+	recipient := types.AttributeValueBurned
+	if params.BaseFeeRecipient != "" {
+		recipient = params.BaseFeeRecipient
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, recipient, baseFees); err != nil {
+			return err
+		}
+	} else {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, baseFees); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, baseFees); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseFee,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, baseFees.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+		),
+	)
+
+	return nil
+}
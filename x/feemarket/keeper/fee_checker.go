@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"errors"
+	"math"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// TxFeeChecker returns the TxFeeChecker of the DeductFeeDecorator enforcing the
+// base fee. On top of the validator min gas prices checked on CheckTx, the fee
+// must cover the base fee of the gas limit in the fee denom in every execution
+// mode. The base fee of the transactions executed in the block is recorded, and
+// moved out of the fee collector at the end of the block.
+//
+// The priority of a transaction is its tip per gas, the part of its fee in the
+// fee denom above the base fee divided by its gas limit.
+func (k *Keeper) TxFeeChecker() ante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		fee, priority, err := ante.CheckTxFeeWithValidatorMinGasPrices(ctx, tx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		// the gentxs of the genesis block are not charged a base fee
+		if !params.Enabled || ctx.BlockHeight() == 0 {
+			return fee, priority, nil
+		}
+
+		state, err := k.State.Get(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+		gas := feeTx.GetGas()
+
+		baseFee := params.BaseFee(state.BaseGasPrice, gas)
+		paid := fee.AmountOf(params.FeeDenom)
+		if paid.LT(baseFee.Amount) {
+			return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fee; got: %s required base fee: %s", fee, baseFee)
+		}
+
+		if ctx.ExecMode() == sdk.ExecModeFinalize && baseFee.IsPositive() {
+			collected, err := k.BlockBaseFees.Get(ctx, baseFee.Denom)
+			if err != nil {
+				if !errors.Is(err, collections.ErrNotFound) {
+					return nil, 0, err
+				}
+				collected = sdkmath.ZeroInt()
+			}
+			if err := k.BlockBaseFees.Set(ctx, baseFee.Denom, collected.Add(baseFee.Amount)); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		return fee, tipPriority(paid.Sub(baseFee.Amount), gas), nil
+	}
+}
+
+// tipPriority returns the tip per gas of a transaction, capped at math.MaxInt64.
+func tipPriority(tip sdkmath.Int, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	tipPerGas := tip.Quo(sdkmath.NewIntFromUint64(gas))
+	if !tipPerGas.IsInt64() {
+		return math.MaxInt64
+	}
+	return tipPerGas.Int64()
+}
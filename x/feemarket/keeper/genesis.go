@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+	return k.State.Set(ctx, genState.State)
+}
+
+// ExportGenesis returns the module's exported genesis.
+func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	state, err := k.State.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{Params: params, State: state}, nil
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type Keeper struct {
+	logger log.Logger
+
+	storeService corestore.KVStoreService
+	cdc          codec.Codec
+	addressCodec address.Codec
+	// Address capable of executing a MsgUpdateParams message.
+	// Typically, this should be the x/gov module account.
+	authority []byte
+
+	authKeeper types.AuthKeeper
+	bankKeeper types.BankKeeper
+
+	feeCollectorName string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// State is the base gas price and the gas used by the last block
+	State collections.Item[types.State]
+	// BlockBaseFees key: denom | value: base fees collected in the current block, cleared at the end of the block
+	BlockBaseFees collections.Map[string, math.Int]
+}
+
+func NewKeeper(
+	logger log.Logger,
+	storeService corestore.KVStoreService,
+	cdc codec.Codec,
+	addressCodec address.Codec,
+	authority []byte,
+	authKeeper types.AuthKeeper,
+	bankKeeper types.BankKeeper,
+	feeCollectorName string,
+) *Keeper {
+	if _, err := addressCodec.BytesToString(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
+	}
+
+	// ensure the feemarket module account is set, the base fees are burned from it
+	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		logger:           logger,
+		storeService:     storeService,
+		cdc:              cdc,
+		addressCodec:     addressCodec,
+		authority:        authority,
+		authKeeper:       authKeeper,
+		bankKeeper:       bankKeeper,
+		feeCollectorName: feeCollectorName,
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		State:            collections.NewItem(sb, types.StateKey, "state", codec.CollValue[types.State](cdc)),
+		BlockBaseFees: collections.NewMap(
+			sb, types.BlockBaseFeesKey, "block_base_fees", collections.StringKey, sdk.IntValue,
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return &k
+}
+
+// GetAuthority returns the module's authority.
+func (k *Keeper) GetAuthority() []byte {
+	return k.authority
+}
@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type mockAuthKeeper struct{}
+
+func (mockAuthKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+type moduleTransfer struct {
+	from, to string
+	amount   sdk.Coins
+}
+
+type mockBankKeeper struct {
+	transfers []moduleTransfer
+	burned    sdk.Coins
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToModule(_ context.Context, from, to string, amt sdk.Coins) error {
+	b.transfers = append(b.transfers, moduleTransfer{from: from, to: to, amount: amt})
+	return nil
+}
+
+func (b *mockBankKeeper) BurnCoins(_ context.Context, _ string, amt sdk.Coins) error {
+	b.burned = b.burned.Add(amt...)
+	return nil
+}
+
+type fixture struct {
+	ctx        sdk.Context
+	keeper     *keeper.Keeper
+	bankKeeper *mockBankKeeper
+	encCfg     moduletestutil.TestEncodingConfig
+}
+
+func setup(t *testing.T, params types.Params) fixture {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	bankKeeper := &mockBankKeeper{}
+
+	k := keeper.NewKeeper(
+		log.NewNopLogger(),
+		runtime.NewKVStoreService(key),
+		encCfg.Codec,
+		address.NewBech32Codec("cosmos"),
+		authtypes.NewModuleAddress(types.GovModuleName),
+		mockAuthKeeper{},
+		bankKeeper,
+		authtypes.FeeCollectorName,
+	)
+
+	ctx := testCtx.Ctx.WithBlockHeight(1)
+	require.NoError(t, k.InitGenesis(ctx, types.GenesisState{
+		Params: params,
+		State:  types.State{BaseGasPrice: params.MinBaseGasPrice},
+	}))
+
+	return fixture{ctx: ctx, keeper: k, bankKeeper: bankKeeper, encCfg: encCfg}
+}
+
+func (f fixture) feeTx(t *testing.T, fee sdk.Coins, gas uint64) sdk.Tx {
+	t.Helper()
+
+	builder := f.encCfg.TxConfig.NewTxBuilder()
+	builder.SetFeeAmount(fee)
+	builder.SetGasLimit(gas)
+	return builder.GetTx()
+}
+
+func enabledParams() types.Params {
+	params := types.DefaultParams()
+	params.Enabled = true
+	params.MinBaseGasPrice = math.LegacyNewDec(1)
+	params.TargetBlockGas = 1000
+	return params
+}
+
+func TestTxFeeChecker(t *testing.T) {
+	f := setup(t, enabledParams())
+	checker := f.keeper.TxFeeChecker()
+	ctx := f.ctx.WithExecMode(sdk.ExecModeFinalize)
+
+	// the fee must cover the base fee
+	_, _, err := checker(ctx, f.feeTx(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 99)), 100))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// the priority is the tip per gas
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+	effectiveFee, priority, err := checker(ctx, f.feeTx(t, fee, 100))
+	require.NoError(t, err)
+	require.Equal(t, fee, effectiveFee)
+	require.Equal(t, int64(2), priority)
+
+	_, priority, err = checker(ctx, f.feeTx(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), 100))
+	require.NoError(t, err)
+	require.Equal(t, int64(0), priority)
+
+	// the base fees are recorded in finalize mode only
+	_, _, err = checker(f.ctx.WithExecMode(sdk.ExecModeCheck), f.feeTx(t, fee, 100))
+	require.NoError(t, err)
+	collected, err := f.keeper.BlockBaseFees.Get(ctx, sdk.DefaultBondDenom)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(200), collected)
+}
+
+func TestTxFeeCheckerDisabled(t *testing.T) {
+	params := enabledParams()
+	params.Enabled = false
+	f := setup(t, params)
+
+	_, _, err := f.keeper.TxFeeChecker()(f.ctx.WithExecMode(sdk.ExecModeFinalize), f.feeTx(t, nil, 100))
+	require.NoError(t, err)
+
+	has, err := f.keeper.BlockBaseFees.Has(f.ctx, sdk.DefaultBondDenom)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestEndBlock(t *testing.T) {
+	f := setup(t, enabledParams())
+	ctx := f.ctx.WithExecMode(sdk.ExecModeFinalize)
+
+	_, _, err := f.keeper.TxFeeChecker()(ctx, f.feeTx(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)), 100))
+	require.NoError(t, err)
+
+	// a full block raises the base gas price by 1/8
+	meter := storetypes.NewGasMeter(2000)
+	meter.ConsumeGas(2000, "txs")
+	require.NoError(t, f.keeper.EndBlock(ctx.WithBlockGasMeter(meter)))
+
+	baseFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.Equal(t, []moduleTransfer{{from: authtypes.FeeCollectorName, to: types.ModuleName, amount: baseFee}}, f.bankKeeper.transfers)
+	require.Equal(t, baseFee, f.bankKeeper.burned)
+
+	state, err := f.keeper.State.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(1125, 3).String(), state.BaseGasPrice.String())
+	require.Equal(t, uint64(2000), state.LastBlockGasUsed)
+
+	// the base fees are cleared
+	has, err := f.keeper.BlockBaseFees.Has(ctx, sdk.DefaultBondDenom)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// an empty block lowers the base gas price, down to the min
+	require.NoError(t, f.keeper.EndBlock(ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())))
+	state, err = f.keeper.State.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(1).String(), state.BaseGasPrice.String())
+	require.Len(t, f.bankKeeper.transfers, 1)
+}
+
+func TestEndBlockBaseFeeRecipient(t *testing.T) {
+	params := enabledParams()
+	params.BaseFeeRecipient = "distribution"
+	f := setup(t, params)
+	ctx := f.ctx.WithExecMode(sdk.ExecModeFinalize)
+
+	_, _, err := f.keeper.TxFeeChecker()(ctx, f.feeTx(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), 100))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.EndBlock(ctx))
+
+	baseFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.Equal(t, []moduleTransfer{{from: authtypes.FeeCollectorName, to: "distribution", amount: baseFee}}, f.bankKeeper.transfers)
+	require.True(t, f.bankKeeper.burned.IsZero())
+}
+
+func TestQueryGasPrice(t *testing.T) {
+	f := setup(t, enabledParams())
+	queryServer := keeper.NewQueryServerImpl(*f.keeper)
+
+	res, err := queryServer.GasPrice(f.ctx, &types.QueryGasPriceRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(1)), res.Price)
+}
+
+func TestUpdateParams(t *testing.T) {
+	f := setup(t, types.DefaultParams())
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	authority, err := address.NewBech32Codec("cosmos").BytesToString(authtypes.NewModuleAddress(types.GovModuleName))
+	require.NoError(t, err)
+
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: enabledParams()})
+	require.NoError(t, err)
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, enabledParams(), params)
+
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: types.Params{}})
+	require.Error(t, err)
+
+	other, err := address.NewBech32Codec("cosmos").BytesToString(authtypes.NewModuleAddress("other"))
+	require.NoError(t, err)
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: other, Params: enabledParams()})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+}
@@ -0,0 +1,17 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/feemarket module sentinel errors
+var (
+	ErrInvalidSigner           = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidFeeDenom         = errors.Register(ModuleName, 1101, "invalid fee denom")
+	ErrInvalidBaseGasPrice     = errors.Register(ModuleName, 1102, "invalid base gas price")
+	ErrInvalidTargetBlockGas   = errors.Register(ModuleName, 1103, "invalid target block gas")
+	ErrInvalidChangeDenom      = errors.Register(ModuleName, 1104, "invalid base gas price change denominator")
+	ErrInvalidBaseFeeRecipient = errors.Register(ModuleName, 1105, "invalid base fee recipient")
+)
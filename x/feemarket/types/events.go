@@ -0,0 +1,12 @@
+package types
+
+// Feemarket module event types
+const (
+	EventTypeBaseGasPrice = "base_gas_price"
+	EventTypeBaseFee      = "base_fee"
+
+	AttributeKeyBaseGasPrice = "base_gas_price"
+	AttributeKeyGasUsed      = "gas_used"
+	AttributeKeyRecipient    = "recipient"
+	AttributeValueBurned     = "burned"
+)
@@ -0,0 +1,20 @@
+package types
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	params := DefaultParams()
+	return &GenesisState{
+		Params: params,
+		State:  State{BaseGasPrice: params.MinBaseGasPrice},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	return gs.State.Validate(gs.Params)
+}
@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "base gas price above the min",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				State:  types.State{BaseGasPrice: math.LegacyNewDec(1), LastBlockGasUsed: 10},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid params",
+			genState: &types.GenesisState{
+				Params: types.Params{},
+				State:  types.State{BaseGasPrice: math.LegacyNewDec(1)},
+			},
+		},
+		{
+			desc: "base gas price below the min",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				State:  types.State{BaseGasPrice: math.LegacyNewDecWithPrec(1, 4)},
+			},
+		},
+		{
+			desc: "missing base gas price",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	// It should be synced with the gov module's name if it is ever changed.
+	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
+	GovModuleName = "gov"
+)
+
+var (
+	// ParamsKey is the prefix to retrieve all Params
+	ParamsKey = collections.NewPrefix("p_feemarket")
+
+	// StateKey is the key of the fee market state
+	StateKey = collections.NewPrefix(1)
+	// BlockBaseFeesKey is the prefix to retrieve the base fees collected in the current block by denom
+	BlockBaseFeesKey = collections.NewPrefix(2)
+)
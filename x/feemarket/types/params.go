@@ -0,0 +1,83 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params instance.
+func NewParams(
+	enabled bool, feeDenom string, minBaseGasPrice math.LegacyDec, targetBlockGas, changeDenominator uint64, baseFeeRecipient string,
+) Params {
+	return Params{
+		Enabled:                       enabled,
+		FeeDenom:                      feeDenom,
+		MinBaseGasPrice:               minBaseGasPrice,
+		TargetBlockGas:                targetBlockGas,
+		BaseGasPriceChangeDenominator: changeDenominator,
+		BaseFeeRecipient:              baseFeeRecipient,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(
+		false,
+		sdk.DefaultBondDenom,
+		math.LegacyNewDecWithPrec(25, 4), // 0.0025
+		15_000_000,
+		8,  // as in EIP-1559
+		"", // burn the base fee
+	)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeDenom, err.Error())
+	}
+	if p.MinBaseGasPrice.IsNil() || p.MinBaseGasPrice.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidBaseGasPrice, "min base gas price must be non-negative: %s", p.MinBaseGasPrice)
+	}
+	if p.TargetBlockGas == 0 {
+		return errorsmod.Wrap(ErrInvalidTargetBlockGas, "target block gas must be positive")
+	}
+	if p.BaseGasPriceChangeDenominator == 0 {
+		return errorsmod.Wrap(ErrInvalidChangeDenom, "base gas price change denominator must be positive")
+	}
+	if p.BaseFeeRecipient != strings.TrimSpace(p.BaseFeeRecipient) {
+		return errorsmod.Wrapf(ErrInvalidBaseFeeRecipient, "base fee recipient cannot have leading or trailing spaces: %q", p.BaseFeeRecipient)
+	}
+	return nil
+}
+
+// NextBaseGasPrice returns the base gas price following a block which used gasUsed
+// gas at baseGasPrice. As in EIP-1559, the base gas price changes by
+// (gasUsed - target) / target / denominator of itself, where gasUsed is capped at
+// twice the target, and it is never lower than the min base gas price.
+func (p Params) NextBaseGasPrice(baseGasPrice math.LegacyDec, gasUsed uint64) math.LegacyDec {
+	target := math.NewIntFromUint64(p.TargetBlockGas)
+	used := math.NewIntFromUint64(min(gasUsed, 2*p.TargetBlockGas))
+
+	delta := baseGasPrice.MulInt(used.Sub(target)).QuoInt(target).QuoInt(math.NewIntFromUint64(p.BaseGasPriceChangeDenominator))
+	return math.LegacyMaxDec(baseGasPrice.Add(delta), p.MinBaseGasPrice)
+}
+
+// BaseFee returns the base fee of a transaction of the gas limit at baseGasPrice,
+// ceil(baseGasPrice * gas) in the fee denom.
+func (p Params) BaseFee(baseGasPrice math.LegacyDec, gas uint64) sdk.Coin {
+	fee := baseGasPrice.MulInt(math.NewIntFromUint64(gas))
+	return sdk.NewCoin(p.FeeDenom, fee.Ceil().TruncateInt())
+}
+
+// Validate validates the state against the params.
+func (s State) Validate(params Params) error {
+	if s.BaseGasPrice.IsNil() || s.BaseGasPrice.LT(params.MinBaseGasPrice) {
+		return errorsmod.Wrapf(ErrInvalidBaseGasPrice, "base gas price %s is lower than the min base gas price %s", s.BaseGasPrice, params.MinBaseGasPrice)
+	}
+	return nil
+}
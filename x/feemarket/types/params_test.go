@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		malleate func(*types.Params)
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			malleate: func(*types.Params) {},
+			valid:    true,
+		},
+		{
+			desc:     "invalid fee denom",
+			malleate: func(p *types.Params) { p.FeeDenom = "1" },
+		},
+		{
+			desc:     "negative min base gas price",
+			malleate: func(p *types.Params) { p.MinBaseGasPrice = math.LegacyNewDec(-1) },
+		},
+		{
+			desc:     "zero target block gas",
+			malleate: func(p *types.Params) { p.TargetBlockGas = 0 },
+		},
+		{
+			desc:     "zero change denominator",
+			malleate: func(p *types.Params) { p.BaseGasPriceChangeDenominator = 0 },
+		},
+		{
+			desc:     "base fee recipient with spaces",
+			malleate: func(p *types.Params) { p.BaseFeeRecipient = " distribution" },
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.malleate(&params)
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_NextBaseGasPrice(t *testing.T) {
+	params := types.NewParams(true, sdk.DefaultBondDenom, math.LegacyNewDec(1), 100, 8, "")
+	base := math.LegacyNewDec(8)
+
+	tests := []struct {
+		desc    string
+		gasUsed uint64
+		exp     math.LegacyDec
+	}{
+		{desc: "at target", gasUsed: 100, exp: math.LegacyNewDec(8)},
+		{desc: "full block", gasUsed: 200, exp: math.LegacyNewDec(9)},
+		{desc: "gas used capped at twice the target", gasUsed: 1000, exp: math.LegacyNewDec(9)},
+		{desc: "empty block", gasUsed: 0, exp: math.LegacyNewDec(7)},
+		{desc: "half target", gasUsed: 50, exp: math.LegacyNewDecWithPrec(75, 1)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.exp.String(), params.NextBaseGasPrice(base, tc.gasUsed).String())
+		})
+	}
+
+	// the base gas price never falls below the min base gas price
+	require.Equal(t, params.MinBaseGasPrice.String(), params.NextBaseGasPrice(math.LegacyNewDec(1), 0).String())
+}
+
+func TestParams_BaseFee(t *testing.T) {
+	params := types.DefaultParams()
+
+	require.Equal(t, sdk.NewInt64Coin(params.FeeDenom, 250), params.BaseFee(math.LegacyNewDecWithPrec(25, 4), 100_000))
+	// rounded up
+	require.Equal(t, sdk.NewInt64Coin(params.FeeDenom, 1), params.BaseFee(math.LegacyNewDecWithPrec(25, 4), 1))
+	require.True(t, params.BaseFee(math.LegacyNewDecWithPrec(25, 4), 0).IsZero())
+}
@@ -46,6 +46,9 @@ type ModuleInputs struct {
 	// CustomCalculateVoteResultsAndVotingPowerFn is an optional input to set a custom CalculateVoteResultsAndVotingPowerFn.
 	// If this function is not provided, the default function is used.
 	CustomCalculateVoteResultsAndVotingPowerFn keeper.CalculateVoteResultsAndVotingPowerFn `optional:"true"`
+	// CustomTotalVotingPowerFn is an optional input to set a custom TotalVotingPowerFn.
+	// If this function is not provided, the default function is used.
+	CustomTotalVotingPowerFn keeper.TotalVotingPowerFn `optional:"true"`
 
 	// LegacySubspace is used solely for migration of x/params managed parameters
 	LegacySubspace govtypes.ParamSubspace `optional:"true"`
@@ -75,6 +78,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	if in.CustomCalculateVoteResultsAndVotingPowerFn != nil {
 		opts = append(opts, keeper.WithCustomCalculateVoteResultsAndVotingPowerFn(in.CustomCalculateVoteResultsAndVotingPowerFn))
 	}
+	if in.CustomTotalVotingPowerFn != nil {
+		opts = append(opts, keeper.WithCustomTotalVotingPowerFn(in.CustomTotalVotingPowerFn))
+	}
 
 	k := keeper.NewKeeper(
 		in.Cdc,
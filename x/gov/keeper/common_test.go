@@ -51,7 +51,7 @@ func getTestProposal() []sdk.Msg {
 }
 
 // setupGovKeeper creates a govKeeper as well as all its dependencies.
-func setupGovKeeper(t *testing.T) (
+func setupGovKeeper(t *testing.T, opts ...keeper.InitOption) (
 	*keeper.Keeper,
 	*govtestutil.MockAccountKeeper,
 	*govtestutil.MockBankKeeper,
@@ -100,7 +100,7 @@ func setupGovKeeper(t *testing.T) (
 
 	// Gov keeper initializations
 
-	govKeeper := keeper.NewKeeper(encCfg.Codec, storeService, acctKeeper, bankKeeper, stakingKeeper, distributionKeeper, msr, types.DefaultConfig(), govAcct.String())
+	govKeeper := keeper.NewKeeper(encCfg.Codec, storeService, acctKeeper, bankKeeper, stakingKeeper, distributionKeeper, msr, types.DefaultConfig(), govAcct.String(), opts...)
 	require.NoError(t, govKeeper.ProposalID.Set(ctx, 1))
 	govRouter := v1beta1.NewRouter() // Also register legacy gov handlers to test them too.
 	govRouter.AddRoute(types.RouterKey, v1beta1.ProposalHandler)
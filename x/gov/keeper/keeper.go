@@ -44,6 +44,7 @@ type Keeper struct {
 	config types.Config
 
 	calculateVoteResultsAndVotingPowerFn CalculateVoteResultsAndVotingPowerFn
+	totalVotingPowerFn                   TotalVotingPowerFn
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -75,6 +76,18 @@ func WithCustomCalculateVoteResultsAndVotingPowerFn(calculateVoteResultsAndVotin
 	}
 }
 
+// WithCustomTotalVotingPowerFn is an optional input to set a custom TotalVotingPowerFn.
+// If this function is not provided, the default function is used.
+func WithCustomTotalVotingPowerFn(totalVotingPowerFn TotalVotingPowerFn) InitOption {
+	return func(k *Keeper) {
+		if totalVotingPowerFn == nil {
+			panic("totalVotingPowerFn cannot be nil")
+		}
+
+		k.totalVotingPowerFn = totalVotingPowerFn
+	}
+}
+
 // GetAuthority returns the x/gov module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -117,6 +130,7 @@ func NewKeeper(
 		router:                               router,
 		config:                               config,
 		calculateVoteResultsAndVotingPowerFn: defaultCalculateVoteResultsAndVotingPower,
+		totalVotingPowerFn:                   DefaultTotalVotingPower,
 		authority:                            authority,
 		Constitution:                         collections.NewItem(sb, types.ConstitutionKey, "constitution", collections.StringValue),
 		Params:                               collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc)),
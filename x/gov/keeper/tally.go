@@ -23,6 +23,31 @@ type CalculateVoteResultsAndVotingPowerFn func(
 	validators map[string]v1.ValidatorGovInfo,
 ) (totalVoterPower math.LegacyDec, results map[v1.VoteOption]math.LegacyDec, err error)
 
+// TotalVotingPowerFn is a function signature for calculating the total voting power used to check a proposal
+// quorum. It can be overridden together with CalculateVoteResultsAndVotingPowerFn when voting power does not come
+// from x/staking bonded tokens.
+type TotalVotingPowerFn func(ctx context.Context, k Keeper) (math.LegacyDec, error)
+
+// DefaultCalculateVoteResultsAndVotingPower is the default CalculateVoteResultsAndVotingPowerFn. It weights votes by
+// the x/staking delegations of the voters.
+func DefaultCalculateVoteResultsAndVotingPower(
+	ctx context.Context,
+	k Keeper,
+	proposal v1.Proposal,
+	validators map[string]v1.ValidatorGovInfo,
+) (totalVoterPower math.LegacyDec, results map[v1.VoteOption]math.LegacyDec, err error) {
+	return defaultCalculateVoteResultsAndVotingPower(ctx, k, proposal, validators)
+}
+
+// DefaultTotalVotingPower is the default TotalVotingPowerFn. It returns the x/staking total bonded tokens.
+func DefaultTotalVotingPower(ctx context.Context, k Keeper) (math.LegacyDec, error) {
+	totalBonded, err := k.sk.TotalBondedTokens(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return math.LegacyNewDecFromInt(totalBonded), nil
+}
+
 func defaultCalculateVoteResultsAndVotingPower(
 	ctx context.Context,
 	k Keeper,
@@ -158,7 +183,7 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
-	totalBonded, err := k.sk.TotalBondedTokens(ctx)
+	totalBonded, err := k.totalVotingPowerFn(ctx, k)
 	if err != nil {
 		return false, false, tallyResults, err
 	}
@@ -173,7 +198,7 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(totalBonded))
+	percentVoting := totalVotingPower.Quo(totalBonded)
 	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
 	if percentVoting.LT(quorum) {
 		return false, params.BurnVoteQuorum, tallyResults, nil
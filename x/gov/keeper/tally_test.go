@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -10,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
@@ -113,3 +116,65 @@ func TestMultipleProposalsVoteRemoval(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestTallyCustomTotalVotingPower(t *testing.T) {
+	// the voters hold 40 of the voting power and vote yes
+	calculateVoteResults := func(context.Context, keeper.Keeper, v1.Proposal, map[string]v1.ValidatorGovInfo) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+		return math.LegacyNewDec(40), map[v1.VoteOption]math.LegacyDec{
+			v1.OptionYes:        math.LegacyNewDec(40),
+			v1.OptionAbstain:    math.LegacyZeroDec(),
+			v1.OptionNo:         math.LegacyZeroDec(),
+			v1.OptionNoWithVeto: math.LegacyZeroDec(),
+		}, nil
+	}
+
+	testCases := []struct {
+		name        string
+		totalPower  math.LegacyDec
+		totalErr    error
+		expPasses   bool
+		expBurn     bool
+		expErrorMsg string
+	}{
+		{
+			name:       "quorum reached",
+			totalPower: math.LegacyNewDec(100),
+			expPasses:  true,
+		},
+		{
+			name:       "quorum not reached",
+			totalPower: math.LegacyNewDec(200),
+			expBurn:    v1.DefaultParams().BurnVoteQuorum,
+		},
+		{
+			name:       "no voting power",
+			totalPower: math.LegacyZeroDec(),
+		},
+		{
+			name:        "total voting power error",
+			totalErr:    errors.New("no validator set"),
+			expErrorMsg: "no validator set",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			govKeeper, _, _, _, _, _, ctx := setupGovKeeper(t,
+				keeper.WithCustomCalculateVoteResultsAndVotingPowerFn(calculateVoteResults),
+				keeper.WithCustomTotalVotingPowerFn(func(context.Context, keeper.Keeper) (math.LegacyDec, error) {
+					return tc.totalPower, tc.totalErr
+				}),
+			)
+
+			passes, burnDeposits, tallyResults, err := govKeeper.Tally(ctx, v1.Proposal{Id: 1})
+			if tc.expErrorMsg != "" {
+				require.ErrorContains(t, err, tc.expErrorMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expPasses, passes)
+			require.Equal(t, tc.expBurn, burnDeposits)
+			require.Equal(t, math.NewInt(40).String(), tallyResults.YesCount)
+		})
+	}
+}
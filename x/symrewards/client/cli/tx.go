@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/cometbft/cometbft/privval"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symrewards/types"
+)
+
+const (
+	// FlagPrivValidatorKey is the path of the validator consensus key file.
+	FlagPrivValidatorKey = "priv-validator-key"
+	// FlagNonce is the nonce of the reward address registration, queried from the chain if not set.
+	FlagNonce = "nonce"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/symrewards transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Symrewards transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSetRewardAddressCmd(),
+	)
+
+	return txCmd
+}
+
+// NewSetRewardAddressCmd returns a CLI command handler for creating a MsgSetRewardAddress transaction.
+func NewSetRewardAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-reward-address",
+		Short: "Register the --from account as the reward address of this node's validator",
+		Long: `Register the --from account as the account withdrawing the rewards of this node's validator.
+The registration is signed with the validator consensus key read from --priv-validator-key,
+which defaults to config/priv_validator_key.json in the node home, and with the registration
+nonce of the validator, queried from the chain unless set with --nonce.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			keyFile, _ := cmd.Flags().GetString(FlagPrivValidatorKey)
+			if keyFile == "" {
+				keyFile = filepath.Join(clientCtx.HomeDir, "config", "priv_validator_key.json")
+			}
+			pv := privval.LoadFilePVEmptyState(keyFile, "")
+
+			rewardAddress := clientCtx.GetFromAddress().String()
+			if clientCtx.ChainID == "" {
+				return fmt.Errorf("chain id is required to sign the reward address registration")
+			}
+			nonce, err := cmd.Flags().GetUint64(FlagNonce)
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed(FlagNonce) {
+				res, err := types.NewQueryClient(clientCtx).ValidatorRewards(cmd.Context(), &types.QueryValidatorRewardsRequest{
+					ValidatorAddress: sdk.ConsAddress(pv.Key.PubKey.Address()).String(),
+				})
+				if err != nil {
+					return fmt.Errorf("could not query the reward address nonce: %w", err)
+				}
+				nonce = res.RewardAddressNonce
+			}
+			signature, err := pv.Key.PrivKey.Sign(types.RewardAddressSignBytes(clientCtx.ChainID, rewardAddress, nonce))
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetRewardAddress{
+				RewardAddress:   rewardAddress,
+				ConsensusPubkey: pv.Key.PubKey.Bytes(),
+				Signature:       signature,
+				Nonce:           nonce,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPrivValidatorKey, "", "Path of the validator consensus key file")
+	cmd.Flags().Uint64(FlagNonce, 0, "Nonce of the registration, queried from the chain if not set")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symrewards/types"
+	symstakingtypes "github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+// CreateRewardBatch settles the outstanding rewards of every validator and requests a relay signature over the merkle
+// root of the cumulative settled rewards, which validators claim on the settlement chain. Claims are cumulative so
+// every batch supersedes the previous ones. The signature request is only persisted here, the nodes submit it to
+// their relay once the block is committed.
+func (k *Keeper) CreateRewardBatch(ctx context.Context, keyTag uint32, epoch uint64) (types.RewardBatch, error) {
+	var claims []types.RewardClaim
+	err := k.ValidatorRewards.Walk(ctx, nil, func(consAddr sdk.ConsAddress, rewards types.ValidatorRewards) (bool, error) {
+		settled, remainder := rewards.Outstanding.TruncateDecimal()
+		if !settled.IsZero() {
+			rewards.Settled = rewards.Settled.Add(settled...)
+			rewards.Outstanding = remainder
+			if err := k.ValidatorRewards.Set(ctx, consAddr, rewards); err != nil {
+				return true, err
+			}
+		}
+		if !rewards.Settled.IsZero() {
+			claims = append(claims, types.RewardClaim{ValidatorAddress: consAddr, Amount: rewards.Settled})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return types.RewardBatch{}, err
+	}
+	if len(claims) == 0 {
+		return types.RewardBatch{}, nil
+	}
+
+	id, err := k.RewardBatchSequence.Next(ctx)
+	if err != nil {
+		return types.RewardBatch{}, err
+	}
+	batch := types.RewardBatch{
+		Id:     id,
+		Height: sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		Epoch:  epoch,
+		Root:   types.ClaimsRoot(claims),
+		Claims: claims,
+	}
+	batch.RequestId, err = k.symStakingKeeper.RequestSignature(ctx, symstakingtypes.TypedMessage{
+		Module:  types.ModuleName,
+		Type:    types.RewardBatchMessageType,
+		Payload: batch.Payload(),
+	}, keyTag)
+	if err != nil {
+		return types.RewardBatch{}, errorsmod.Wrapf(err, "could not request reward batch %d signature", batch.Id)
+	}
+
+	if err := k.RewardBatches.Set(ctx, batch.Id, batch); err != nil {
+		return types.RewardBatch{}, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardBatch,
+			sdk.NewAttribute(types.AttributeKeyBatchID, strconv.FormatUint(batch.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRequestID, batch.RequestId),
+		),
+	)
+	return batch, nil
+}
+
+func (k *Keeper) getRewardBatch(ctx context.Context, id uint64) (types.RewardBatch, error) {
+	batch, err := k.RewardBatches.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RewardBatch{}, errorsmod.Wrapf(types.ErrRewardBatchNotFound, "id %d", id)
+	}
+	return batch, err
+}
@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/comet"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symrewards/types"
+)
+
+// BeginBlock records the power the validators signed the previous block with, collects the rewards of the fee
+// collector and distributes the collected rewards every DistributionInterval blocks, or at every symstaking epoch
+// transition if the interval is zero.
+//
+// The rewards are collected every block, as x/distribution allocates whatever is left in the fee collector right
+// after.
+func (k *Keeper) BeginBlock(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, voteInfo := range sdkCtx.VoteInfos() {
+		if comet.BlockIDFlag(voteInfo.BlockIdFlag) != comet.BlockIDFlagCommit || voteInfo.Validator.Power <= 0 {
+			continue
+		}
+		if err := k.addSignedPower(ctx, voteInfo.Validator.Address, uint64(voteInfo.Validator.Power)); err != nil {
+			return err
+		}
+	}
+
+	if err := k.CollectRewards(ctx); err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "could not get params")
+	}
+	epoch, err := k.symStakingKeeper.GetCurrentEpoch(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "could not get current epoch")
+	}
+	last, err := k.LastDistribution.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if params.DistributionInterval > 0 {
+		if sdkCtx.BlockHeight()-last.Height < params.DistributionInterval {
+			return nil
+		}
+	} else if epoch.Epoch == last.Epoch {
+		return nil
+	}
+
+	return k.Distribute(ctx, params, epoch.Epoch)
+}
+
+func (k *Keeper) addSignedPower(ctx context.Context, consAddr sdk.ConsAddress, power uint64) error {
+	signed, err := k.SignedPower.Get(ctx, consAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	return k.SignedPower.Set(ctx, consAddr, signed+power)
+}
+
+// CollectRewards moves the balance of the fee collector to the module account, where it is held until the next
+// distribution.
+func (k *Keeper) CollectRewards(ctx context.Context) error {
+	collected := k.bankKeeper.GetAllBalances(ctx, k.authKeeper.GetModuleAddress(k.feeCollectorName))
+	if collected.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, collected); err != nil {
+		return errorsmod.Wrap(err, "could not collect rewards")
+	}
+
+	for _, coin := range collected {
+		amount, err := k.CollectedRewards.Get(ctx, coin.Denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			amount = math.ZeroInt()
+		} else if err != nil {
+			return err
+		}
+		if err := k.CollectedRewards.Set(ctx, coin.Denom, amount.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCollectedRewards returns the rewards collected since the last distribution.
+func (k *Keeper) GetCollectedRewards(ctx context.Context) (sdk.Coins, error) {
+	var collected sdk.Coins
+	err := k.CollectedRewards.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		collected = append(collected, sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	return collected, err
+}
+
+// Distribute collects the rewards of the fee collector and accrues all the rewards collected since the last
+// distribution to the validators proportionally to the power they signed blocks with since then. In settlement payout
+// mode, the accrued rewards are then batched for claiming on the settlement chain. If no block was signed, the rewards
+// are kept for the next distribution.
+func (k *Keeper) Distribute(ctx context.Context, params types.Params, epoch uint64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.CollectRewards(ctx); err != nil {
+		return err
+	}
+	if err := k.LastDistribution.Set(ctx, types.LastDistribution{Height: sdkCtx.BlockHeight(), Epoch: epoch}); err != nil {
+		return err
+	}
+
+	var (
+		validators []sdk.ConsAddress
+		powers     []uint64
+	)
+	err := k.SignedPower.Walk(ctx, nil, func(consAddr sdk.ConsAddress, power uint64) (bool, error) {
+		validators = append(validators, consAddr)
+		powers = append(powers, power)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := k.SignedPower.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	collected, err := k.GetCollectedRewards(ctx)
+	if err != nil {
+		return err
+	}
+	if len(validators) == 0 || collected.IsZero() {
+		return nil
+	}
+	if err := k.CollectedRewards.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	// the truncated remainder stays in the module account
+	allocated := types.AllocateRewards(sdk.NewDecCoinsFromCoins(collected...), powers)
+	for i, consAddr := range validators {
+		rewards, err := k.getValidatorRewards(ctx, consAddr)
+		if err != nil {
+			return err
+		}
+		rewards.Outstanding = rewards.Outstanding.Add(allocated[i]...)
+		if err := k.ValidatorRewards.Set(ctx, consAddr, rewards); err != nil {
+			return err
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistribution,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, collected.String()),
+		),
+	)
+
+	if params.PayoutMode == types.PayoutMode_PAYOUT_MODE_SETTLEMENT {
+		_, err := k.CreateRewardBatch(ctx, params.SigningKeyTag, epoch)
+		return err
+	}
+	return nil
+}
+
+func (k *Keeper) getValidatorRewards(ctx context.Context, consAddr sdk.ConsAddress) (types.ValidatorRewards, error) {
+	rewards, err := k.ValidatorRewards.Get(ctx, consAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ValidatorRewards{}, nil
+	}
+	return rewards, err
+}
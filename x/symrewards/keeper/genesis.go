@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symrewards/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+	if err := k.symStakingKeeper.ValidateKeyTag(ctx, genState.Params.SigningKeyTag); err != nil {
+		return errorsmod.Wrap(err, "invalid signing key tag")
+	}
+	if err := k.LastDistribution.Set(ctx, genState.LastDistribution); err != nil {
+		return err
+	}
+	for _, coin := range genState.CollectedRewards {
+		if err := k.CollectedRewards.Set(ctx, coin.Denom, coin.Amount); err != nil {
+			return err
+		}
+	}
+
+	for _, val := range genState.Validators {
+		consAddr, err := k.consensusAddressCodec.StringToBytes(val.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if val.RewardAddress != "" {
+			rewardAddress, err := k.addressCodec.StringToBytes(val.RewardAddress)
+			if err != nil {
+				return err
+			}
+			if err := k.RewardAddresses.Set(ctx, consAddr, rewardAddress); err != nil {
+				return err
+			}
+		}
+		if val.RewardAddressNonce > 0 {
+			if err := k.RewardAddressNonces.Set(ctx, consAddr, val.RewardAddressNonce); err != nil {
+				return err
+			}
+		}
+		if val.SignedPower > 0 {
+			if err := k.SignedPower.Set(ctx, consAddr, val.SignedPower); err != nil {
+				return err
+			}
+		}
+		if !val.Rewards.Outstanding.IsZero() || !val.Rewards.Settled.IsZero() {
+			if err := k.ValidatorRewards.Set(ctx, consAddr, val.Rewards); err != nil {
+				return err
+			}
+		}
+	}
+
+	var nextBatchID uint64
+	for _, batch := range genState.Batches {
+		if err := k.RewardBatches.Set(ctx, batch.Id, batch); err != nil {
+			return err
+		}
+		nextBatchID = max(nextBatchID, batch.Id+1)
+	}
+	return k.RewardBatchSequence.Set(ctx, nextBatchID)
+}
+
+// ExportGenesis returns the module's exported genesis.
+func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	var err error
+
+	genesis := types.DefaultGenesis()
+	genesis.Params, err = k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.LastDistribution, err = k.LastDistribution.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	genesis.CollectedRewards, err = k.GetCollectedRewards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make(map[string]*types.GenesisValidator)
+	getValidator := func(consAddr sdk.ConsAddress) (*types.GenesisValidator, error) {
+		if val, ok := validators[string(consAddr)]; ok {
+			return val, nil
+		}
+		addr, err := k.consensusAddressCodec.BytesToString(consAddr)
+		if err != nil {
+			return nil, err
+		}
+		val := &types.GenesisValidator{ValidatorAddress: addr}
+		validators[string(consAddr)] = val
+		return val, nil
+	}
+
+	err = k.RewardAddresses.Walk(ctx, nil, func(consAddr sdk.ConsAddress, rewardAddress []byte) (bool, error) {
+		val, err := getValidator(consAddr)
+		if err != nil {
+			return true, err
+		}
+		val.RewardAddress, err = k.addressCodec.BytesToString(rewardAddress)
+		return err != nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.RewardAddressNonces.Walk(ctx, nil, func(consAddr sdk.ConsAddress, nonce uint64) (bool, error) {
+		val, err := getValidator(consAddr)
+		if err != nil {
+			return true, err
+		}
+		val.RewardAddressNonce = nonce
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.SignedPower.Walk(ctx, nil, func(consAddr sdk.ConsAddress, power uint64) (bool, error) {
+		val, err := getValidator(consAddr)
+		if err != nil {
+			return true, err
+		}
+		val.SignedPower = power
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.ValidatorRewards.Walk(ctx, nil, func(consAddr sdk.ConsAddress, rewards types.ValidatorRewards) (bool, error) {
+		val, err := getValidator(consAddr)
+		if err != nil {
+			return true, err
+		}
+		val.Rewards = rewards
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(validators))
+	for key := range validators {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		genesis.Validators = append(genesis.Validators, *validators[key])
+	}
+
+	err = k.RewardBatches.Walk(ctx, nil, func(_ uint64, batch types.RewardBatch) (bool, error) {
+		genesis.Batches = append(genesis.Batches, batch)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
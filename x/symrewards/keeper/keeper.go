@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symrewards/types"
+)
+
+type Keeper struct {
+	logger log.Logger
+
+	storeService          corestore.KVStoreService
+	cdc                   codec.Codec
+	addressCodec          address.Codec
+	consensusAddressCodec address.Codec
+	// Address capable of executing a MsgUpdateParams message.
+	// Typically, this should be the x/gov module account.
+	authority []byte
+
+	authKeeper       types.AuthKeeper
+	bankKeeper       types.BankKeeper
+	symStakingKeeper types.SymStakingKeeper
+
+	feeCollectorName string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// SignedPower key: validator consensus address | value: power the validator signed blocks with since the last
+	// distribution
+	SignedPower collections.Map[sdk.ConsAddress, uint64]
+	// ValidatorRewards key: validator consensus address | value: rewards accrued by the validator
+	ValidatorRewards collections.Map[sdk.ConsAddress, types.ValidatorRewards]
+	// RewardAddresses key: validator consensus address | value: account withdrawing the validator rewards
+	RewardAddresses collections.Map[sdk.ConsAddress, []byte]
+	// RewardAddressNonces key: validator consensus address | value: nonce of the next reward address registration
+	RewardAddressNonces collections.Map[sdk.ConsAddress, uint64]
+	// RewardBatches key: batch id | value: reward batch
+	RewardBatches collections.Map[uint64, types.RewardBatch]
+	// RewardBatchSequence is the id of the next reward batch
+	RewardBatchSequence collections.Sequence
+	// LastDistribution is the height and epoch the collected rewards were last distributed at
+	LastDistribution collections.Item[types.LastDistribution]
+	// CollectedRewards key: denom | value: amount collected from the fee collector since the last distribution
+	CollectedRewards collections.Map[string, math.Int]
+}
+
+func NewKeeper(
+	logger log.Logger,
+	storeService corestore.KVStoreService,
+	cdc codec.Codec,
+	addressCodec address.Codec,
+	consensusAddressCodec address.Codec,
+	authority []byte,
+	authKeeper types.AuthKeeper,
+	bankKeeper types.BankKeeper,
+	symStakingKeeper types.SymStakingKeeper,
+	feeCollectorName string,
+) *Keeper {
+	if _, err := addressCodec.BytesToString(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
+	}
+
+	// ensure the rewards module account is set
+	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		logger:                logger,
+		storeService:          storeService,
+		cdc:                   cdc,
+		addressCodec:          addressCodec,
+		consensusAddressCodec: consensusAddressCodec,
+		authority:             authority,
+		authKeeper:            authKeeper,
+		bankKeeper:            bankKeeper,
+		symStakingKeeper:      symStakingKeeper,
+		feeCollectorName:      feeCollectorName,
+		Params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		SignedPower: collections.NewMap(
+			sb, types.SignedPowerKey, "signed_power", sdk.ConsAddressKey, collections.Uint64Value,
+		),
+		ValidatorRewards: collections.NewMap(
+			sb, types.ValidatorRewardsKey, "validator_rewards", sdk.ConsAddressKey, codec.CollValue[types.ValidatorRewards](cdc),
+		),
+		RewardAddresses: collections.NewMap(
+			sb, types.RewardAddressesKey, "reward_addresses", sdk.ConsAddressKey, collections.BytesValue,
+		),
+		RewardAddressNonces: collections.NewMap(
+			sb, types.RewardAddressNoncesKey, "reward_address_nonces", sdk.ConsAddressKey, collections.Uint64Value,
+		),
+		RewardBatches: collections.NewMap(
+			sb, types.RewardBatchesKey, "reward_batches", collections.Uint64Key, codec.CollValue[types.RewardBatch](cdc),
+		),
+		RewardBatchSequence: collections.NewSequence(sb, types.RewardBatchSequenceKey, "reward_batch_sequence"),
+		LastDistribution: collections.NewItem(
+			sb, types.LastDistributionKey, "last_distribution", codec.CollValue[types.LastDistribution](cdc),
+		),
+		CollectedRewards: collections.NewMap(
+			sb, types.CollectedRewardsKey, "collected_rewards", collections.StringKey, sdk.IntValue,
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return &k
+}
+
+// GetAuthority returns the module's authority.
+func (k *Keeper) GetAuthority() []byte {
+	return k.authority
+}
@@ -0,0 +1,505 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/symrewards/keeper"
+	"github.com/cosmos/cosmos-sdk/x/symrewards/types"
+	symstakingtypes "github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+const (
+	testChainID      = "test-chain"
+	feeCollectorName = authtypes.FeeCollectorName
+)
+
+type authKeeper struct{}
+
+func (authKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+// bankKeeper keeps the balances in memory.
+type bankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (b *bankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *bankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := b.balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds: %s < %s", b.balances[from.String()], amt)
+	}
+	b.balances[from.String()] = balance
+	b.balances[to.String()] = b.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (b *bankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *bankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+// symStakingKeeper records the requested signatures.
+type symStakingKeeper struct {
+	epoch    uint64
+	requests map[string]symstakingtypes.SignatureRequest
+	err      error
+}
+
+func (s *symStakingKeeper) RequestSignature(ctx context.Context, msg symstakingtypes.TypedMessage, keyTag uint32) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	message := msg.SignBytes(sdk.UnwrapSDKContext(ctx).ChainID())
+	requestID := symstakingtypes.SignatureRequestID(keyTag, s.epoch, message)
+	s.requests[requestID] = symstakingtypes.SignatureRequest{
+		RequestId: requestID,
+		KeyTag:    keyTag,
+		Message:   message,
+		Epoch:     s.epoch,
+		Status:    symstakingtypes.SignatureStatus_SIGNATURE_STATUS_PENDING,
+	}
+	return requestID, nil
+}
+
+func (s *symStakingKeeper) GetSignatureRequest(_ context.Context, requestID string) (symstakingtypes.SignatureRequest, error) {
+	req, ok := s.requests[requestID]
+	if !ok {
+		return symstakingtypes.SignatureRequest{}, symstakingtypes.ErrSignatureRequestNotFound
+	}
+	return req, nil
+}
+
+func (s *symStakingKeeper) ValidateKeyTag(_ context.Context, keyTag uint32) error {
+	if keyTag != symstakingtypes.DefaultParams().ValidatorKeyTag {
+		return symstakingtypes.ErrInvalidKeyTag
+	}
+	return nil
+}
+
+func (s *symStakingKeeper) GetCurrentEpoch(context.Context) (*symstakingtypes.StoreEpoch, error) {
+	return &symstakingtypes.StoreEpoch{Epoch: s.epoch}, nil
+}
+
+type fixture struct {
+	ctx        sdk.Context
+	keeper     *keeper.Keeper
+	msgServer  types.MsgServer
+	bank       *bankKeeper
+	symStaking *symStakingKeeper
+}
+
+func setupKeeper(t *testing.T) fixture {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := sdktestutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{ChainID: testChainID, Height: 10}).WithChainID(testChainID)
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	f := fixture{
+		ctx:        ctx,
+		bank:       &bankKeeper{balances: map[string]sdk.Coins{}},
+		symStaking: &symStakingKeeper{epoch: 3, requests: map[string]symstakingtypes.SignatureRequest{}},
+	}
+	f.keeper = keeper.NewKeeper(
+		ctx.Logger(),
+		runtime.NewKVStoreService(key),
+		encCfg.Codec,
+		address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		address.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
+		authtypes.NewModuleAddress(types.GovModuleName),
+		authKeeper{},
+		f.bank,
+		f.symStaking,
+		feeCollectorName,
+	)
+	require.NoError(t, f.keeper.Params.Set(ctx, types.DefaultParams()))
+	f.msgServer = keeper.NewMsgServerImpl(f.keeper)
+	return f
+}
+
+func (f fixture) balance(moduleName string) sdk.Coins {
+	return f.bank.balances[authtypes.NewModuleAddress(moduleName).String()]
+}
+
+func (f fixture) setOutstanding(t *testing.T, consAddr sdk.ConsAddress, outstanding sdk.DecCoins) {
+	t.Helper()
+	rewards, err := f.keeper.ValidatorRewards.Get(f.ctx, consAddr)
+	if err != nil {
+		rewards = types.ValidatorRewards{}
+	}
+	rewards.Outstanding = outstanding
+	require.NoError(t, f.keeper.ValidatorRewards.Set(f.ctx, consAddr, rewards))
+}
+
+var (
+	valA = sdk.ConsAddress([]byte("validator_a_________"))
+	valB = sdk.ConsAddress([]byte("validator_b_________"))
+)
+
+func TestDistribute(t *testing.T) {
+	f := setupKeeper(t)
+	require.NoError(t, f.keeper.SignedPower.Set(f.ctx, valA, 30))
+	require.NoError(t, f.keeper.SignedPower.Set(f.ctx, valB, 10))
+	f.bank.balances[authtypes.NewModuleAddress(feeCollectorName).String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 101))
+
+	require.NoError(t, f.keeper.Distribute(f.ctx, types.DefaultParams(), 3))
+
+	require.True(t, f.balance(feeCollectorName).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 101)), f.balance(types.ModuleName))
+
+	rewardsA, err := f.keeper.ValidatorRewards.Get(f.ctx, valA)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkmath.LegacyMustNewDecFromStr("75.75"))), rewardsA.Outstanding)
+	rewardsB, err := f.keeper.ValidatorRewards.Get(f.ctx, valB)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkmath.LegacyMustNewDecFromStr("25.25"))), rewardsB.Outstanding)
+
+	has, err := f.keeper.SignedPower.Has(f.ctx, valA)
+	require.NoError(t, err)
+	require.False(t, has)
+	last, err := f.keeper.LastDistribution.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.LastDistribution{Height: 10, Epoch: 3}, last)
+
+	// no block was signed since, so newly collected rewards are kept for the next distribution
+	f.bank.balances[authtypes.NewModuleAddress(feeCollectorName).String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 7))
+	require.NoError(t, f.keeper.Distribute(f.ctx, types.DefaultParams(), 4))
+	require.True(t, f.balance(feeCollectorName).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 108)), f.balance(types.ModuleName))
+	collected, err := f.keeper.GetCollectedRewards(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 7)), collected)
+	require.Empty(t, f.symStaking.requests)
+}
+
+func TestBeginBlockDistributesPeriodRewards(t *testing.T) {
+	f := setupKeeper(t)
+	params := types.DefaultParams()
+	params.DistributionInterval = 3
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	require.NoError(t, f.keeper.LastDistribution.Set(f.ctx, types.LastDistribution{Height: 10, Epoch: 3}))
+
+	feeCollector := authtypes.NewModuleAddress(feeCollectorName).String()
+	voteInfos := []abci.VoteInfo{
+		{Validator: abci.Validator{Address: valA, Power: 3}, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+		{Validator: abci.Validator{Address: valB, Power: 1}, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+	}
+	for height := int64(11); height <= 13; height++ {
+		// fees and inflation of the previous block
+		f.bank.balances[feeCollector] = f.bank.balances[feeCollector].Add(sdk.NewInt64Coin("stake", 100))
+		ctx := f.ctx.WithBlockHeight(height).WithVoteInfos(voteInfos)
+		require.NoError(t, f.keeper.BeginBlock(ctx))
+
+		// nothing is left for x/distribution to allocate after symrewards
+		require.True(t, f.balance(feeCollectorName).IsZero())
+		f.bank.balances[feeCollector] = nil
+	}
+
+	// the rewards of the whole period are distributed with the power signed over the period
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 300)), f.balance(types.ModuleName))
+	collected, err := f.keeper.GetCollectedRewards(f.ctx)
+	require.NoError(t, err)
+	require.True(t, collected.IsZero())
+	rewardsA, err := f.keeper.ValidatorRewards.Get(f.ctx, valA)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 225)), rewardsA.Outstanding)
+	rewardsB, err := f.keeper.ValidatorRewards.Get(f.ctx, valB)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 75)), rewardsB.Outstanding)
+	last, err := f.keeper.LastDistribution.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.LastDistribution{Height: 13, Epoch: 3}, last)
+
+	// the collected rewards are exported and imported
+	genesis, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.True(t, genesis.CollectedRewards.IsZero())
+	f.bank.balances[feeCollector] = sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	require.NoError(t, f.keeper.BeginBlock(f.ctx.WithBlockHeight(14)))
+	genesis, err = f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), genesis.CollectedRewards)
+
+	imported := setupKeeper(t)
+	require.NoError(t, imported.keeper.InitGenesis(imported.ctx, *genesis))
+	collected, err = imported.keeper.GetCollectedRewards(imported.ctx)
+	require.NoError(t, err)
+	require.Equal(t, genesis.CollectedRewards, collected)
+}
+
+func TestDistributeSettlement(t *testing.T) {
+	f := setupKeeper(t)
+	require.NoError(t, f.keeper.SignedPower.Set(f.ctx, valA, 1))
+	f.bank.balances[authtypes.NewModuleAddress(feeCollectorName).String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	params := types.DefaultParams()
+	params.PayoutMode = types.PayoutMode_PAYOUT_MODE_SETTLEMENT
+	require.NoError(t, f.keeper.Distribute(f.ctx, params, 3))
+
+	batch, err := f.keeper.RewardBatches.Get(f.ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, []types.RewardClaim{{ValidatorAddress: valA, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}}, batch.Claims)
+	require.Contains(t, f.symStaking.requests, batch.RequestId)
+}
+
+func TestWithdrawValidatorRewards(t *testing.T) {
+	f := setupKeeper(t)
+	f.bank.balances[authtypes.NewModuleAddress(types.ModuleName).String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	f.setOutstanding(t, valA, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkmath.LegacyMustNewDecFromStr("10.5"))))
+	to := sdk.AccAddress([]byte("reward_address______"))
+
+	amount, err := f.keeper.WithdrawValidatorRewards(f.ctx, valA, to)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), amount)
+	require.Equal(t, amount, f.bank.balances[to.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), f.balance(types.ModuleName))
+
+	// the truncated remainder stays outstanding
+	rewards, err := f.keeper.ValidatorRewards.Get(f.ctx, valA)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkmath.LegacyMustNewDecFromStr("0.5"))), rewards.Outstanding)
+
+	amount, err = f.keeper.WithdrawValidatorRewards(f.ctx, valA, to)
+	require.NoError(t, err)
+	require.True(t, amount.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), f.bank.balances[to.String()])
+}
+
+func TestMsgWithdrawRewards(t *testing.T) {
+	f := setupKeeper(t)
+	f.bank.balances[authtypes.NewModuleAddress(types.ModuleName).String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	f.setOutstanding(t, valA, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 5)))
+	rewardAddress := sdk.AccAddress([]byte("reward_address______"))
+	require.NoError(t, f.keeper.RewardAddresses.Set(f.ctx, valA, rewardAddress))
+
+	_, err := f.msgServer.WithdrawRewards(f.ctx, &types.MsgWithdrawRewards{
+		RewardAddress:    sdk.AccAddress([]byte("other_address_______")).String(),
+		ValidatorAddress: valA.String(),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = f.msgServer.WithdrawRewards(f.ctx, &types.MsgWithdrawRewards{
+		RewardAddress:    rewardAddress.String(),
+		ValidatorAddress: valB.String(),
+	})
+	require.ErrorIs(t, err, types.ErrRewardAddressNotFound)
+
+	res, err := f.msgServer.WithdrawRewards(f.ctx, &types.MsgWithdrawRewards{
+		RewardAddress:    rewardAddress.String(),
+		ValidatorAddress: valA.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), res.Amount)
+
+	params := types.DefaultParams()
+	params.PayoutMode = types.PayoutMode_PAYOUT_MODE_SETTLEMENT
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	_, err = f.msgServer.WithdrawRewards(f.ctx, &types.MsgWithdrawRewards{
+		RewardAddress:    rewardAddress.String(),
+		ValidatorAddress: valA.String(),
+	})
+	require.ErrorIs(t, err, types.ErrWithdrawDisabled)
+}
+
+func TestSetRewardAddress(t *testing.T) {
+	f := setupKeeper(t)
+	privKey := ed25519.GenPrivKey()
+	consAddr := sdk.ConsAddress(privKey.PubKey().Address())
+	rewardAddress := sdk.AccAddress([]byte("reward_address______")).String()
+	otherAddress := sdk.AccAddress([]byte("other_address_______")).String()
+
+	newMsg := func(rewardAddress string, nonce uint64) *types.MsgSetRewardAddress {
+		signature, err := privKey.Sign(types.RewardAddressSignBytes(testChainID, rewardAddress, nonce))
+		require.NoError(t, err)
+		return &types.MsgSetRewardAddress{
+			ConsensusPubkey: privKey.PubKey().Bytes(),
+			RewardAddress:   rewardAddress,
+			Signature:       signature,
+			Nonce:           nonce,
+		}
+	}
+
+	first := newMsg(rewardAddress, 0)
+	_, err := f.msgServer.SetRewardAddress(f.ctx, first)
+	require.NoError(t, err)
+	registered, err := f.keeper.RewardAddresses.Get(f.ctx, consAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustAccAddressFromBech32(rewardAddress), sdk.AccAddress(registered))
+	nonce, err := f.keeper.GetRewardAddressNonce(f.ctx, consAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), nonce)
+
+	second := newMsg(otherAddress, 1)
+	_, err = f.msgServer.SetRewardAddress(f.ctx, second)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSetRewardAddress
+		expErr string
+	}{
+		{
+			name:   "replayed registration",
+			msg:    first,
+			expErr: "expected nonce 2, got 0",
+		},
+		{
+			name: "signature over another nonce",
+			msg: func() *types.MsgSetRewardAddress {
+				msg := newMsg(rewardAddress, 1)
+				msg.Nonce = 2
+				return msg
+			}(),
+			expErr: "invalid consensus key signature",
+		},
+		{
+			name: "signature over another address",
+			msg: func() *types.MsgSetRewardAddress {
+				msg := newMsg(otherAddress, 2)
+				msg.RewardAddress = rewardAddress
+				return msg
+			}(),
+			expErr: "invalid consensus key signature",
+		},
+		{
+			name: "signature by another key",
+			msg: func() *types.MsgSetRewardAddress {
+				msg := newMsg(rewardAddress, 2)
+				msg.ConsensusPubkey = ed25519.GenPrivKey().PubKey().Bytes()
+				return msg
+			}(),
+			expErr: "expected nonce 0, got 2",
+		},
+		{
+			name: "invalid consensus pubkey",
+			msg: func() *types.MsgSetRewardAddress {
+				msg := newMsg(rewardAddress, 2)
+				msg.ConsensusPubkey = msg.ConsensusPubkey[1:]
+				return msg
+			}(),
+			expErr: "expected 32 bytes ed25519 consensus pubkey",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.msgServer.SetRewardAddress(f.ctx, tc.msg)
+			require.ErrorIs(t, err, types.ErrInvalidRewardAddress)
+			require.ErrorContains(t, err, tc.expErr)
+
+			// the registration is left untouched
+			registered, err := f.keeper.RewardAddresses.Get(f.ctx, consAddr)
+			require.NoError(t, err)
+			require.Equal(t, sdk.MustAccAddressFromBech32(otherAddress), sdk.AccAddress(registered))
+			nonce, err := f.keeper.GetRewardAddressNonce(f.ctx, consAddr)
+			require.NoError(t, err)
+			require.Equal(t, uint64(2), nonce)
+		})
+	}
+}
+
+func TestCreateRewardBatch(t *testing.T) {
+	f := setupKeeper(t)
+	f.setOutstanding(t, valA, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkmath.LegacyMustNewDecFromStr("10.5"))))
+	f.setOutstanding(t, valB, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkmath.LegacyMustNewDecFromStr("0.5"))))
+
+	batch, err := f.keeper.CreateRewardBatch(f.ctx, 15, 3)
+	require.NoError(t, err)
+	claims := []types.RewardClaim{{ValidatorAddress: valA, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}}
+	require.Equal(t, uint64(0), batch.Id)
+	require.Equal(t, int64(10), batch.Height)
+	require.Equal(t, uint64(3), batch.Epoch)
+	require.Equal(t, claims, batch.Claims)
+	require.Equal(t, types.ClaimsRoot(claims), batch.Root)
+
+	// the batch payload is requested to be signed with the key tag
+	req, err := f.symStaking.GetSignatureRequest(f.ctx, batch.RequestId)
+	require.NoError(t, err)
+	require.Equal(t, uint32(15), req.KeyTag)
+	require.Equal(t, symstakingtypes.TypedMessage{
+		Module:  types.ModuleName,
+		Type:    types.RewardBatchMessageType,
+		Payload: batch.Payload(),
+	}.SignBytes(testChainID), req.Message)
+
+	stored, err := f.keeper.RewardBatches.Get(f.ctx, batch.Id)
+	require.NoError(t, err)
+	require.Equal(t, batch, stored)
+
+	rewardsA, err := f.keeper.ValidatorRewards.Get(f.ctx, valA)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkmath.LegacyMustNewDecFromStr("0.5"))), rewardsA.Outstanding)
+	require.Equal(t, claims[0].Amount, rewardsA.Settled)
+
+	// claims are cumulative
+	f.setOutstanding(t, valB, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 2)))
+	batch, err = f.keeper.CreateRewardBatch(f.ctx, 15, 4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), batch.Id)
+	require.Equal(t, []types.RewardClaim{
+		{ValidatorAddress: valA, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+		{ValidatorAddress: valB, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 2))},
+	}, batch.Claims)
+	require.Len(t, f.symStaking.requests, 2)
+
+	// a failed signature request does not store the batch
+	f.symStaking.err = fmt.Errorf("request failed")
+	_, err = f.keeper.CreateRewardBatch(f.ctx, 15, 5)
+	require.ErrorContains(t, err, "could not request reward batch 2 signature")
+	has, err := f.keeper.RewardBatches.Has(f.ctx, 2)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestCreateRewardBatchWithoutRewards(t *testing.T) {
+	f := setupKeeper(t)
+	f.setOutstanding(t, valA, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkmath.LegacyMustNewDecFromStr("0.5"))))
+
+	batch, err := f.keeper.CreateRewardBatch(f.ctx, 15, 3)
+	require.NoError(t, err)
+	require.Equal(t, types.RewardBatch{}, batch)
+	require.Empty(t, f.symStaking.requests)
+}
+
+func TestUpdateParams(t *testing.T) {
+	f := setupKeeper(t)
+	authority := authtypes.NewModuleAddress(types.GovModuleName).String()
+
+	params := types.DefaultParams()
+	params.PayoutMode = types.PayoutMode_PAYOUT_MODE_SETTLEMENT
+	_, err := f.msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	// the reward batches could never be verified with a key tag symstaking has no verifier for
+	params.SigningKeyTag = 15
+	_, err = f.msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.ErrorIs(t, err, symstakingtypes.ErrInvalidKeyTag)
+	stored, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, symstakingtypes.DefaultParams().ValidatorKeyTag, stored.SigningKeyTag)
+}
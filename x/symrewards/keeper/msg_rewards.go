@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symrewards/types"
+)
+
+func (k msgServer) SetRewardAddress(ctx context.Context, req *types.MsgSetRewardAddress) (*types.MsgSetRewardAddressResponse, error) {
+	rewardAddress, err := k.addressCodec.StringToBytes(req.RewardAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid reward address")
+	}
+
+	if len(req.ConsensusPubkey) != ed25519.PubKeySize {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRewardAddress, "expected %d bytes ed25519 consensus pubkey, got %d", ed25519.PubKeySize, len(req.ConsensusPubkey))
+	}
+	pubKey := &ed25519.PubKey{Key: req.ConsensusPubkey}
+	consAddr := sdk.ConsAddress(pubKey.Address())
+	nonce, err := k.GetRewardAddressNonce(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+	if req.Nonce != nonce {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRewardAddress, "expected nonce %d, got %d", nonce, req.Nonce)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !pubKey.VerifySignature(types.RewardAddressSignBytes(sdkCtx.ChainID(), req.RewardAddress, req.Nonce), req.Signature) {
+		return nil, errorsmod.Wrap(types.ErrInvalidRewardAddress, "invalid consensus key signature")
+	}
+
+	if err := k.RewardAddresses.Set(ctx, consAddr, rewardAddress); err != nil {
+		return nil, err
+	}
+	if err := k.RewardAddressNonces.Set(ctx, consAddr, nonce+1); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRewardAddressResponse{}, nil
+}
+
+func (k msgServer) WithdrawRewards(ctx context.Context, req *types.MsgWithdrawRewards) (*types.MsgWithdrawRewardsResponse, error) {
+	rewardAddress, err := k.addressCodec.StringToBytes(req.RewardAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid reward address")
+	}
+	consAddr, err := k.consensusAddressCodec.StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid validator address")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.PayoutMode != types.PayoutMode_PAYOUT_MODE_WITHDRAW {
+		return nil, types.ErrWithdrawDisabled
+	}
+
+	registered, err := k.RewardAddresses.Get(ctx, consAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(types.ErrRewardAddressNotFound, "validator %s", req.ValidatorAddress)
+	} else if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(registered, rewardAddress) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "%s is not the reward address of validator %s", req.RewardAddress, req.ValidatorAddress)
+	}
+
+	amount, err := k.WithdrawValidatorRewards(ctx, consAddr, rewardAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawRewardsResponse{Amount: amount}, nil
+}
+
+// GetRewardAddressNonce returns the nonce the next reward address registration of a validator must be signed with.
+func (k *Keeper) GetRewardAddressNonce(ctx context.Context, consAddr sdk.ConsAddress) (uint64, error) {
+	nonce, err := k.RewardAddressNonces.Get(ctx, consAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return nonce, err
+}
+
+// WithdrawValidatorRewards sends the outstanding rewards of a validator, truncated to whole coins, to the given
+// address.
+func (k *Keeper) WithdrawValidatorRewards(ctx context.Context, consAddr sdk.ConsAddress, to sdk.AccAddress) (sdk.Coins, error) {
+	rewards, err := k.getValidatorRewards(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, remainder := rewards.Outstanding.TruncateDecimal()
+	if amount.IsZero() {
+		return amount, nil
+	}
+	rewards.Outstanding = remainder
+	if err := k.ValidatorRewards.Set(ctx, consAddr, rewards); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, amount); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, consAddr.String()),
+		),
+	)
+	return amount, nil
+}
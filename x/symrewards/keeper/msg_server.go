@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/symrewards/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: *keeper}
+}
+
+var _ types.MsgServer = msgServer{}
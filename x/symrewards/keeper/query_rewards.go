@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/symrewards/types"
+)
+
+func (q queryServer) ValidatorRewards(ctx context.Context, req *types.QueryValidatorRewardsRequest) (*types.QueryValidatorRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	consAddr, err := q.k.consensusAddressCodec.StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %v", err)
+	}
+
+	rewards, err := q.k.getValidatorRewards(ctx, consAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get validator rewards: %v", err)
+	}
+	signedPower, err := q.k.SignedPower.Get(ctx, consAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to get signed power: %v", err)
+	}
+
+	nonce, err := q.k.GetRewardAddressNonce(ctx, consAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get reward address nonce: %v", err)
+	}
+
+	resp := &types.QueryValidatorRewardsResponse{Rewards: rewards, SignedPower: signedPower, RewardAddressNonce: nonce}
+	rewardAddress, err := q.k.RewardAddresses.Get(ctx, consAddr)
+	switch {
+	case err == nil:
+		if resp.RewardAddress, err = q.k.addressCodec.BytesToString(rewardAddress); err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid reward address: %v", err)
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, status.Errorf(codes.Internal, "failed to get reward address: %v", err)
+	}
+
+	return resp, nil
+}
+
+func (q queryServer) RewardBatch(ctx context.Context, req *types.QueryRewardBatchRequest) (*types.QueryRewardBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	batch, err := q.k.getRewardBatch(ctx, req.Id)
+	if errors.Is(err, types.ErrRewardBatchNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get reward batch: %v", err)
+	}
+	signatureRequest, err := q.k.symStakingKeeper.GetSignatureRequest(ctx, batch.RequestId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get reward batch signature request: %v", err)
+	}
+
+	return &types.QueryRewardBatchResponse{
+		Batch:  batch,
+		Status: signatureRequest.Status,
+		Proof:  signatureRequest.Proof,
+	}, nil
+}
+
+func (q queryServer) RewardBatches(ctx context.Context, req *types.QueryRewardBatchesRequest) (*types.QueryRewardBatchesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	batches, pageRes, err := query.CollectionPaginate(ctx, q.k.RewardBatches, req.Pagination, func(_ uint64, batch types.RewardBatch) (types.RewardBatch, error) {
+		return batch, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get reward batches: %v", err)
+	}
+
+	return &types.QueryRewardBatchesResponse{Batches: batches, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ClaimProof(ctx context.Context, req *types.QueryClaimProofRequest) (*types.QueryClaimProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	consAddr, err := q.k.consensusAddressCodec.StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %v", err)
+	}
+
+	batch, err := q.k.getRewardBatch(ctx, req.Id)
+	if errors.Is(err, types.ErrRewardBatchNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get reward batch: %v", err)
+	}
+
+	for i, claim := range batch.Claims {
+		if !bytes.Equal(claim.ValidatorAddress, consAddr) {
+			continue
+		}
+		proof := types.ClaimProof(batch.Claims, i)
+		return &types.QueryClaimProofResponse{
+			Claim: claim,
+			Root:  batch.Root,
+			Index: proof.Index,
+			Total: proof.Total,
+			Aunts: proof.Aunts,
+		}, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "%s: validator %s in batch %d", types.ErrRewardClaimNotFound, req.ValidatorAddress, req.Id)
+}
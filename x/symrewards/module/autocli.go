@@ -0,0 +1,66 @@
+package symrewards
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/cosmos/cosmos-sdk/x/symrewards/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod:      "ValidatorRewards",
+					Use:            "validator-rewards [validator-address]",
+					Short:          "Query the rewards and reward address of a validator by consensus address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
+				{
+					RpcMethod:      "RewardBatch",
+					Use:            "reward-batch [id]",
+					Short:          "Query a reward batch and the aggregation proof of its relay signature",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "RewardBatches",
+					Use:       "reward-batches",
+					Short:     "Query all reward batches",
+				},
+				{
+					RpcMethod:      "ClaimProof",
+					Use:            "claim-proof [id] [validator-address]",
+					Short:          "Query the merkle proof of the claim of a validator in a reward batch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "validator_address"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              types.Msg_serviceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SetRewardAddress",
+					Skip:      true, // skipped because it requires a consensus key signature, see cli.NewSetRewardAddressCmd
+				},
+				{
+					RpcMethod:      "WithdrawRewards",
+					Use:            "withdraw-rewards [validator-address]",
+					Short:          "Withdraw the outstanding rewards of a validator to its reward address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
+			},
+		},
+	}
+}
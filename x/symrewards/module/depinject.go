@@ -0,0 +1,80 @@
+package symrewards
+
+import (
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/symrewards/keeper"
+	"github.com/cosmos/cosmos-sdk/x/symrewards/types"
+)
+
+var _ depinject.OnePerModuleType = AppModule{}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (AppModule) IsOnePerModuleType() {}
+
+func init() {
+	appconfig.Register(
+		&types.Module{},
+		appconfig.Provide(ProvideModule),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Config                *types.Module
+	StoreService          store.KVStoreService
+	Cdc                   codec.Codec
+	AddressCodec          address.Codec
+	ConsensusAddressCodec runtime.ConsensusAddressCodec
+
+	AuthKeeper       types.AuthKeeper
+	BankKeeper       types.BankKeeper
+	SymStakingKeeper types.SymStakingKeeper
+
+	Logger log.Logger
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	SymrewardsKeeper *keeper.Keeper
+	Module           appmodule.AppModule
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	feeCollectorName := in.Config.FeeCollectorName
+	if feeCollectorName == "" {
+		feeCollectorName = authtypes.FeeCollectorName
+	}
+
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	k := keeper.NewKeeper(
+		in.Logger,
+		in.StoreService,
+		in.Cdc,
+		in.AddressCodec,
+		in.ConsensusAddressCodec,
+		authority,
+		in.AuthKeeper,
+		in.BankKeeper,
+		in.SymStakingKeeper,
+		feeCollectorName,
+	)
+	m := NewAppModule(in.Cdc, k)
+
+	return ModuleOutputs{SymrewardsKeeper: k, Module: m}
+}
@@ -0,0 +1,19 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/symrewards module sentinel errors
+var (
+	ErrInvalidSigner               = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidDistributionInterval = errors.Register(ModuleName, 1101, "invalid distribution interval")
+	ErrInvalidPayoutMode           = errors.Register(ModuleName, 1102, "invalid payout mode")
+	ErrInvalidRewardAddress        = errors.Register(ModuleName, 1103, "invalid reward address registration")
+	ErrRewardAddressNotFound       = errors.Register(ModuleName, 1104, "reward address not found")
+	ErrWithdrawDisabled            = errors.Register(ModuleName, 1105, "rewards are paid out on the settlement chain")
+	ErrRewardBatchNotFound         = errors.Register(ModuleName, 1106, "reward batch not found")
+	ErrRewardClaimNotFound         = errors.Register(ModuleName, 1107, "reward claim not found")
+)
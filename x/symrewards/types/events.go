@@ -0,0 +1,12 @@
+package types
+
+// Symrewards module event types
+const (
+	EventTypeDistribution    = "distribute_rewards"
+	EventTypeWithdrawRewards = "withdraw_rewards"
+	EventTypeRewardBatch     = "reward_batch"
+
+	AttributeKeyValidator = "validator"
+	AttributeKeyBatchID   = "batch_id"
+	AttributeKeyRequestID = "request_id"
+)
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	symstakingtypes "github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+// AuthKeeper defines the expected interface for the Auth module.
+type AuthKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
+
+// BankKeeper defines the expected interface for the Bank module.
+type BankKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// SymStakingKeeper defines the expected interface for the symstaking module.
+type SymStakingKeeper interface {
+	symstakingtypes.RelaySigner
+	GetCurrentEpoch(ctx context.Context) (*symstakingtypes.StoreEpoch, error)
+}
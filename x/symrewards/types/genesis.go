@@ -0,0 +1,52 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.CollectedRewards.Validate(); err != nil {
+		return fmt.Errorf("invalid collected rewards: %w", err)
+	}
+
+	seenValidators := make(map[string]bool, len(gs.Validators))
+	for _, val := range gs.Validators {
+		if seenValidators[val.ValidatorAddress] {
+			return fmt.Errorf("duplicate validator %s", val.ValidatorAddress)
+		}
+		seenValidators[val.ValidatorAddress] = true
+
+		if err := val.Rewards.Outstanding.Validate(); err != nil {
+			return fmt.Errorf("invalid outstanding rewards of validator %s: %w", val.ValidatorAddress, err)
+		}
+		if err := val.Rewards.Settled.Validate(); err != nil {
+			return fmt.Errorf("invalid settled rewards of validator %s: %w", val.ValidatorAddress, err)
+		}
+	}
+
+	seenBatches := make(map[uint64]bool, len(gs.Batches))
+	for _, batch := range gs.Batches {
+		if seenBatches[batch.Id] {
+			return fmt.Errorf("duplicate reward batch %d", batch.Id)
+		}
+		seenBatches[batch.Id] = true
+
+		if root := ClaimsRoot(batch.Claims); !bytes.Equal(root, batch.Root) {
+			return fmt.Errorf("reward batch %d root %X does not match its claims root %X", batch.Id, batch.Root, root)
+		}
+	}
+	return nil
+}
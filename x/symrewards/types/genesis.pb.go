@@ -0,0 +1,935 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/symrewards/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the symrewards module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params           Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Validators       []GenesisValidator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+	Batches          []RewardBatch      `protobuf:"bytes,3,rep,name=batches,proto3" json:"batches"`
+	LastDistribution LastDistribution   `protobuf:"bytes,4,opt,name=last_distribution,json=lastDistribution,proto3" json:"last_distribution"`
+	// collected_rewards are the rewards collected from the fee collector since the last distribution
+	CollectedRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=collected_rewards,json=collectedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collected_rewards"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28c4d1c461111683, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetValidators() []GenesisValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBatches() []RewardBatch {
+	if m != nil {
+		return m.Batches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastDistribution() LastDistribution {
+	if m != nil {
+		return m.LastDistribution
+	}
+	return LastDistribution{}
+}
+
+func (m *GenesisState) GetCollectedRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CollectedRewards
+	}
+	return nil
+}
+
+// GenesisValidator defines the reward state of a validator.
+type GenesisValidator struct {
+	// validator_address is the consensus address of the validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// reward_address is the account registered to withdraw the validator rewards
+	RewardAddress string `protobuf:"bytes,2,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
+	// signed_power is the power the validator signed blocks with since the last distribution
+	SignedPower uint64           `protobuf:"varint,3,opt,name=signed_power,json=signedPower,proto3" json:"signed_power,omitempty"`
+	Rewards     ValidatorRewards `protobuf:"bytes,4,opt,name=rewards,proto3" json:"rewards"`
+	// reward_address_nonce is the nonce the next reward address registration of the validator must be signed with
+	RewardAddressNonce uint64 `protobuf:"varint,5,opt,name=reward_address_nonce,json=rewardAddressNonce,proto3" json:"reward_address_nonce,omitempty"`
+}
+
+func (m *GenesisValidator) Reset()         { *m = GenesisValidator{} }
+func (m *GenesisValidator) String() string { return proto.CompactTextString(m) }
+func (*GenesisValidator) ProtoMessage()    {}
+func (*GenesisValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28c4d1c461111683, []int{1}
+}
+func (m *GenesisValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisValidator.Merge(m, src)
+}
+func (m *GenesisValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisValidator proto.InternalMessageInfo
+
+func (m *GenesisValidator) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *GenesisValidator) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *GenesisValidator) GetSignedPower() uint64 {
+	if m != nil {
+		return m.SignedPower
+	}
+	return 0
+}
+
+func (m *GenesisValidator) GetRewards() ValidatorRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return ValidatorRewards{}
+}
+
+func (m *GenesisValidator) GetRewardAddressNonce() uint64 {
+	if m != nil {
+		return m.RewardAddressNonce
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "cosmos.symrewards.v1.GenesisState")
+	proto.RegisterType((*GenesisValidator)(nil), "cosmos.symrewards.v1.GenesisValidator")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/symrewards/v1/genesis.proto", fileDescriptor_28c4d1c461111683)
+}
+
+var fileDescriptor_28c4d1c461111683 = []byte{
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x9b, 0x76, 0x7f, 0xb0, 0xd3, 0x55, 0xda, 0xa1, 0x87, 0x58, 0x24, 0xdb, 0x16, 0x94,
+	0xa2, 0x98, 0xb1, 0x2b, 0x9e, 0xc5, 0x2a, 0x8a, 0x28, 0xb2, 0x56, 0xf0, 0xe0, 0xc1, 0x30, 0x49,
+	0x86, 0xec, 0x60, 0x92, 0x29, 0x79, 0xb3, 0x5d, 0xf7, 0xe0, 0xdd, 0xa3, 0x7f, 0x86, 0x78, 0xf2,
+	0xcf, 0x58, 0x6f, 0x7b, 0xf4, 0xa4, 0xd2, 0x1e, 0xfc, 0x37, 0x24, 0x33, 0x93, 0x98, 0x2e, 0xb1,
+	0x97, 0x64, 0x78, 0xef, 0x33, 0xdf, 0xf7, 0x7d, 0xef, 0x31, 0x68, 0x14, 0x08, 0x48, 0x04, 0x10,
+	0x38, 0x4b, 0x32, 0x76, 0x4a, 0xb3, 0x10, 0xc8, 0x62, 0x42, 0x22, 0x96, 0x32, 0xe0, 0xe0, 0xce,
+	0x33, 0x21, 0x05, 0xee, 0x69, 0xc6, 0xfd, 0xc7, 0xb8, 0x8b, 0x49, 0xbf, 0x4b, 0x13, 0x9e, 0x0a,
+	0xa2, 0xbe, 0x1a, 0xec, 0xf7, 0x22, 0x11, 0x09, 0x75, 0x24, 0xf9, 0xc9, 0x44, 0x1d, 0x53, 0xc2,
+	0xa7, 0xc0, 0xc8, 0x62, 0xe2, 0x33, 0x49, 0x27, 0x24, 0x10, 0x3c, 0x35, 0xf9, 0x61, 0xad, 0x85,
+	0x39, 0xcd, 0x68, 0x62, 0x1c, 0xf4, 0xeb, 0x5d, 0x16, 0x66, 0x14, 0x33, 0xfa, 0xde, 0x42, 0xfb,
+	0x4f, 0xb5, 0xef, 0xd7, 0x92, 0x4a, 0x86, 0x1f, 0xa0, 0x1d, 0x2d, 0x62, 0x5b, 0x03, 0x6b, 0xdc,
+	0x3e, 0xbc, 0xee, 0xd6, 0xf5, 0xe1, 0x1e, 0x29, 0x66, 0xba, 0x77, 0xfe, 0xf3, 0xa0, 0xf1, 0xe5,
+	0xcf, 0xb7, 0x5b, 0xd6, 0xcc, 0x5c, 0xc3, 0xaf, 0x10, 0x5a, 0xd0, 0x98, 0x87, 0x54, 0x8a, 0x0c,
+	0xec, 0xe6, 0xa0, 0x35, 0x6e, 0x1f, 0xde, 0xac, 0x17, 0x31, 0x85, 0xdf, 0x14, 0x78, 0x55, 0xae,
+	0x22, 0x82, 0x9f, 0xa0, 0x5d, 0x9f, 0xca, 0xe0, 0x98, 0x81, 0xdd, 0x52, 0x7a, 0xc3, 0x7a, 0xbd,
+	0x99, 0x3a, 0x4e, 0x73, 0xb4, 0x2a, 0x55, 0x5c, 0xc6, 0xef, 0x50, 0x37, 0xa6, 0x20, 0xbd, 0x90,
+	0x83, 0xcc, 0xb8, 0x7f, 0x22, 0xb9, 0x48, 0xed, 0xad, 0x81, 0xf5, 0x7f, 0x87, 0x2f, 0x28, 0xc8,
+	0xc7, 0x15, 0xba, 0x2a, 0xdb, 0x89, 0x2f, 0x25, 0xf1, 0x47, 0xd4, 0x0d, 0x44, 0x1c, 0xb3, 0x40,
+	0xb2, 0xd0, 0x33, 0x2a, 0xf6, 0xb6, 0x72, 0x7c, 0xad, 0xd0, 0xcf, 0xf7, 0xe9, 0x9a, 0x7d, 0xba,
+	0x8f, 0x04, 0x4f, 0xa7, 0xf7, 0x73, 0xc9, 0xaf, 0xbf, 0x0e, 0xc6, 0x11, 0x97, 0xc7, 0x27, 0xbe,
+	0x1b, 0x88, 0x84, 0x98, 0xcd, 0xe9, 0xdf, 0x1d, 0x08, 0xdf, 0x13, 0x79, 0x36, 0x67, 0xa0, 0x2e,
+	0x80, 0x29, 0x5f, 0x96, 0xd2, 0x6d, 0xc3, 0xe8, 0x53, 0x13, 0x75, 0x2e, 0x8f, 0x14, 0xdf, 0x46,
+	0xdd, 0x72, 0x92, 0x1e, 0x0d, 0xc3, 0x8c, 0x81, 0x5e, 0xed, 0xde, 0xac, 0x53, 0x26, 0x1e, 0xea,
+	0x38, 0xbe, 0x81, 0xae, 0x6a, 0xdb, 0x25, 0xd9, 0x54, 0xe4, 0x15, 0x1d, 0x2d, 0xb0, 0x21, 0xda,
+	0x07, 0x1e, 0xa5, 0x2c, 0xf4, 0xe6, 0xe2, 0x94, 0x65, 0x76, 0x6b, 0x60, 0x8d, 0xb7, 0x66, 0x6d,
+	0x1d, 0x3b, 0xca, 0x43, 0xf8, 0x39, 0xda, 0x2d, 0x06, 0xb0, 0x71, 0xc0, 0xa5, 0x51, 0xd3, 0xc4,
+	0xda, 0xde, 0x0c, 0x87, 0xef, 0xa2, 0xde, 0xba, 0x2d, 0x2f, 0x15, 0x69, 0xc0, 0xec, 0x6d, 0x55,
+	0x17, 0xaf, 0x99, 0x7b, 0x99, 0x67, 0xa6, 0xcf, 0xce, 0x97, 0x8e, 0x75, 0xb1, 0x74, 0xac, 0xdf,
+	0x4b, 0xc7, 0xfa, 0xbc, 0x72, 0x1a, 0x17, 0x2b, 0xa7, 0xf1, 0x63, 0xe5, 0x34, 0xde, 0x92, 0x8d,
+	0x53, 0xfe, 0x50, 0x7d, 0x2c, 0x6a, 0xe4, 0xfe, 0x8e, 0x7a, 0x28, 0xf7, 0xfe, 0x0e, 0x00, 0x8f,
+	0xd6, 0xfb, 0xb5, 0xf4, 0x03, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollectedRewards) > 0 {
+		for iNdEx := len(m.CollectedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.LastDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardAddressNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RewardAddressNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.SignedPower != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.LastDistribution.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CollectedRewards) > 0 {
+		for _, e := range m.CollectedRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.SignedPower != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedPower))
+	}
+	l = m.Rewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.RewardAddressNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.RewardAddressNonce))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, GenesisValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, RewardBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectedRewards = append(m.CollectedRewards, types.Coin{})
+			if err := m.CollectedRewards[len(m.CollectedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPower", wireType)
+			}
+			m.SignedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddressNonce", wireType)
+			}
+			m.RewardAddressNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardAddressNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symrewards/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	claims := []types.RewardClaim{{ValidatorAddress: []byte{1}, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}}
+
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Validators:       []types.GenesisValidator{{ValidatorAddress: "a", SignedPower: 10}, {ValidatorAddress: "b"}},
+				Batches:          []types.RewardBatch{{Id: 0, Root: types.ClaimsRoot(claims), Claims: claims}},
+				CollectedRewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid collected rewards",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				CollectedRewards: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdkmath.NewInt(-1)}},
+			},
+		},
+		{
+			desc: "negative distribution interval",
+			genState: &types.GenesisState{
+				Params: types.Params{DistributionInterval: -1},
+			},
+		},
+		{
+			desc: "duplicate validator",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				Validators: []types.GenesisValidator{{ValidatorAddress: "a"}, {ValidatorAddress: "a"}},
+			},
+		},
+		{
+			desc: "batch root mismatch",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Batches: []types.RewardBatch{{Id: 0, Root: []byte{1}, Claims: claims}},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
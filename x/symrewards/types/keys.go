@@ -0,0 +1,39 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "symrewards"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	// It should be synced with the gov module's name if it is ever changed.
+	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
+	GovModuleName = "gov"
+)
+
+var (
+	// ParamsKey is the prefix to retrieve all Params
+	ParamsKey = collections.NewPrefix("p_symrewards")
+
+	// SignedPowerKey is the prefix to retrieve the power a validator signed blocks with since the last distribution
+	SignedPowerKey = collections.NewPrefix(1)
+	// ValidatorRewardsKey is the prefix to retrieve the rewards of a validator by consensus address
+	ValidatorRewardsKey = collections.NewPrefix(2)
+	// RewardAddressesKey is the prefix to retrieve the reward address of a validator by consensus address
+	RewardAddressesKey = collections.NewPrefix(3)
+	// RewardBatchesKey is the prefix to retrieve reward batches by id
+	RewardBatchesKey = collections.NewPrefix(4)
+	// RewardBatchSequenceKey is the key of the next reward batch id
+	RewardBatchSequenceKey = collections.NewPrefix(5)
+	// LastDistributionKey is the key of the last distribution
+	LastDistributionKey = collections.NewPrefix(6)
+	// RewardAddressNoncesKey is the prefix to retrieve the reward address registration nonce of a validator by
+	// consensus address
+	RewardAddressNoncesKey = collections.NewPrefix(7)
+	// CollectedRewardsKey is the prefix to retrieve the rewards collected since the last distribution by denom
+	CollectedRewardsKey = collections.NewPrefix(8)
+)
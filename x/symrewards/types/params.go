@@ -0,0 +1,30 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewParams creates a new Params instance.
+func NewParams() Params {
+	return Params{
+		DistributionInterval: 0, // at every symstaking epoch transition
+		PayoutMode:           PayoutMode_PAYOUT_MODE_WITHDRAW,
+		SigningKeyTag:        43, // the symstaking validator key tag, whose aggregation proofs are verified out of the box
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams()
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if p.DistributionInterval < 0 {
+		return errorsmod.Wrapf(ErrInvalidDistributionInterval, "distribution interval cannot be negative: %d", p.DistributionInterval)
+	}
+	if _, ok := PayoutMode_name[int32(p.PayoutMode)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidPayoutMode, "unknown payout mode %s", p.PayoutMode)
+	}
+	return nil
+}
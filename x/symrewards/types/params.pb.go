@@ -0,0 +1,448 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/symrewards/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PayoutMode defines how the rewards accrued by the validators are paid out.
+type PayoutMode int32
+
+const (
+	// PAYOUT_MODE_WITHDRAW lets the reward address registered for a validator withdraw its rewards on this chain.
+	PayoutMode_PAYOUT_MODE_WITHDRAW PayoutMode = 0
+	// PAYOUT_MODE_SETTLEMENT batches the cumulative rewards of all validators into a relay-signed merkle root claimed on
+	// the settlement chain. The rewards stay locked in the module account.
+	PayoutMode_PAYOUT_MODE_SETTLEMENT PayoutMode = 1
+)
+
+var PayoutMode_name = map[int32]string{
+	0: "PAYOUT_MODE_WITHDRAW",
+	1: "PAYOUT_MODE_SETTLEMENT",
+}
+
+var PayoutMode_value = map[string]int32{
+	"PAYOUT_MODE_WITHDRAW":   0,
+	"PAYOUT_MODE_SETTLEMENT": 1,
+}
+
+func (x PayoutMode) String() string {
+	return proto.EnumName(PayoutMode_name, int32(x))
+}
+
+func (PayoutMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_926ac33b1d90fb24, []int{0}
+}
+
+// Params defines the parameters for the module.
+type Params struct {
+	// distribution_interval defines the cosmos block interval at which the collected rewards are distributed. Zero
+	// distributes the rewards at every symstaking epoch transition instead.
+	DistributionInterval int64 `protobuf:"varint,1,opt,name=distribution_interval,json=distributionInterval,proto3" json:"distribution_interval,omitempty"`
+	// payout_mode defines how the rewards accrued by the validators are paid out
+	PayoutMode PayoutMode `protobuf:"varint,2,opt,name=payout_mode,json=payoutMode,proto3,enum=cosmos.symrewards.v1.PayoutMode" json:"payout_mode,omitempty"`
+	// signing_key_tag defines the key tag that will be used to sign the reward batches on relay. It must be the
+	// symstaking validator key tag unless the app sets a symstaking aggregation proof verifier for it.
+	SigningKeyTag uint32 `protobuf:"varint,3,opt,name=signing_key_tag,json=signingKeyTag,proto3" json:"signing_key_tag,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_926ac33b1d90fb24, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDistributionInterval() int64 {
+	if m != nil {
+		return m.DistributionInterval
+	}
+	return 0
+}
+
+func (m *Params) GetPayoutMode() PayoutMode {
+	if m != nil {
+		return m.PayoutMode
+	}
+	return PayoutMode_PAYOUT_MODE_WITHDRAW
+}
+
+func (m *Params) GetSigningKeyTag() uint32 {
+	if m != nil {
+		return m.SigningKeyTag
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.symrewards.v1.PayoutMode", PayoutMode_name, PayoutMode_value)
+	proto.RegisterType((*Params)(nil), "cosmos.symrewards.v1.Params")
+}
+
+func init() { proto.RegisterFile("cosmos/symrewards/v1/params.proto", fileDescriptor_926ac33b1d90fb24) }
+
+var fileDescriptor_926ac33b1d90fb24 = []byte{
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xb1, 0x4e, 0xc2, 0x40,
+	0x1c, 0xc6, 0x7b, 0x92, 0x30, 0x9c, 0x41, 0xb1, 0xa9, 0xa6, 0x61, 0xa8, 0xd5, 0xc1, 0x10, 0x12,
+	0x7b, 0x22, 0x93, 0x6e, 0x10, 0x9a, 0x48, 0x14, 0x21, 0xb5, 0x86, 0xe8, 0xd2, 0x1c, 0xf4, 0x72,
+	0x5e, 0xb0, 0xbd, 0xa6, 0x77, 0xa0, 0x7d, 0x05, 0x27, 0x1f, 0xc1, 0x47, 0xf0, 0x31, 0x1c, 0x19,
+	0x1d, 0x0d, 0x1d, 0xf4, 0x31, 0x8c, 0x2d, 0x11, 0x06, 0x07, 0x97, 0xbb, 0x2f, 0xff, 0xef, 0x97,
+	0xbb, 0xef, 0xff, 0xc1, 0xbd, 0x11, 0x17, 0x01, 0x17, 0x48, 0x24, 0x41, 0x4c, 0x1e, 0x70, 0xec,
+	0x0b, 0x34, 0xad, 0xa3, 0x08, 0xc7, 0x38, 0x10, 0x56, 0x14, 0x73, 0xc9, 0x55, 0x2d, 0x47, 0xac,
+	0x25, 0x62, 0x4d, 0xeb, 0x95, 0x2d, 0x1c, 0xb0, 0x90, 0xa3, 0xec, 0xcc, 0xc1, 0x8a, 0x46, 0x39,
+	0xe5, 0x99, 0x44, 0x3f, 0x2a, 0x9f, 0xee, 0xa7, 0x00, 0x16, 0xfb, 0xd9, 0x7b, 0x6a, 0x03, 0x6e,
+	0xfb, 0x4c, 0xc8, 0x98, 0x0d, 0x27, 0x92, 0xf1, 0xd0, 0x63, 0xa1, 0x24, 0xf1, 0x14, 0xdf, 0xeb,
+	0xc0, 0x04, 0xd5, 0x82, 0xa3, 0xad, 0x9a, 0x9d, 0x85, 0xa7, 0x36, 0xe1, 0x7a, 0x84, 0x13, 0x3e,
+	0x91, 0x5e, 0xc0, 0x7d, 0xa2, 0xaf, 0x99, 0xa0, 0xba, 0x71, 0x6c, 0x5a, 0x7f, 0x85, 0xb2, 0xfa,
+	0x19, 0xd8, 0xe5, 0x3e, 0x71, 0x60, 0xf4, 0xab, 0xd5, 0x03, 0xb8, 0x29, 0x18, 0x0d, 0x59, 0x48,
+	0xbd, 0x31, 0x49, 0x3c, 0x89, 0xa9, 0x5e, 0x30, 0x41, 0xb5, 0xe4, 0x94, 0x16, 0xe3, 0x73, 0x92,
+	0xb8, 0x98, 0x9e, 0x9e, 0x7c, 0xbd, 0xec, 0x82, 0xa7, 0xcf, 0xd7, 0xda, 0x11, 0x65, 0xf2, 0x6e,
+	0x32, 0xb4, 0x46, 0x3c, 0x40, 0x8b, 0x82, 0xf2, 0xeb, 0x50, 0xf8, 0x63, 0xf4, 0xb8, 0xda, 0x56,
+	0xbe, 0x5a, 0xad, 0x05, 0xe1, 0xf2, 0x73, 0x55, 0x87, 0x5a, 0xbf, 0x79, 0xd3, 0xbb, 0x76, 0xbd,
+	0x6e, 0xaf, 0x6d, 0x7b, 0x83, 0x8e, 0x7b, 0xd6, 0x76, 0x9a, 0x83, 0xb2, 0xa2, 0x56, 0xe0, 0xce,
+	0xaa, 0x73, 0x65, 0xbb, 0xee, 0x85, 0xdd, 0xb5, 0x2f, 0xdd, 0x32, 0x68, 0x75, 0xde, 0xe6, 0x06,
+	0x98, 0xcd, 0x0d, 0xf0, 0x31, 0x37, 0xc0, 0x73, 0x6a, 0x28, 0xb3, 0xd4, 0x50, 0xde, 0x53, 0x43,
+	0xb9, 0x45, 0xff, 0xcf, 0x23, 0x93, 0x88, 0x88, 0x61, 0x31, 0xeb, 0xbe, 0xf1, 0x3d, 0x00, 0xe8,
+	0x27, 0xa1, 0xd7, 0xdf, 0x01, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DistributionInterval != that1.DistributionInterval {
+		return false
+	}
+	if this.PayoutMode != that1.PayoutMode {
+		return false
+	}
+	if this.SigningKeyTag != that1.SigningKeyTag {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SigningKeyTag != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SigningKeyTag))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PayoutMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PayoutMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DistributionInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DistributionInterval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionInterval != 0 {
+		n += 1 + sovParams(uint64(m.DistributionInterval))
+	}
+	if m.PayoutMode != 0 {
+		n += 1 + sovParams(uint64(m.PayoutMode))
+	}
+	if m.SigningKeyTag != 0 {
+		n += 1 + sovParams(uint64(m.SigningKeyTag))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionInterval", wireType)
+			}
+			m.DistributionInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutMode", wireType)
+			}
+			m.PayoutMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutMode |= PayoutMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeyTag", wireType)
+			}
+			m.SigningKeyTag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningKeyTag |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	symstakingtypes "github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+const (
+	// RewardAddressMessageType is the typed message type signed by a validator consensus key to register its reward
+	// address.
+	RewardAddressMessageType = "set_reward_address"
+	// RewardBatchMessageType is the typed message type of the relay signature requests over reward batches.
+	RewardBatchMessageType = "reward_batch"
+)
+
+// RewardAddressSignBytes returns the bytes a validator consensus key signs to register rewardAddress as the account
+// withdrawing its rewards on the given chain, with the nonce of the registration:
+// nonce | reward address
+// where nonce is a big endian uint64.
+func RewardAddressSignBytes(chainID, rewardAddress string, nonce uint64) []byte {
+	return symstakingtypes.TypedMessage{
+		Module:  ModuleName,
+		Type:    RewardAddressMessageType,
+		Payload: append(binary.BigEndian.AppendUint64(nil, nonce), rewardAddress...),
+	}.SignBytes(chainID)
+}
+
+// AllocateRewards splits rewards between validators proportionally to the power they signed blocks with. Amounts are
+// truncated, so the allocated rewards never exceed rewards.
+func AllocateRewards(rewards sdk.DecCoins, signedPowers []uint64) []sdk.DecCoins {
+	total := math.ZeroInt()
+	for _, power := range signedPowers {
+		total = total.Add(math.NewIntFromUint64(power))
+	}
+
+	allocated := make([]sdk.DecCoins, len(signedPowers))
+	if total.IsZero() {
+		return allocated
+	}
+	for i, power := range signedPowers {
+		fraction := math.LegacyNewDecFromInt(math.NewIntFromUint64(power)).QuoTruncate(math.LegacyNewDecFromInt(total))
+		allocated[i] = rewards.MulDecTruncate(fraction)
+	}
+	return allocated
+}
+
+// Bytes returns the merkle leaf of the claim:
+// len(validator address) | validator address | number of coins | (len(denom) | denom | amount)...
+// where lengths are big endian uint32 and amounts are big endian uint256.
+func (c RewardClaim) Bytes() []byte {
+	bz := binary.BigEndian.AppendUint32(nil, uint32(len(c.ValidatorAddress)))
+	bz = append(bz, c.ValidatorAddress...)
+	bz = binary.BigEndian.AppendUint32(bz, uint32(len(c.Amount)))
+	for _, coin := range c.Amount {
+		bz = binary.BigEndian.AppendUint32(bz, uint32(len(coin.Denom)))
+		bz = append(bz, coin.Denom...)
+		bz = append(bz, coin.Amount.BigInt().FillBytes(make([]byte, 32))...)
+	}
+	return bz
+}
+
+// ClaimsRoot returns the RFC 6962 merkle root of the claims.
+func ClaimsRoot(claims []RewardClaim) []byte {
+	return merkle.HashFromByteSlices(claimLeaves(claims))
+}
+
+// ClaimProof returns the RFC 6962 merkle proof of the claim at index i.
+func ClaimProof(claims []RewardClaim, i int) *merkle.Proof {
+	_, proofs := merkle.ProofsFromByteSlices(claimLeaves(claims))
+	return proofs[i]
+}
+
+func claimLeaves(claims []RewardClaim) [][]byte {
+	leaves := make([][]byte, len(claims))
+	for i, claim := range claims {
+		leaves[i] = claim.Bytes()
+	}
+	return leaves
+}
+
+// Payload returns the bytes signed by the relay for the batch:
+// id | epoch | root
+// where id and epoch are big endian uint64.
+func (b RewardBatch) Payload() []byte {
+	bz := binary.BigEndian.AppendUint64(nil, b.Id)
+	bz = binary.BigEndian.AppendUint64(bz, b.Epoch)
+	return append(bz, b.Root...)
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symrewards/types"
+)
+
+func TestAllocateRewards(t *testing.T) {
+	rewards := sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 100), sdk.NewInt64DecCoin("atom", 7))
+
+	allocated := types.AllocateRewards(rewards, []uint64{3, 1, 0})
+	require.Len(t, allocated, 3)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 75), sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("5.25"))), allocated[0])
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 25), sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("1.75"))), allocated[1])
+	require.True(t, allocated[2].IsZero())
+
+	// truncation never allocates more than the rewards
+	allocated = types.AllocateRewards(rewards, []uint64{1, 1, 1})
+	total := sdk.DecCoins{}
+	for _, coins := range allocated {
+		total = total.Add(coins...)
+	}
+	_, hasNeg := rewards.SafeSub(total)
+	require.False(t, hasNeg)
+
+	allocated = types.AllocateRewards(rewards, []uint64{0, 0})
+	require.True(t, allocated[0].IsZero())
+	require.True(t, allocated[1].IsZero())
+}
+
+func TestClaimProof(t *testing.T) {
+	claims := []types.RewardClaim{
+		{ValidatorAddress: []byte{1}, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+		{ValidatorAddress: []byte{2}, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("stake", 20))},
+		{ValidatorAddress: []byte{3}, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 30))},
+	}
+	root := types.ClaimsRoot(claims)
+	require.Len(t, root, 32)
+
+	for i, claim := range claims {
+		proof := types.ClaimProof(claims, i)
+		require.NoError(t, proof.Verify(root, claim.Bytes()))
+	}
+
+	// a claim with a different amount is not part of the tree
+	forged := claims[0]
+	forged.Amount = sdk.NewCoins(sdk.NewInt64Coin("stake", 11))
+	require.Error(t, types.ClaimProof(claims, 0).Verify(root, forged.Bytes()))
+}
+
+func TestRewardBatch_Payload(t *testing.T) {
+	batch := types.RewardBatch{Id: 1, Epoch: 2, Root: []byte{0xaa, 0xbb}}
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2, 0xaa, 0xbb}, batch.Payload())
+}
+
+func TestRewardAddressSignBytes(t *testing.T) {
+	bz := types.RewardAddressSignBytes("chain-1", "cosmos1reward", 0)
+	require.NotEqual(t, bz, types.RewardAddressSignBytes("chain-1", "cosmos1other", 0))
+	require.NotEqual(t, bz, types.RewardAddressSignBytes("chain-2", "cosmos1reward", 0))
+	require.NotEqual(t, bz, types.RewardAddressSignBytes("chain-1", "cosmos1reward", 1))
+}
@@ -19,6 +19,13 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	// Iterate over all the validators which *should* have signed this block
 	// store whether or not they have actually signed it and slash/unbond any
 	// which have missed too many blocks in a row (downtime slashing)
+	// the liveness is not tracked while the downtime feature is tripped in the
+	// circuit breaker
+	allowed, err := k.IsFeatureAllowed(ctx, types.FeatureDowntime)
+	if err != nil || !allowed {
+		return err
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	for _, voteInfo := range sdkCtx.VoteInfos() {
 		err := k.HandleValidatorSignature(ctx, voteInfo.Validator.Address, voteInfo.Validator.Power, comet.BlockIDFlag(voteInfo.BlockIdFlag))
@@ -34,6 +34,42 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "signing-infos",
 					Short:     "Query signing information of all validators",
 				},
+				{
+					RpcMethod: "MissedBlocks",
+					Use:       "missed-blocks [validator-conspub/address]",
+					Short:     "Query the signed blocks window of a validator",
+					Long:      "Query the blocks of a validator's signed blocks window with their heights and missed status, with a pubkey ('<appd> comet show-validator') or a validator consensus address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cons_address"},
+					},
+				},
+				{
+					RpcMethod: "LivenessReport",
+					Use:       "liveness-report",
+					Short:     "Query validators ranked by missed blocks with their margin before a downtime slash",
+				},
+				{
+					RpcMethod: "CooldownState",
+					Use:       "cooldown-state [validator-conspub/address]",
+					Short:     "Query a validator's grace period and slashing cooldowns",
+					Long:      "Query a validator's grace period and slashing cooldowns, with a pubkey ('<appd> comet show-validator') or a validator consensus address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cons_address"},
+					},
+				},
+				{
+					RpcMethod: "CooldownStates",
+					Use:       "cooldown-states",
+					Short:     "Query the grace period and slashing cooldowns of all validators",
+				},
+				{
+					RpcMethod: "EpochLiveness",
+					Use:       "epoch-liveness [epoch]",
+					Short:     "Query the liveness summary of an evaluated symstaking epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "epoch"},
+					},
+				},
 			},
 		},
 	}
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symslashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+// GetSlashCooldown returns the last slash of a validator for an infraction. If
+// not found it returns ErrNoSlashCooldownFound.
+func (k Keeper) GetSlashCooldown(ctx context.Context, addr sdk.ConsAddress, infraction stakingtypes.Infraction) (types.SlashCooldown, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	var cooldown types.SlashCooldown
+	bz, err := store.Get(types.SlashCooldownKey(addr, infraction))
+	if err != nil {
+		return cooldown, err
+	}
+
+	if bz == nil {
+		return cooldown, types.ErrNoSlashCooldownFound
+	}
+
+	err = k.cdc.Unmarshal(bz, &cooldown)
+	return cooldown, err
+}
+
+// SetSlashCooldown sets the last slash of a validator for an infraction.
+func (k Keeper) SetSlashCooldown(ctx context.Context, addr sdk.ConsAddress, cooldown types.SlashCooldown) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&cooldown)
+	if err != nil {
+		return err
+	}
+	return store.Set(types.SlashCooldownKey(addr, cooldown.Infraction), bz)
+}
+
+// GetSlashCooldowns returns the last slashes of a validator per infraction.
+func (k Keeper) GetSlashCooldowns(ctx context.Context, addr sdk.ConsAddress) ([]types.SlashCooldown, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := types.SlashCooldownPrefixKey(addr)
+	iter, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var cooldowns []types.SlashCooldown
+	for ; iter.Valid(); iter.Next() {
+		var cooldown types.SlashCooldown
+		if err := k.cdc.Unmarshal(iter.Value(), &cooldown); err != nil {
+			return nil, err
+		}
+		cooldowns = append(cooldowns, cooldown)
+	}
+
+	return cooldowns, nil
+}
+
+// IterateSlashCooldowns iterates over the stored SlashCooldown of all validators.
+func (k Keeper) IterateSlashCooldowns(ctx context.Context, handler func(cooldown types.SlashCooldown) (stop bool)) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(types.SlashCooldownKeyPrefix, storetypes.PrefixEndBytes(types.SlashCooldownKeyPrefix))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var cooldown types.SlashCooldown
+		if err := k.cdc.Unmarshal(iter.Value(), &cooldown); err != nil {
+			return err
+		}
+		if handler(cooldown) {
+			break
+		}
+	}
+	return nil
+}
+
+// startSlashCooldown records a slash of a validator for an infraction at the
+// current height, from which the cooldown params apply.
+func (k Keeper) startSlashCooldown(ctx context.Context, addr sdk.ConsAddress, infraction stakingtypes.Infraction) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	epoch, err := k.sk.GetCurrentEpoch(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the current epoch")
+	}
+
+	return k.SetSlashCooldown(ctx, addr, types.SlashCooldown{
+		Address:     addr.String(),
+		Infraction:  infraction,
+		SlashHeight: sdkCtx.BlockHeight(),
+		SlashEpoch:  epoch.Epoch,
+		UntilHeight: sdkCtx.BlockHeight() + params.SlashCooldownBlocks,
+		UntilTime:   sdkCtx.BlockTime().Add(params.SlashCooldownDuration),
+	})
+}
+
+// GetSlashCooldownStatus returns whether the cooldown params still apply to a
+// slash and whether repeat downtime slashes are suppressed until the next
+// Symbiotic epoch.
+func (k Keeper) GetSlashCooldownStatus(ctx context.Context, cooldown types.SlashCooldown) (types.SlashCooldownStatus, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	status := types.SlashCooldownStatus{
+		Cooldown: cooldown,
+		Active:   sdkCtx.BlockHeight() < cooldown.UntilHeight || sdkCtx.BlockTime().Before(cooldown.UntilTime),
+	}
+
+	if cooldown.Infraction == stakingtypes.Infraction_INFRACTION_DOWNTIME {
+		epoch, err := k.sk.GetCurrentEpoch(ctx)
+		if err != nil {
+			return status, errors.Wrap(err, "failed to get the current epoch")
+		}
+		// the validator stays in the set until the next epoch, so a repeat downtime
+		// slash within the same epoch would only submit a new request for the same fault
+		status.EpochSuppressed = cooldown.SlashEpoch == epoch.Epoch
+	}
+
+	return status, nil
+}
+
+// IsSlashSuppressed returns true if a validator must not be slashed for an
+// infraction because of its last slash for that infraction, or because the
+// downtime feature is tripped in the circuit breaker for downtime infractions.
+func (k Keeper) IsSlashSuppressed(ctx context.Context, addr sdk.ConsAddress, infraction stakingtypes.Infraction) (bool, error) {
+	if infraction == stakingtypes.Infraction_INFRACTION_DOWNTIME {
+		allowed, err := k.IsFeatureAllowed(ctx, types.FeatureDowntime)
+		if err != nil || !allowed {
+			return true, err
+		}
+	}
+
+	cooldown, err := k.GetSlashCooldown(ctx, addr, infraction)
+	if errors.IsOf(err, types.ErrNoSlashCooldownFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	status, err := k.GetSlashCooldownStatus(ctx, cooldown)
+	if err != nil {
+		return false, err
+	}
+	return status.Active || status.EpochSuppressed, nil
+}
+
+// GetValidatorCooldownState returns the grace period and the slash cooldowns of
+// a validator.
+func (k Keeper) GetValidatorCooldownState(ctx context.Context, addr sdk.ConsAddress, info types.ValidatorSigningInfo) (types.ValidatorCooldownState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.ValidatorCooldownState{}, err
+	}
+
+	graceEnd := info.StartHeight + params.DowntimeGracePeriod
+	state := types.ValidatorCooldownState{
+		Address:              info.Address,
+		InGracePeriod:        sdk.UnwrapSDKContext(ctx).BlockHeight() <= graceEnd,
+		GracePeriodEndHeight: graceEnd,
+		Cooldowns:            []types.SlashCooldownStatus{},
+	}
+
+	cooldowns, err := k.GetSlashCooldowns(ctx, addr)
+	if err != nil {
+		return state, err
+	}
+	for _, cooldown := range cooldowns {
+		status, err := k.GetSlashCooldownStatus(ctx, cooldown)
+		if err != nil {
+			return state, err
+		}
+		state.Cooldowns = append(state.Cooldowns, status)
+	}
+
+	return state, nil
+}
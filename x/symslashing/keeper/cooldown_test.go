@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/symslashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func (s *KeeperTestSuite) TestSlashCooldown() {
+	ctx, keeper := s.ctx.WithBlockHeight(100), s.slashingKeeper
+	require := s.Require()
+
+	suppressed, err := keeper.IsSlashSuppressed(ctx, consAddr, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	require.NoError(err)
+	require.False(suppressed)
+
+	// a double sign in a past epoch is suppressed until both the height and the time cooldowns ended
+	require.NoError(keeper.SetSlashCooldown(ctx, consAddr, slashingtypes.SlashCooldown{
+		Address:     consAddr.String(),
+		Infraction:  stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+		SlashHeight: 90,
+		SlashEpoch:  0,
+		UntilHeight: 110,
+		UntilTime:   ctx.BlockTime().Add(time.Minute),
+	}))
+	suppressed, err = keeper.IsSlashSuppressed(ctx, consAddr, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	require.NoError(err)
+	require.True(suppressed)
+
+	ctx = ctx.WithBlockHeight(110)
+	suppressed, err = keeper.IsSlashSuppressed(ctx, consAddr, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	require.NoError(err)
+	require.True(suppressed)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	suppressed, err = keeper.IsSlashSuppressed(ctx, consAddr, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	require.NoError(err)
+	require.False(suppressed)
+
+	// cooldowns are tracked per infraction
+	suppressed, err = keeper.IsSlashSuppressed(ctx, consAddr, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	require.NoError(err)
+	require.False(suppressed)
+
+	// a downtime slash without cooldown params is suppressed until the next epoch
+	require.NoError(keeper.SetSlashCooldown(ctx, consAddr, slashingtypes.SlashCooldown{
+		Address:     consAddr.String(),
+		Infraction:  stakingtypes.Infraction_INFRACTION_DOWNTIME,
+		SlashHeight: 110,
+		SlashEpoch:  s.epoch,
+		UntilHeight: 110,
+		UntilTime:   ctx.BlockTime(),
+	}))
+	suppressed, err = keeper.IsSlashSuppressed(ctx, consAddr, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	require.NoError(err)
+	require.True(suppressed)
+
+	s.epoch++
+	suppressed, err = keeper.IsSlashSuppressed(ctx, consAddr, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	require.NoError(err)
+	require.False(suppressed)
+}
+
+func (s *KeeperTestSuite) TestMinDowntimeSlashHeight() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	info := slashingtypes.NewValidatorSigningInfo(consAddr, 10, 0, 0)
+	minHeight, err := keeper.MinDowntimeSlashHeight(ctx, info)
+	require.NoError(err)
+	require.Equal(int64(1010), minHeight)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.DowntimeGracePeriod = 5000
+	require.NoError(keeper.SetParams(ctx, params))
+
+	minHeight, err = keeper.MinDowntimeSlashHeight(ctx, info)
+	require.NoError(err)
+	require.Equal(int64(5010), minHeight)
+}
+
+func (s *KeeperTestSuite) TestGRPCCooldownState() {
+	queryClient, ctx, keeper := s.queryClient, s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, err := queryClient.CooldownState(gocontext.Background(), &slashingtypes.QueryCooldownStateRequest{ConsAddress: ""})
+	require.ErrorContains(err, "invalid request")
+
+	_, err = queryClient.CooldownState(gocontext.Background(), &slashingtypes.QueryCooldownStateRequest{ConsAddress: consAddr.String()})
+	require.ErrorContains(err, "SigningInfo not found")
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.DowntimeGracePeriod = 100
+	require.NoError(keeper.SetParams(ctx, params))
+
+	require.NoError(keeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, ctx.BlockHeight(), 0, 0)))
+	cooldown := slashingtypes.SlashCooldown{
+		Address:     consAddr.String(),
+		Infraction:  stakingtypes.Infraction_INFRACTION_DOWNTIME,
+		SlashHeight: ctx.BlockHeight(),
+		SlashEpoch:  s.epoch,
+		UntilHeight: ctx.BlockHeight(),
+		UntilTime:   ctx.BlockTime().UTC(),
+	}
+	require.NoError(keeper.SetSlashCooldown(ctx, consAddr, cooldown))
+
+	resp, err := queryClient.CooldownState(gocontext.Background(), &slashingtypes.QueryCooldownStateRequest{ConsAddress: consAddr.String()})
+	require.NoError(err)
+	require.Equal(slashingtypes.ValidatorCooldownState{
+		Address:              consAddr.String(),
+		InGracePeriod:        true,
+		GracePeriodEndHeight: ctx.BlockHeight() + 100,
+		Cooldowns: []slashingtypes.SlashCooldownStatus{
+			{Cooldown: cooldown, Active: false, EpochSuppressed: true},
+		},
+	}, resp.State)
+
+	consAddr2 := sdk.ConsAddress("addr2_______________")
+	require.NoError(keeper.SetValidatorSigningInfo(ctx, consAddr2, slashingtypes.NewValidatorSigningInfo(consAddr2, ctx.BlockHeight(), 0, 0)))
+
+	statesResp, err := queryClient.CooldownStates(gocontext.Background(), &slashingtypes.QueryCooldownStatesRequest{})
+	require.NoError(err)
+	require.Len(statesResp.States, 2)
+	for _, state := range statesResp.States {
+		if state.Address == consAddr2.String() {
+			require.Empty(state.Cooldowns)
+		} else {
+			require.Len(state.Cooldowns, 1)
+		}
+	}
+}
+
+// circuitBreaker is a fake circuit breaker tripping the features of its set.
+type circuitBreaker struct {
+	features map[string]bool
+}
+
+func (cb *circuitBreaker) RegisterFeatures(features ...string) {
+	for _, feature := range features {
+		cb.features[feature] = false
+	}
+}
+
+func (cb *circuitBreaker) IsFeatureAllowed(_ gocontext.Context, feature string) (bool, error) {
+	return !cb.features[feature], nil
+}
+
+func (s *KeeperTestSuite) TestDowntimeCircuitBreaker() {
+	require := s.Require()
+
+	cb := &circuitBreaker{features: map[string]bool{}}
+	s.slashingKeeper.SetCircuitBreaker(cb)
+	require.Contains(cb.features, slashingtypes.FeatureDowntime)
+	ctx, keeper := s.ctx, s.slashingKeeper
+
+	// the downtime slashes are suppressed while the feature is tripped, the other infractions are not
+	cb.features[slashingtypes.FeatureDowntime] = true
+	suppressed, err := keeper.IsSlashSuppressed(ctx, consAddr, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	require.NoError(err)
+	require.True(suppressed)
+
+	suppressed, err = keeper.IsSlashSuppressed(ctx, consAddr, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	require.NoError(err)
+	require.False(suppressed)
+
+	cb.features[slashingtypes.FeatureDowntime] = false
+	suppressed, err = keeper.IsSlashSuppressed(ctx, consAddr, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	require.NoError(err)
+	require.False(suppressed)
+}
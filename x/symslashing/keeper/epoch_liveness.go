@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symslashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+// GetEpochStartHeight returns the first height of the current epoch.
+func (k Keeper) GetEpochStartHeight(ctx context.Context) (int64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.EpochStartHeightKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// SetEpochStartHeight sets the first height of the current epoch.
+func (k Keeper) SetEpochStartHeight(ctx context.Context, height int64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return store.Set(types.EpochStartHeightKey, bz)
+}
+
+// GetEpochLiveness returns the liveness summary of an evaluated epoch. If not
+// found it returns ErrNoEpochLivenessFound.
+func (k Keeper) GetEpochLiveness(ctx context.Context, epoch uint64) (types.EpochLiveness, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	var liveness types.EpochLiveness
+	bz, err := store.Get(types.EpochLivenessKey(epoch))
+	if err != nil {
+		return liveness, err
+	}
+
+	if bz == nil {
+		return liveness, types.ErrNoEpochLivenessFound
+	}
+
+	err = k.cdc.Unmarshal(bz, &liveness)
+	return liveness, err
+}
+
+// SetEpochLiveness sets the liveness summary of an evaluated epoch.
+func (k Keeper) SetEpochLiveness(ctx context.Context, liveness types.EpochLiveness) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&liveness)
+	if err != nil {
+		return err
+	}
+	return store.Set(types.EpochLivenessKey(liveness.Epoch), bz)
+}
+
+// IterateEpochLiveness iterates over the liveness summaries of the evaluated
+// epochs, oldest first.
+func (k Keeper) IterateEpochLiveness(ctx context.Context, handler func(liveness types.EpochLiveness) (stop bool)) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(types.EpochLivenessKeyPrefix, storetypes.PrefixEndBytes(types.EpochLivenessKeyPrefix))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var liveness types.EpochLiveness
+		if err := k.cdc.Unmarshal(iter.Value(), &liveness); err != nil {
+			return err
+		}
+		if handler(liveness) {
+			break
+		}
+	}
+	return nil
+}
+
+// EvaluateEpochLiveness is called from the symstaking EndBlock of the last block
+// of the previous epoch, when the validator set of the new epoch is applied and
+// the last validator set is still the one of the previous epoch.
+// In the epoch liveness window mode, the window of every validator of the
+// previous epoch is evaluated against MinSignedPerWindow, the validators that
+// missed too many blocks are slashed, and all windows are reset for the new
+// epoch.
+func (k Keeper) EvaluateEpochLiveness(ctx context.Context, previousEpoch uint64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	startHeight, err := k.GetEpochStartHeight(ctx)
+	if err != nil {
+		return err
+	}
+	// the current block is the last one of the previous epoch, the new epoch
+	// starts at the next block
+	if err := k.SetEpochStartHeight(ctx, height+1); err != nil {
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.LivenessWindowMode != types.LivenessWindowMode_LIVENESS_WINDOW_MODE_EPOCH {
+		return nil
+	}
+
+	summary := types.EpochLiveness{
+		Epoch:       previousEpoch,
+		StartHeight: startHeight,
+		EndHeight:   height,
+		Validators:  []types.ValidatorEpochLiveness{},
+	}
+
+	var evalErr error
+	err = k.sk.IterateValidators(ctx, func(_ int64, validator abci.ValidatorUpdate) (stop bool) {
+		liveness, err := k.evaluateValidatorLiveness(ctx, params, validator)
+		if err != nil {
+			evalErr = err
+			return true
+		}
+		if liveness != nil {
+			summary.Validators = append(summary.Validators, *liveness)
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	if evalErr != nil {
+		return evalErr
+	}
+
+	if err := k.SetEpochLiveness(ctx, summary); err != nil {
+		return err
+	}
+
+	// reset the windows, so the validators of the new epoch are only measured
+	// against the blocks in which they are active
+	var addrs []sdk.ConsAddress
+	if err := k.IterateValidatorSigningInfos(ctx, func(addr sdk.ConsAddress, _ types.ValidatorSigningInfo) (stop bool) {
+		addrs = append(addrs, addr)
+		return false
+	}); err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		info, err := k.GetValidatorSigningInfo(ctx, addr)
+		if err != nil {
+			return err
+		}
+		info.IndexOffset = 0
+		info.MissedBlocksCounter = 0
+		if err := k.DeleteMissedBlockBitmap(ctx, addr); err != nil {
+			return err
+		}
+		if err := k.SetValidatorSigningInfo(ctx, addr, info); err != nil {
+			return err
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochLiveness,
+			sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", previousEpoch)),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", height)),
+		),
+	)
+
+	return nil
+}
+
+// evaluateValidatorLiveness evaluates the window of a validator of the previous
+// epoch and slashes it if it missed too many blocks. It returns nil if the
+// validator has no signing info.
+func (k Keeper) evaluateValidatorLiveness(ctx context.Context, params types.Params, validator abci.ValidatorUpdate) (*types.ValidatorEpochLiveness, error) {
+	pk := &ed25519.PubKey{Key: validator.PubKey.GetEd25519()}
+	consAddr := sdk.ConsAddress(pk.Address())
+
+	info, err := k.GetValidatorSigningInfo(ctx, consAddr)
+	if errors.IsOf(err, types.ErrNoSigningInfoFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := min(info.IndexOffset, params.SignedBlocksWindow)
+	maxMissed, minHeight, err := k.DowntimeThreshold(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+	liveness := &types.ValidatorEpochLiveness{
+		Address:      consAddr.String(),
+		Power:        validator.Power,
+		Blocks:       blocks,
+		MissedBlocks: info.MissedBlocksCounter,
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if blocks == 0 || info.MissedBlocksCounter <= maxMissed || height <= minHeight {
+		return liveness, nil
+	}
+
+	suppressed, err := k.IsSlashSuppressed(ctx, consAddr, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	if err != nil || suppressed {
+		return liveness, err
+	}
+
+	liveness.SlashRequestId, err = k.slashDowntime(ctx, consAddr, pk, validator.Power)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info(
+		"slashing validator due to epoch liveness fault",
+		"height", height,
+		"validator", consAddr.String(),
+		"blocks", blocks,
+		"missed", info.MissedBlocksCounter,
+		"slashed", params.SlashFractionDowntime.String(),
+	)
+
+	return liveness, nil
+}
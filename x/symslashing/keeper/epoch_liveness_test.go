@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"go.uber.org/mock/gomock"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/symslashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func (s *KeeperTestSuite) TestEvaluateEpochLiveness() {
+	ctx, keeper := s.ctx.WithBlockHeight(100), s.slashingKeeper
+	require := s.Require()
+
+	pk1, pk2 := ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()
+	addr1, addr2 := sdk.ConsAddress(pk1.Address()), sdk.ConsAddress(pk2.Address())
+	s.stakingKeeper.EXPECT().IterateValidators(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ gocontext.Context, fn func(int64, abci.ValidatorUpdate) bool) error {
+			for i, pk := range []*ed25519.PubKey{pk1.(*ed25519.PubKey), pk2.(*ed25519.PubKey)} {
+				fn(int64(i), abci.ValidatorUpdate{
+					PubKey: cmtcrypto.PublicKey{Sum: &cmtcrypto.PublicKey_Ed25519{Ed25519: pk.Key}},
+					Power:  10,
+				})
+			}
+			return nil
+		}).AnyTimes()
+
+	// the sliding window mode only records the start of the epoch, the hook is
+	// called at the last block of the previous epoch
+	require.NoError(keeper.Hooks().AfterEpochChanged(ctx, 0, 1))
+	startHeight, err := keeper.GetEpochStartHeight(ctx)
+	require.NoError(err)
+	require.Equal(int64(101), startHeight)
+	_, err = keeper.GetEpochLiveness(ctx, 0)
+	require.ErrorIs(err, slashingtypes.ErrNoEpochLivenessFound)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.LivenessWindowMode = slashingtypes.LivenessWindowMode_LIVENESS_WINDOW_MODE_EPOCH
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// validator 1 missed more than half of the epoch, validator 2 did not
+	require.NoError(keeper.SetValidatorSigningInfo(ctx, addr1, slashingtypes.NewValidatorSigningInfo(addr1, 0, 10, 6)))
+	require.NoError(keeper.SetValidatorSigningInfo(ctx, addr2, slashingtypes.NewValidatorSigningInfo(addr2, 0, 10, 2)))
+	require.NoError(keeper.SetMissedBlockBitmapValue(ctx, addr1, 0, true))
+
+	ctx = ctx.WithBlockHeight(110)
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(
+		gomock.Any(), pk1.Bytes(), int64(110-sdk.ValidatorUpdateDelay-1), int64(10), params.SlashFractionDowntime, stakingtypes.Infraction_INFRACTION_DOWNTIME,
+	).Return("request-1", nil)
+
+	require.NoError(keeper.Hooks().AfterEpochChanged(ctx, 1, 2))
+
+	liveness, err := keeper.GetEpochLiveness(ctx, 1)
+	require.NoError(err)
+	require.Equal(slashingtypes.EpochLiveness{
+		Epoch:       1,
+		StartHeight: 101,
+		EndHeight:   110,
+		Validators: []slashingtypes.ValidatorEpochLiveness{
+			{Address: addr1.String(), Power: 10, Blocks: 10, MissedBlocks: 6, SlashRequestId: "request-1"},
+			{Address: addr2.String(), Power: 10, Blocks: 10, MissedBlocks: 2},
+		},
+	}, liveness)
+
+	// the new epoch starts at the block following the last block of epoch 1
+	startHeight, err = keeper.GetEpochStartHeight(ctx)
+	require.NoError(err)
+	require.Equal(int64(111), startHeight)
+
+	// the windows are reset for the new epoch
+	for _, addr := range []sdk.ConsAddress{addr1, addr2} {
+		info, err := keeper.GetValidatorSigningInfo(ctx, addr)
+		require.NoError(err)
+		require.Zero(info.IndexOffset)
+		require.Zero(info.MissedBlocksCounter)
+	}
+	missed, err := keeper.GetMissedBlockBitmapValue(ctx, addr1, 0)
+	require.NoError(err)
+	require.False(missed)
+
+	resp, err := s.queryClient.EpochLiveness(gocontext.Background(), &slashingtypes.QueryEpochLivenessRequest{Epoch: 1})
+	require.NoError(err)
+	require.Equal(liveness, resp.Liveness)
+
+	_, err = s.queryClient.EpochLiveness(gocontext.Background(), &slashingtypes.QueryEpochLivenessRequest{Epoch: 2})
+	require.ErrorContains(err, "EpochLiveness not found")
+}
@@ -57,6 +57,26 @@ func (k Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKeeper,
 		}
 	}
 
+	for _, cooldown := range data.SlashCooldowns {
+		address, err := k.sk.ConsensusAddressCodec().StringToBytes(cooldown.Address)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.SetSlashCooldown(ctx, address, cooldown); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, liveness := range data.EpochLiveness {
+		if err := k.SetEpochLiveness(ctx, liveness); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.SetEpochStartHeight(ctx, data.EpochStartHeight); err != nil {
+		panic(err)
+	}
+
 	if err := k.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
@@ -95,5 +115,32 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	slashCooldowns := make([]types.SlashCooldown, 0)
+	err = k.IterateSlashCooldowns(ctx, func(cooldown types.SlashCooldown) (stop bool) {
+		slashCooldowns = append(slashCooldowns, cooldown)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	epochLiveness := make([]types.EpochLiveness, 0)
+	err = k.IterateEpochLiveness(ctx, func(liveness types.EpochLiveness) (stop bool) {
+		epochLiveness = append(epochLiveness, liveness)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	epochStartHeight, err := k.GetEpochStartHeight(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis := types.NewGenesisState(params, signingInfos, missedBlocks)
+	genesis.SlashCooldowns = slashCooldowns
+	genesis.EpochLiveness = epochLiveness
+	genesis.EpochStartHeight = epochStartHeight
+	return genesis
 }
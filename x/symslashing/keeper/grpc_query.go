@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"log"
+	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -10,6 +11,7 @@ import (
 	"cosmossdk.io/store/prefix"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/symslashing/types"
 )
@@ -83,3 +85,185 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// MissedBlocks returns the signed blocks window of a specific validator.
+func (k Keeper) MissedBlocks(ctx context.Context, req *types.QueryMissedBlocksRequest) (*types.QueryMissedBlocksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	signingInfo, err := k.GetValidatorSigningInfo(ctx, consAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	window, err := k.SignedBlocksWindow(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := k.GetWindowBlocks(ctx, consAddr, signingInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.MissedOnly {
+		missed := blocks[:0]
+		for _, block := range blocks {
+			if block.Missed {
+				missed = append(missed, block)
+			}
+		}
+		blocks = missed
+	}
+
+	return &types.QueryMissedBlocksResponse{
+		SignedBlocksWindow:  window,
+		MissedBlocksCounter: signingInfo.MissedBlocksCounter,
+		Blocks:              blocks,
+	}, nil
+}
+
+// LivenessReport returns the validators ranked by missed blocks, with their
+// remaining margin before being slashed for downtime.
+func (k Keeper) LivenessReport(ctx context.Context, req *types.QueryLivenessReportRequest) (*types.QueryLivenessReportResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	window, err := k.SignedBlocksWindow(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	minSignedPerWindow, err := k.MinSignedPerWindow(ctx)
+	if err != nil {
+		return nil, err
+	}
+	maxMissed := window - minSignedPerWindow
+
+	var (
+		validators []types.ValidatorLiveness
+		iterErr    error
+	)
+	err = k.IterateValidatorSigningInfos(ctx, func(consAddr sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool) {
+		// the margin is the one the next downtime evaluation uses, it is
+		// smaller than the full window one in an epoch window being filled
+		validatorMaxMissed, minHeight, err := k.DowntimeThreshold(ctx, info)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		validators = append(validators, types.ValidatorLiveness{
+			Address:             info.Address,
+			MissedBlocksCounter: info.MissedBlocksCounter,
+			Margin:              validatorMaxMissed - info.MissedBlocksCounter,
+			SlashableHeight:     minHeight + 1,
+		})
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if iterErr != nil {
+		return nil, iterErr
+	}
+
+	sort.SliceStable(validators, func(i, j int) bool {
+		if validators[i].MissedBlocksCounter != validators[j].MissedBlocksCounter {
+			return validators[i].MissedBlocksCounter > validators[j].MissedBlocksCounter
+		}
+		return validators[i].Address < validators[j].Address
+	})
+
+	if req.Limit > 0 && int(req.Limit) < len(validators) {
+		validators = validators[:req.Limit]
+	}
+
+	return &types.QueryLivenessReportResponse{
+		SignedBlocksWindow: window,
+		MaxMissedBlocks:    maxMissed,
+		Validators:         validators,
+	}, nil
+}
+
+// CooldownState returns the slashing cooldown state of a specific validator.
+func (k Keeper) CooldownState(ctx context.Context, req *types.QueryCooldownStateRequest) (*types.QueryCooldownStateResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	signingInfo, err := k.GetValidatorSigningInfo(ctx, consAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	state, err := k.GetValidatorCooldownState(ctx, consAddr, signingInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCooldownStateResponse{State: state}, nil
+}
+
+// CooldownStates returns the slashing cooldown state of all validators.
+func (k Keeper) CooldownStates(ctx context.Context, req *types.QueryCooldownStatesRequest) (*types.QueryCooldownStatesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	var states []types.ValidatorCooldownState
+
+	sigInfoStore := prefix.NewStore(runtime.KVStoreAdapter(store), types.ValidatorSigningInfoKeyPrefix)
+	pageRes, err := query.Paginate(sigInfoStore, req.Pagination, func(key, value []byte) error {
+		var info types.ValidatorSigningInfo
+		if err := k.cdc.Unmarshal(value, &info); err != nil {
+			return err
+		}
+
+		// the key is the length prefixed consensus address
+		state, err := k.GetValidatorCooldownState(ctx, sdk.ConsAddress(key[1:]), info)
+		if err != nil {
+			return err
+		}
+		states = append(states, state)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCooldownStatesResponse{States: states, Pagination: pageRes}, nil
+}
+
+// EpochLiveness returns the liveness summary of an evaluated epoch.
+func (k Keeper) EpochLiveness(ctx context.Context, req *types.QueryEpochLivenessRequest) (*types.QueryEpochLivenessResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	liveness, err := k.GetEpochLiveness(ctx, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "EpochLiveness not found for epoch %d", req.Epoch)
+	}
+
+	return &types.QueryEpochLivenessResponse{Liveness: liveness}, nil
+}
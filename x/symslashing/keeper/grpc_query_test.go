@@ -84,3 +84,80 @@ func (s *KeeperTestSuite) TestGRPCSigningInfos() {
 	require.NotNil(infoResp.Pagination.NextKey)
 	require.Equal(uint64(2), infoResp.Pagination.Total)
 }
+
+func (s *KeeperTestSuite) TestGRPCMissedBlocks() {
+	queryClient, ctx, keeper := s.queryClient, s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, err := queryClient.MissedBlocks(gocontext.Background(), &slashingtypes.QueryMissedBlocksRequest{ConsAddress: ""})
+	require.ErrorContains(err, "invalid request")
+
+	_, err = queryClient.MissedBlocks(gocontext.Background(), &slashingtypes.QueryMissedBlocksRequest{ConsAddress: consAddr.String()})
+	require.ErrorContains(err, "SigningInfo not found")
+
+	// the window wrapped around, offsets [3, 1002] are recorded
+	signingInfo := slashingtypes.NewValidatorSigningInfo(consAddr, 100, 1003, 3)
+	require.NoError(keeper.SetValidatorSigningInfo(ctx, consAddr, signingInfo))
+	for _, index := range []int64{1, 2, 5} {
+		require.NoError(keeper.SetMissedBlockBitmapValue(ctx, consAddr, index, true))
+	}
+
+	resp, err := queryClient.MissedBlocks(gocontext.Background(), &slashingtypes.QueryMissedBlocksRequest{ConsAddress: consAddr.String()})
+	require.NoError(err)
+	require.Equal(int64(1000), resp.SignedBlocksWindow)
+	require.Equal(int64(3), resp.MissedBlocksCounter)
+	require.Len(resp.Blocks, 1000)
+	require.Equal(slashingtypes.WindowBlock{Index: 3, Missed: false}, resp.Blocks[0])
+	require.Equal(slashingtypes.WindowBlock{Index: 2, Missed: true}, resp.Blocks[999])
+
+	resp, err = queryClient.MissedBlocks(gocontext.Background(), &slashingtypes.QueryMissedBlocksRequest{ConsAddress: consAddr.String(), MissedOnly: true})
+	require.NoError(err)
+	require.Equal([]slashingtypes.WindowBlock{
+		{Index: 5, Missed: true},
+		{Index: 1, Missed: true},
+		{Index: 2, Missed: true},
+	}, resp.Blocks)
+}
+
+func (s *KeeperTestSuite) TestGRPCLivenessReport() {
+	queryClient, ctx, keeper := s.queryClient, s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	consAddr1 := sdk.ConsAddress("addr1_______________")
+	consAddr2 := sdk.ConsAddress("addr2_______________")
+	consAddr3 := sdk.ConsAddress("addr3_______________")
+	require.NoError(keeper.SetValidatorSigningInfo(ctx, consAddr1, slashingtypes.NewValidatorSigningInfo(consAddr1, 0, 10, 2)))
+	require.NoError(keeper.SetValidatorSigningInfo(ctx, consAddr2, slashingtypes.NewValidatorSigningInfo(consAddr2, 5, 10, 7)))
+	require.NoError(keeper.SetValidatorSigningInfo(ctx, consAddr3, slashingtypes.NewValidatorSigningInfo(consAddr3, 0, 10, 0)))
+
+	resp, err := queryClient.LivenessReport(gocontext.Background(), &slashingtypes.QueryLivenessReportRequest{})
+	require.NoError(err)
+	require.Equal(int64(1000), resp.SignedBlocksWindow)
+	require.Equal(int64(500), resp.MaxMissedBlocks)
+	require.Equal([]slashingtypes.ValidatorLiveness{
+		{Address: consAddr2.String(), MissedBlocksCounter: 7, Margin: 493, SlashableHeight: 1006},
+		{Address: consAddr1.String(), MissedBlocksCounter: 2, Margin: 498, SlashableHeight: 1001},
+		{Address: consAddr3.String(), MissedBlocksCounter: 0, Margin: 500, SlashableHeight: 1001},
+	}, resp.Validators)
+
+	resp, err = queryClient.LivenessReport(gocontext.Background(), &slashingtypes.QueryLivenessReportRequest{Limit: 1})
+	require.NoError(err)
+	require.Len(resp.Validators, 1)
+	require.Equal(consAddr2.String(), resp.Validators[0].Address)
+
+	// in the epoch liveness window mode, the margins are the ones of the blocks
+	// recorded in the epoch, and only the grace period delays the slashes
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.LivenessWindowMode = slashingtypes.LivenessWindowMode_LIVENESS_WINDOW_MODE_EPOCH
+	params.DowntimeGracePeriod = 20
+	require.NoError(keeper.SetParams(ctx, params))
+
+	resp, err = queryClient.LivenessReport(gocontext.Background(), &slashingtypes.QueryLivenessReportRequest{})
+	require.NoError(err)
+	require.Equal([]slashingtypes.ValidatorLiveness{
+		{Address: consAddr2.String(), MissedBlocksCounter: 7, Margin: -2, SlashableHeight: 26},
+		{Address: consAddr1.String(), MissedBlocksCounter: 2, Margin: 3, SlashableHeight: 21},
+		{Address: consAddr3.String(), MissedBlocksCounter: 0, Margin: 5, SlashableHeight: 21},
+	}, resp.Validators)
+}
@@ -61,3 +61,9 @@ func (h Hooks) AfterValidatorCreated(ctx context.Context, consPubKey cryptotypes
 func (h Hooks) AfterValidatorModified(_ context.Context, _ cryptotypes.PubKey) error {
 	return nil
 }
+
+// AfterEpochChanged evaluates the liveness of the previous epoch when the
+// signing windows are scoped to epochs.
+func (h Hooks) AfterEpochChanged(ctx context.Context, previousEpoch, _ uint64) error {
+	return h.k.EvaluateEpochLiveness(ctx, previousEpoch)
+}
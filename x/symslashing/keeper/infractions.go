@@ -107,40 +107,43 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 		)
 	}
 
-	minHeight := signInfo.StartHeight + signedBlocksWindow
-	maxMissed := signedBlocksWindow - minSignedPerWindow
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.LivenessWindowMode == types.LivenessWindowMode_LIVENESS_WINDOW_MODE_EPOCH {
+		// the window is evaluated when the epoch changes, see EvaluateEpochLiveness
+		return k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	}
+
+	maxMissed, minHeight, err := k.DowntimeThreshold(ctx, signInfo)
+	if err != nil {
+		return err
+	}
 
 	// if we are past the minimum height and the validator has missed too many blocks, punish them
 	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
-		// Downtime confirmed: slash and jail the validator
-		// We need to retrieve the stake distribution which signed the block, so we subtract ValidatorUpdateDelay from the evidence height,
-		// and subtract an additional 1 since this is the LastCommit.
-		// Note that this *can* result in a negative "distributionHeight" up to -ValidatorUpdateDelay-1,
-		// i.e. at the end of the pre-genesis block (none) = at the beginning of the genesis block.
-		// That's fine since this is just used to filter unbonding delegations & redelegations.
-		distributionHeight := height - sdk.ValidatorUpdateDelay - 1
-
-		slashFractionDowntime, err := k.SlashFractionDowntime(ctx)
+		// The validator stays in the set until the next epoch, so a repeat slash within
+		// the cooldown or the same epoch is skipped. The counter and bitmap are kept, so
+		// the validator is slashed once the suppression ends if it is still missing blocks.
+		suppressed, err := k.IsSlashSuppressed(ctx, consAddr, stakingtypes.Infraction_INFRACTION_DOWNTIME)
 		if err != nil {
 			return err
 		}
+		if suppressed {
+			logger.Debug(
+				"skipping repeat downtime slash",
+				"height", height,
+				"validator", consAddr.String(),
+				"missed", signInfo.MissedBlocksCounter,
+			)
+			return k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+		}
 
-		slashRequestID, err := k.sk.SlashWithInfractionReason(ctx, pk.Bytes(), distributionHeight, power, slashFractionDowntime, stakingtypes.Infraction_INFRACTION_DOWNTIME)
-		if err != nil {
+		if _, err := k.slashDowntime(ctx, consAddr, pk, power); err != nil {
 			return err
 		}
 
-		sdkCtx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeSlash,
-				sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
-				sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
-				sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
-				sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
-				sdk.NewAttribute(types.AttributeKeySlashRequestID, slashRequestID),
-			),
-		)
-
 		// We need to reset the counter & bitmap so that the validator won't be
 		// immediately slashed for downtime upon re-bonding.
 		signInfo.MissedBlocksCounter = 0
@@ -156,7 +159,7 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 			"validator", consAddr.String(),
 			"min_height", minHeight,
 			"threshold", minSignedPerWindow,
-			"slashed", slashFractionDowntime.String(),
+			"slashed", params.SlashFractionDowntime.String(),
 		)
 
 	}
@@ -164,3 +167,44 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 	// Set the updated signing info
 	return k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
+
+// slashDowntime requests a downtime slash of a validator through the staking
+// module and starts its cooldown. It returns the signature request id of the slash.
+func (k Keeper) slashDowntime(ctx context.Context, consAddr sdk.ConsAddress, pk cryptotypes.PubKey, power int64) (string, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Downtime confirmed: slash the validator
+	// We need to retrieve the stake distribution which signed the block, so we subtract ValidatorUpdateDelay from the evidence height,
+	// and subtract an additional 1 since this is the LastCommit.
+	// Note that this *can* result in a negative "distributionHeight" up to -ValidatorUpdateDelay-1,
+	// i.e. at the end of the pre-genesis block (none) = at the beginning of the genesis block.
+	// That's fine since this is just used to filter unbonding delegations & redelegations.
+	distributionHeight := sdkCtx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+
+	slashFractionDowntime, err := k.SlashFractionDowntime(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	slashRequestID, err := k.sk.SlashWithInfractionReason(ctx, pk.Bytes(), distributionHeight, power, slashFractionDowntime, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	if err != nil {
+		return "", err
+	}
+
+	if err := k.startSlashCooldown(ctx, consAddr, stakingtypes.Infraction_INFRACTION_DOWNTIME); err != nil {
+		return "", err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
+			sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySlashRequestID, slashRequestID),
+		),
+	)
+
+	return slashRequestID, nil
+}
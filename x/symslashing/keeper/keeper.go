@@ -21,6 +21,9 @@ type Keeper struct {
 	cdc          codec.BinaryCodec
 	legacyAmino  *codec.LegacyAmino
 	sk           types.StakingKeeper
+	// circuitBreaker pauses the module features during incidents, all
+	// features are allowed when it is not set
+	circuitBreaker types.CircuitBreaker
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -38,6 +41,26 @@ func NewKeeper(cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, storeServi
 	}
 }
 
+// SetCircuitBreaker sets the circuit breaker consulted before the downtime
+// slashing and registers the module features in it. It must be called before
+// the keeper is copied into the module and the hooks.
+func (k *Keeper) SetCircuitBreaker(cb types.CircuitBreaker) {
+	if k.circuitBreaker != nil {
+		panic("cannot set symslashing circuit breaker twice")
+	}
+	cb.RegisterFeatures(types.FeatureDowntime)
+	k.circuitBreaker = cb
+}
+
+// IsFeatureAllowed returns true if the given feature of the module is allowed
+// by the circuit breaker.
+func (k Keeper) IsFeatureAllowed(ctx context.Context, feature string) (bool, error) {
+	if k.circuitBreaker == nil {
+		return true, nil
+	}
+	return k.circuitBreaker.IsFeatureAllowed(ctx, feature)
+}
+
 // GetAuthority returns the x/slashing module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -88,10 +111,23 @@ func (k Keeper) SlashWithInfractionReason(ctx context.Context, consAddr sdk.Cons
 	if err != nil {
 		return err
 	}
+
+	suppressed, err := k.IsSlashSuppressed(ctx, consAddr, infraction)
+	if err != nil {
+		return err
+	}
+	if suppressed {
+		k.Logger(ctx).Info("skipping slash within cooldown", "validator", consAddr.String(), "infraction", infraction.String())
+		return nil
+	}
+
 	slashRequestID, err := k.sk.SlashWithInfractionReason(ctx, pk.Bytes(), distributionHeight, power, fraction, infraction)
 	if err != nil {
 		return err
 	}
+	if err := k.startSlashCooldown(ctx, consAddr, infraction); err != nil {
+		return err
+	}
 
 	reasonAttr := sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueUnspecified)
 	switch infraction {
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -36,6 +37,7 @@ type KeeperTestSuite struct {
 	slashingKeeper slashingkeeper.Keeper
 	queryClient    slashingtypes.QueryClient
 	msgServer      slashingtypes.MsgServer
+	epoch          uint64
 }
 
 func (s *KeeperTestSuite) SetupTest() {
@@ -50,6 +52,10 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.stakingKeeper = slashingtestutil.NewMockStakingKeeper(ctrl)
 	s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
 	s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons")).AnyTimes()
+	s.epoch = 1
+	s.stakingKeeper.EXPECT().GetCurrentEpoch(gomock.Any()).DoAndReturn(func(context.Context) (*stakingtypes.StoreEpoch, error) {
+		return &stakingtypes.StoreEpoch{Epoch: s.epoch}, nil
+	}).AnyTimes()
 
 	s.ctx = ctx
 	s.slashingKeeper = slashingkeeper.NewKeeper(
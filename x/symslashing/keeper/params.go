@@ -41,6 +41,41 @@ func (k Keeper) SlashFractionDowntime(ctx context.Context) (sdkmath.LegacyDec, e
 	return params.SlashFractionDowntime, err
 }
 
+// MinDowntimeSlashHeight - height after which a validator can be slashed for
+// downtime, once a full window was recorded and the grace period has passed
+func (k Keeper) MinDowntimeSlashHeight(ctx context.Context, info types.ValidatorSigningInfo) (int64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return info.StartHeight + max(params.SignedBlocksWindow, params.DowntimeGracePeriod), nil
+}
+
+// DowntimeThreshold - number of blocks a validator can miss in its window
+// without being slashed for downtime and height after which it can be slashed,
+// as evaluated by HandleValidatorSignature in the sliding liveness window mode
+// and by EvaluateEpochLiveness in the epoch one, where the window only holds the
+// blocks recorded in the epoch
+func (k Keeper) DowntimeThreshold(ctx context.Context, info types.ValidatorSigningInfo) (maxMissed, minHeight int64, err error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if params.LivenessWindowMode == types.LivenessWindowMode_LIVENESS_WINDOW_MODE_EPOCH {
+		blocks := min(info.IndexOffset, params.SignedBlocksWindow)
+		return blocks - params.MinSignedPerWindow.MulInt64(blocks).RoundInt64(), info.StartHeight + params.DowntimeGracePeriod, nil
+	}
+
+	minSignedPerWindow, err := k.MinSignedPerWindow(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	minHeight, err = k.MinDowntimeSlashHeight(ctx, info)
+	return params.SignedBlocksWindow - minSignedPerWindow, minHeight, err
+}
+
 // GetParams returns the current x/slashing module parameters.
 func (k Keeper) GetParams(ctx context.Context) (params types.Params, err error) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -230,3 +230,46 @@ func (k Keeper) GetValidatorMissedBlocks(ctx context.Context, addr sdk.ConsAddre
 
 	return missedBlocks, err
 }
+
+// GetWindowBlocks returns the blocks of a validator's signed blocks window
+// recorded so far, oldest first.
+func (k Keeper) GetWindowBlocks(ctx context.Context, addr sdk.ConsAddress, info types.ValidatorSigningInfo) ([]types.WindowBlock, error) {
+	window, err := k.SignedBlocksWindow(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	first := info.IndexOffset - window
+	if first < 0 {
+		first = 0
+	}
+
+	chunks := make(map[int64]*bitset.BitSet)
+	blocks := make([]types.WindowBlock, 0, info.IndexOffset-first)
+	for offset := first; offset < info.IndexOffset; offset++ {
+		index := offset % window
+		chunkIndex := index / types.MissedBlockBitmapChunkSize
+
+		bs, ok := chunks[chunkIndex]
+		if !ok {
+			bs = bitset.New(uint(types.MissedBlockBitmapChunkSize))
+			chunk, err := k.getMissedBlockBitmapChunk(ctx, addr, chunkIndex)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get bitmap chunk; index: %d", index)
+			}
+			if chunk != nil {
+				if err := bs.UnmarshalBinary(chunk); err != nil {
+					return nil, errors.Wrapf(err, "failed to decode bitmap chunk; index: %d", index)
+				}
+			}
+			chunks[chunkIndex] = bs
+		}
+
+		blocks = append(blocks, types.WindowBlock{
+			Index:  index,
+			Missed: bs.Test(uint(index % types.MissedBlockBitmapChunkSize)),
+		})
+	}
+
+	return blocks, nil
+}
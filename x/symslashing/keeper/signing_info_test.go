@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/symslashing/testutil"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/symslashing/types"
@@ -80,3 +81,32 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBitmap_SmallWindow() {
 		require.Len(missedBlocks, int(params.SignedBlocksWindow)-1)
 	}
 }
+
+func (s *KeeperTestSuite) TestInitTestnet() {
+	ctx, keeper := s.ctx.WithBlockHeight(20), s.slashingKeeper
+	require := s.Require()
+
+	info := slashingtypes.NewValidatorSigningInfo(consAddr, 1, 10, 5)
+	require.NoError(keeper.SetValidatorSigningInfo(ctx, consAddr, info))
+	require.NoError(keeper.SetMissedBlockBitmapValue(ctx, consAddr, 3, true))
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	localAddr := sdk.ConsAddress(pubKey.Address())
+	require.NoError(keeper.InitTestnet(ctx, pubKey))
+
+	// the signing infos of the forked network are cleared
+	require.False(keeper.HasValidatorSigningInfo(ctx, consAddr))
+	missedBlocks, err := keeper.GetValidatorMissedBlocks(ctx, consAddr)
+	require.NoError(err)
+	require.Empty(missedBlocks)
+
+	// the local validator starts with a fresh signing info
+	localInfo, err := keeper.GetValidatorSigningInfo(ctx, localAddr)
+	require.NoError(err)
+	require.Equal(int64(20), localInfo.StartHeight)
+	require.Zero(localInfo.IndexOffset)
+	require.Zero(localInfo.MissedBlocksCounter)
+	storedPubKey, err := keeper.GetPubkey(ctx, pubKey.Address())
+	require.NoError(err)
+	require.Equal(pubKey, storedPubKey)
+}
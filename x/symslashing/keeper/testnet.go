@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symslashing/types"
+)
+
+// InitTestnet clears the signing infos and missed block bitmaps of a network
+// forked into an in-place testnet, so the local validator with the given
+// consensus key starts with a fresh signing info and the validators of the
+// forked network are neither tracked nor slashed anymore.
+func (k Keeper) InitTestnet(ctx context.Context, consPubKey cryptotypes.PubKey) error {
+	var addrs []sdk.ConsAddress
+	if err := k.IterateValidatorSigningInfos(ctx, func(addr sdk.ConsAddress, _ types.ValidatorSigningInfo) bool {
+		addrs = append(addrs, addr)
+		return false
+	}); err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	for _, addr := range addrs {
+		if err := store.Delete(types.ValidatorSigningInfoKey(addr)); err != nil {
+			return err
+		}
+		if err := k.DeleteMissedBlockBitmap(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	// the local validator may already be part of the forked validator set, in
+	// which case symstaking doesn't report it as created
+	return k.Hooks().AfterValidatorCreated(ctx, consPubKey)
+}
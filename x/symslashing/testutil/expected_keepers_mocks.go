@@ -278,6 +278,21 @@ func (mr *MockStakingKeeperMockRecorder) ConsensusAddressCodec() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsensusAddressCodec", reflect.TypeOf((*MockStakingKeeper)(nil).ConsensusAddressCodec))
 }
 
+// GetCurrentEpoch mocks base method.
+func (m *MockStakingKeeper) GetCurrentEpoch(ctx context.Context) (*types1.StoreEpoch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentEpoch", ctx)
+	ret0, _ := ret[0].(*types1.StoreEpoch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentEpoch indicates an expected call of GetCurrentEpoch.
+func (mr *MockStakingKeeperMockRecorder) GetCurrentEpoch(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentEpoch", reflect.TypeOf((*MockStakingKeeper)(nil).GetCurrentEpoch), ctx)
+}
+
 // IterateValidators mocks base method.
 func (m *MockStakingKeeper) IterateValidators(arg0 context.Context, arg1 func(int64, types2.ValidatorUpdate) bool) error {
 	m.ctrl.T.Helper()
@@ -321,7 +336,6 @@ func (mr *MockStakingKeeperMockRecorder) Unjail(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unjail", reflect.TypeOf((*MockStakingKeeper)(nil).Unjail), arg0, arg1)
 }
 
-
 // ValidatorAddressCodec mocks base method.
 func (m *MockStakingKeeper) ValidatorAddressCodec() address.Codec {
 	m.ctrl.T.Helper()
@@ -336,7 +350,6 @@ func (mr *MockStakingKeeperMockRecorder) ValidatorAddressCodec() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorAddressCodec", reflect.TypeOf((*MockStakingKeeper)(nil).ValidatorAddressCodec))
 }
 
-
 // MockStakingHooks is a mock of StakingHooks interface.
 type MockStakingHooks struct {
 	ctrl     *gomock.Controller
@@ -12,4 +12,6 @@ var (
 	ErrSelfDelegationTooLowToUnjail = errors.Register(ModuleName, 7, "validator's self delegation less than minimum; cannot be unjailed")
 	ErrNoSigningInfoFound           = errors.Register(ModuleName, 8, "no validator signing info found")
 	ErrValidatorTombstoned          = errors.Register(ModuleName, 9, "validator already tombstoned")
+	ErrNoSlashCooldownFound         = errors.Register(ModuleName, 10, "no slash cooldown found")
+	ErrNoEpochLivenessFound         = errors.Register(ModuleName, 11, "no epoch liveness found")
 )
@@ -5,6 +5,8 @@ const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
 
+	EventTypeEpochLiveness = "epoch_liveness"
+
 	AttributeKeyAddress        = "address"
 	AttributeKeyHeight         = "height"
 	AttributeKeyPower          = "power"
@@ -12,6 +14,7 @@ const (
 	AttributeKeyJailed         = "jailed"
 	AttributeKeyMissedBlocks   = "missed_blocks"
 	AttributeKeySlashRequestID = "slash_request_id"
+	AttributeKeyEpoch          = "epoch"
 
 	AttributeValueUnspecified      = "unspecified"
 	AttributeValueDoubleSign       = "double_sign"
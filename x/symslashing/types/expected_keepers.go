@@ -42,6 +42,15 @@ type StakingKeeper interface {
 	SlashWithInfractionReason(context.Context, []byte, int64, int64, math.LegacyDec, stakingtypes.Infraction) (string, error)
 	ConsensusAddressCodec() address.Codec
 	IterateValidators(ctx context.Context, fn func(index int64, validator abci.ValidatorUpdate) (stop bool)) error
+	GetCurrentEpoch(ctx context.Context) (*stakingtypes.StoreEpoch, error)
+}
+
+// CircuitBreaker defines the expected interface for the circuit module, it lets
+// the authorized accounts pause the named features of the module during
+// incidents.
+type CircuitBreaker interface {
+	RegisterFeatures(features ...string)
+	IsFeatureAllowed(ctx context.Context, feature string) (bool, error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -49,4 +58,5 @@ type StakingHooks interface {
 	AfterValidatorCreated(ctx context.Context, consPubKey cryptotypes.PubKey) error  // Must be called when a validator is created
 	AfterValidatorModified(ctx context.Context, consPubKey cryptotypes.PubKey) error // Must be called when a validator's state changes
 	AfterValidatorRemoved(ctx context.Context, consPubKey cryptotypes.PubKey) error  // Must be called when a validator is deleted
+	AfterEpochChanged(ctx context.Context, previousEpoch, epoch uint64) error        // Must be called when the validator set of a new epoch is applied
 }
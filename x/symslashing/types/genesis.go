@@ -28,9 +28,11 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:         DefaultParams(),
+		SigningInfos:   []SigningInfo{},
+		MissedBlocks:   []ValidatorMissedBlocks{},
+		SlashCooldowns: []SlashCooldown{},
+		EpochLiveness:  []EpochLiveness{},
 	}
 }
 
@@ -56,5 +58,17 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if data.Params.SlashCooldownBlocks < 0 {
+		return fmt.Errorf("slash cooldown blocks cannot be negative, is %d", data.Params.SlashCooldownBlocks)
+	}
+
+	if data.Params.SlashCooldownDuration < 0 {
+		return fmt.Errorf("slash cooldown duration cannot be negative, is %s", data.Params.SlashCooldownDuration)
+	}
+
+	if data.Params.DowntimeGracePeriod < 0 {
+		return fmt.Errorf("downtime grace period cannot be negative, is %d", data.Params.DowntimeGracePeriod)
+	}
+
 	return nil
 }
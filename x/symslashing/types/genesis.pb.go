@@ -35,6 +35,12 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// slash_cooldowns are the last slashes of the validators per infraction.
+	SlashCooldowns []SlashCooldown `protobuf:"bytes,4,rep,name=slash_cooldowns,json=slashCooldowns,proto3" json:"slash_cooldowns"`
+	// epoch_liveness are the liveness summaries of the evaluated epochs.
+	EpochLiveness []EpochLiveness `protobuf:"bytes,5,rep,name=epoch_liveness,json=epochLiveness,proto3" json:"epoch_liveness"`
+	// epoch_start_height is the first height of the current epoch.
+	EpochStartHeight int64 `protobuf:"varint,6,opt,name=epoch_start_height,json=epochStartHeight,proto3" json:"epoch_start_height,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -91,6 +97,27 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetSlashCooldowns() []SlashCooldown {
+	if m != nil {
+		return m.SlashCooldowns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochLiveness() []EpochLiveness {
+	if m != nil {
+		return m.EpochLiveness
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochStartHeight() int64 {
+	if m != nil {
+		return m.EpochStartHeight
+	}
+	return 0
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -269,35 +296,41 @@ func init() {
 }
 
 var fileDescriptor_0e001176ef6bb7ae = []byte{
-	// 447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x33, 0x2d, 0x56, 0x77, 0xb2, 0x7b, 0x70, 0xa8, 0x4b, 0x2c, 0x18, 0xd7, 0x5c, 0xac,
-	0xc2, 0x26, 0xdb, 0xf5, 0xb8, 0x27, 0x23, 0x22, 0x0a, 0x82, 0xa4, 0xe0, 0x82, 0x97, 0x30, 0x69,
-	0x66, 0x67, 0x87, 0x6d, 0x66, 0x6a, 0xde, 0x6c, 0xd9, 0xfd, 0x16, 0xfa, 0x2d, 0xbc, 0x08, 0x1e,
-	0xbc, 0x7a, 0xdf, 0x63, 0xf1, 0xe4, 0x49, 0xa4, 0x3d, 0xf8, 0x35, 0xc4, 0x99, 0x54, 0x53, 0x69,
-	0x4b, 0xc1, 0x4b, 0x92, 0x99, 0xf9, 0xfd, 0xff, 0xef, 0xcd, 0x3f, 0x3c, 0xdc, 0x1d, 0x28, 0x28,
-	0x14, 0x44, 0x70, 0x59, 0xc0, 0x90, 0xc2, 0xa9, 0x90, 0x3c, 0x1a, 0xf7, 0x32, 0xa6, 0x69, 0x2f,
-	0xe2, 0x4c, 0x32, 0x10, 0x10, 0x8e, 0x4a, 0xa5, 0x15, 0xe9, 0x58, 0x32, 0xac, 0x91, 0x61, 0x45,
-	0x76, 0xda, 0x5c, 0x71, 0x65, 0xb0, 0xe8, 0xf7, 0x97, 0x55, 0x74, 0x1e, 0xac, 0xf1, 0xfe, 0x63,
-	0x61, 0xd1, 0xdb, 0x16, 0x4d, 0xad, 0x47, 0x55, 0xc9, 0x1e, 0xdd, 0xa4, 0x85, 0x90, 0x2a, 0x32,
-	0x4f, 0xbb, 0x15, 0xbc, 0x6f, 0xe0, 0xed, 0x67, 0xb6, 0xb9, 0xbe, 0xa6, 0x9a, 0x91, 0xa7, 0xb8,
-	0x35, 0xa2, 0x25, 0x2d, 0xc0, 0x43, 0x7b, 0xa8, 0xeb, 0x1e, 0x06, 0xe1, 0xea, 0x66, 0xc3, 0x57,
-	0x86, 0x8c, 0xb7, 0xae, 0xbe, 0xdf, 0x75, 0x3e, 0xfc, 0xfc, 0xf4, 0x10, 0x25, 0x95, 0x98, 0x1c,
-	0xe3, 0x1d, 0x10, 0x5c, 0x0a, 0xc9, 0x53, 0x21, 0x4f, 0x14, 0x78, 0x8d, 0xbd, 0x66, 0xd7, 0x3d,
-	0xbc, 0xbf, 0xce, 0xad, 0x6f, 0x05, 0xcf, 0xe5, 0x89, 0xaa, 0x5b, 0x6e, 0xc3, 0xdf, 0x7d, 0x20,
-	0x14, 0xef, 0x14, 0x02, 0x80, 0xe5, 0x69, 0x36, 0x54, 0x83, 0x33, 0xf0, 0x9a, 0xc6, 0xb8, 0xb7,
-	0xce, 0xf8, 0x35, 0x1d, 0x8a, 0x9c, 0x6a, 0x55, 0xbe, 0x34, 0xca, 0xd8, 0x08, 0x17, 0x4a, 0x14,
-	0xb5, 0x83, 0xe0, 0x0b, 0xc2, 0x6e, 0xad, 0x17, 0x72, 0x84, 0xaf, 0xd3, 0x3c, 0x2f, 0x19, 0xd8,
-	0x4c, 0xb6, 0xe2, 0x7b, 0x5f, 0x3f, 0xef, 0xdf, 0xa9, 0xea, 0x3d, 0x51, 0x12, 0x98, 0x84, 0x73,
-	0x78, 0x6c, 0x91, 0xbe, 0x2e, 0x85, 0xe4, 0xc9, 0x5c, 0x41, 0xde, 0xe2, 0xdd, 0xf1, 0xbc, 0x7c,
-	0x5a, 0x8f, 0xc4, 0x6b, 0x98, 0x7c, 0x0f, 0x36, 0x6a, 0x7c, 0x45, 0x34, 0xed, 0xf1, 0x12, 0x20,
-	0xf8, 0x88, 0xf0, 0xad, 0xa5, 0x57, 0xfe, 0xbf, 0x9b, 0x1c, 0xff, 0x9b, 0xfc, 0x06, 0xbf, 0xb4,
-	0x56, 0x7d, 0x75, 0xde, 0x47, 0xd8, 0xad, 0x71, 0xa4, 0x8d, 0xaf, 0x09, 0x99, 0xb3, 0x0b, 0xd3,
-	0x62, 0x33, 0xb1, 0x0b, 0xb2, 0x8b, 0x5b, 0x56, 0x64, 0x72, 0xbb, 0x91, 0x54, 0xab, 0xf8, 0xc5,
-	0xd5, 0xd4, 0x47, 0x93, 0xa9, 0x8f, 0x7e, 0x4c, 0x7d, 0xf4, 0x6e, 0xe6, 0x3b, 0x93, 0x99, 0xef,
-	0x7c, 0x9b, 0xf9, 0xce, 0x9b, 0x03, 0x2e, 0xf4, 0xe9, 0x79, 0x16, 0x0e, 0x54, 0x51, 0x8d, 0x41,
-	0xf5, 0xda, 0x87, 0xfc, 0x2c, 0xba, 0x58, 0x98, 0x25, 0x7d, 0x39, 0x62, 0x90, 0xb5, 0xcc, 0x4c,
-	0x3c, 0xfa, 0x15, 0x00, 0x00, 0xff, 0xff, 0x56, 0xa0, 0x9d, 0x18, 0xca, 0x03, 0x00, 0x00,
+	// 534 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x10, 0x8f, 0x9b, 0x7f, 0xf3, 0xa7, 0x9b, 0xa6, 0xc0, 0x2a, 0x54, 0x26, 0x12, 0x26, 0xe4, 0x42,
+	0x8a, 0xa8, 0xdd, 0x94, 0x63, 0x4f, 0xa4, 0xaa, 0xf8, 0x10, 0x48, 0xc8, 0x91, 0xa8, 0x04, 0x42,
+	0xd6, 0xc6, 0xde, 0xda, 0xab, 0xda, 0xbb, 0xc1, 0xb3, 0x0d, 0xed, 0x5b, 0xf0, 0x18, 0x5c, 0x90,
+	0x38, 0x70, 0xe5, 0xde, 0x63, 0xc5, 0x89, 0x13, 0x42, 0xc9, 0x81, 0x87, 0xe0, 0x82, 0xbc, 0xeb,
+	0xc2, 0x16, 0x35, 0xa1, 0x12, 0x97, 0xc4, 0x3b, 0xbf, 0x8f, 0x19, 0xcf, 0xcc, 0x1a, 0x75, 0x43,
+	0x01, 0x99, 0x00, 0x0f, 0x8e, 0x32, 0x48, 0x09, 0x24, 0x8c, 0xc7, 0xde, 0xb8, 0x37, 0xa4, 0x92,
+	0xf4, 0xbc, 0x98, 0x72, 0x0a, 0x0c, 0xdc, 0x51, 0x2e, 0xa4, 0xc0, 0x2d, 0xcd, 0x74, 0x0d, 0xa6,
+	0x5b, 0x32, 0x5b, 0xcd, 0x58, 0xc4, 0x42, 0xd1, 0xbc, 0xe2, 0x49, 0x2b, 0x5a, 0x6b, 0x73, 0xbc,
+	0x7f, 0x59, 0x68, 0xea, 0x75, 0x4d, 0x0d, 0xb4, 0x47, 0x99, 0x49, 0x43, 0x57, 0x49, 0xc6, 0xb8,
+	0xf0, 0xd4, 0xaf, 0x0e, 0x75, 0x7e, 0x54, 0xd1, 0xf2, 0x03, 0x5d, 0xdc, 0x40, 0x12, 0x49, 0xf1,
+	0x0e, 0xaa, 0x8d, 0x48, 0x4e, 0x32, 0xb0, 0xad, 0xb6, 0xd5, 0xad, 0x6f, 0x76, 0xdc, 0xd9, 0xc5,
+	0xba, 0xcf, 0x14, 0xb3, 0xbf, 0x74, 0xfc, 0xf5, 0x66, 0xe5, 0xdd, 0xf7, 0x0f, 0x77, 0x2c, 0xbf,
+	0x14, 0xe3, 0x5d, 0xd4, 0x00, 0x16, 0x73, 0xc6, 0xe3, 0x80, 0xf1, 0x3d, 0x01, 0xf6, 0x42, 0xbb,
+	0xda, 0xad, 0x6f, 0xde, 0x9e, 0xe7, 0x36, 0xd0, 0x82, 0x47, 0x7c, 0x4f, 0x98, 0x96, 0xcb, 0xf0,
+	0x3b, 0x0e, 0x98, 0xa0, 0x46, 0xc6, 0x00, 0x68, 0x14, 0x0c, 0x53, 0x11, 0xee, 0x83, 0x5d, 0x55,
+	0xc6, 0xbd, 0x79, 0xc6, 0xcf, 0x49, 0xca, 0x22, 0x22, 0x45, 0xfe, 0x54, 0x29, 0xfb, 0x4a, 0x78,
+	0x26, 0x45, 0x66, 0x00, 0xf8, 0x15, 0xba, 0xac, 0x2c, 0x82, 0x50, 0x88, 0x34, 0x12, 0x6f, 0x38,
+	0xd8, 0xff, 0xa9, 0x24, 0x6b, 0x73, 0xab, 0x2f, 0x02, 0xdb, 0xa5, 0xc2, 0x34, 0x5f, 0x01, 0x13,
+	0x01, 0xfc, 0x12, 0xad, 0xd0, 0x91, 0x08, 0x93, 0x20, 0x65, 0xe3, 0xa2, 0xf3, 0x60, 0x2f, 0xfe,
+	0xdd, 0x7d, 0xa7, 0x50, 0x3c, 0x29, 0x05, 0xa6, 0x7b, 0x83, 0x9a, 0x08, 0xbe, 0x8b, 0xb0, 0x36,
+	0x07, 0x49, 0x72, 0x19, 0x24, 0x94, 0xc5, 0x89, 0xb4, 0x6b, 0x6d, 0xab, 0x5b, 0xf5, 0xaf, 0x28,
+	0x64, 0x50, 0x00, 0x0f, 0x55, 0xbc, 0xf3, 0xc9, 0x42, 0x75, 0xa3, 0xeb, 0x78, 0x0b, 0xfd, 0x4f,
+	0xa2, 0x28, 0xa7, 0xa0, 0xa7, 0xbf, 0xd4, 0xbf, 0xf5, 0xf9, 0xe3, 0xfa, 0x8d, 0xb2, 0xac, 0x6d,
+	0xc1, 0x81, 0x72, 0x38, 0x80, 0xfb, 0x9a, 0x32, 0x90, 0x39, 0xe3, 0xb1, 0x7f, 0xaa, 0xc0, 0xaf,
+	0xd1, 0xea, 0xf8, 0xb4, 0xd1, 0x81, 0x39, 0x7c, 0x7b, 0x41, 0x6d, 0xd2, 0xc6, 0x85, 0x46, 0x34,
+	0x63, 0x09, 0x9a, 0xe3, 0x73, 0x08, 0x9d, 0xf7, 0x16, 0xba, 0x76, 0xee, 0x70, 0xff, 0xed, 0x4d,
+	0x76, 0xff, 0xdc, 0xb1, 0x0b, 0x2c, 0xaf, 0x91, 0x7d, 0xe6, 0x66, 0x75, 0xb6, 0x50, 0xdd, 0xe0,
+	0xe1, 0x26, 0x5a, 0x64, 0x3c, 0xa2, 0x87, 0xaa, 0xc4, 0xaa, 0xaf, 0x0f, 0x78, 0x15, 0xd5, 0xb4,
+	0x48, 0xf5, 0xed, 0x92, 0x5f, 0x9e, 0xfa, 0x8f, 0x8f, 0x27, 0x8e, 0x75, 0x32, 0x71, 0xac, 0x6f,
+	0x13, 0xc7, 0x7a, 0x3b, 0x75, 0x2a, 0x27, 0x53, 0xa7, 0xf2, 0x65, 0xea, 0x54, 0x5e, 0x6c, 0xc4,
+	0x4c, 0x26, 0x07, 0x43, 0x37, 0x14, 0x59, 0x79, 0xe1, 0xcb, 0xbf, 0x75, 0x88, 0xf6, 0xbd, 0xc3,
+	0x33, 0x5f, 0x0d, 0x79, 0x34, 0xa2, 0x30, 0xac, 0xa9, 0xdb, 0x7f, 0xef, 0xe7, 0x00, 0xf5, 0x2b,
+	0xa0, 0xbc, 0xb4, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -320,6 +353,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EpochStartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochStartHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EpochLiveness) > 0 {
+		for iNdEx := len(m.EpochLiveness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochLiveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SlashCooldowns) > 0 {
+		for iNdEx := len(m.SlashCooldowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashCooldowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -514,6 +580,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SlashCooldowns) > 0 {
+		for _, e := range m.SlashCooldowns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochLiveness) > 0 {
+		for _, e := range m.EpochLiveness {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EpochStartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochStartHeight))
+	}
 	return n
 }
 
@@ -702,6 +783,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashCooldowns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashCooldowns = append(m.SlashCooldowns, SlashCooldown{})
+			if err := m.SlashCooldowns[len(m.SlashCooldowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLiveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochLiveness = append(m.EpochLiveness, EpochLiveness{})
+			if err := m.EpochLiveness[len(m.EpochLiveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartHeight", wireType)
+			}
+			m.EpochStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
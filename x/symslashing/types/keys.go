@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/kv"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/symstaking/types"
 )
 
 const (
@@ -18,6 +19,11 @@ const (
 	// RouterKey is the message route for slashing
 	RouterKey = ModuleName
 
+	// FeatureDowntime is the circuit breaker feature of the downtime slashing,
+	// tripping it stops the liveness tracking and the downtime slashes until it
+	// is reset.
+	FeatureDowntime = ModuleName + "/downtime"
+
 	// MissedBlockBitmapChunkSize defines the chunk size, in number of bits, of a
 	// validator missed block bitmap. Chunks are used to reduce the storage and
 	// write overhead of IAVL nodes. The total size of the bitmap is roughly in
@@ -46,12 +52,21 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes><infraction (4 Bytes)>: SlashCooldown
+//
+// - 0x05: int64 first height of the current epoch
+//
+// - 0x06<epoch (8 Bytes)>: EpochLiveness
 
 var (
 	ParamsKey                           = []byte{0x00} // Prefix for params key
 	ValidatorSigningInfoKeyPrefix       = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitmapKeyPrefix = []byte{0x02} // Prefix for missed block bitmap
 	AddrPubkeyRelationKeyPrefix         = []byte{0x03} // Prefix for address-pubkey relation
+	SlashCooldownKeyPrefix              = []byte{0x04} // Prefix for slash cooldowns
+	EpochStartHeightKey                 = []byte{0x05} // Key for the first height of the current epoch
+	EpochLivenessKeyPrefix              = []byte{0x06} // Prefix for epoch liveness summaries
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -87,3 +102,26 @@ func ValidatorMissedBlockBitmapKey(v sdk.ConsAddress, chunkIndex int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// SlashCooldownPrefixKey returns the key prefix for a validator's slash
+// cooldowns.
+func SlashCooldownPrefixKey(v sdk.ConsAddress) []byte {
+	return append(SlashCooldownKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// SlashCooldownKey returns the key for a validator's slash cooldown of an
+// infraction.
+func SlashCooldownKey(v sdk.ConsAddress, infraction stakingtypes.Infraction) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, uint32(infraction))
+
+	return append(SlashCooldownPrefixKey(v), bz...)
+}
+
+// EpochLivenessKey returns the key for the liveness summary of an epoch.
+func EpochLivenessKey(epoch uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, epoch)
+
+	return append(EpochLivenessKeyPrefix, bz...)
+}
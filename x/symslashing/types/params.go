@@ -11,12 +11,15 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultSlashCooldownBlocks  = int64(0)
+	DefaultDowntimeGracePeriod  = int64(0)
 )
 
 var (
 	DefaultMinSignedPerWindow      = math.LegacyNewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
 	DefaultSlashFractionDowntime   = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+	DefaultSlashCooldownDuration   = time.Duration(0)
 )
 
 // NewParams creates a new Params object
@@ -34,13 +37,18 @@ func NewParams(
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultSignedBlocksWindow,
 		DefaultMinSignedPerWindow,
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
 	)
+	params.SlashCooldownBlocks = DefaultSlashCooldownBlocks
+	params.SlashCooldownDuration = DefaultSlashCooldownDuration
+	params.DowntimeGracePeriod = DefaultDowntimeGracePeriod
+
+	return params
 }
 
 // Validate validates the params
@@ -57,6 +65,18 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateSlashCooldownBlocks(p.SlashCooldownBlocks); err != nil {
+		return err
+	}
+	if err := validateSlashCooldownDuration(p.SlashCooldownDuration); err != nil {
+		return err
+	}
+	if err := validateDowntimeGracePeriod(p.DowntimeGracePeriod); err != nil {
+		return err
+	}
+	if err := validateLivenessWindowMode(p.LivenessWindowMode); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -129,3 +149,55 @@ func validateSlashFractionDowntime(i any) error {
 
 	return nil
 }
+
+func validateSlashCooldownBlocks(i any) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("slash cooldown blocks cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateSlashCooldownDuration(i any) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("slash cooldown duration cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeGracePeriod(i any) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime grace period cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateLivenessWindowMode(i any) error {
+	v, ok := i.(LivenessWindowMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := LivenessWindowMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid liveness window mode: %d", v)
+	}
+
+	return nil
+}
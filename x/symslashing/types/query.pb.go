@@ -309,513 +309,2341 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.symslashing.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.symslashing.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QuerySigningInfoRequest)(nil), "cosmos.symslashing.v1beta1.QuerySigningInfoRequest")
-	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.symslashing.v1beta1.QuerySigningInfoResponse")
-	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.symslashing.v1beta1.QuerySigningInfosRequest")
-	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.symslashing.v1beta1.QuerySigningInfosResponse")
+// QueryMissedBlocksRequest is the request type for the Query/MissedBlocks RPC
+// method
+type QueryMissedBlocksRequest struct {
+	// cons_address is the address to query the missed blocks of
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// missed_only omits the signed blocks from the response
+	MissedOnly bool `protobuf:"varint,2,opt,name=missed_only,json=missedOnly,proto3" json:"missed_only,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("cosmos/symslashing/v1beta1/query.proto", fileDescriptor_faa48f50de75efed)
+func (m *QueryMissedBlocksRequest) Reset()         { *m = QueryMissedBlocksRequest{} }
+func (m *QueryMissedBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksRequest) ProtoMessage()    {}
+func (*QueryMissedBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa48f50de75efed, []int{6}
 }
-
-var fileDescriptor_faa48f50de75efed = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4f, 0x6b, 0x13, 0x41,
-	0x18, 0xc6, 0x33, 0xb5, 0x16, 0x3a, 0x29, 0xa2, 0x63, 0xc1, 0x36, 0xe8, 0xaa, 0x8b, 0x54, 0x1b,
-	0xe9, 0x4e, 0xd3, 0x2a, 0x88, 0x27, 0x8d, 0xff, 0xd0, 0x8b, 0x9a, 0x82, 0x07, 0x11, 0xc2, 0x24,
-	0x99, 0x4e, 0x07, 0x77, 0x67, 0xb6, 0x3b, 0x9b, 0x60, 0x10, 0x0f, 0x7a, 0x17, 0x04, 0xf1, 0x3b,
-	0x78, 0x12, 0x0f, 0x7a, 0xf1, 0x13, 0xf4, 0x58, 0xf4, 0xe2, 0x49, 0x24, 0x11, 0xfc, 0x1a, 0xb2,
-	0x33, 0x93, 0x74, 0x42, 0x74, 0xdb, 0xe0, 0x25, 0x59, 0x66, 0xde, 0xe7, 0x7d, 0x7e, 0xef, 0xcb,
-	0xb3, 0x0b, 0x97, 0x9a, 0x52, 0x45, 0x52, 0x61, 0xd5, 0x8d, 0x54, 0x48, 0xd4, 0x16, 0x17, 0x0c,
-	0x77, 0x2a, 0x0d, 0x9a, 0x92, 0x0a, 0xde, 0x6e, 0xd3, 0xa4, 0x1b, 0xc4, 0x89, 0x4c, 0x25, 0x2a,
-	0x99, 0xba, 0xc0, 0xa9, 0x0b, 0x6c, 0x5d, 0xa9, 0x6c, 0x7b, 0x34, 0x88, 0xa2, 0x46, 0x34, 0x6c,
-	0x11, 0x13, 0xc6, 0x05, 0x49, 0xb9, 0x14, 0xa6, 0x4f, 0x69, 0x9e, 0x49, 0x26, 0xf5, 0x23, 0xce,
-	0x9e, 0xec, 0xe9, 0x49, 0x26, 0x25, 0x0b, 0x29, 0x26, 0x31, 0xc7, 0x44, 0x08, 0x99, 0x6a, 0x89,
-	0xb2, 0xb7, 0xcb, 0x39, 0x8c, 0x43, 0x18, 0x53, 0xba, 0x68, 0x4a, 0xeb, 0xc6, 0xc1, 0x32, 0x9b,
-	0xab, 0x63, 0x24, 0xe2, 0x42, 0x62, 0xfd, 0x6b, 0x8e, 0xfc, 0x79, 0x88, 0x1e, 0x66, 0xb8, 0x0f,
-	0x48, 0x42, 0x22, 0x55, 0xa3, 0xdb, 0x6d, 0xaa, 0x52, 0xff, 0x09, 0x3c, 0x3e, 0x72, 0xaa, 0x62,
-	0x29, 0x14, 0x45, 0xb7, 0xe0, 0x4c, 0xac, 0x4f, 0x16, 0xc0, 0x19, 0x70, 0xa1, 0xb8, 0xe6, 0x07,
-	0xff, 0x5e, 0x49, 0x60, 0xb4, 0xd5, 0xd9, 0x9d, 0x1f, 0xa7, 0x0b, 0xef, 0x7f, 0x7f, 0x2c, 0x83,
-	0x9a, 0x15, 0xfb, 0x75, 0x78, 0x42, 0x77, 0xdf, 0xe0, 0x4c, 0x70, 0xc1, 0xee, 0x8a, 0x4d, 0x69,
-	0x8d, 0xd1, 0x4d, 0x38, 0xd7, 0x94, 0x42, 0xd5, 0x49, 0xab, 0x95, 0x50, 0x65, 0x7c, 0x66, 0xab,
-	0x67, 0xbf, 0x7e, 0x5a, 0x39, 0x65, 0xad, 0x6e, 0x64, 0x24, 0x42, 0xb5, 0xd5, 0x75, 0x53, 0xb2,
-	0x91, 0x26, 0x5c, 0xb0, 0x5a, 0x31, 0x93, 0xd9, 0x23, 0xff, 0x25, 0x80, 0x0b, 0xe3, 0x0e, 0x76,
-	0x08, 0x0a, 0x8f, 0x76, 0x48, 0x58, 0x57, 0xe6, 0xaa, 0xce, 0xc5, 0xa6, 0xb4, 0xe3, 0xac, 0xe6,
-	0x8d, 0xf3, 0x88, 0x84, 0xbc, 0x45, 0x52, 0x99, 0x38, 0x3d, 0xdd, 0xe1, 0x8e, 0x74, 0x48, 0xe8,
-	0x5c, 0xf9, 0x8d, 0x71, 0x84, 0xc1, 0x7a, 0xd1, 0x6d, 0x08, 0xf7, 0x52, 0x61, 0xcd, 0x97, 0x06,
-	0xe6, 0x59, 0x84, 0x02, 0x93, 0xbb, 0xbd, 0x55, 0x32, 0x6a, 0xb5, 0x35, 0x47, 0xe9, 0x7f, 0x06,
-	0x70, 0xf1, 0x2f, 0x26, 0x76, 0xd0, 0xfb, 0x70, 0xda, 0x0e, 0x77, 0xe8, 0x7f, 0x87, 0xd3, 0x8d,
-	0xd0, 0x9d, 0x11, 0xec, 0x29, 0x8d, 0x7d, 0x7e, 0x5f, 0x6c, 0x43, 0xe3, 0x72, 0xaf, 0xbd, 0x9e,
-	0x86, 0x87, 0x35, 0x37, 0x7a, 0x07, 0xe0, 0x8c, 0x09, 0x0a, 0x0a, 0xf2, 0x00, 0xc7, 0x33, 0x5a,
-	0xc2, 0x07, 0xae, 0x37, 0x04, 0x7e, 0xf9, 0xd5, 0xb7, 0x5f, 0x6f, 0xa7, 0xce, 0x21, 0x1f, 0xe7,
-	0xbc, 0x4c, 0x26, 0xa2, 0xe8, 0x0b, 0x80, 0x45, 0x67, 0x17, 0x68, 0x7d, 0x5f, 0xb3, 0xf1, 0x30,
-	0x97, 0x2e, 0x4d, 0x26, 0xb2, 0x98, 0xd7, 0x34, 0xe6, 0x55, 0x74, 0x25, 0x0f, 0xd3, 0x4d, 0xaf,
-	0xc2, 0xcf, 0xdd, 0x77, 0xe6, 0x05, 0xfa, 0x00, 0xe0, 0x9c, 0x9b, 0x08, 0x34, 0x11, 0xc8, 0x70,
-	0xc1, 0x97, 0x27, 0x54, 0x59, 0xfe, 0x8a, 0xe6, 0xbf, 0x88, 0x96, 0x0f, 0xcc, 0x5f, 0xbd, 0xb7,
-	0xd3, 0xf3, 0xc0, 0x6e, 0xcf, 0x03, 0x3f, 0x7b, 0x1e, 0x78, 0xd3, 0xf7, 0x0a, 0xbb, 0x7d, 0xaf,
-	0xf0, 0xbd, 0xef, 0x15, 0x1e, 0xaf, 0x32, 0x9e, 0x6e, 0xb5, 0x1b, 0x41, 0x53, 0x46, 0x83, 0x76,
-	0xe6, 0x6f, 0x45, 0xb5, 0x9e, 0xe2, 0x67, 0x23, 0xbd, 0xd3, 0x6e, 0x4c, 0x55, 0x63, 0x46, 0x7f,
-	0xd7, 0xd6, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x16, 0xac, 0x78, 0xd6, 0x05, 0x00, 0x00,
+func (m *QueryMissedBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of slashing module
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// SigningInfo queries the signing info of given cons address
-	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
-	// SigningInfos queries signing info of all validators
-	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+func (m *QueryMissedBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryMissedBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksRequest.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryMissedBlocksRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.symslashing.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryMissedBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error) {
-	out := new(QuerySigningInfoResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.symslashing.v1beta1.Query/SigningInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMissedBlocksRequest proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error) {
-	out := new(QuerySigningInfosResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.symslashing.v1beta1.Query/SigningInfos", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMissedBlocksRequest) GetMissedOnly() bool {
+	if m != nil {
+		return m.MissedOnly
 	}
-	return out, nil
+	return false
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of slashing module
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// SigningInfo queries the signing info of given cons address
-	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
-	// SigningInfos queries signing info of all validators
-	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+// QueryMissedBlocksResponse is the response type for the Query/MissedBlocks RPC
+// method
+type QueryMissedBlocksResponse struct {
+	// signed_blocks_window is the size of the window
+	SignedBlocksWindow int64 `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// missed_blocks_counter is the number of blocks missed in the window
+	MissedBlocksCounter int64 `protobuf:"varint,2,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// blocks are the blocks of the window recorded so far, oldest first
+	Blocks []WindowBlock `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryMissedBlocksResponse) Reset()         { *m = QueryMissedBlocksResponse{} }
+func (m *QueryMissedBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksResponse) ProtoMessage()    {}
+func (*QueryMissedBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa48f50de75efed, []int{7}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryMissedBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SigningInfo not implemented")
+func (m *QueryMissedBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
+func (m *QueryMissedBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryMissedBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.symslashing.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryMissedBlocksResponse proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksResponse) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_SigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySigningInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SigningInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.symslashing.v1beta1.Query/SigningInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SigningInfo(ctx, req.(*QuerySigningInfoRequest))
+func (m *QueryMissedBlocksResponse) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySigningInfosRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SigningInfos(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.symslashing.v1beta1.Query/SigningInfos",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SigningInfos(ctx, req.(*QuerySigningInfosRequest))
+func (m *QueryMissedBlocksResponse) GetBlocks() []WindowBlock {
+	if m != nil {
+		return m.Blocks
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.symslashing.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "SigningInfo",
-			Handler:    _Query_SigningInfo_Handler,
-		},
-		{
-			MethodName: "SigningInfos",
-			Handler:    _Query_SigningInfos_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/symslashing/v1beta1/query.proto",
+// WindowBlock is a block of the signed blocks window. The heights of the blocks
+// are not recorded, as the liveness is not tracked while the downtime feature is
+// tripped in the circuit breaker.
+type WindowBlock struct {
+	// index is the index of the block in the missed block bitmap
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// missed is the missed status
+	Missed bool `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WindowBlock) Reset()         { *m = WindowBlock{} }
+func (m *WindowBlock) String() string { return proto.CompactTextString(m) }
+func (*WindowBlock) ProtoMessage()    {}
+func (*WindowBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa48f50de75efed, []int{8}
+}
+func (m *WindowBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WindowBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindowBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WindowBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowBlock.Merge(m, src)
+}
+func (m *WindowBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *WindowBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowBlock.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_WindowBlock proto.InternalMessageInfo
+
+func (m *WindowBlock) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WindowBlock) GetMissed() bool {
+	if m != nil {
+		return m.Missed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryLivenessReportRequest is the request type for the Query/LivenessReport
+// RPC method
+type QueryLivenessReportRequest struct {
+	// limit is the maximum number of validators returned, zero returns all of them
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryLivenessReportRequest) Reset()         { *m = QueryLivenessReportRequest{} }
+func (m *QueryLivenessReportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLivenessReportRequest) ProtoMessage()    {}
+func (*QueryLivenessReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa48f50de75efed, []int{9}
+}
+func (m *QueryLivenessReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLivenessReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLivenessReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySigningInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryLivenessReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLivenessReportRequest.Merge(m, src)
+}
+func (m *QueryLivenessReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLivenessReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLivenessReportRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySigningInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryLivenessReportRequest proto.InternalMessageInfo
 
-func (m *QuerySigningInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsAddress) > 0 {
-		i -= len(m.ConsAddress)
-		copy(dAtA[i:], m.ConsAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryLivenessReportRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QuerySigningInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryLivenessReportResponse is the response type for the Query/LivenessReport
+// RPC method
+type QueryLivenessReportResponse struct {
+	// signed_blocks_window is the size of the window
+	SignedBlocksWindow int64 `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// max_missed_blocks is the number of blocks a validator can miss in the window
+	// without being slashed
+	MaxMissedBlocks int64 `protobuf:"varint,2,opt,name=max_missed_blocks,json=maxMissedBlocks,proto3" json:"max_missed_blocks,omitempty"`
+	// validators are ranked by missed blocks, most first
+	Validators []ValidatorLiveness `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
 }
 
-func (m *QuerySigningInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLivenessReportResponse) Reset()         { *m = QueryLivenessReportResponse{} }
+func (m *QueryLivenessReportResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLivenessReportResponse) ProtoMessage()    {}
+func (*QueryLivenessReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa48f50de75efed, []int{10}
 }
-
-func (m *QuerySigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ValSigningInfo.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryLivenessReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLivenessReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLivenessReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySigningInfosRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryLivenessReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLivenessReportResponse.Merge(m, src)
+}
+func (m *QueryLivenessReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLivenessReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLivenessReportResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySigningInfosRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryLivenessReportResponse proto.InternalMessageInfo
+
+func (m *QueryLivenessReportResponse) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
 }
 
-func (m *QuerySigningInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryLivenessReportResponse) GetMaxMissedBlocks() int64 {
+	if m != nil {
+		return m.MaxMissedBlocks
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QuerySigningInfosResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLivenessReportResponse) GetValidators() []ValidatorLiveness {
+	if m != nil {
+		return m.Validators
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySigningInfosResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorLiveness is the liveness of a validator.
+type ValidatorLiveness struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// missed_blocks_counter is the number of blocks missed in the window
+	MissedBlocksCounter int64 `protobuf:"varint,2,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// margin is the number of blocks the validator can still miss in the window
+	// before being slashed for downtime
+	Margin int64 `protobuf:"varint,3,opt,name=margin,proto3" json:"margin,omitempty"`
+	// slashable_height is the height from which the validator can be slashed for
+	// downtime, once a full window was recorded
+	SlashableHeight int64 `protobuf:"varint,4,opt,name=slashable_height,json=slashableHeight,proto3" json:"slashable_height,omitempty"`
 }
 
-func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Info) > 0 {
-		for iNdEx := len(m.Info) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Info[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ValidatorLiveness) Reset()         { *m = ValidatorLiveness{} }
+func (m *ValidatorLiveness) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLiveness) ProtoMessage()    {}
+func (*ValidatorLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa48f50de75efed, []int{11}
+}
+func (m *ValidatorLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLiveness.Merge(m, src)
+}
+func (m *ValidatorLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLiveness.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ValidatorLiveness proto.InternalMessageInfo
+
+func (m *ValidatorLiveness) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ValidatorLiveness) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorLiveness) GetMargin() int64 {
+	if m != nil {
+		return m.Margin
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QuerySigningInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ValidatorLiveness) GetSlashableHeight() int64 {
+	if m != nil {
+		return m.SlashableHeight
 	}
-	return n
+	return 0
 }
 
-func (m *QuerySigningInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ValSigningInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryCooldownStateRequest is the request type for the Query/CooldownState RPC
+// method
+type QueryCooldownStateRequest struct {
+	// cons_address is the address to query the cooldown state of
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
 }
 
-func (m *QuerySigningInfosRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCooldownStateRequest) Reset()         { *m = QueryCooldownStateRequest{} }
+func (m *QueryCooldownStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCooldownStateRequest) ProtoMessage()    {}
+func (*QueryCooldownStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa48f50de75efed, []int{12}
+}
+func (m *QueryCooldownStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCooldownStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCooldownStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryCooldownStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCooldownStateRequest.Merge(m, src)
+}
+func (m *QueryCooldownStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCooldownStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCooldownStateRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySigningInfosResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryCooldownStateRequest proto.InternalMessageInfo
+
+func (m *QueryCooldownStateRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
 	}
-	var l int
-	_ = l
-	if len(m.Info) > 0 {
-		for _, e := range m.Info {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryCooldownStateResponse is the response type for the Query/CooldownState
+// RPC method
+type QueryCooldownStateResponse struct {
+	State ValidatorCooldownState `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+}
+
+func (m *QueryCooldownStateResponse) Reset()         { *m = QueryCooldownStateResponse{} }
+func (m *QueryCooldownStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCooldownStateResponse) ProtoMessage()    {}
+func (*QueryCooldownStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa48f50de75efed, []int{13}
+}
+func (m *QueryCooldownStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCooldownStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCooldownStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
 }
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryCooldownStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCooldownStateResponse.Merge(m, src)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryCooldownStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCooldownStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCooldownStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCooldownStateResponse proto.InternalMessageInfo
+
+func (m *QueryCooldownStateResponse) GetState() ValidatorCooldownState {
+	if m != nil {
+		return m.State
+	}
+	return ValidatorCooldownState{}
+}
+
+// QueryCooldownStatesRequest is the request type for the Query/CooldownStates
+// RPC method
+type QueryCooldownStatesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCooldownStatesRequest) Reset()         { *m = QueryCooldownStatesRequest{} }
+func (m *QueryCooldownStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCooldownStatesRequest) ProtoMessage()    {}
+func (*QueryCooldownStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa48f50de75efed, []int{14}
+}
+func (m *QueryCooldownStatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCooldownStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCooldownStatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCooldownStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCooldownStatesRequest.Merge(m, src)
+}
+func (m *QueryCooldownStatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCooldownStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCooldownStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCooldownStatesRequest proto.InternalMessageInfo
+
+func (m *QueryCooldownStatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCooldownStatesResponse is the response type for the Query/CooldownStates
+// RPC method
+type QueryCooldownStatesResponse struct {
+	States     []ValidatorCooldownState `protobuf:"bytes,1,rep,name=states,proto3" json:"states"`
+	Pagination *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCooldownStatesResponse) Reset()         { *m = QueryCooldownStatesResponse{} }
+func (m *QueryCooldownStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCooldownStatesResponse) ProtoMessage()    {}
+func (*QueryCooldownStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa48f50de75efed, []int{15}
+}
+func (m *QueryCooldownStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCooldownStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCooldownStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCooldownStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCooldownStatesResponse.Merge(m, src)
+}
+func (m *QueryCooldownStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCooldownStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCooldownStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCooldownStatesResponse proto.InternalMessageInfo
+
+func (m *QueryCooldownStatesResponse) GetStates() []ValidatorCooldownState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *QueryCooldownStatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ValidatorCooldownState is the slashing cooldown state of a validator.
+type ValidatorCooldownState struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// in_grace_period is true while the validator is not slashed for downtime
+	// since it joined the validator set
+	InGracePeriod bool `protobuf:"varint,2,opt,name=in_grace_period,json=inGracePeriod,proto3" json:"in_grace_period,omitempty"`
+	// grace_period_end_height is the last height of the grace period
+	GracePeriodEndHeight int64 `protobuf:"varint,3,opt,name=grace_period_end_height,json=gracePeriodEndHeight,proto3" json:"grace_period_end_height,omitempty"`
+	// cooldowns are the last slashes of the validator per infraction
+	Cooldowns []SlashCooldownStatus `protobuf:"bytes,4,rep,name=cooldowns,proto3" json:"cooldowns"`
+}
+
+func (m *ValidatorCooldownState) Reset()         { *m = ValidatorCooldownState{} }
+func (m *ValidatorCooldownState) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCooldownState) ProtoMessage()    {}
+func (*ValidatorCooldownState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa48f50de75efed, []int{16}
+}
+func (m *ValidatorCooldownState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCooldownState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCooldownState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorCooldownState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCooldownState.Merge(m, src)
+}
+func (m *ValidatorCooldownState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCooldownState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCooldownState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorCooldownState proto.InternalMessageInfo
+
+func (m *ValidatorCooldownState) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorCooldownState) GetInGracePeriod() bool {
+	if m != nil {
+		return m.InGracePeriod
+	}
+	return false
+}
+
+func (m *ValidatorCooldownState) GetGracePeriodEndHeight() int64 {
+	if m != nil {
+		return m.GracePeriodEndHeight
+	}
+	return 0
+}
+
+func (m *ValidatorCooldownState) GetCooldowns() []SlashCooldownStatus {
+	if m != nil {
+		return m.Cooldowns
+	}
+	return nil
+}
+
+// SlashCooldownStatus is the status of a validator's slashing cooldown.
+type SlashCooldownStatus struct {
+	Cooldown SlashCooldown `protobuf:"bytes,1,opt,name=cooldown,proto3" json:"cooldown"`
+	// active is true while the validator is not slashed again for the infraction
+	// because of the cooldown params
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	// epoch_suppressed is true while repeat downtime slashes are suppressed until
+	// the next Symbiotic epoch
+	EpochSuppressed bool `protobuf:"varint,3,opt,name=epoch_suppressed,json=epochSuppressed,proto3" json:"epoch_suppressed,omitempty"`
+}
+
+func (m *SlashCooldownStatus) Reset()         { *m = SlashCooldownStatus{} }
+func (m *SlashCooldownStatus) String() string { return proto.CompactTextString(m) }
+func (*SlashCooldownStatus) ProtoMessage()    {}
+func (*SlashCooldownStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa48f50de75efed, []int{17}
+}
+func (m *SlashCooldownStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashCooldownStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashCooldownStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashCooldownStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashCooldownStatus.Merge(m, src)
+}
+func (m *SlashCooldownStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashCooldownStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashCooldownStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashCooldownStatus proto.InternalMessageInfo
+
+func (m *SlashCooldownStatus) GetCooldown() SlashCooldown {
+	if m != nil {
+		return m.Cooldown
+	}
+	return SlashCooldown{}
+}
+
+func (m *SlashCooldownStatus) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *SlashCooldownStatus) GetEpochSuppressed() bool {
+	if m != nil {
+		return m.EpochSuppressed
+	}
+	return false
+}
+
+// QueryEpochLivenessRequest is the request type for the Query/EpochLiveness RPC
+// method
+type QueryEpochLivenessRequest struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *QueryEpochLivenessRequest) Reset()         { *m = QueryEpochLivenessRequest{} }
+func (m *QueryEpochLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochLivenessRequest) ProtoMessage()    {}
+func (*QueryEpochLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa48f50de75efed, []int{18}
+}
+func (m *QueryEpochLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochLivenessRequest.Merge(m, src)
+}
+func (m *QueryEpochLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochLivenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochLivenessRequest proto.InternalMessageInfo
+
+func (m *QueryEpochLivenessRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// QueryEpochLivenessResponse is the response type for the Query/EpochLiveness
+// RPC method
+type QueryEpochLivenessResponse struct {
+	Liveness EpochLiveness `protobuf:"bytes,1,opt,name=liveness,proto3" json:"liveness"`
+}
+
+func (m *QueryEpochLivenessResponse) Reset()         { *m = QueryEpochLivenessResponse{} }
+func (m *QueryEpochLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochLivenessResponse) ProtoMessage()    {}
+func (*QueryEpochLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa48f50de75efed, []int{19}
+}
+func (m *QueryEpochLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochLivenessResponse.Merge(m, src)
+}
+func (m *QueryEpochLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochLivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochLivenessResponse proto.InternalMessageInfo
+
+func (m *QueryEpochLivenessResponse) GetLiveness() EpochLiveness {
+	if m != nil {
+		return m.Liveness
+	}
+	return EpochLiveness{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.symslashing.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.symslashing.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QuerySigningInfoRequest)(nil), "cosmos.symslashing.v1beta1.QuerySigningInfoRequest")
+	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.symslashing.v1beta1.QuerySigningInfoResponse")
+	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.symslashing.v1beta1.QuerySigningInfosRequest")
+	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.symslashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryMissedBlocksRequest)(nil), "cosmos.symslashing.v1beta1.QueryMissedBlocksRequest")
+	proto.RegisterType((*QueryMissedBlocksResponse)(nil), "cosmos.symslashing.v1beta1.QueryMissedBlocksResponse")
+	proto.RegisterType((*WindowBlock)(nil), "cosmos.symslashing.v1beta1.WindowBlock")
+	proto.RegisterType((*QueryLivenessReportRequest)(nil), "cosmos.symslashing.v1beta1.QueryLivenessReportRequest")
+	proto.RegisterType((*QueryLivenessReportResponse)(nil), "cosmos.symslashing.v1beta1.QueryLivenessReportResponse")
+	proto.RegisterType((*ValidatorLiveness)(nil), "cosmos.symslashing.v1beta1.ValidatorLiveness")
+	proto.RegisterType((*QueryCooldownStateRequest)(nil), "cosmos.symslashing.v1beta1.QueryCooldownStateRequest")
+	proto.RegisterType((*QueryCooldownStateResponse)(nil), "cosmos.symslashing.v1beta1.QueryCooldownStateResponse")
+	proto.RegisterType((*QueryCooldownStatesRequest)(nil), "cosmos.symslashing.v1beta1.QueryCooldownStatesRequest")
+	proto.RegisterType((*QueryCooldownStatesResponse)(nil), "cosmos.symslashing.v1beta1.QueryCooldownStatesResponse")
+	proto.RegisterType((*ValidatorCooldownState)(nil), "cosmos.symslashing.v1beta1.ValidatorCooldownState")
+	proto.RegisterType((*SlashCooldownStatus)(nil), "cosmos.symslashing.v1beta1.SlashCooldownStatus")
+	proto.RegisterType((*QueryEpochLivenessRequest)(nil), "cosmos.symslashing.v1beta1.QueryEpochLivenessRequest")
+	proto.RegisterType((*QueryEpochLivenessResponse)(nil), "cosmos.symslashing.v1beta1.QueryEpochLivenessResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/symslashing/v1beta1/query.proto", fileDescriptor_faa48f50de75efed)
+}
+
+var fileDescriptor_faa48f50de75efed = []byte{
+	// 1252 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0x89, 0x49, 0x9f, 0x9b, 0xfe, 0x99, 0x9a, 0xd6, 0x5d, 0xc0, 0x2d, 0x2b, 0x48,
+	0x49, 0xaa, 0x78, 0x13, 0xa7, 0x09, 0xa8, 0x5c, 0x68, 0x42, 0xda, 0x26, 0x02, 0x1a, 0xd6, 0x02,
+	0x2a, 0x84, 0xb4, 0x5a, 0x7b, 0xa7, 0x9b, 0x51, 0xd7, 0x33, 0xce, 0xce, 0xda, 0x49, 0x54, 0xf5,
+	0x00, 0x1f, 0x00, 0x21, 0x21, 0xbe, 0x03, 0xe2, 0x80, 0x7a, 0x00, 0x0e, 0x48, 0x9c, 0x29, 0x42,
+	0x48, 0x11, 0x08, 0x89, 0x13, 0x42, 0x09, 0x12, 0x5f, 0x03, 0xed, 0xcc, 0xac, 0xb3, 0x4b, 0x16,
+	0xc7, 0x4e, 0x72, 0x69, 0x3d, 0x6f, 0xde, 0x7b, 0xbf, 0xdf, 0xfb, 0x33, 0x6f, 0x9f, 0x02, 0x13,
+	0x0d, 0xc6, 0x9b, 0x8c, 0x9b, 0x7c, 0xbb, 0xc9, 0x7d, 0x87, 0xaf, 0x13, 0xea, 0x99, 0x9d, 0xd9,
+	0x3a, 0x0e, 0x9d, 0x59, 0x73, 0xa3, 0x8d, 0x83, 0xed, 0x4a, 0x2b, 0x60, 0x21, 0x43, 0xba, 0xd4,
+	0xab, 0x24, 0xf4, 0x2a, 0x4a, 0x4f, 0x9f, 0x52, 0x3e, 0xea, 0x0e, 0xc7, 0xd2, 0xa8, 0xeb, 0xa2,
+	0xe5, 0x78, 0x84, 0x3a, 0x21, 0x61, 0x54, 0xfa, 0xd1, 0x8b, 0x1e, 0xf3, 0x98, 0xf8, 0x69, 0x46,
+	0xbf, 0x94, 0xf4, 0x79, 0x8f, 0x31, 0xcf, 0xc7, 0xa6, 0xd3, 0x22, 0xa6, 0x43, 0x29, 0x0b, 0x85,
+	0x09, 0x57, 0xb7, 0x93, 0x3d, 0x38, 0x76, 0xc9, 0x48, 0xd5, 0xcb, 0x52, 0xd5, 0x96, 0x08, 0x8a,
+	0xb3, 0xbc, 0x3a, 0xef, 0x34, 0x09, 0x65, 0xa6, 0xf8, 0x57, 0x8a, 0x8c, 0x22, 0xa0, 0x77, 0x23,
+	0xba, 0x6b, 0x4e, 0xe0, 0x34, 0xb9, 0x85, 0x37, 0xda, 0x98, 0x87, 0xc6, 0x47, 0x70, 0x21, 0x25,
+	0xe5, 0x2d, 0x46, 0x39, 0x46, 0xcb, 0x90, 0x6f, 0x09, 0x49, 0x49, 0xbb, 0xaa, 0xbd, 0x52, 0xa8,
+	0x1a, 0x95, 0xff, 0x4f, 0x49, 0x45, 0xda, 0x2e, 0x9e, 0x7a, 0xfa, 0xe7, 0x95, 0xa1, 0x2f, 0xff,
+	0x79, 0x32, 0xa5, 0x59, 0xca, 0xd8, 0xb0, 0xe1, 0x92, 0xf0, 0x5e, 0x23, 0x1e, 0x25, 0xd4, 0x5b,
+	0xa1, 0x0f, 0x98, 0x02, 0x46, 0x6f, 0xc2, 0xe9, 0x06, 0xa3, 0xdc, 0x76, 0x5c, 0x37, 0xc0, 0x5c,
+	0xe2, 0x9c, 0x5a, 0x7c, 0xf1, 0xd7, 0x6f, 0xa6, 0x5f, 0x50, 0x50, 0x4b, 0x11, 0x13, 0xca, 0xdb,
+	0xfc, 0x96, 0x54, 0xa9, 0x85, 0x01, 0xa1, 0x9e, 0x55, 0x88, 0xcc, 0x94, 0xc8, 0xf8, 0x58, 0x83,
+	0xd2, 0x41, 0x04, 0x15, 0x04, 0x86, 0x73, 0x1d, 0xc7, 0xb7, 0xb9, 0xbc, 0xb2, 0x09, 0x7d, 0xc0,
+	0x54, 0x38, 0x33, 0xbd, 0xc2, 0x79, 0xdf, 0xf1, 0x89, 0xeb, 0x84, 0x2c, 0x48, 0xf8, 0x4c, 0x06,
+	0x77, 0xa6, 0xe3, 0xf8, 0x89, 0x2b, 0xa3, 0x7e, 0x90, 0x42, 0x9c, 0x5e, 0x74, 0x1b, 0x60, 0xbf,
+	0x2b, 0x14, 0xf8, 0x44, 0x0c, 0x1e, 0xb5, 0x50, 0x45, 0xf6, 0xdd, 0x7e, 0x2a, 0x3d, 0xac, 0x6c,
+	0xad, 0x84, 0xa5, 0xf1, 0xad, 0x06, 0x97, 0x33, 0x40, 0x54, 0xa0, 0xf7, 0x60, 0x44, 0x05, 0x97,
+	0x3b, 0x6e, 0x70, 0xc2, 0x11, 0xba, 0x93, 0xa2, 0x3d, 0x2c, 0x68, 0x5f, 0x3b, 0x94, 0xb6, 0x64,
+	0x93, 0xe2, 0xdd, 0xad, 0xcf, 0xdb, 0x84, 0x73, 0xec, 0x2e, 0xfa, 0xac, 0xf1, 0x90, 0x9f, 0x68,
+	0x0b, 0xa0, 0x2b, 0x50, 0x68, 0x0a, 0xe7, 0x36, 0xa3, 0xfe, 0xb6, 0x20, 0x3b, 0x66, 0x81, 0x14,
+	0xdd, 0xa3, 0xfe, 0xb6, 0xf1, 0x4b, 0x9c, 0xbb, 0x34, 0x07, 0x95, 0xbb, 0x19, 0x28, 0x46, 0x0d,
+	0x82, 0x5d, 0xbb, 0x2e, 0x2e, 0xec, 0x4d, 0x42, 0x5d, 0xb6, 0x29, 0xc8, 0xe4, 0x2c, 0x24, 0xef,
+	0xa4, 0xcd, 0x07, 0xe2, 0x06, 0x55, 0xe1, 0x59, 0x05, 0xa8, 0x2c, 0x1a, 0xac, 0x4d, 0x43, 0x1c,
+	0x08, 0xe8, 0x9c, 0x75, 0xa1, 0x99, 0x80, 0x59, 0x92, 0x57, 0x68, 0x15, 0xf2, 0x52, 0xb9, 0x94,
+	0xbb, 0x9a, 0x4b, 0x26, 0x33, 0xab, 0x46, 0x12, 0x47, 0x38, 0x48, 0x3d, 0x2a, 0xe9, 0xc1, 0x58,
+	0x81, 0x42, 0x42, 0x03, 0x15, 0x61, 0x94, 0x50, 0x17, 0x6f, 0x29, 0xc6, 0xf2, 0x80, 0x2e, 0x42,
+	0x5e, 0xf2, 0x28, 0xe5, 0x44, 0x42, 0xd4, 0x69, 0x75, 0x64, 0x6c, 0xf8, 0x5c, 0xce, 0xca, 0xaf,
+	0x63, 0xe2, 0xad, 0x87, 0x46, 0x15, 0x74, 0x91, 0x99, 0xb7, 0x48, 0x07, 0x53, 0xcc, 0xb9, 0x85,
+	0x5b, 0x2c, 0x08, 0xe3, 0xfa, 0x14, 0x61, 0xd4, 0x27, 0x4d, 0x12, 0x0a, 0xcf, 0xe3, 0x96, 0x3c,
+	0x18, 0xbf, 0x6b, 0xf0, 0x5c, 0xa6, 0xd1, 0x91, 0x13, 0x3a, 0x05, 0xe7, 0x9b, 0xce, 0x96, 0x9d,
+	0x4a, 0xaa, 0x4a, 0xe6, 0xd9, 0xa6, 0xb3, 0x95, 0x2c, 0x1b, 0xba, 0x0f, 0xd0, 0x89, 0x5b, 0x38,
+	0x4e, 0xe6, 0x74, 0x5f, 0x0d, 0x1f, 0xd3, 0x4d, 0xa6, 0x34, 0xe1, 0xcb, 0xf8, 0x51, 0x83, 0xf3,
+	0x07, 0x94, 0xd1, 0xeb, 0xf0, 0xcc, 0xc0, 0xed, 0x19, 0x5b, 0x1c, 0xa9, 0x53, 0xa2, 0xc2, 0x39,
+	0x81, 0x47, 0xa8, 0x28, 0x5c, 0xce, 0x52, 0x27, 0x34, 0x09, 0xe7, 0x44, 0x6c, 0x4e, 0xdd, 0xc7,
+	0xb6, 0x2c, 0x5f, 0x69, 0x44, 0xe6, 0xa8, 0x2b, 0xbf, 0x2b, 0xab, 0xea, 0xa8, 0x7e, 0x5f, 0x62,
+	0xcc, 0x77, 0xd9, 0x26, 0xad, 0x85, 0x4e, 0x88, 0x4f, 0x76, 0xee, 0x6e, 0x80, 0x9e, 0x05, 0xa1,
+	0x5a, 0xa0, 0x06, 0xa3, 0x3c, 0x12, 0xa8, 0x81, 0x57, 0xed, 0xab, 0x3e, 0x29, 0x57, 0xc9, 0x22,
+	0x49, 0x5f, 0x86, 0x9b, 0x05, 0x79, 0xe2, 0x83, 0xf6, 0x87, 0xb8, 0xbb, 0xff, 0x0b, 0xa3, 0x42,
+	0x7b, 0x0f, 0xf2, 0x82, 0x0e, 0x57, 0xc3, 0xf6, 0x98, 0xb1, 0x29, 0x67, 0x27, 0x37, 0x70, 0x3f,
+	0x1d, 0x86, 0x8b, 0xd9, 0xb0, 0xc7, 0x6b, 0xe5, 0x09, 0x38, 0x4b, 0xa8, 0xed, 0x05, 0x4e, 0x03,
+	0xdb, 0x2d, 0x1c, 0x10, 0xe6, 0xaa, 0x49, 0x3b, 0x4e, 0xe8, 0x9d, 0x48, 0xba, 0x26, 0x84, 0x68,
+	0x1e, 0x2e, 0x25, 0x95, 0x6c, 0x4c, 0xdd, 0xb8, 0x5b, 0x65, 0x3f, 0x17, 0xbd, 0x7d, 0xed, 0x65,
+	0xea, 0xca, 0x96, 0x45, 0xf7, 0xe1, 0x54, 0x43, 0x91, 0xe5, 0xa5, 0x11, 0x91, 0x59, 0xb3, 0x57,
+	0x66, 0x6b, 0x91, 0x20, 0x19, 0x5e, 0x3b, 0xf5, 0xae, 0xf7, 0x9d, 0x19, 0x5f, 0x69, 0x70, 0x21,
+	0x43, 0x1b, 0xad, 0xc1, 0x58, 0xac, 0xa4, 0xda, 0x65, 0xb2, 0x6f, 0xc0, 0x24, 0x54, 0xd7, 0x4b,
+	0xf4, 0x72, 0x9d, 0x46, 0x48, 0x3a, 0x58, 0x65, 0x46, 0x9d, 0xa2, 0x97, 0x8b, 0x5b, 0xac, 0xb1,
+	0x6e, 0xf3, 0x76, 0xab, 0x15, 0xe0, 0xc4, 0x50, 0x3e, 0x2b, 0xe4, 0xb5, 0xae, 0xd8, 0x98, 0x55,
+	0x2f, 0x77, 0x39, 0x92, 0xef, 0xcf, 0xd7, 0xee, 0x38, 0x16, 0xfa, 0x82, 0xee, 0x88, 0x25, 0x0f,
+	0x06, 0x05, 0x3d, 0xcb, 0x44, 0xb5, 0xeb, 0x1a, 0x8c, 0xf9, 0x4a, 0xd6, 0x4f, 0x94, 0x29, 0x27,
+	0xa9, 0x28, 0x63, 0x2f, 0xd5, 0x9d, 0x02, 0x8c, 0x0a, 0x40, 0xf4, 0x85, 0x06, 0x79, 0xb9, 0xfa,
+	0xa1, 0x4a, 0x2f, 0xa7, 0x07, 0xb7, 0x4e, 0xdd, 0xec, 0x5b, 0x5f, 0xc6, 0x61, 0x4c, 0x7d, 0xf2,
+	0xdb, 0xdf, 0x9f, 0x0f, 0xbf, 0x84, 0x0c, 0xb3, 0xc7, 0x7a, 0x2c, 0x97, 0x4e, 0xf4, 0xbd, 0x06,
+	0x85, 0xc4, 0x76, 0x83, 0xe6, 0x0e, 0x05, 0x3b, 0xb8, 0x9e, 0xea, 0x37, 0x06, 0x33, 0x52, 0x34,
+	0xdf, 0x10, 0x34, 0x6f, 0xa2, 0xd7, 0x7a, 0xd1, 0x4c, 0xee, 0xa3, 0xdc, 0x7c, 0x94, 0x9c, 0xc6,
+	0x8f, 0xd1, 0xd7, 0x1a, 0x9c, 0x4e, 0x78, 0xe6, 0x68, 0x20, 0x22, 0xdd, 0x04, 0xcf, 0x0f, 0x68,
+	0xa5, 0xf8, 0xcf, 0x0a, 0xfe, 0xd7, 0xd1, 0x64, 0xdf, 0xfc, 0xd1, 0xcf, 0x1a, 0x9c, 0x4e, 0x7d,
+	0xa1, 0x0f, 0x27, 0x9c, 0xb1, 0x0b, 0xea, 0xf3, 0x03, 0x5a, 0x29, 0xc2, 0xef, 0x08, 0xc2, 0x77,
+	0xd1, 0xed, 0xa3, 0x26, 0xdc, 0x4c, 0x7d, 0xa0, 0xd1, 0x77, 0x1a, 0x9c, 0x49, 0xef, 0x35, 0x68,
+	0xe1, 0x50, 0x66, 0x99, 0xdb, 0x93, 0xfe, 0xea, 0xc0, 0x76, 0x2a, 0xa6, 0x39, 0x11, 0xd3, 0x34,
+	0xba, 0xde, 0x2b, 0xa6, 0xf8, 0x3d, 0xda, 0x81, 0x64, 0xf9, 0x93, 0x06, 0xe3, 0xe9, 0x71, 0x7f,
+	0x78, 0x46, 0xb3, 0xf6, 0x03, 0x7d, 0x61, 0x50, 0x33, 0xc5, 0x7a, 0x45, 0xb0, 0x5e, 0x42, 0xb7,
+	0x8e, 0x5c, 0x89, 0xee, 0x20, 0x7d, 0xa2, 0xc1, 0x99, 0x14, 0x08, 0x47, 0x03, 0xb2, 0xe2, 0xfd,
+	0x17, 0x21, 0xfb, 0x3b, 0x6f, 0x4c, 0x8b, 0x70, 0xae, 0xa1, 0x97, 0x7b, 0x85, 0x13, 0x33, 0x16,
+	0x33, 0x67, 0x3c, 0x35, 0x3c, 0xfb, 0x48, 0x7f, 0xd6, 0x90, 0xd7, 0x17, 0x06, 0x35, 0x53, 0x7c,
+	0x6f, 0x0a, 0xbe, 0x37, 0x50, 0xb5, 0x17, 0x5f, 0xf9, 0x19, 0x8a, 0x5b, 0xc7, 0x7c, 0x24, 0xce,
+	0x8f, 0x17, 0x57, 0x9f, 0xee, 0x96, 0xb5, 0x9d, 0xdd, 0xb2, 0xf6, 0xd7, 0x6e, 0x59, 0xfb, 0x6c,
+	0xaf, 0x3c, 0xb4, 0xb3, 0x57, 0x1e, 0xfa, 0x63, 0xaf, 0x3c, 0xf4, 0xe1, 0x8c, 0x47, 0xc2, 0xf5,
+	0x76, 0xbd, 0xd2, 0x60, 0xcd, 0xd8, 0xaf, 0xfc, 0x6f, 0x9a, 0xbb, 0x0f, 0xcd, 0xad, 0x14, 0x48,
+	0xb8, 0xdd, 0xc2, 0xbc, 0x9e, 0x17, 0x7f, 0x6c, 0x98, 0xfb, 0x77, 0x00, 0x25, 0x66, 0x3c, 0x54,
+	0x6b, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of slashing module
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// SigningInfo queries the signing info of given cons address
+	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
+	// SigningInfos queries signing info of all validators
+	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// MissedBlocks queries the signed blocks window of given cons address with the height of every block
+	MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error)
+	// LivenessReport queries all validators ranked by missed blocks with their margin before a downtime slash
+	LivenessReport(ctx context.Context, in *QueryLivenessReportRequest, opts ...grpc.CallOption) (*QueryLivenessReportResponse, error)
+	// CooldownState queries the slashing cooldown state of given cons address
+	CooldownState(ctx context.Context, in *QueryCooldownStateRequest, opts ...grpc.CallOption) (*QueryCooldownStateResponse, error)
+	// CooldownStates queries the slashing cooldown state of all validators
+	CooldownStates(ctx context.Context, in *QueryCooldownStatesRequest, opts ...grpc.CallOption) (*QueryCooldownStatesResponse, error)
+	// EpochLiveness queries the liveness summary of an evaluated symstaking epoch
+	EpochLiveness(ctx context.Context, in *QueryEpochLivenessRequest, opts ...grpc.CallOption) (*QueryEpochLivenessResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symslashing.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error) {
+	out := new(QuerySigningInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symslashing.v1beta1.Query/SigningInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error) {
+	out := new(QuerySigningInfosResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symslashing.v1beta1.Query/SigningInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error) {
+	out := new(QueryMissedBlocksResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symslashing.v1beta1.Query/MissedBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LivenessReport(ctx context.Context, in *QueryLivenessReportRequest, opts ...grpc.CallOption) (*QueryLivenessReportResponse, error) {
+	out := new(QueryLivenessReportResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symslashing.v1beta1.Query/LivenessReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CooldownState(ctx context.Context, in *QueryCooldownStateRequest, opts ...grpc.CallOption) (*QueryCooldownStateResponse, error) {
+	out := new(QueryCooldownStateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symslashing.v1beta1.Query/CooldownState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CooldownStates(ctx context.Context, in *QueryCooldownStatesRequest, opts ...grpc.CallOption) (*QueryCooldownStatesResponse, error) {
+	out := new(QueryCooldownStatesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symslashing.v1beta1.Query/CooldownStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochLiveness(ctx context.Context, in *QueryEpochLivenessRequest, opts ...grpc.CallOption) (*QueryEpochLivenessResponse, error) {
+	out := new(QueryEpochLivenessResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symslashing.v1beta1.Query/EpochLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of slashing module
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// SigningInfo queries the signing info of given cons address
+	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
+	// SigningInfos queries signing info of all validators
+	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// MissedBlocks queries the signed blocks window of given cons address with the height of every block
+	MissedBlocks(context.Context, *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error)
+	// LivenessReport queries all validators ranked by missed blocks with their margin before a downtime slash
+	LivenessReport(context.Context, *QueryLivenessReportRequest) (*QueryLivenessReportResponse, error)
+	// CooldownState queries the slashing cooldown state of given cons address
+	CooldownState(context.Context, *QueryCooldownStateRequest) (*QueryCooldownStateResponse, error)
+	// CooldownStates queries the slashing cooldown state of all validators
+	CooldownStates(context.Context, *QueryCooldownStatesRequest) (*QueryCooldownStatesResponse, error)
+	// EpochLiveness queries the liveness summary of an evaluated symstaking epoch
+	EpochLiveness(context.Context, *QueryEpochLivenessRequest) (*QueryEpochLivenessResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfo not implemented")
+}
+func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
+}
+func (*UnimplementedQueryServer) MissedBlocks(ctx context.Context, req *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissedBlocks not implemented")
+}
+func (*UnimplementedQueryServer) LivenessReport(ctx context.Context, req *QueryLivenessReportRequest) (*QueryLivenessReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LivenessReport not implemented")
+}
+func (*UnimplementedQueryServer) CooldownState(ctx context.Context, req *QueryCooldownStateRequest) (*QueryCooldownStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CooldownState not implemented")
+}
+func (*UnimplementedQueryServer) CooldownStates(ctx context.Context, req *QueryCooldownStatesRequest) (*QueryCooldownStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CooldownStates not implemented")
+}
+func (*UnimplementedQueryServer) EpochLiveness(ctx context.Context, req *QueryEpochLivenessRequest) (*QueryEpochLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochLiveness not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symslashing.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symslashing.v1beta1.Query/SigningInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfo(ctx, req.(*QuerySigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symslashing.v1beta1.Query/SigningInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfos(ctx, req.(*QuerySigningInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MissedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissedBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symslashing.v1beta1.Query/MissedBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissedBlocks(ctx, req.(*QueryMissedBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LivenessReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLivenessReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LivenessReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symslashing.v1beta1.Query/LivenessReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LivenessReport(ctx, req.(*QueryLivenessReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CooldownState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCooldownStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CooldownState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symslashing.v1beta1.Query/CooldownState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CooldownState(ctx, req.(*QueryCooldownStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CooldownStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCooldownStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CooldownStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symslashing.v1beta1.Query/CooldownStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CooldownStates(ctx, req.(*QueryCooldownStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symslashing.v1beta1.Query/EpochLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochLiveness(ctx, req.(*QueryEpochLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.symslashing.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "SigningInfo",
+			Handler:    _Query_SigningInfo_Handler,
+		},
+		{
+			MethodName: "SigningInfos",
+			Handler:    _Query_SigningInfos_Handler,
+		},
+		{
+			MethodName: "MissedBlocks",
+			Handler:    _Query_MissedBlocks_Handler,
+		},
+		{
+			MethodName: "LivenessReport",
+			Handler:    _Query_LivenessReport_Handler,
+		},
+		{
+			MethodName: "CooldownState",
+			Handler:    _Query_CooldownState_Handler,
+		},
+		{
+			MethodName: "CooldownStates",
+			Handler:    _Query_CooldownStates_Handler,
+		},
+		{
+			MethodName: "EpochLiveness",
+			Handler:    _Query_EpochLiveness_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/symslashing/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ValSigningInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Info) > 0 {
+		for iNdEx := len(m.Info) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Info[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissedBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissedBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissedBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedOnly {
+		i--
+		if m.MissedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissedBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissedBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissedBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WindowBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindowBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindowBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Missed {
+		i--
+		if m.Missed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLivenessReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLivenessReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLivenessReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLivenessReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLivenessReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLivenessReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxMissedBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxMissedBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashableHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashableHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Margin != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Margin))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCooldownStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCooldownStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCooldownStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCooldownStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCooldownStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCooldownStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCooldownStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCooldownStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCooldownStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCooldownStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCooldownStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCooldownStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.States) > 0 {
+		for iNdEx := len(m.States) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.States[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorCooldownState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCooldownState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCooldownState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cooldowns) > 0 {
+		for iNdEx := len(m.Cooldowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cooldowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.GracePeriodEndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GracePeriodEndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InGracePeriod {
+		i--
+		if m.InGracePeriod {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashCooldownStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashCooldownStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashCooldownStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochSuppressed {
+		i--
+		if m.EpochSuppressed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Cooldown.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Liveness.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValSigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		for _, e := range m.Info {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissedBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MissedOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryMissedBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovQuery(uint64(m.SignedBlocksWindow))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissedBlocksCounter))
+	}
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WindowBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	if m.Missed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryLivenessReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryLivenessReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovQuery(uint64(m.SignedBlocksWindow))
+	}
+	if m.MaxMissedBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.MaxMissedBlocks))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissedBlocksCounter))
+	}
+	if m.Margin != 0 {
+		n += 1 + sovQuery(uint64(m.Margin))
+	}
+	if m.SlashableHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashableHeight))
+	}
+	return n
+}
+
+func (m *QueryCooldownStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCooldownStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCooldownStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCooldownStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		for _, e := range m.States {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorCooldownState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InGracePeriod {
+		n += 2
+	}
+	if m.GracePeriodEndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.GracePeriodEndHeight))
+	}
+	if len(m.Cooldowns) > 0 {
+		for _, e := range m.Cooldowns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SlashCooldownStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cooldown.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	if m.EpochSuppressed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryEpochLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryEpochLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Liveness.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -827,25 +2655,1287 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, ValidatorSigningInfo{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissedBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MissedOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissedBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, WindowBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WindowBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindowBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindowBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Missed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLivenessReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLivenessReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLivenessReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLivenessReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLivenessReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLivenessReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedBlocks", wireType)
+			}
+			m.MaxMissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorLiveness{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			m.Margin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Margin |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashableHeight", wireType)
+			}
+			m.SlashableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCooldownStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCooldownStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCooldownStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCooldownStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCooldownStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCooldownStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -867,7 +3957,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCooldownStatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -890,15 +3980,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCooldownStatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCooldownStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -925,7 +4015,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -950,7 +4043,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCooldownStatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -973,17 +4066,17 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCooldownStatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCooldownStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -993,23 +4086,61 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			m.States = append(m.States, ValidatorCooldownState{})
+			if err := m.States[len(m.States)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1032,7 +4163,7 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorCooldownState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1055,15 +4186,86 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorCooldownState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorCooldownState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InGracePeriod", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InGracePeriod = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodEndHeight", wireType)
+			}
+			m.GracePeriodEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriodEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cooldowns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1090,7 +4292,8 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Cooldowns = append(m.Cooldowns, SlashCooldownStatus{})
+			if err := m.Cooldowns[len(m.Cooldowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1115,7 +4318,7 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+func (m *SlashCooldownStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1138,15 +4341,15 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashCooldownStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashCooldownStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cooldown", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1173,13 +4376,50 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cooldown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSuppressed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EpochSuppressed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1201,7 +4441,7 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochLivenessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1224,17 +4464,17 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochLivenessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var msglen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1244,29 +4484,64 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, ValidatorSigningInfo{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochLivenessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochLivenessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liveness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1293,10 +4568,7 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Liveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
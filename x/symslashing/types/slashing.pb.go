@@ -8,19 +8,23 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/cosmos-sdk/x/symstaking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,6 +32,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// LivenessWindowMode defines how the signed blocks window is evaluated.
+type LivenessWindowMode int32
+
+const (
+	// LIVENESS_WINDOW_MODE_SLIDING evaluates a sliding window of the last
+	// signed_blocks_window blocks on every block.
+	LivenessWindowMode_LIVENESS_WINDOW_MODE_SLIDING LivenessWindowMode = 0
+	// LIVENESS_WINDOW_MODE_EPOCH evaluates the window when the symstaking epoch
+	// changes, and resets it for the next epoch.
+	LivenessWindowMode_LIVENESS_WINDOW_MODE_EPOCH LivenessWindowMode = 1
+)
+
+var LivenessWindowMode_name = map[int32]string{
+	0: "LIVENESS_WINDOW_MODE_SLIDING",
+	1: "LIVENESS_WINDOW_MODE_EPOCH",
+}
+
+var LivenessWindowMode_value = map[string]int32{
+	"LIVENESS_WINDOW_MODE_SLIDING": 0,
+	"LIVENESS_WINDOW_MODE_EPOCH":   1,
+}
+
+func (x LivenessWindowMode) String() string {
+	return proto.EnumName(LivenessWindowMode_name, int32(x))
+}
+
+func (LivenessWindowMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_34c6888ce6ffde6e, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -110,6 +144,17 @@ type Params struct {
 	MinSignedPerWindow      cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_signed_per_window"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// slash_cooldown_blocks is the number of blocks after a slash during which
+	// the validator is not slashed again for the same infraction, zero disables it
+	SlashCooldownBlocks int64 `protobuf:"varint,6,opt,name=slash_cooldown_blocks,json=slashCooldownBlocks,proto3" json:"slash_cooldown_blocks,omitempty"`
+	// slash_cooldown_duration is the time after a slash during which the
+	// validator is not slashed again for the same infraction, zero disables it
+	SlashCooldownDuration time.Duration `protobuf:"bytes,7,opt,name=slash_cooldown_duration,json=slashCooldownDuration,proto3,stdduration" json:"slash_cooldown_duration"`
+	// downtime_grace_period is the number of blocks from a validator's start
+	// height during which it is not slashed for downtime
+	DowntimeGracePeriod int64 `protobuf:"varint,8,opt,name=downtime_grace_period,json=downtimeGracePeriod,proto3" json:"downtime_grace_period,omitempty"`
+	// liveness_window_mode selects how the signed blocks window is evaluated
+	LivenessWindowMode LivenessWindowMode `protobuf:"varint,9,opt,name=liveness_window_mode,json=livenessWindowMode,proto3,enum=cosmos.symslashing.v1beta1.LivenessWindowMode" json:"liveness_window_mode,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -152,9 +197,286 @@ func (m *Params) GetSignedBlocksWindow() int64 {
 	return 0
 }
 
+func (m *Params) GetSlashCooldownBlocks() int64 {
+	if m != nil {
+		return m.SlashCooldownBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSlashCooldownDuration() time.Duration {
+	if m != nil {
+		return m.SlashCooldownDuration
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeGracePeriod() int64 {
+	if m != nil {
+		return m.DowntimeGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetLivenessWindowMode() LivenessWindowMode {
+	if m != nil {
+		return m.LivenessWindowMode
+	}
+	return LivenessWindowMode_LIVENESS_WINDOW_MODE_SLIDING
+}
+
+// EpochLiveness is the liveness summary of a symstaking epoch.
+type EpochLiveness struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// start_height is the first height of the epoch
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the height at which the epoch was evaluated
+	EndHeight  int64                    `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Validators []ValidatorEpochLiveness `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *EpochLiveness) Reset()         { *m = EpochLiveness{} }
+func (m *EpochLiveness) String() string { return proto.CompactTextString(m) }
+func (*EpochLiveness) ProtoMessage()    {}
+func (*EpochLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34c6888ce6ffde6e, []int{2}
+}
+func (m *EpochLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochLiveness.Merge(m, src)
+}
+func (m *EpochLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochLiveness proto.InternalMessageInfo
+
+func (m *EpochLiveness) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochLiveness) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EpochLiveness) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *EpochLiveness) GetValidators() []ValidatorEpochLiveness {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// ValidatorEpochLiveness is the liveness of a validator in a symstaking epoch.
+type ValidatorEpochLiveness struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// power is the validator power in the epoch
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	// blocks is the number of blocks recorded in the window
+	Blocks int64 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// missed_blocks is the number of blocks missed in the window
+	MissedBlocks int64 `protobuf:"varint,4,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+	// slash_request_id is the relay request id of the downtime slash, empty if
+	// the validator was not slashed
+	SlashRequestId string `protobuf:"bytes,5,opt,name=slash_request_id,json=slashRequestId,proto3" json:"slash_request_id,omitempty"`
+}
+
+func (m *ValidatorEpochLiveness) Reset()         { *m = ValidatorEpochLiveness{} }
+func (m *ValidatorEpochLiveness) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEpochLiveness) ProtoMessage()    {}
+func (*ValidatorEpochLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34c6888ce6ffde6e, []int{3}
+}
+func (m *ValidatorEpochLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorEpochLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorEpochLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorEpochLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEpochLiveness.Merge(m, src)
+}
+func (m *ValidatorEpochLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorEpochLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEpochLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEpochLiveness proto.InternalMessageInfo
+
+func (m *ValidatorEpochLiveness) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorEpochLiveness) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *ValidatorEpochLiveness) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *ValidatorEpochLiveness) GetMissedBlocks() int64 {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorEpochLiveness) GetSlashRequestId() string {
+	if m != nil {
+		return m.SlashRequestId
+	}
+	return ""
+}
+
+// SlashCooldown records the last slash of a validator for an infraction.
+type SlashCooldown struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// infraction is the infraction the validator was slashed for
+	Infraction types.Infraction `protobuf:"varint,2,opt,name=infraction,proto3,enum=cosmos.symstaking.v1.Infraction" json:"infraction,omitempty"`
+	// slash_height is the height at which the validator was slashed
+	SlashHeight int64 `protobuf:"varint,3,opt,name=slash_height,json=slashHeight,proto3" json:"slash_height,omitempty"`
+	// slash_epoch is the Symbiotic epoch in which the validator was slashed
+	SlashEpoch uint64 `protobuf:"varint,4,opt,name=slash_epoch,json=slashEpoch,proto3" json:"slash_epoch,omitempty"`
+	// until_height is the first height at which the validator can be slashed
+	// again for the infraction
+	UntilHeight int64 `protobuf:"varint,5,opt,name=until_height,json=untilHeight,proto3" json:"until_height,omitempty"`
+	// until_time is the first block time at which the validator can be slashed
+	// again for the infraction
+	UntilTime time.Time `protobuf:"bytes,6,opt,name=until_time,json=untilTime,proto3,stdtime" json:"until_time"`
+}
+
+func (m *SlashCooldown) Reset()         { *m = SlashCooldown{} }
+func (m *SlashCooldown) String() string { return proto.CompactTextString(m) }
+func (*SlashCooldown) ProtoMessage()    {}
+func (*SlashCooldown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34c6888ce6ffde6e, []int{4}
+}
+func (m *SlashCooldown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashCooldown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashCooldown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashCooldown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashCooldown.Merge(m, src)
+}
+func (m *SlashCooldown) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashCooldown) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashCooldown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashCooldown proto.InternalMessageInfo
+
+func (m *SlashCooldown) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SlashCooldown) GetInfraction() types.Infraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return types.Infraction_INFRACTION_UNSPECIFIED
+}
+
+func (m *SlashCooldown) GetSlashHeight() int64 {
+	if m != nil {
+		return m.SlashHeight
+	}
+	return 0
+}
+
+func (m *SlashCooldown) GetSlashEpoch() uint64 {
+	if m != nil {
+		return m.SlashEpoch
+	}
+	return 0
+}
+
+func (m *SlashCooldown) GetUntilHeight() int64 {
+	if m != nil {
+		return m.UntilHeight
+	}
+	return 0
+}
+
+func (m *SlashCooldown) GetUntilTime() time.Time {
+	if m != nil {
+		return m.UntilTime
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.symslashing.v1beta1.LivenessWindowMode", LivenessWindowMode_name, LivenessWindowMode_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.symslashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.symslashing.v1beta1.Params")
+	proto.RegisterType((*EpochLiveness)(nil), "cosmos.symslashing.v1beta1.EpochLiveness")
+	proto.RegisterType((*ValidatorEpochLiveness)(nil), "cosmos.symslashing.v1beta1.ValidatorEpochLiveness")
+	proto.RegisterType((*SlashCooldown)(nil), "cosmos.symslashing.v1beta1.SlashCooldown")
 }
 
 func init() {
@@ -162,41 +484,67 @@ func init() {
 }
 
 var fileDescriptor_34c6888ce6ffde6e = []byte{
-	// 535 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x52, 0x8a, 0x30, 0x5d, 0x30, 0xa9, 0x1a, 0x82, 0x70, 0xda, 0x8a, 0xa1, 0x54,
-	0x8a, 0xdd, 0x82, 0xc4, 0x50, 0x26, 0xd2, 0x08, 0x81, 0x84, 0xa0, 0x4a, 0x24, 0x90, 0x58, 0xac,
-	0xb3, 0x7d, 0xb9, 0x9c, 0x62, 0xdf, 0x8b, 0xee, 0x9d, 0x49, 0xf3, 0x15, 0x98, 0xf8, 0x08, 0x8c,
-	0x1d, 0x33, 0xf4, 0x43, 0x74, 0xac, 0xca, 0x82, 0x18, 0x2a, 0x94, 0x0c, 0xe5, 0x63, 0x20, 0xdf,
-	0x5d, 0x04, 0x14, 0xb1, 0xd0, 0xc5, 0xb2, 0xdf, 0xff, 0xff, 0xfe, 0x3f, 0xbd, 0xbb, 0x67, 0xf7,
-	0x61, 0x02, 0x98, 0x03, 0x86, 0x38, 0xc9, 0x31, 0x23, 0x38, 0xe0, 0x82, 0x85, 0x1f, 0x76, 0x63,
-	0xaa, 0xc8, 0x6e, 0xb8, 0x28, 0x04, 0x23, 0x09, 0x0a, 0xbc, 0x86, 0xb1, 0x06, 0xbf, 0x59, 0x03,
-	0x6b, 0x6d, 0xd4, 0x18, 0x30, 0xd0, 0xb6, 0xb0, 0x7c, 0x33, 0x1d, 0x0d, 0x9f, 0x01, 0xb0, 0x8c,
-	0x86, 0xfa, 0x2b, 0x2e, 0xfa, 0x61, 0x5a, 0x48, 0xa2, 0x38, 0x08, 0xab, 0x37, 0x2f, 0xeb, 0x8a,
-	0xe7, 0x14, 0x15, 0xc9, 0x47, 0xd6, 0x70, 0xd7, 0x20, 0x23, 0x93, 0x6c, 0xf9, 0x46, 0xba, 0x4d,
-	0x72, 0x2e, 0x20, 0xd4, 0x4f, 0x53, 0xda, 0xfc, 0xe2, 0xb8, 0xb5, 0xb7, 0x24, 0xe3, 0x29, 0x51,
-	0x20, 0x7b, 0x9c, 0x09, 0x2e, 0xd8, 0x4b, 0xd1, 0x07, 0xef, 0xa9, 0x7b, 0x83, 0xa4, 0xa9, 0xa4,
-	0x88, 0x75, 0x67, 0xdd, 0xd9, 0xba, 0xd9, 0xde, 0x38, 0x3b, 0x6e, 0xdd, 0xb7, 0x71, 0xfb, 0x20,
-	0x90, 0x0a, 0x2c, 0xf0, 0x99, 0xb1, 0xf4, 0x94, 0xe4, 0x82, 0x75, 0x17, 0x1d, 0xde, 0x86, 0xbb,
-	0x82, 0x8a, 0x48, 0x15, 0x0d, 0x28, 0x67, 0x03, 0x55, 0xbf, 0xb6, 0xee, 0x6c, 0x55, 0xbb, 0xb7,
-	0x74, 0xed, 0x85, 0x2e, 0x95, 0x16, 0x2e, 0x52, 0x7a, 0x18, 0x41, 0xbf, 0x8f, 0x54, 0xd5, 0xab,
-	0xc6, 0xa2, 0x6b, 0x6f, 0x74, 0xc9, 0x7b, 0xe4, 0xae, 0xe6, 0x1c, 0x91, 0xa6, 0x51, 0x9c, 0x41,
-	0x32, 0xc4, 0x28, 0x81, 0x42, 0x28, 0x2a, 0xeb, 0x4b, 0xda, 0x7b, 0xc7, 0x88, 0x6d, 0xad, 0xed,
-	0x1b, 0x69, 0x6f, 0xe9, 0xc7, 0xe7, 0xa6, 0xb3, 0x39, 0xad, 0xba, 0xcb, 0x07, 0x44, 0x92, 0x1c,
-	0xbd, 0x1d, 0xb7, 0x86, 0x9c, 0x89, 0x5f, 0x21, 0x63, 0x2e, 0x52, 0x18, 0xeb, 0xa1, 0xaa, 0x5d,
-	0xcf, 0x68, 0x26, 0xe3, 0x9d, 0x56, 0x3c, 0x5e, 0x62, 0x45, 0x64, 0xbb, 0x46, 0x54, 0x2e, 0x5a,
-	0xca, 0x29, 0x56, 0xda, 0x4f, 0x4e, 0xce, 0x9b, 0x95, 0x6f, 0xe7, 0xcd, 0x7b, 0xe6, 0x2c, 0x30,
-	0x1d, 0x06, 0x1c, 0xc2, 0x9c, 0xa8, 0x41, 0xf0, 0x8a, 0x32, 0x92, 0x4c, 0x3a, 0x34, 0x39, 0x3b,
-	0x6e, 0xb9, 0xf6, 0xa8, 0x3a, 0x34, 0x39, 0xba, 0x98, 0x6e, 0x3b, 0x5d, 0x2f, 0xe7, 0xa2, 0xa7,
-	0x33, 0x0f, 0xa8, 0xb4, 0x28, 0x74, 0x1b, 0x7a, 0x2d, 0xa2, 0xbe, 0x24, 0x49, 0x79, 0xc9, 0x51,
-	0x0a, 0x45, 0x9c, 0x51, 0x0d, 0xd7, 0x63, 0xfe, 0x3f, 0x6f, 0x4d, 0x27, 0x3f, 0xb7, 0xc1, 0x1d,
-	0x9d, 0x5b, 0xf2, 0x3d, 0xe1, 0xae, 0xfd, 0x05, 0x1d, 0x8b, 0x72, 0x8d, 0xea, 0xd7, 0xaf, 0x44,
-	0x5c, 0xbd, 0x44, 0x34, 0xa1, 0x7b, 0x0f, 0x3e, 0x5e, 0x4c, 0xb7, 0x9b, 0xc6, 0xdc, 0xc2, 0x74,
-	0x18, 0x1e, 0xfe, 0xf1, 0xe7, 0x98, 0x7b, 0x6a, 0xbf, 0x3e, 0x9a, 0xf9, 0xce, 0xc9, 0xcc, 0x77,
-	0x4e, 0x67, 0xbe, 0xf3, 0x7d, 0xe6, 0x3b, 0x9f, 0xe6, 0x7e, 0xe5, 0x74, 0xee, 0x57, 0xbe, 0xce,
-	0xfd, 0xca, 0xfb, 0x1d, 0xc6, 0xd5, 0xa0, 0x88, 0x83, 0x04, 0x72, 0xbb, 0xd2, 0xe1, 0x3f, 0x03,
-	0xd5, 0x64, 0x44, 0x31, 0x5e, 0xd6, 0xfb, 0xfd, 0xf8, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xec,
-	0xb9, 0xd4, 0x73, 0xad, 0x03, 0x00, 0x00,
+	// 955 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x63, 0x49, 0xa9, 0xce, 0x3f, 0xe0, 0x5e, 0x95, 0x98, 0x51, 0x1b, 0x4a, 0x51, 0x3b,
+	0xa8, 0x06, 0x4c, 0xc6, 0x2a, 0xd0, 0x21, 0x5d, 0x5a, 0x59, 0x6a, 0x2c, 0xc0, 0xb1, 0x0d, 0xaa,
+	0xb0, 0x81, 0x02, 0x05, 0x43, 0x91, 0x27, 0xea, 0x60, 0xf2, 0x4e, 0xe5, 0x9d, 0xec, 0xf8, 0x5f,
+	0xe8, 0x94, 0xb1, 0x63, 0xc7, 0x8c, 0x19, 0xb2, 0x77, 0x2b, 0x32, 0x06, 0xee, 0x52, 0x14, 0x45,
+	0x5a, 0xd8, 0x43, 0xfa, 0x67, 0x14, 0xbc, 0x77, 0x74, 0x65, 0x3b, 0x29, 0x0a, 0x78, 0x21, 0x78,
+	0xef, 0xfb, 0xde, 0xf7, 0xee, 0xf1, 0xfd, 0x20, 0xfa, 0x34, 0xe0, 0x22, 0xe1, 0xc2, 0x11, 0xc7,
+	0x89, 0x88, 0x7d, 0x31, 0xa6, 0x2c, 0x72, 0x0e, 0xd7, 0x87, 0x44, 0xfa, 0xeb, 0x4e, 0x6e, 0xb0,
+	0x27, 0x29, 0x97, 0x1c, 0xd7, 0x80, 0x6a, 0xcf, 0x50, 0x6d, 0x4d, 0xad, 0x55, 0x23, 0x1e, 0x71,
+	0x45, 0x73, 0xb2, 0x37, 0xf0, 0xa8, 0x59, 0x11, 0xe7, 0x51, 0x4c, 0x1c, 0x75, 0x1a, 0x4e, 0x47,
+	0x4e, 0x38, 0x4d, 0x7d, 0x49, 0x39, 0xd3, 0x78, 0xfd, 0x32, 0x2e, 0x69, 0x42, 0x84, 0xf4, 0x93,
+	0x89, 0x26, 0xdc, 0x81, 0x90, 0x1e, 0x28, 0xeb, 0xf8, 0x00, 0xbd, 0xef, 0x27, 0x94, 0x71, 0x47,
+	0x3d, 0xb5, 0xa9, 0x39, 0x93, 0x8b, 0xf4, 0x0f, 0x20, 0x15, 0x47, 0xbf, 0x02, 0xa7, 0xf9, 0xab,
+	0x81, 0xaa, 0x7b, 0x7e, 0x4c, 0x43, 0x5f, 0xf2, 0x74, 0x40, 0x23, 0x46, 0x59, 0xd4, 0x67, 0x23,
+	0x8e, 0xbf, 0x40, 0x37, 0xfd, 0x30, 0x4c, 0x89, 0x10, 0xa6, 0xd1, 0x30, 0x5a, 0x95, 0xce, 0xbd,
+	0x93, 0x17, 0x6b, 0x77, 0x75, 0xc8, 0x0d, 0xce, 0x04, 0x61, 0x62, 0x2a, 0xbe, 0x02, 0xca, 0x40,
+	0xa6, 0x94, 0x45, 0x6e, 0xee, 0x81, 0xef, 0xa1, 0x05, 0x21, 0xfd, 0x54, 0x7a, 0x63, 0x42, 0xa3,
+	0xb1, 0x34, 0x6f, 0x34, 0x8c, 0xd6, 0x9c, 0x3b, 0xaf, 0x6c, 0x9b, 0xca, 0x94, 0x51, 0x28, 0x0b,
+	0xc9, 0x13, 0x8f, 0x8f, 0x46, 0x82, 0x48, 0x73, 0x0e, 0x28, 0xca, 0xb6, 0xa3, 0x4c, 0xb8, 0x8d,
+	0x6e, 0x25, 0x54, 0x08, 0x12, 0x7a, 0xc3, 0x98, 0x07, 0x07, 0xc2, 0x0b, 0xf8, 0x94, 0x49, 0x92,
+	0x9a, 0x45, 0xc5, 0xfd, 0x00, 0xc0, 0x8e, 0xc2, 0x36, 0x00, 0x7a, 0x50, 0xfc, 0xfb, 0xa7, 0xba,
+	0xd1, 0xfc, 0xa3, 0x84, 0xca, 0xbb, 0x7e, 0xea, 0x27, 0x02, 0xdf, 0x47, 0x55, 0x41, 0x23, 0xf6,
+	0xaf, 0xc8, 0x11, 0x65, 0x21, 0x3f, 0x52, 0x49, 0xcd, 0xb9, 0x18, 0x30, 0xd0, 0xd8, 0x57, 0x08,
+	0xa6, 0x59, 0x58, 0xe6, 0x69, 0xaf, 0x09, 0x49, 0x73, 0x97, 0x2c, 0x8b, 0x85, 0xce, 0xe7, 0x2f,
+	0x5f, 0xd7, 0x0b, 0xbf, 0xbf, 0xae, 0x7f, 0x08, 0xdf, 0x42, 0x84, 0x07, 0x36, 0xe5, 0x4e, 0xe2,
+	0xcb, 0xb1, 0xbd, 0x45, 0x22, 0x3f, 0x38, 0xee, 0x92, 0xe0, 0xe4, 0xc5, 0x1a, 0xd2, 0x9f, 0xaa,
+	0x4b, 0x82, 0x67, 0x6f, 0x9e, 0xaf, 0x1a, 0x2e, 0x4e, 0x28, 0x1b, 0x28, 0xcd, 0x5d, 0x92, 0xea,
+	0x50, 0x02, 0xd5, 0x54, 0xeb, 0x78, 0xa3, 0xd4, 0x0f, 0xb2, 0x46, 0xf0, 0x42, 0x3e, 0x1d, 0xc6,
+	0x44, 0x05, 0x37, 0x8b, 0xd7, 0x8a, 0xb7, 0xa2, 0x94, 0xbf, 0xd6, 0xc2, 0x5d, 0xa5, 0x9b, 0xc5,
+	0xc7, 0x0c, 0xad, 0x5c, 0x09, 0x7a, 0xc4, 0xb2, 0x56, 0x33, 0x4b, 0xd7, 0x8a, 0x78, 0xeb, 0x52,
+	0x44, 0x10, 0xcd, 0xca, 0x08, 0xf1, 0x02, 0xce, 0xe3, 0x2c, 0x94, 0xae, 0x84, 0x59, 0x86, 0x32,
+	0x2a, 0x70, 0x43, 0x63, 0x50, 0x09, 0xfc, 0x18, 0xad, 0x5c, 0xf2, 0xc9, 0x47, 0xc5, 0xbc, 0xd9,
+	0x30, 0x5a, 0xf3, 0xed, 0x3b, 0x36, 0xcc, 0x8a, 0x9d, 0xcf, 0x8a, 0xdd, 0xd5, 0x84, 0xce, 0x62,
+	0x76, 0xfd, 0x1f, 0xff, 0xac, 0x1b, 0xb3, 0xb7, 0xca, 0xf5, 0x73, 0x56, 0x76, 0xab, 0x3c, 0x6d,
+	0x2f, 0x4a, 0xfd, 0x80, 0x64, 0x95, 0xa6, 0x3c, 0x34, 0xdf, 0x83, 0x5b, 0xe5, 0xe0, 0xc3, 0x0c,
+	0xdb, 0x55, 0x10, 0x7e, 0x8c, 0xaa, 0x31, 0x3d, 0x24, 0x8c, 0x88, 0xbc, 0x8d, 0xbc, 0x84, 0x87,
+	0xc4, 0xac, 0x34, 0x8c, 0xd6, 0x52, 0xdb, 0xb6, 0xdf, 0xbd, 0x10, 0xec, 0x2d, 0xed, 0x07, 0x85,
+	0x7f, 0xc4, 0x43, 0xe2, 0xe2, 0xf8, 0x8a, 0xed, 0xc1, 0x27, 0x3f, 0xbc, 0x79, 0xbe, 0x5a, 0x07,
+	0x9d, 0x35, 0x11, 0x1e, 0x38, 0x4f, 0x2e, 0x6c, 0x22, 0xe8, 0xe9, 0xe6, 0x2f, 0x06, 0x5a, 0xec,
+	0x4d, 0x78, 0x30, 0xce, 0x55, 0x71, 0x15, 0x95, 0x48, 0x66, 0x50, 0x6d, 0x5d, 0x74, 0xe1, 0xf0,
+	0x7f, 0xc6, 0xf0, 0x2e, 0x42, 0x84, 0x85, 0x39, 0x01, 0x86, 0xb0, 0x42, 0x58, 0xa8, 0xe1, 0xef,
+	0x10, 0x3a, 0xcc, 0xb7, 0x83, 0x30, 0x8b, 0x8d, 0xb9, 0xd6, 0x7c, 0xbb, 0xfd, 0x5f, 0x79, 0x9e,
+	0xef, 0x92, 0x0b, 0xf7, 0xeb, 0x54, 0xb2, 0x9a, 0x40, 0x3d, 0x66, 0x04, 0x9b, 0x27, 0x06, 0xba,
+	0xfd, 0x76, 0x8f, 0xeb, 0xed, 0x9f, 0x2a, 0x2a, 0x4d, 0xf8, 0x11, 0x49, 0x75, 0xc6, 0x70, 0xc0,
+	0xb7, 0x51, 0x59, 0x77, 0x1e, 0xe4, 0xa9, 0x4f, 0xf8, 0x63, 0xb4, 0x78, 0x61, 0xcf, 0xe8, 0xfd,
+	0xb2, 0x30, 0xbb, 0x5f, 0x70, 0x0b, 0x2d, 0x43, 0x47, 0xa6, 0xe4, 0xfb, 0x29, 0x11, 0xd2, 0xa3,
+	0xa1, 0x1a, 0x97, 0x8a, 0xbb, 0xa4, 0xec, 0x2e, 0x98, 0xfb, 0x61, 0xf3, 0xe7, 0x1b, 0x68, 0x71,
+	0x30, 0xdb, 0x73, 0xd7, 0xcb, 0xe5, 0x4b, 0x84, 0x28, 0xcb, 0x47, 0x55, 0x25, 0xb4, 0xd4, 0x6e,
+	0xcc, 0x96, 0x40, 0xef, 0xf3, 0xc3, 0x75, 0xbb, 0x7f, 0xce, 0x73, 0x67, 0x7c, 0x54, 0x1b, 0xa8,
+	0xab, 0x5f, 0xa8, 0xf2, 0xbc, 0xb2, 0xe9, 0x3a, 0xd7, 0x11, 0x1c, 0x3d, 0xe8, 0xa2, 0xa2, 0xea,
+	0x22, 0xa4, 0x4c, 0xbd, 0xbc, 0x95, 0xa6, 0x4c, 0xd2, 0x38, 0xd7, 0x28, 0x81, 0x86, 0xb2, 0x69,
+	0x8d, 0x4d, 0x84, 0x80, 0xa2, 0x56, 0x49, 0x59, 0x8d, 0x69, 0xed, 0xca, 0x98, 0x7e, 0x93, 0xff,
+	0xd2, 0x60, 0x4e, 0x9f, 0x9e, 0xcf, 0x69, 0x45, 0x39, 0x67, 0xf0, 0xea, 0x1e, 0xc2, 0x57, 0xe7,
+	0x05, 0x37, 0xd0, 0x47, 0x5b, 0xfd, 0xbd, 0xde, 0x76, 0x6f, 0x30, 0xf0, 0xf6, 0xfb, 0xdb, 0xdd,
+	0x9d, 0x7d, 0xef, 0xd1, 0x4e, 0xb7, 0xe7, 0x0d, 0xb6, 0xfa, 0xdd, 0xfe, 0xf6, 0xc3, 0xe5, 0x02,
+	0xb6, 0x50, 0xed, 0xad, 0x8c, 0xde, 0xee, 0xce, 0xc6, 0xe6, 0xb2, 0xd1, 0xd9, 0x7e, 0x76, 0x6a,
+	0x19, 0x2f, 0x4f, 0x2d, 0xe3, 0xd5, 0xa9, 0x65, 0xfc, 0x75, 0x6a, 0x19, 0x4f, 0xcf, 0xac, 0xc2,
+	0xab, 0x33, 0xab, 0xf0, 0xdb, 0x99, 0x55, 0xf8, 0xf6, 0x7e, 0x44, 0xe5, 0x78, 0x3a, 0xb4, 0x03,
+	0x9e, 0xe8, 0x5f, 0xab, 0xf3, 0xce, 0x41, 0x94, 0xc7, 0x13, 0x22, 0x86, 0x65, 0x95, 0xd5, 0x67,
+	0xff, 0x0c, 0x00, 0xd7, 0x76, 0xaf, 0xe6, 0x35, 0x08, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -263,73 +611,221 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.SlashCooldownBlocks != that1.SlashCooldownBlocks {
+		return false
+	}
+	if this.SlashCooldownDuration != that1.SlashCooldownDuration {
+		return false
+	}
+	if this.DowntimeGracePeriod != that1.DowntimeGracePeriod {
+		return false
+	}
+	if this.LivenessWindowMode != that1.LivenessWindowMode {
+		return false
+	}
 	return true
 }
-func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *EpochLiveness) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MissedBlocksCounter != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
-		i--
-		dAtA[i] = 0x20
+	that1, ok := that.(*EpochLiveness)
+	if !ok {
+		that2, ok := that.(EpochLiveness)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.IndexOffset != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.IndexOffset))
-		i--
-		dAtA[i] = 0x18
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x10
+	if this.Epoch != that1.Epoch {
+		return false
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if this.StartHeight != that1.StartHeight {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.EndHeight != that1.EndHeight {
+		return false
 	}
-	return dAtA[:n], nil
+	if len(this.Validators) != len(that1.Validators) {
+		return false
+	}
+	for i := range this.Validators {
+		if !this.Validators[i].Equal(&that1.Validators[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *ValidatorEpochLiveness) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+	that1, ok := that.(*ValidatorEpochLiveness)
+	if !ok {
+		that2, ok := that.(ValidatorEpochLiveness)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	if this.Blocks != that1.Blocks {
+		return false
+	}
+	if this.MissedBlocks != that1.MissedBlocks {
+		return false
+	}
+	if this.SlashRequestId != that1.SlashRequestId {
+		return false
+	}
+	return true
+}
+func (this *SlashCooldown) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashCooldown)
+	if !ok {
+		that2, ok := that.(SlashCooldown)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Infraction != that1.Infraction {
+		return false
+	}
+	if this.SlashHeight != that1.SlashHeight {
+		return false
+	}
+	if this.SlashEpoch != that1.SlashEpoch {
+		return false
+	}
+	if this.UntilHeight != that1.UntilHeight {
+		return false
+	}
+	if !this.UntilTime.Equal(that1.UntilTime) {
+		return false
+	}
+	return true
+}
+func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
 func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.LivenessWindowMode != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.LivenessWindowMode))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DowntimeGracePeriod != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.DowntimeGracePeriod))
+		i--
+		dAtA[i] = 0x40
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SlashCooldownDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashCooldownDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.SlashCooldownBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SlashCooldownBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -356,76 +852,864 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintSlashing(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.SignedBlocksWindow != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocksWindow))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSlashing(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ValidatorSigningInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovSlashing(uint64(l))
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovSlashing(uint64(m.StartHeight))
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEpochLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEpochLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEpochLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashRequestId) > 0 {
+		i -= len(m.SlashRequestId)
+		copy(dAtA[i:], m.SlashRequestId)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.SlashRequestId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MissedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Power != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashCooldown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashCooldown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashCooldown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UntilTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UntilTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.UntilHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.UntilHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SlashEpoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SlashEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SlashHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SlashHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Infraction != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDoubleSign.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.SlashCooldownBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.SlashCooldownBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashCooldownDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.DowntimeGracePeriod != 0 {
+		n += 1 + sovSlashing(uint64(m.DowntimeGracePeriod))
+	}
+	if m.LivenessWindowMode != 0 {
+		n += 1 + sovSlashing(uint64(m.LivenessWindowMode))
+	}
+	return n
+}
+
+func (m *EpochLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovSlashing(uint64(m.Epoch))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.EndHeight))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorEpochLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovSlashing(uint64(m.Power))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovSlashing(uint64(m.Blocks))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocks))
+	}
+	l = len(m.SlashRequestId)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	return n
+}
+
+func (m *SlashCooldown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovSlashing(uint64(m.Infraction))
+	}
+	if m.SlashHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.SlashHeight))
+	}
+	if m.SlashEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.SlashEpoch))
+	}
+	if m.UntilHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.UntilHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UntilTime)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashCooldownBlocks", wireType)
+			}
+			m.SlashCooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashCooldownBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashCooldownDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SlashCooldownDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeGracePeriod", wireType)
+			}
+			m.DowntimeGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeGracePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessWindowMode", wireType)
+			}
+			m.LivenessWindowMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LivenessWindowMode |= LivenessWindowMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorEpochLiveness{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.IndexOffset != 0 {
-		n += 1 + sovSlashing(uint64(m.IndexOffset))
-	}
-	if m.MissedBlocksCounter != 0 {
-		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
-	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignedBlocksWindow != 0 {
-		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.MinSignedPerWindow.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDoubleSign.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDowntime.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	return n
-}
-
-func sovSlashing(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSlashing(x uint64) (n int) {
-	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+func (m *ValidatorEpochLiveness) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -448,10 +1732,10 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorEpochLiveness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorEpochLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -488,9 +1772,9 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			m.StartHeight = 0
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -500,16 +1784,16 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			m.IndexOffset = 0
+			m.Blocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -519,16 +1803,16 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IndexOffset |= int64(b&0x7F) << shift
+				m.Blocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
 			}
-			m.MissedBlocksCounter = 0
+			m.MissedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -538,11 +1822,43 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				m.MissedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -564,7 +1880,7 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *SlashCooldown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -587,17 +1903,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashCooldown: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashCooldown: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.SignedBlocksWindow = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -607,16 +1923,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
 			}
-			var byteLen int
+			m.Infraction = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -626,30 +1955,35 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Infraction |= types.Infraction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashHeight", wireType)
 			}
-			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SlashHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEpoch", wireType)
 			}
-			var byteLen int
+			m.SlashEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -659,30 +1993,35 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.SlashEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntilHeight", wireType)
 			}
-			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UntilTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -692,22 +2031,22 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UntilTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,7 +2,7 @@ package abci
 
 import (
 	abci "github.com/cometbft/cometbft/abci/types"
-	"github.com/cosmos/gogoproto/proto"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -12,9 +12,18 @@ import (
 	symstakingTypes "github.com/cosmos/cosmos-sdk/x/symstaking/types"
 )
 
+// MaxInjectedSignatureProofs is the maximum number of aggregation proofs the proposer injects into a block.
+const MaxInjectedSignatureProofs = 100
+
+// ProposalHandler defines the ABCI PrepareProposal and ProcessProposal handlers injecting the relay data as the
+// first tx of a block, and the PreBlocker storing it. The handlers can be composed with the proposal handlers of the
+// app mempool with SetAppProposalHandlers.
 type ProposalHandler struct {
 	logger log.Logger
 	keeper *keeper.Keeper
+
+	prepareProposal sdk.PrepareProposalHandler
+	processProposal sdk.ProcessProposalHandler
 }
 
 func NewProposalHandler(logger log.Logger, keeper *keeper.Keeper) *ProposalHandler {
@@ -24,48 +33,42 @@ func NewProposalHandler(logger log.Logger, keeper *keeper.Keeper) *ProposalHandl
 	}
 }
 
+// SetAppProposalHandlers sets the proposal handlers of the app mempool, e.g. the lane mempool's. The PrepareProposal
+// handler selects the txs the relay data is injected in front of, and the ProcessProposal handler processes the txs
+// of a proposal without the injected relay data tx, which no app mempool knows how to decode.
+func (h *ProposalHandler) SetAppProposalHandlers(prepareProposal sdk.PrepareProposalHandler, processProposal sdk.ProcessProposalHandler) {
+	h.prepareProposal = prepareProposal
+	h.processProposal = processProposal
+}
+
 func (h *ProposalHandler) PrepareProposal() sdk.PrepareProposalHandler {
 	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
-		proposalTxs := req.Txs
-
-		params, err := h.keeper.Params.Get(ctx)
+		bz, err := h.prepareRelayInjectedTx(ctx, req.Height)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to get params")
-		}
-		if req.Height%params.EpochCheckInterval != 0 {
-			return &abci.ResponsePrepareProposal{
-				Txs: proposalTxs,
-			}, nil
+			return nil, err
 		}
 
-		epoch, err := h.keeper.GetCurrentEpoch(ctx)
-		if err != nil {
-			epoch = &symstakingTypes.StoreEpoch{Epoch: 0}
+		proposalTxs := req.Txs
+		if h.prepareProposal != nil {
+			// leave room for the injected tx in the block space of the app txs
+			appReq := *req
+			if bz != nil {
+				appReq.MaxTxBytes -= cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz})
+			}
+			res, err := h.prepareProposal(ctx, &appReq)
+			if err != nil {
+				return nil, err
+			}
+			proposalTxs = res.Txs
 		}
-
-		latestEpoch, err := h.keeper.GetLatestEpoch(ctx)
-		if err != nil {
-			h.logger.Error("PrepareProposal: failed to get latest epoch from relay", "err", err)
+		if bz == nil {
 			return &abci.ResponsePrepareProposal{
 				Txs: proposalTxs,
 			}, nil
 		}
 
-		if latestEpoch <= epoch.Epoch {
-			// if no new epoch found push existing one
-			latestEpoch = epoch.Epoch
-		}
-
-		data := symstakingTypes.StoreEpoch{
-			Epoch: latestEpoch,
-		}
-		bz, err := proto.Marshal(&data)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to encode injected vote extension tx")
-		}
-
 		// Inject a "fake" tx into the proposal s.t. validators can decode, verify,
-		// and store the canonical stake-weighted average prices.
+		// and store the relay epoch and the signature proofs.
 		proposalTxs = append([][]byte{bz}, proposalTxs...)
 
 		return &abci.ResponsePrepareProposal{
@@ -74,34 +77,133 @@ func (h *ProposalHandler) PrepareProposal() sdk.PrepareProposalHandler {
 	}
 }
 
+// prepareRelayInjectedTx returns the relay data tx to inject into the proposal of the given height, or nil if the
+// relay is not polled at this height or cannot be reached.
+func (h *ProposalHandler) prepareRelayInjectedTx(ctx sdk.Context, height int64) ([]byte, error) {
+	params, err := h.keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get params")
+	}
+	poll, err := h.keeper.ShouldPollRelay(ctx, params, height)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get relay poll schedule")
+	}
+	if !poll {
+		return nil, nil
+	}
+
+	epoch, err := h.keeper.GetCurrentEpoch(ctx)
+	if err != nil {
+		epoch = &symstakingTypes.StoreEpoch{Epoch: 0}
+	}
+
+	latestEpoch, err := h.keeper.GetLatestEpoch(ctx)
+	if err != nil {
+		h.logger.Error("PrepareProposal: failed to get latest epoch from relay", "err", err)
+		return nil, nil
+	}
+
+	if latestEpoch <= epoch.Epoch {
+		// if no new epoch found push existing one
+		latestEpoch = epoch.Epoch
+	}
+
+	proofs, err := h.keeper.FetchSignatureProofs(ctx, MaxInjectedSignatureProofs)
+	if err != nil {
+		h.logger.Error("PrepareProposal: failed to fetch signature proofs from relay", "err", err)
+		proofs = nil
+	}
+
+	data := symstakingTypes.RelayInjectedData{
+		Epoch:  latestEpoch,
+		Proofs: proofs,
+	}
+	bz, err := symstakingTypes.EncodeRelayInjectedTx(&data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode injected relay data tx")
+	}
+	return bz, nil
+}
+
 func (h *ProposalHandler) ProcessProposal() sdk.ProcessProposalHandler {
 	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
-		params, err := h.keeper.Params.Get(ctx)
+		accept, err := h.processRelayInjectedTx(ctx, req)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to get params")
+			return nil, err
 		}
-		if req.Height%params.EpochCheckInterval != 0 || len(req.Txs) == 0 {
+		if !accept {
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+		if h.processProposal == nil {
 			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
 		}
 
-		var epoch symstakingTypes.StoreEpoch
-		if err := proto.Unmarshal(req.Txs[0], &epoch); err != nil {
-			h.logger.Error("ProcessProposal: failed to decode injected epoch tx", "err", err)
-			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		appReq := *req
+		if len(req.Txs) > 0 && symstakingTypes.IsRelayInjectedTx(req.Txs[0]) {
+			appReq.Txs = req.Txs[1:]
 		}
+		return h.processProposal(ctx, &appReq)
+	}
+}
 
-		currentEpoch, err := h.keeper.GetCurrentEpoch(ctx)
-		if err != nil {
-			currentEpoch = &symstakingTypes.StoreEpoch{Epoch: 0}
+// processRelayInjectedTx returns whether the relay data tx injected into the proposal, if any, is valid.
+func (h *ProposalHandler) processRelayInjectedTx(ctx sdk.Context, req *abci.RequestProcessProposal) (bool, error) {
+	params, err := h.keeper.Params.Get(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get params")
+	}
+	poll, err := h.keeper.ShouldPollRelay(ctx, params, req.Height)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get relay poll schedule")
+	}
+	for i, tx := range req.Txs {
+		if symstakingTypes.IsRelayInjectedTx(tx) && (i > 0 || !poll) {
+			h.logger.Error("ProcessProposal: unexpected injected relay data tx", "index", i, "poll", poll)
+			return false, nil
 		}
+	}
+	if !poll || len(req.Txs) == 0 {
+		return true, nil
+	}
 
-		if epoch.Epoch < currentEpoch.Epoch {
-			h.logger.Error("ProcessProposal: invalid epoch number", "expected >=", currentEpoch.Epoch, "got", epoch.Epoch)
-			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
-		}
+	epoch, injected, err := symstakingTypes.DecodeRelayInjectedTx(req.Txs[0])
+	if !injected {
+		// the proposer could not reach its relay
+		return true, nil
+	}
+	if err != nil {
+		h.logger.Error("ProcessProposal: failed to decode injected relay data tx", "err", err)
+		return false, nil
+	}
+
+	currentEpoch, err := h.keeper.GetCurrentEpoch(ctx)
+	if err != nil {
+		currentEpoch = &symstakingTypes.StoreEpoch{Epoch: 0}
+	}
+
+	if epoch.Epoch < currentEpoch.Epoch {
+		h.logger.Error("ProcessProposal: invalid epoch number", "expected >=", currentEpoch.Epoch, "got", epoch.Epoch)
+		return false, nil
+	}
 
-		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	if len(epoch.Proofs) > MaxInjectedSignatureProofs {
+		h.logger.Error("ProcessProposal: too many signature proofs", "max", MaxInjectedSignatureProofs, "got", len(epoch.Proofs))
+		return false, nil
 	}
+	seen := make(map[string]struct{}, len(epoch.Proofs))
+	for _, proof := range epoch.Proofs {
+		if _, ok := seen[proof.RequestId]; ok {
+			h.logger.Error("ProcessProposal: duplicate signature proof", "request_id", proof.RequestId)
+			return false, nil
+		}
+		seen[proof.RequestId] = struct{}{}
+		if err := h.keeper.ValidateSignatureProof(ctx, proof); err != nil {
+			h.logger.Error("ProcessProposal: invalid signature proof", "request_id", proof.RequestId, "err", err)
+			return false, nil
+		}
+	}
+
+	return true, nil
 }
 
 func (h *ProposalHandler) PreBlocker() sdk.PreBlocker {
@@ -110,23 +212,47 @@ func (h *ProposalHandler) PreBlocker() sdk.PreBlocker {
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to get params")
 		}
-		if req.Height%params.EpochCheckInterval != 0 || len(req.Txs) == 0 {
+		poll, err := h.keeper.ShouldPollRelay(ctx, params, req.Height)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get relay poll schedule")
+		}
+		if !poll {
 			return &sdk.ResponsePreBlock{
 				ConsensusParamsChanged: false,
 			}, nil
 		}
 
-		var epoch symstakingTypes.StoreEpoch
-		if err := proto.Unmarshal(req.Txs[0], &epoch); err != nil {
-			return &sdk.ResponsePreBlock{
-				ConsensusParamsChanged: false,
-			}, errors.Wrap(err, "failed to decode injected epoch tx")
+		advanced := false
+		if len(req.Txs) > 0 && symstakingTypes.IsRelayInjectedTx(req.Txs[0]) {
+			epoch, _, err := symstakingTypes.DecodeRelayInjectedTx(req.Txs[0])
+			if err != nil {
+				return &sdk.ResponsePreBlock{
+					ConsensusParamsChanged: false,
+				}, errors.Wrap(err, "failed to decode injected relay data tx")
+			}
+
+			currentEpoch, err := h.keeper.GetCurrentEpoch(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get current epoch")
+			}
+			advanced = epoch.Epoch > currentEpoch.Epoch
+
+			if err := h.keeper.SetCurrentEpoch(ctx, &symstakingTypes.StoreEpoch{Epoch: epoch.Epoch}); err != nil {
+				return &sdk.ResponsePreBlock{
+					ConsensusParamsChanged: false,
+				}, errors.Wrap(err, "failed to set current epoch")
+			}
+			for _, proof := range epoch.Proofs {
+				if err := h.keeper.SetSignatureProof(ctx, proof); err != nil {
+					return &sdk.ResponsePreBlock{
+						ConsensusParamsChanged: false,
+					}, errors.Wrapf(err, "failed to set signature proof for request %s", proof.RequestId)
+				}
+			}
 		}
 
-		if err := h.keeper.SetCurrentEpoch(ctx, &epoch); err != nil {
-			return &sdk.ResponsePreBlock{
-				ConsensusParamsChanged: false,
-			}, errors.Wrap(err, "failed to set current epoch")
+		if err := h.keeper.UpdatePollWindow(ctx, params, req.Height, advanced); err != nil {
+			return nil, errors.Wrap(err, "failed to update relay poll window")
 		}
 		return &sdk.ResponsePreBlock{
 			ConsensusParamsChanged: false,
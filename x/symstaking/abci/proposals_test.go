@@ -0,0 +1,313 @@
+package abci_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/symbioticfi/relay/api/client/v1"
+	"google.golang.org/grpc"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	symabci "github.com/cosmos/cosmos-sdk/x/symstaking/abci"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func setupKeeper(t *testing.T, params types.Params, relayClient types.RelayClient) (sdk.Context, *keeper.Keeper, *symabci.ProposalHandler) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := sdktestutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	k := keeper.NewKeeper(
+		log.NewNopLogger(),
+		runtime.NewKVStoreService(key),
+		encCfg.Codec,
+		address.NewBech32Codec("cosmos"),
+		address.NewBech32Codec("cosmosvalcons"),
+		authtypes.NewModuleAddress(types.GovModuleName),
+		relayClient,
+	)
+	require.NoError(t, k.Params.Set(testCtx.Ctx, params))
+
+	return testCtx.Ctx, k, symabci.NewProposalHandler(log.NewNopLogger(), k)
+}
+
+func injectedEpoch(t *testing.T, epoch uint64) [][]byte {
+	t.Helper()
+
+	bz, err := types.EncodeRelayInjectedTx(&types.RelayInjectedData{Epoch: epoch})
+	require.NoError(t, err)
+	return [][]byte{bz}
+}
+
+// userTxs returns encoded user txs.
+func userTxs(t *testing.T, n int) [][]byte {
+	t.Helper()
+
+	txConfig := moduletestutil.MakeTestEncodingConfig().TxConfig
+	txs := make([][]byte, n)
+	for i := range txs {
+		builder := txConfig.NewTxBuilder()
+		builder.SetMemo(fmt.Sprintf("user tx %d", i))
+		bz, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		txs[i] = bz
+	}
+	return txs
+}
+
+// failingRelayClient fails to fetch the relay epoch.
+type failingRelayClient struct {
+	*types.MockRelayClient
+}
+
+func (failingRelayClient) GetLastAllCommitted(context.Context, *v1.GetLastAllCommittedRequest, ...grpc.CallOption) (*v1.GetLastAllCommittedResponse, error) {
+	return nil, errors.New("relay unavailable")
+}
+
+func preBlock(t *testing.T, ctx sdk.Context, h *symabci.ProposalHandler, height int64, txs [][]byte) {
+	t.Helper()
+
+	_, err := h.PreBlocker()(ctx.WithBlockHeight(height), &abci.RequestFinalizeBlock{Height: height, Txs: txs})
+	require.NoError(t, err)
+}
+
+func requireEpoch(t *testing.T, ctx sdk.Context, k *keeper.Keeper, expected uint64) {
+	t.Helper()
+
+	epoch, err := k.GetCurrentEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, epoch.Epoch)
+}
+
+func requirePolls(t *testing.T, ctx sdk.Context, k *keeper.Keeper, params types.Params, expected map[int64]bool, from, to int64) {
+	t.Helper()
+
+	for height := from; height <= to; height++ {
+		poll, err := k.ShouldPollRelay(ctx, params, height)
+		require.NoError(t, err)
+		require.Equal(t, expected[height], poll, "height %d", height)
+	}
+}
+
+func TestPollScheduleHeight(t *testing.T) {
+	params := types.DefaultParams()
+	params.EpochCheckInterval = 10
+	params.PollGraceBlocks = 3
+	ctx, k, h := setupKeeper(t, params, nil)
+
+	requirePolls(t, ctx, k, params, map[int64]bool{10: true, 20: true}, 1, 20)
+
+	// the proposer could not reach its relay at the check height: the relay is polled on the next blocks
+	preBlock(t, ctx, h, 10, nil)
+	requirePolls(t, ctx, k, params, map[int64]bool{11: true, 12: true, 13: true, 20: true}, 11, 20)
+
+	// the relay epoch is unchanged: the window stays open
+	preBlock(t, ctx, h, 11, injectedEpoch(t, 0))
+	requirePolls(t, ctx, k, params, map[int64]bool{12: true, 13: true, 20: true}, 12, 20)
+
+	// a new epoch closes the window
+	preBlock(t, ctx, h, 12, injectedEpoch(t, 1))
+	requireEpoch(t, ctx, k, 1)
+	requirePolls(t, ctx, k, params, map[int64]bool{20: true}, 12, 20)
+
+	// blocks outside of the schedule are ignored
+	preBlock(t, ctx, h, 13, injectedEpoch(t, 2))
+	requireEpoch(t, ctx, k, 1)
+
+	// without grace blocks, a missed check height skips the interval
+	params.PollGraceBlocks = 0
+	require.NoError(t, k.Params.Set(ctx, params))
+	preBlock(t, ctx, h, 20, nil)
+	requirePolls(t, ctx, k, params, map[int64]bool{30: true}, 21, 30)
+}
+
+func TestPollScheduleTime(t *testing.T) {
+	params := types.DefaultParams()
+	params.PollSchedule = types.PollSchedule_POLL_SCHEDULE_TIME
+	params.PollEpochIdentifier = "minute"
+	params.PollGraceBlocks = 2
+	ctx, k, h := setupKeeper(t, params, nil)
+
+	// check heights are not used by the time schedule
+	requirePolls(t, ctx, k, params, nil, 1, 20)
+
+	// other epochs are ignored
+	require.NoError(t, k.EpochHooks().BeforeEpochStart(ctx.WithBlockHeight(5), "hour", 1))
+	requirePolls(t, ctx, k, params, nil, 1, 20)
+
+	// the relay is polled from the block after the epoch start, for the grace blocks
+	require.NoError(t, k.EpochHooks().BeforeEpochStart(ctx.WithBlockHeight(5), "minute", 1))
+	requirePolls(t, ctx, k, params, map[int64]bool{6: true, 7: true, 8: true}, 6, 20)
+
+	preBlock(t, ctx, h, 6, nil)
+	preBlock(t, ctx, h, 7, injectedEpoch(t, 0))
+	requirePolls(t, ctx, k, params, map[int64]bool{8: true}, 8, 20)
+
+	preBlock(t, ctx, h, 8, injectedEpoch(t, 1))
+	requireEpoch(t, ctx, k, 1)
+	requirePolls(t, ctx, k, params, nil, 8, 20)
+
+	// the relay epoch is never polled outside of a window
+	preBlock(t, ctx, h, 10, injectedEpoch(t, 2))
+	requireEpoch(t, ctx, k, 1)
+}
+
+func processProposal(t *testing.T, ctx sdk.Context, h *symabci.ProposalHandler, height int64, txs [][]byte) abci.ResponseProcessProposal_ProposalStatus {
+	t.Helper()
+
+	res, err := h.ProcessProposal()(ctx.WithBlockHeight(height), &abci.RequestProcessProposal{Height: height, Txs: txs})
+	require.NoError(t, err)
+	return res.Status
+}
+
+func TestProposalWithoutRelay(t *testing.T) {
+	params := types.DefaultParams()
+	params.EpochCheckInterval = 10
+	params.PollGraceBlocks = 2
+	ctx, k, h := setupKeeper(t, params, failingRelayClient{types.NewMockRelayClient(nil)})
+	require.NoError(t, k.SetCurrentEpoch(ctx, &types.StoreEpoch{Epoch: 3}))
+	txs := userTxs(t, 3)
+
+	// the proposer could not reach its relay: only the user txs are proposed
+	res, err := h.PrepareProposal()(ctx.WithBlockHeight(10), &abci.RequestPrepareProposal{Height: 10, Txs: txs})
+	require.NoError(t, err)
+	require.Equal(t, txs, res.Txs)
+
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processProposal(t, ctx, h, 10, res.Txs))
+	preBlock(t, ctx, h, 10, res.Txs)
+	requireEpoch(t, ctx, k, 3)
+
+	// the relay is polled again on the next blocks
+	requirePolls(t, ctx, k, params, map[int64]bool{11: true, 12: true}, 11, 19)
+}
+
+func TestProposalWithRelay(t *testing.T) {
+	params := types.DefaultParams()
+	params.EpochCheckInterval = 10
+	ctx, k, h := setupKeeper(t, params, types.NewMockRelayClient(nil))
+	txs := userTxs(t, 3)
+
+	res, err := h.PrepareProposal()(ctx.WithBlockHeight(10), &abci.RequestPrepareProposal{Height: 10, Txs: txs})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 4)
+	require.True(t, types.IsRelayInjectedTx(res.Txs[0]))
+	require.Equal(t, txs, res.Txs[1:])
+
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processProposal(t, ctx, h, 10, res.Txs))
+	preBlock(t, ctx, h, 10, res.Txs)
+	requireEpoch(t, ctx, k, 0)
+}
+
+func TestProcessProposal(t *testing.T) {
+	params := types.DefaultParams()
+	params.EpochCheckInterval = 10
+	ctx, k, h := setupKeeper(t, params, nil)
+	require.NoError(t, k.SetCurrentEpoch(ctx, &types.StoreEpoch{Epoch: 3}))
+
+	txs := userTxs(t, 2)
+	garbage := append([]byte(types.RelayInjectedTxPrefix), 0xff, 0xff)
+	testCases := []struct {
+		name     string
+		height   int64
+		txs      [][]byte
+		expected abci.ResponseProcessProposal_ProposalStatus
+	}{
+		{"empty block", 10, nil, abci.ResponseProcessProposal_ACCEPT},
+		{"user txs only", 10, txs, abci.ResponseProcessProposal_ACCEPT},
+		{"injected epoch", 10, append(injectedEpoch(t, 4), txs...), abci.ResponseProcessProposal_ACCEPT},
+		{"stale injected epoch", 10, append(injectedEpoch(t, 2), txs...), abci.ResponseProcessProposal_REJECT},
+		{"injected epoch after a user tx", 10, append([][]byte{txs[0]}, injectedEpoch(t, 4)...), abci.ResponseProcessProposal_REJECT},
+		{"malformed injected data", 10, append([][]byte{garbage}, txs...), abci.ResponseProcessProposal_REJECT},
+		{"user txs outside of a poll", 11, txs, abci.ResponseProcessProposal_ACCEPT},
+		{"injected epoch outside of a poll", 11, append(injectedEpoch(t, 4), txs...), abci.ResponseProcessProposal_REJECT},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, processProposal(t, ctx, h, tc.height, tc.txs))
+		})
+	}
+}
+
+func TestPreBlockerUserTxs(t *testing.T) {
+	params := types.DefaultParams()
+	params.EpochCheckInterval = 10
+	params.PollGraceBlocks = 2
+	ctx, k, h := setupKeeper(t, params, nil)
+	require.NoError(t, k.SetCurrentEpoch(ctx, &types.StoreEpoch{Epoch: 3}))
+	txs := userTxs(t, 2)
+
+	// user txs are not decoded as injected relay data
+	preBlock(t, ctx, h, 10, txs)
+	requireEpoch(t, ctx, k, 3)
+	requirePolls(t, ctx, k, params, map[int64]bool{11: true, 12: true}, 11, 19)
+
+	preBlock(t, ctx, h, 11, append(injectedEpoch(t, 4), txs...))
+	requireEpoch(t, ctx, k, 4)
+	requirePolls(t, ctx, k, params, nil, 12, 19)
+}
+
+func TestAppProposalHandlers(t *testing.T) {
+	params := types.DefaultParams()
+	params.EpochCheckInterval = 10
+	ctx, _, h := setupKeeper(t, params, types.NewMockRelayClient(nil))
+	txs := userTxs(t, 3)
+
+	// the app mempool proposes its own txs and rejects the proposals of more than two txs
+	var prepareReq *abci.RequestPrepareProposal
+	var processedTxs [][]byte
+	h.SetAppProposalHandlers(
+		func(_ sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+			prepareReq = req
+			return &abci.ResponsePrepareProposal{Txs: txs[:2]}, nil
+		},
+		func(_ sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+			processedTxs = req.Txs
+			if len(req.Txs) > 2 {
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		},
+	)
+
+	res, err := h.PrepareProposal()(ctx.WithBlockHeight(10), &abci.RequestPrepareProposal{Height: 10, MaxTxBytes: 1000, Txs: txs})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 3)
+	require.True(t, types.IsRelayInjectedTx(res.Txs[0]))
+	require.Equal(t, txs[:2], res.Txs[1:])
+	// the injected tx is taken out of the block space of the app txs
+	require.Less(t, prepareReq.MaxTxBytes, int64(1000))
+
+	// the app handler processes the txs without the injected tx
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processProposal(t, ctx, h, 10, res.Txs))
+	require.Equal(t, txs[:2], processedTxs)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processProposal(t, ctx, h, 10, append(res.Txs, txs[2])))
+
+	// an invalid injected tx is rejected before the app handler runs
+	processedTxs = nil
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processProposal(t, ctx, h, 11, res.Txs))
+	require.Nil(t, processedTxs)
+
+	// outside of a poll, the app txs are proposed as they are
+	res, err = h.PrepareProposal()(ctx.WithBlockHeight(11), &abci.RequestPrepareProposal{Height: 11, MaxTxBytes: 1000, Txs: txs})
+	require.NoError(t, err)
+	require.Equal(t, txs[:2], res.Txs)
+	require.Equal(t, int64(1000), prepareReq.MaxTxBytes)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processProposal(t, ctx, h, 11, res.Txs))
+	require.Equal(t, txs[:2], processedTxs)
+}
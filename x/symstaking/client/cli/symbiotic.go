@@ -0,0 +1,480 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/cometbft/cometbft/privval"
+	"github.com/spf13/cobra"
+	v1 "github.com/symbioticfi/relay/api/client/v1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+const (
+	// FlagRelayRPC is the address of the relay sidecar.
+	FlagRelayRPC = "relay-rpc"
+	// FlagRelayKeyFile is the validator schedule read by the mock relay client.
+	FlagRelayKeyFile = "relay-key-file"
+)
+
+// NewSymbioticCmd returns the command group inspecting the relay sidecar
+// configured for the node and comparing it with the node state.
+func NewSymbioticCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "symbiotic",
+		Short:                      "Symbiotic relay operator subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewRelayEpochsCmd(),
+		NewValsetDiffCmd(),
+		NewCheckKeyCmd(),
+		NewSlashStatusCmd(),
+	)
+
+	return cmd
+}
+
+// NewRelayEpochsCmd returns a CLI command handler showing the relay epochs.
+func NewRelayEpochsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epochs",
+		Short: "Show the relay's current epoch and the last committed epoch of every settlement chain",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			relayClient, err := relayClientFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			current, err := relayClient.GetCurrentEpoch(cmd.Context(), &v1.GetCurrentEpochRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to get the relay current epoch: %w", err)
+			}
+			committed, err := relayClient.GetLastAllCommitted(cmd.Context(), &v1.GetLastAllCommittedRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to get the relay committed epochs: %w", err)
+			}
+
+			out := RelayEpochs{
+				CurrentEpoch: current.GetEpoch(),
+				Chains:       []RelayChainEpoch{},
+			}
+			for chainID, info := range committed.GetEpochInfos() {
+				out.Chains = append(out.Chains, RelayChainEpoch{
+					ChainID:            chainID,
+					LastCommittedEpoch: info.GetLastCommittedEpoch(),
+				})
+			}
+			sort.Slice(out.Chains, func(i, j int) bool { return out.Chains[i].ChainID < out.Chains[j].ChainID })
+
+			return printJSON(clientCtx, out)
+		},
+	}
+
+	addRelayFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewValsetDiffCmd returns a CLI command handler comparing the relay
+// validator set with the CometBFT validator set of the node.
+func NewValsetDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valset-diff [epoch]",
+		Short: "Compare the relay validator set of an epoch with the CometBFT validator set",
+		Long: `Compare the relay validator set of an epoch with the CometBFT validator set of the node,
+as shown by 'comet validator-set'. The epoch defaults to the current x/symstaking epoch.
+Consensus keys are read from the relay keys tagged with the ValidatorKeyTag param.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			relayClient, err := relayClientFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			var epoch uint64
+			if len(args) > 0 {
+				epoch, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid epoch %s: %w", args[0], err)
+				}
+			} else {
+				current, err := queryClient.CurrentEpoch(cmd.Context(), &types.QueryCurrentEpochRequest{})
+				if err != nil {
+					return err
+				}
+				epoch = current.Epoch
+			}
+
+			relaySet, err := relayClient.GetValidatorSet(cmd.Context(), &v1.GetValidatorSetRequest{Epoch: &epoch})
+			if err != nil {
+				return fmt.Errorf("failed to get the relay validator set of epoch %d: %w", epoch, err)
+			}
+			relayVals, err := RelayValidators(relaySet.GetValidators(), params.Params.ValidatorKeyTag)
+			if err != nil {
+				return err
+			}
+
+			cometVals, err := cometValidators(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			diff := DiffValidatorSets(relayVals, cometVals)
+			diff.Epoch = epoch
+			return printJSON(clientCtx, diff)
+		},
+	}
+
+	addRelayFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCheckKeyCmd returns a CLI command handler checking that the consensus key
+// of the node is registered in the relay.
+func NewCheckKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-key",
+		Short: "Check that this node's consensus key is registered in the relay under the ValidatorKeyTag",
+		Long: `Check that the consensus key of this node, read from --priv-validator-key which defaults to
+config/priv_validator_key.json in the node home, is registered in the relay validator set of the
+current relay epoch under the ValidatorKeyTag param. The command fails if the key is not registered.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			relayClient, err := relayClientFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			keyFile, _ := cmd.Flags().GetString(FlagPrivValidatorKey)
+			if keyFile == "" {
+				keyFile = filepath.Join(clientCtx.HomeDir, "config", "priv_validator_key.json")
+			}
+			pv := privval.LoadFilePVEmptyState(keyFile, "")
+			pubKey := pv.Key.PubKey.Bytes()
+
+			params, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			current, err := relayClient.GetCurrentEpoch(cmd.Context(), &v1.GetCurrentEpochRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to get the relay current epoch: %w", err)
+			}
+			epoch := current.GetEpoch()
+			relaySet, err := relayClient.GetValidatorSet(cmd.Context(), &v1.GetValidatorSetRequest{Epoch: &epoch})
+			if err != nil {
+				return fmt.Errorf("failed to get the relay validator set of epoch %d: %w", epoch, err)
+			}
+
+			out := KeyCheck{
+				PubKey: hex.EncodeToString(pubKey),
+				KeyTag: params.Params.ValidatorKeyTag,
+				Epoch:  epoch,
+			}
+			for _, val := range relaySet.GetValidators() {
+				for _, key := range val.Keys {
+					if key.GetTag() == params.Params.ValidatorKeyTag && bytes.Equal(key.GetPayload(), pubKey) {
+						out.Registered = true
+						out.Operator = val.GetOperator()
+						out.VotingPower = val.GetVotingPower()
+					}
+				}
+			}
+
+			if err := printJSON(clientCtx, out); err != nil {
+				return err
+			}
+			if !out.Registered {
+				return fmt.Errorf("consensus key %s is not registered in relay epoch %d under key tag %d", out.PubKey, epoch, out.KeyTag)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagPrivValidatorKey, "", "Path of the validator consensus key file")
+	addRelayFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSlashStatusCmd returns a CLI command handler showing the relay
+// aggregation status of a slash request.
+func NewSlashStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-status [request-id]",
+		Short: "Show the on-chain signature request and the relay aggregation status of a slash request",
+		Long: `Show the on-chain signature request and the relay aggregation status of a slash request.
+The request id is the on-chain signature request id of the slash_request_id event attribute. The relay
+request id is resolved by submitting the message of the request to the relay again, which returns the
+id of the existing relay request.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			relayClient, err := relayClientFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).SignatureRequest(cmd.Context(), &types.QuerySignatureRequestRequest{RequestId: args[0]})
+			if err != nil {
+				return fmt.Errorf("failed to get the signature request %s: %w", args[0], err)
+			}
+
+			out, err := RelaySlashStatus(cmd.Context(), relayClient, res.SignatureRequest)
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, out)
+		},
+	}
+
+	addRelayFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// RelaySlashStatus returns the relay aggregation status of an on-chain
+// signature request. The relay request id is resolved from the key tag,
+// message and epoch of the request.
+func RelaySlashStatus(ctx context.Context, relayClient types.RelayClient, req types.SignatureRequest) (SlashStatus, error) {
+	out := SlashStatus{RequestID: req.RequestId, SignatureRequest: req}
+
+	signed, err := relayClient.SignMessage(ctx, &v1.SignMessageRequest{
+		KeyTag:        req.KeyTag,
+		Message:       req.Message,
+		RequiredEpoch: &req.Epoch,
+	})
+	if err != nil {
+		return out, fmt.Errorf("failed to get the relay request id of request %s: %w", req.RequestId, err)
+	}
+	out.RelayRequestID = signed.GetRequestId()
+
+	status, err := relayClient.GetAggregationStatus(ctx, &v1.GetAggregationStatusRequest{RequestId: out.RelayRequestID})
+	if err != nil {
+		return out, fmt.Errorf("failed to get the relay aggregation status of request %s: %w", out.RelayRequestID, err)
+	}
+	out.CurrentVotingPower = status.GetCurrentVotingPower()
+	out.SignerOperators = status.GetSignerOperators()
+
+	proof, err := relayClient.GetAggregationProof(ctx, &v1.GetAggregationProofRequest{RequestId: out.RelayRequestID})
+	out.ProofAvailable = err == nil && proof.GetAggregationProof() != nil
+
+	return out, nil
+}
+
+// RelayEpochs is the output of the epochs command.
+type RelayEpochs struct {
+	CurrentEpoch uint64            `json:"current_epoch"`
+	Chains       []RelayChainEpoch `json:"chains"`
+}
+
+// RelayChainEpoch is the last epoch committed to a settlement chain.
+type RelayChainEpoch struct {
+	ChainID            uint64 `json:"chain_id"`
+	LastCommittedEpoch uint64 `json:"last_committed_epoch"`
+}
+
+// KeyCheck is the output of the check-key command.
+type KeyCheck struct {
+	PubKey      string `json:"pub_key"`
+	KeyTag      uint32 `json:"key_tag"`
+	Epoch       uint64 `json:"epoch"`
+	Registered  bool   `json:"registered"`
+	Operator    string `json:"operator,omitempty"`
+	VotingPower string `json:"voting_power,omitempty"`
+}
+
+// SlashStatus is the output of the slash-status command.
+type SlashStatus struct {
+	RequestID          string                 `json:"request_id"`
+	RelayRequestID     string                 `json:"relay_request_id"`
+	SignatureRequest   types.SignatureRequest `json:"signature_request"`
+	CurrentVotingPower string                 `json:"current_voting_power"`
+	SignerOperators    []string               `json:"signer_operators"`
+	ProofAvailable     bool                   `json:"proof_available"`
+}
+
+// ValidatorPower is a consensus key and its voting power in a validator set.
+type ValidatorPower struct {
+	PubKey   string `json:"pub_key"`
+	Operator string `json:"operator,omitempty"`
+	Power    int64  `json:"power"`
+}
+
+// PowerMismatch is a consensus key with different voting powers in the relay
+// and the CometBFT validator sets.
+type PowerMismatch struct {
+	PubKey     string `json:"pub_key"`
+	Operator   string `json:"operator,omitempty"`
+	RelayPower int64  `json:"relay_power"`
+	CometPower int64  `json:"comet_power"`
+}
+
+// ValidatorSetDiff is the output of the valset-diff command.
+type ValidatorSetDiff struct {
+	Epoch     uint64           `json:"epoch"`
+	InSync    bool             `json:"in_sync"`
+	OnlyRelay []ValidatorPower `json:"only_relay"`
+	OnlyComet []ValidatorPower `json:"only_comet"`
+	Power     []PowerMismatch  `json:"power_mismatch"`
+}
+
+// RelayValidators returns the consensus keys and powers of a relay validator
+// set, reading the ed25519 keys tagged with keyTag.
+func RelayValidators(vals []*v1.Validator, keyTag uint32) ([]ValidatorPower, error) {
+	out := make([]ValidatorPower, 0, len(vals))
+	for _, val := range vals {
+		var pubKey []byte
+		for _, key := range val.Keys {
+			if key.GetTag() == keyTag && len(key.GetPayload()) == ed25519.PubKeySize {
+				pubKey = key.GetPayload()
+				break
+			}
+		}
+		if pubKey == nil {
+			return nil, fmt.Errorf("consensus key with tag %d not found for validator %s", keyTag, val.GetOperator())
+		}
+
+		power, err := strconv.ParseInt(val.GetVotingPower(), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid voting power of validator %s: %w", val.GetOperator(), err)
+		}
+		out = append(out, ValidatorPower{
+			PubKey:   hex.EncodeToString(pubKey),
+			Operator: val.GetOperator(),
+			Power:    power,
+		})
+	}
+	return out, nil
+}
+
+// DiffValidatorSets compares a relay validator set with a CometBFT validator
+// set by consensus key.
+func DiffValidatorSets(relay, comet []ValidatorPower) ValidatorSetDiff {
+	diff := ValidatorSetDiff{
+		OnlyRelay: []ValidatorPower{},
+		OnlyComet: []ValidatorPower{},
+		Power:     []PowerMismatch{},
+	}
+
+	cometPowers := make(map[string]int64, len(comet))
+	for _, val := range comet {
+		cometPowers[val.PubKey] = val.Power
+	}
+	relayKeys := make(map[string]bool, len(relay))
+	for _, val := range relay {
+		relayKeys[val.PubKey] = true
+		cometPower, ok := cometPowers[val.PubKey]
+		switch {
+		case !ok:
+			diff.OnlyRelay = append(diff.OnlyRelay, val)
+		case cometPower != val.Power:
+			diff.Power = append(diff.Power, PowerMismatch{
+				PubKey:     val.PubKey,
+				Operator:   val.Operator,
+				RelayPower: val.Power,
+				CometPower: cometPower,
+			})
+		}
+	}
+	for _, val := range comet {
+		if !relayKeys[val.PubKey] {
+			diff.OnlyComet = append(diff.OnlyComet, val)
+		}
+	}
+
+	diff.InSync = len(diff.OnlyRelay) == 0 && len(diff.OnlyComet) == 0 && len(diff.Power) == 0
+	return diff
+}
+
+// cometValidators returns the validator set of the latest block of the node.
+func cometValidators(cmd *cobra.Command, clientCtx client.Context) ([]ValidatorPower, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	var out []ValidatorPower
+	perPage := 100
+	for page := 1; ; page++ {
+		res, err := node.Validators(cmd.Context(), nil, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+		for _, val := range res.Validators {
+			out = append(out, ValidatorPower{
+				PubKey: hex.EncodeToString(val.PubKey.Bytes()),
+				Power:  val.VotingPower,
+			})
+		}
+		if len(out) >= res.Total || len(res.Validators) == 0 {
+			return out, nil
+		}
+	}
+}
+
+// relayClientFromFlags returns a client of the relay configured by the relay flags.
+func relayClientFromFlags(cmd *cobra.Command) (types.RelayClient, error) {
+	address, _ := cmd.Flags().GetString(FlagRelayRPC)
+	if address == "" {
+		return nil, fmt.Errorf("no relay rpc address configured, set --%s or SYMBIOTIC_RELAY_RPC", FlagRelayRPC)
+	}
+	keyFile, _ := cmd.Flags().GetString(FlagRelayKeyFile)
+	return types.NewRelayClient(address, keyFile)
+}
+
+func addRelayFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagRelayRPC, os.Getenv("SYMBIOTIC_RELAY_RPC"), fmt.Sprintf("Address of the relay sidecar, or %s for the mock relay", types.MockRelayRPCAddress))
+	cmd.Flags().String(FlagRelayKeyFile, os.Getenv("SYMBIOTIC_KEY_FILE"), "Validator schedule of the mock relay")
+}
+
+func printJSON(clientCtx client.Context, out any) error {
+	bz, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintBytes(bz)
+}
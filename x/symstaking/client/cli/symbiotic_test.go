@@ -0,0 +1,79 @@
+package cli_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/symbioticfi/relay/api/client/v1"
+
+	"github.com/cosmos/cosmos-sdk/x/symstaking/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func TestRelayValidators(t *testing.T) {
+	pk := ed25519.GenPrivKey().PubKey().Bytes()
+	vals := []*v1.Validator{{
+		Operator:    "0xValidator0",
+		VotingPower: "10000",
+		Keys:        []*v1.Key{{Tag: 15, Payload: []byte{1, 2, 3}}, {Tag: 43, Payload: pk}},
+	}}
+
+	out, err := cli.RelayValidators(vals, 43)
+	require.NoError(t, err)
+	require.Equal(t, []cli.ValidatorPower{{PubKey: hex.EncodeToString(pk), Operator: "0xValidator0", Power: 10000}}, out)
+
+	_, err = cli.RelayValidators(vals, 44)
+	require.ErrorContains(t, err, "consensus key with tag 44 not found")
+
+	vals[0].VotingPower = "many"
+	_, err = cli.RelayValidators(vals, 43)
+	require.ErrorContains(t, err, "invalid voting power")
+}
+
+func TestDiffValidatorSets(t *testing.T) {
+	relay := []cli.ValidatorPower{
+		{PubKey: "aa", Operator: "0xa", Power: 10},
+		{PubKey: "bb", Operator: "0xb", Power: 20},
+		{PubKey: "cc", Operator: "0xc", Power: 30},
+	}
+
+	diff := cli.DiffValidatorSets(relay, []cli.ValidatorPower{{PubKey: "cc", Power: 30}, {PubKey: "bb", Power: 20}, {PubKey: "aa", Power: 10}})
+	require.True(t, diff.InSync)
+	require.Empty(t, diff.OnlyRelay)
+	require.Empty(t, diff.OnlyComet)
+	require.Empty(t, diff.Power)
+
+	diff = cli.DiffValidatorSets(relay, []cli.ValidatorPower{{PubKey: "bb", Power: 25}, {PubKey: "cc", Power: 30}, {PubKey: "dd", Power: 40}})
+	require.False(t, diff.InSync)
+	require.Equal(t, []cli.ValidatorPower{relay[0]}, diff.OnlyRelay)
+	require.Equal(t, []cli.ValidatorPower{{PubKey: "dd", Power: 40}}, diff.OnlyComet)
+	require.Equal(t, []cli.PowerMismatch{{PubKey: "bb", Operator: "0xb", RelayPower: 20, CometPower: 25}}, diff.Power)
+}
+
+func TestRelaySlashStatus(t *testing.T) {
+	relayClient := types.NewMockRelayClient(func(uint64) []*v1.Validator {
+		return []*v1.Validator{{Operator: "0xValidator0", VotingPower: "10000"}}
+	})
+	message := []byte("slash")
+	req := types.SignatureRequest{
+		RequestId: types.SignatureRequestID(15, 3, message),
+		KeyTag:    15,
+		Message:   message,
+		Epoch:     3,
+	}
+
+	out, err := cli.RelaySlashStatus(context.Background(), relayClient, req)
+	require.NoError(t, err)
+	// the relay request id differs from the on-chain request id
+	signed, err := relayClient.SignMessage(context.Background(), &v1.SignMessageRequest{KeyTag: 15, Message: message})
+	require.NoError(t, err)
+	require.Equal(t, signed.GetRequestId(), out.RelayRequestID)
+	require.NotEqual(t, req.RequestId, out.RelayRequestID)
+	require.Equal(t, req.RequestId, out.RequestID)
+	require.Equal(t, req, out.SignatureRequest)
+	require.Equal(t, "10000", out.CurrentVotingPower)
+	require.Equal(t, []string{"0xValidator0"}, out.SignerOperators)
+}
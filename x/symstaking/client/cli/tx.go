@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/cometbft/cometbft/privval"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+const (
+	// FlagPrivValidatorKey is the path of the validator consensus key file.
+	FlagPrivValidatorKey = "priv-validator-key"
+	// FlagNonce is the nonce of the voter registration, queried from the chain if not set.
+	FlagNonce = "nonce"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/symstaking transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Symstaking transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterVoterCmd(),
+	)
+
+	return txCmd
+}
+
+// NewRegisterVoterCmd returns a CLI command handler for creating a MsgRegisterVoter transaction.
+func NewRegisterVoterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-voter",
+		Short: "Register the --from account as the governance voter of this node's validator",
+		Long: `Register the --from account as the account voting in governance on behalf of this node's validator.
+The registration is signed with the validator consensus key read from --priv-validator-key,
+which defaults to config/priv_validator_key.json in the node home, and with the registration
+nonce of the validator, queried from the chain unless set with --nonce.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			keyFile, _ := cmd.Flags().GetString(FlagPrivValidatorKey)
+			if keyFile == "" {
+				keyFile = filepath.Join(clientCtx.HomeDir, "config", "priv_validator_key.json")
+			}
+			pv := privval.LoadFilePVEmptyState(keyFile, "")
+
+			voter := clientCtx.GetFromAddress().String()
+			if clientCtx.ChainID == "" {
+				return fmt.Errorf("chain id is required to sign the voter registration")
+			}
+			nonce, err := cmd.Flags().GetUint64(FlagNonce)
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed(FlagNonce) {
+				res, err := types.NewQueryClient(clientCtx).Voter(cmd.Context(), &types.QueryVoterRequest{
+					ConsensusPubkey: pv.Key.PubKey.Bytes(),
+				})
+				if err != nil {
+					return fmt.Errorf("could not query the voter registration nonce: %w", err)
+				}
+				nonce = res.Nonce
+			}
+			signature, err := pv.Key.PrivKey.Sign(types.VoterRegistrationSignBytes(clientCtx.ChainID, voter, nonce))
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRegisterVoter{
+				Voter:           voter,
+				ConsensusPubkey: pv.Key.PubKey.Bytes(),
+				Signature:       signature,
+				Nonce:           nonce,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPrivValidatorKey, "", "Path of the validator consensus key file")
+	cmd.Flags().Uint64(FlagNonce, 0, "Nonce of the registration, queried from the chain if not set")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+// BeginBlock expires the signature requests the relay did not produce an aggregation proof for in time and
+// checkpoints the app hash to the settlement chains every CheckpointInterval blocks, once the app hash of the
+// checkpointed height is found in the block header.
+func (k *Keeper) BeginBlock(ctx context.Context) error {
+	if err := k.ExpireSignatureRequests(ctx); err != nil {
+		return errorsmod.Wrap(err, "could not expire signature requests")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "could not get params")
+	}
+	// the block header carries the app hash of the previous height
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight() - 1
+	if params.CheckpointInterval == 0 || height <= 0 || height%params.CheckpointInterval != 0 {
+		return nil
+	}
+
+	_, err = k.Checkpoint(ctx, params.SigningKeyTag)
+	return err
+}
+
+// Checkpoint requests a relay signature over the app hash and validator set hash found in the current block header
+// and stores the resulting checkpoint at the previous height, the height of the state committed to by the app hash.
+func (k *Keeper) Checkpoint(ctx context.Context, keyTag uint32) (types.Checkpoint, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return types.Checkpoint{}, err
+	}
+
+	header := sdkCtx.BlockHeader()
+	checkpoint := types.Checkpoint{
+		Height:           sdkCtx.BlockHeight() - 1,
+		AppHash:          header.AppHash,
+		ValidatorSetHash: header.ValidatorsHash,
+		Epoch:            epoch.Epoch,
+	}
+	checkpoint.RequestId, err = k.RequestSignature(ctx, types.TypedMessage{
+		Module:  types.ModuleName,
+		Type:    types.CheckpointMessageType,
+		Payload: checkpoint.Payload(),
+	}, keyTag)
+	if err != nil {
+		return types.Checkpoint{}, errorsmod.Wrapf(err, "could not request checkpoint signature at height %d", checkpoint.Height)
+	}
+
+	if err := k.Checkpoints.Set(ctx, checkpoint.Height, checkpoint); err != nil {
+		return types.Checkpoint{}, err
+	}
+	if err := k.pruneCheckpoints(ctx); err != nil {
+		return types.Checkpoint{}, errorsmod.Wrap(err, "could not prune checkpoints")
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCheckpoint,
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(checkpoint.Height, 10)),
+			sdk.NewAttribute(types.AttributeKeyRequestID, checkpoint.RequestId),
+		),
+	)
+	return checkpoint, nil
+}
+
+// pruneCheckpoints removes the checkpoints beyond the checkpoint retention, along with their signature requests.
+func (k *Keeper) pruneCheckpoints(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "could not get params")
+	}
+	if params.CheckpointRetention == 0 {
+		return nil
+	}
+
+	var (
+		kept   uint32
+		pruned []types.Checkpoint
+	)
+	rng := new(collections.Range[int64]).Descending()
+	if err := k.Checkpoints.Walk(ctx, rng, func(_ int64, checkpoint types.Checkpoint) (bool, error) {
+		if kept < params.CheckpointRetention {
+			kept++
+		} else {
+			pruned = append(pruned, checkpoint)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, checkpoint := range pruned {
+		if err := k.Checkpoints.Remove(ctx, checkpoint.Height); err != nil {
+			return err
+		}
+		if err := k.removeSignatureRequest(ctx, checkpoint.RequestId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCheckpointProof returns the checkpoint at the given height with the status and proof of its signature request.
+func (k *Keeper) GetCheckpointProof(ctx context.Context, height int64) (types.CheckpointProof, error) {
+	checkpoint, err := k.Checkpoints.Get(ctx, height)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.CheckpointProof{}, errorsmod.Wrapf(types.ErrCheckpointNotFound, "height %d", height)
+	} else if err != nil {
+		return types.CheckpointProof{}, err
+	}
+	return k.checkpointProof(ctx, checkpoint)
+}
+
+func (k *Keeper) checkpointProof(ctx context.Context, checkpoint types.Checkpoint) (types.CheckpointProof, error) {
+	req, err := k.GetSignatureRequest(ctx, checkpoint.RequestId)
+	if err != nil {
+		return types.CheckpointProof{}, err
+	}
+	return types.CheckpointProof{
+		Checkpoint: &checkpoint,
+		Status:     req.Status,
+		Proof:      req.Proof,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "github.com/symbioticfi/relay/api/client/v1"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func TestBeginBlockCheckpoints(t *testing.T) {
+	ctx, k, _ := setupSigningKeeper(t)
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	params.CheckpointInterval = 5
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	// the checkpoints do not depend on the relay
+	relayClient := &countingRelayClient{
+		MockRelayClient: types.NewMockRelayClient(func(uint64) []*v1.Validator { return nil }),
+		err:             errors.New("relay unavailable"),
+	}
+	k.SetRelayClient(relayClient)
+	require.NoError(t, k.SetCurrentEpoch(ctx, &types.StoreEpoch{Epoch: 3}))
+
+	for height := int64(1); height <= 12; height++ {
+		header := ctx.BlockHeader()
+		header.AppHash = []byte{byte(height)}
+		header.ValidatorsHash = []byte{0xff, byte(height)}
+		ctx = ctx.WithBlockHeader(header).WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+
+		require.NoError(t, k.BeginBlock(ctx))
+		// the app hash of a checkpointed height is found in the header of the next block
+		require.Equal(t, height > 1 && (height-1)%5 == 0, hasEvent(ctx, types.EventTypeCheckpoint), "height %d", height)
+	}
+	require.Zero(t, relayClient.signCalls)
+
+	var heights []int64
+	require.NoError(t, k.Checkpoints.Walk(ctx, nil, func(height int64, _ types.Checkpoint) (bool, error) {
+		heights = append(heights, height)
+		return false, nil
+	}))
+	require.Equal(t, []int64{5, 10}, heights)
+
+	proof, err := k.GetCheckpointProof(ctx, 10)
+	require.NoError(t, err)
+	expected := types.Checkpoint{
+		Height:           10,
+		AppHash:          []byte{11},
+		ValidatorSetHash: []byte{0xff, 11},
+		Epoch:            3,
+	}
+	message := types.TypedMessage{Module: types.ModuleName, Type: types.CheckpointMessageType, Payload: expected.Payload()}
+	expected.RequestId = types.SignatureRequestID(params.SigningKeyTag, 3, message.SignBytes(ctx.ChainID()))
+	require.Equal(t, expected, *proof.Checkpoint)
+	require.Equal(t, types.SignatureStatus_SIGNATURE_STATUS_PENDING, proof.Status)
+
+	// the relay outage only delays the submission of the checkpoints to the relay
+	require.NoError(t, k.SubmitSignatureRequests(ctx))
+	require.Equal(t, 2, relayClient.signCalls)
+}
+
+func TestCheckpointRetention(t *testing.T) {
+	ctx, k, _ := setupSigningKeeper(t)
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	params.CheckpointInterval = 1
+	params.CheckpointRetention = 2
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	requestIDs := map[int64]string{}
+	for height := int64(1); height <= 4; height++ {
+		ctx = ctx.WithBlockHeight(height + 1)
+		require.NoError(t, k.BeginBlock(ctx))
+		checkpoint, err := k.Checkpoints.Get(ctx, height)
+		require.NoError(t, err)
+		requestIDs[height] = checkpoint.RequestId
+	}
+
+	for height, requestID := range requestIDs {
+		_, err := k.Checkpoints.Get(ctx, height)
+		_, reqErr := k.GetSignatureRequest(ctx, requestID)
+		if height <= 2 {
+			require.True(t, errors.Is(err, collections.ErrNotFound), "height %d", height)
+			require.ErrorIs(t, reqErr, types.ErrSignatureRequestNotFound)
+			has, err := k.PendingSignatureRequests.Has(ctx, collections.Join(height+1, requestID))
+			require.NoError(t, err)
+			require.False(t, has)
+		} else {
+			require.NoError(t, err, "height %d", height)
+			require.NoError(t, reqErr)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
@@ -10,6 +11,9 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k *Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) []abci.ValidatorUpdate {
+	if _, err := k.keyTagProofVerifier(genState.Params, genState.Params.SigningKeyTag); err != nil {
+		panic(fmt.Errorf("invalid signing key tag: %w", err))
+	}
 	if err := k.Params.Set(ctx, genState.Params); err != nil {
 		panic(err)
 	}
@@ -25,10 +29,14 @@ func (k *Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) [
 		panic(err)
 	}
 	// set last validator set
-	if err = k.SetLastValidatorSet(ctx, &types.LastValidatorSet{
+	lastValset := types.LastValidatorSet{
 		Epoch:   genState.GenesisEpoch,
 		Updates: valset,
-	}); err != nil {
+	}
+	if err = k.SetLastValidatorSet(ctx, &lastValset); err != nil {
+		panic(err)
+	}
+	if err = k.EpochValidatorSets.Set(ctx, genState.GenesisEpoch, lastValset); err != nil {
 		panic(err)
 	}
 
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+var (
+	_ govkeeper.CalculateVoteResultsAndVotingPowerFn = (*Keeper)(nil).CalculateVoteResultsAndVotingPower
+	_ govkeeper.TotalVotingPowerFn                   = (*Keeper)(nil).TotalVotingPower
+)
+
+// CalculateVoteResultsAndVotingPower implements the x/gov CalculateVoteResultsAndVotingPowerFn. In symstaking tally
+// mode, the vote of each registered voter is weighted by the power of its validator in the current relay validator
+// set and all other votes are ignored. In staking tally mode, it falls back to the x/gov default.
+func (k *Keeper) CalculateVoteResultsAndVotingPower(
+	ctx context.Context,
+	govKeeper govkeeper.Keeper,
+	proposal v1.Proposal,
+	validators map[string]v1.ValidatorGovInfo,
+) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+	if params.GovernanceTallyMode != types.GovernanceTallyMode_GOVERNANCE_TALLY_MODE_SYMSTAKING {
+		return govkeeper.DefaultCalculateVoteResultsAndVotingPower(ctx, govKeeper, proposal, validators)
+	}
+
+	powers, err := k.validatorPowers(ctx)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	totalVoterPower := math.LegacyZeroDec()
+	results := map[v1.VoteOption]math.LegacyDec{
+		v1.OptionYes:        math.LegacyZeroDec(),
+		v1.OptionAbstain:    math.LegacyZeroDec(),
+		v1.OptionNo:         math.LegacyZeroDec(),
+		v1.OptionNoWithVeto: math.LegacyZeroDec(),
+	}
+
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+	var votesToRemove []collections.Pair[uint64, sdk.AccAddress]
+	err = govKeeper.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		votesToRemove = append(votesToRemove, key)
+
+		consensusPubKey, err := k.Voters.Get(ctx, key.K2())
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		power, ok := powers[string(consensusPubKey)]
+		if !ok {
+			return false, nil
+		}
+
+		votingPower := math.LegacyNewDec(power)
+		for _, option := range vote.Options {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			results[option.Option] = results[option.Option].Add(votingPower.Mul(weight))
+		}
+		totalVoterPower = totalVoterPower.Add(votingPower)
+		return false, nil
+	})
+	if err != nil {
+		return math.LegacyDec{}, nil, fmt.Errorf("error while iterating votes: %w", err)
+	}
+
+	for _, key := range votesToRemove {
+		if err := govKeeper.Votes.Remove(ctx, key); err != nil {
+			return math.LegacyDec{}, nil, fmt.Errorf("error while removing vote (%d/%s): %w", key.K1(), key.K2(), err)
+		}
+	}
+
+	return totalVoterPower, results, nil
+}
+
+// TotalVotingPower implements the x/gov TotalVotingPowerFn. In symstaking tally mode, it returns the total power of
+// the current relay validator set. In staking tally mode, it falls back to the x/gov default.
+func (k *Keeper) TotalVotingPower(ctx context.Context, govKeeper govkeeper.Keeper) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if params.GovernanceTallyMode != types.GovernanceTallyMode_GOVERNANCE_TALLY_MODE_SYMSTAKING {
+		return govkeeper.DefaultTotalVotingPower(ctx, govKeeper)
+	}
+
+	powers, err := k.validatorPowers(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	total := math.LegacyZeroDec()
+	for _, power := range powers {
+		total = total.Add(math.LegacyNewDec(power))
+	}
+	return total, nil
+}
+
+// validatorPowers returns the power of the validators of the current relay validator set keyed by consensus pubkey.
+func (k *Keeper) validatorPowers(ctx context.Context) (map[string]int64, error) {
+	valset, err := k.GetLastValidatorSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	powers := make(map[string]int64, len(valset.Updates))
+	for _, val := range valset.Updates {
+		if val.Power > 0 {
+			powers[string(val.PubKey.GetEd25519())] = val.Power
+		}
+	}
+	return powers, nil
+}
@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtestutil "github.com/cosmos/cosmos-sdk/x/gov/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+// setupGovTally returns a symstaking keeper serving the given validator set and a gov keeper tallying with it.
+func setupGovTally(t *testing.T, vals []relayValidator) (sdk.Context, *keeper.Keeper, *govkeeper.Keeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	govKey := storetypes.NewKVStoreKey(govtypes.StoreKey)
+	ctx := sdktestutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{types.StoreKey: key, govtypes.StoreKey: govKey},
+		map[string]*storetypes.TransientStoreKey{"transient_test": storetypes.NewTransientStoreKey("transient_test")},
+		nil,
+	).WithBlockHeight(1)
+	k := newKeeper(ctx, key, map[uint64][]relayValidator{0: vals})
+
+	ctrl := gomock.NewController(t)
+	authKeeper := govtestutil.NewMockAccountKeeper(ctrl)
+	authKeeper.EXPECT().GetModuleAddress(govtypes.ModuleName).Return(authtypes.NewModuleAddress(govtypes.ModuleName)).AnyTimes()
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	stakingKeeper := govtestutil.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).AnyTimes()
+	stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(1000), nil).AnyTimes()
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	govKeeper := govkeeper.NewKeeper(
+		moduletestutil.MakeTestEncodingConfig().Codec,
+		runtime.NewKVStoreService(govKey),
+		authKeeper,
+		govtestutil.NewMockBankKeeper(ctrl),
+		stakingKeeper,
+		govtestutil.NewMockDistributionKeeper(ctrl),
+		baseapp.NewMsgServiceRouter(),
+		govtypes.DefaultConfig(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		govkeeper.WithCustomCalculateVoteResultsAndVotingPowerFn(k.CalculateVoteResultsAndVotingPower),
+		govkeeper.WithCustomTotalVotingPowerFn(k.TotalVotingPower),
+	)
+	require.NoError(t, govKeeper.Params.Set(ctx, govv1.DefaultParams()))
+
+	return ctx, k, govKeeper
+}
+
+func setTallyMode(t *testing.T, ctx sdk.Context, k *keeper.Keeper, mode types.GovernanceTallyMode) {
+	t.Helper()
+
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	params.GovernanceTallyMode = mode
+	require.NoError(t, k.Params.Set(ctx, params))
+}
+
+func addVote(t *testing.T, ctx sdk.Context, govKeeper *govkeeper.Keeper, proposalID uint64, voter sdk.AccAddress, option govv1.VoteOption) {
+	t.Helper()
+
+	vote := govv1.NewVote(proposalID, voter, govv1.NewNonSplitVoteOption(option), "")
+	require.NoError(t, govKeeper.Votes.Set(ctx, collections.Join(proposalID, voter), vote))
+}
+
+func TestGovTallySymstaking(t *testing.T) {
+	vals := []relayValidator{
+		{pubKey: make([]byte, 32), power: 30},
+		{pubKey: append(make([]byte, 31), 1), power: 10},
+		{pubKey: append(make([]byte, 31), 2), power: 20},
+	}
+	ctx, k, govKeeper := setupGovTally(t, vals)
+	setTallyMode(t, ctx, k, types.GovernanceTallyMode_GOVERNANCE_TALLY_MODE_SYMSTAKING)
+
+	voters := []sdk.AccAddress{
+		sdk.AccAddress("voter_0_____________"),
+		sdk.AccAddress("voter_1_____________"),
+		sdk.AccAddress("voter_2_____________"),
+	}
+	for i, val := range vals {
+		require.NoError(t, k.SetVoter(ctx, val.pubKey, voters[i]))
+	}
+
+	total, err := k.TotalVotingPower(ctx, *govKeeper)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(60), total)
+
+	testCases := []struct {
+		name       string
+		votes      map[string]govv1.VoteOption
+		expPasses  bool
+		expResults govv1.TallyResult
+	}{
+		{
+			name: "votes weighted by validator power",
+			votes: map[string]govv1.VoteOption{
+				voters[0].String(): govv1.OptionYes,
+				voters[1].String(): govv1.OptionNo,
+				// votes of unregistered accounts are ignored
+				sdk.AccAddress("not_a_voter_________").String(): govv1.OptionNo,
+			},
+			expPasses:  true,
+			expResults: govv1.TallyResult{YesCount: "30", AbstainCount: "0", NoCount: "10", NoWithVetoCount: "0"},
+		},
+		{
+			name: "quorum of the relay validator set power not reached",
+			votes: map[string]govv1.VoteOption{
+				voters[1].String(): govv1.OptionYes,
+			},
+			expResults: govv1.TallyResult{YesCount: "10", AbstainCount: "0", NoCount: "0", NoWithVetoCount: "0"},
+		},
+		{
+			name: "rejected by the majority of the power",
+			votes: map[string]govv1.VoteOption{
+				voters[0].String(): govv1.OptionNo,
+				voters[1].String(): govv1.OptionYes,
+				voters[2].String(): govv1.OptionYes,
+			},
+			expResults: govv1.TallyResult{YesCount: "30", AbstainCount: "0", NoCount: "30", NoWithVetoCount: "0"},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := govv1.Proposal{Id: uint64(i + 1)}
+			for voter, option := range tc.votes {
+				addVote(t, ctx, govKeeper, proposal.Id, sdk.MustAccAddressFromBech32(voter), option)
+			}
+
+			passes, _, results, err := govKeeper.Tally(ctx, proposal)
+			require.NoError(t, err)
+			require.Equal(t, tc.expPasses, passes)
+			require.Equal(t, tc.expResults, results)
+
+			// the votes are removed once tallied
+			it, err := govKeeper.Votes.Iterate(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id))
+			require.NoError(t, err)
+			require.False(t, it.Valid())
+			require.NoError(t, it.Close())
+		})
+	}
+}
+
+func TestGovTallyStaking(t *testing.T) {
+	ctx, k, govKeeper := setupGovTally(t, []relayValidator{{pubKey: make([]byte, 32), power: 30}})
+	require.NoError(t, k.SetVoter(ctx, make([]byte, 32), sdk.AccAddress("voter_0_____________")))
+
+	// the x/gov defaults are used in staking tally mode
+	total, err := k.TotalVotingPower(ctx, *govKeeper)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(1000), total)
+
+	addVote(t, ctx, govKeeper, 1, sdk.AccAddress("voter_0_____________"), govv1.OptionYes)
+	passes, _, results, err := govKeeper.Tally(ctx, govv1.Proposal{Id: 1})
+	require.NoError(t, err)
+	require.False(t, passes)
+	require.Equal(t, govv1.EmptyTallyResult(), results)
+}
@@ -7,7 +7,6 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	v1 "github.com/symbioticfi/relay/api/client/v1"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
@@ -18,6 +17,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
 )
 
@@ -34,11 +34,42 @@ type Keeper struct {
 
 	Schema collections.Schema
 	Params collections.Item[types.Params]
+	// SignatureRequests key: request id | value: signature request
+	SignatureRequests collections.Map[string, types.SignatureRequest]
+	// PendingSignatureRequests key: height+request id of requests awaiting an aggregation proof
+	PendingSignatureRequests collections.KeySet[collections.Pair[int64, string]]
+	// Checkpoints key: block height | value: app hash checkpoint
+	Checkpoints collections.Map[int64, types.Checkpoint]
+	// Voters key: governance voter account | value: validator consensus pubkey
+	Voters collections.Map[sdk.AccAddress, []byte]
+	// VotersByConsensusKey key: validator consensus pubkey | value: governance voter account
+	VotersByConsensusKey collections.Map[[]byte, []byte]
+	// VoterNonces key: validator consensus pubkey | value: nonce of the next voter registration
+	VoterNonces collections.Map[[]byte, uint64]
+	// PollWindowEnd is the last height of the grace window in which the relay is polled on every block
+	PollWindowEnd collections.Item[int64]
+	// ValidatorSetTransition is the relay validator set being applied over several blocks
+	ValidatorSetTransition collections.Item[types.ValidatorSetTransition]
+	// RejectedValidatorSetEpoch is the last relay epoch whose validator set was rejected as unsafe
+	RejectedValidatorSetEpoch collections.Item[uint64]
+	// RelaySyncState is the state of the relay sync, including the manual overrides of the authority
+	RelaySyncState collections.Item[types.RelaySyncState]
+	// EpochValidatorSets key: relay epoch | value: relay validator set the aggregation proofs of the epoch are verified
+	// against
+	EpochValidatorSets collections.Map[uint64, types.LastValidatorSet]
 
 	// Relay Client
 	relayClient types.RelayClient
+	// relaySubmissions are the signature requests submitted to the relay by this node
+	relaySubmissions *relaySubmissions
 
 	hooks types.SymStakingHooks
+
+	// circuitBreaker pauses the module features during incidents, all features are allowed when it is not set
+	circuitBreaker types.CircuitBreaker
+
+	// proofVerifiers verify the aggregation proofs of the messages signed with other key tags than the validator one
+	proofVerifiers map[uint32]types.AggregationProofVerifier
 }
 
 const (
@@ -69,8 +100,42 @@ func NewKeeper(
 		consensusAddressCodec: consensusAddressCodec,
 		authority:             authority,
 		relayClient:           relayClient,
+		relaySubmissions:      newRelaySubmissions(),
 		Params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		hooks:                 nil,
+		SignatureRequests: collections.NewMap(
+			sb, types.SignatureRequestsKey, "signature_requests", collections.StringKey, codec.CollValue[types.SignatureRequest](cdc),
+		),
+		PendingSignatureRequests: collections.NewKeySet(
+			sb, types.PendingSignatureRequestsKey, "pending_signature_requests",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+		),
+		Checkpoints: collections.NewMap(
+			sb, types.CheckpointsKey, "checkpoints", collections.Int64Key, codec.CollValue[types.Checkpoint](cdc),
+		),
+		Voters: collections.NewMap(
+			sb, types.VotersKey, "voters", sdk.AccAddressKey, collections.BytesValue,
+		),
+		VotersByConsensusKey: collections.NewMap(
+			sb, types.VotersByConsensusKeyKey, "voters_by_consensus_key", collections.BytesKey, collections.BytesValue,
+		),
+		VoterNonces: collections.NewMap(
+			sb, types.VoterNoncesKey, "voter_nonces", collections.BytesKey, collections.Uint64Value,
+		),
+		PollWindowEnd: collections.NewItem(sb, types.PollWindowEndKey, "poll_window_end", collections.Int64Value),
+		ValidatorSetTransition: collections.NewItem(
+			sb, types.ValidatorSetTransitionKey, "validator_set_transition", codec.CollValue[types.ValidatorSetTransition](cdc),
+		),
+		RejectedValidatorSetEpoch: collections.NewItem(
+			sb, types.RejectedValidatorSetEpochKey, "rejected_validator_set_epoch", collections.Uint64Value,
+		),
+		RelaySyncState: collections.NewItem(
+			sb, types.RelaySyncStateKey, "relay_sync_state", codec.CollValue[types.RelaySyncState](cdc),
+		),
+		EpochValidatorSets: collections.NewMap(
+			sb, types.EpochValidatorSetsKey, "epoch_validator_sets", collections.Uint64Key, codec.CollValue[types.LastValidatorSet](cdc),
+		),
+		hooks:          nil,
+		proofVerifiers: map[uint32]types.AggregationProofVerifier{},
 	}
 
 	schema, err := sb.Build()
@@ -89,6 +154,34 @@ func (k *Keeper) SetHooks(sh types.SymStakingHooks) {
 	k.hooks = sh
 }
 
+// SetCircuitBreaker sets the circuit breaker consulted before the relay validator set sync and registers the module
+// features in it.
+func (k *Keeper) SetCircuitBreaker(cb types.CircuitBreaker) {
+	if k.circuitBreaker != nil {
+		panic("cannot set symstaking circuit breaker twice")
+	}
+	cb.RegisterFeatures(types.FeatureValsetSync)
+	k.circuitBreaker = cb
+}
+
+// SetAggregationProofVerifier sets the verifier of the aggregation proofs of the messages signed with the given key
+// tag. The proofs of the messages signed with the validator key tag are verified against the consensus keys of the
+// relay validator set unless a verifier is set for it.
+func (k *Keeper) SetAggregationProofVerifier(keyTag uint32, verifier types.AggregationProofVerifier) {
+	if _, ok := k.proofVerifiers[keyTag]; ok {
+		panic(fmt.Sprintf("cannot set symstaking aggregation proof verifier of key tag %d twice", keyTag))
+	}
+	k.proofVerifiers[keyTag] = verifier
+}
+
+// IsFeatureAllowed reports whether the given feature of the module is allowed by the circuit breaker.
+func (k *Keeper) IsFeatureAllowed(ctx context.Context, feature string) (bool, error) {
+	if k.circuitBreaker == nil {
+		return true, nil
+	}
+	return k.circuitBreaker.IsFeatureAllowed(ctx, feature)
+}
+
 func (k *Keeper) ConsensusAddressCodec() address.Codec {
 	return k.consensusAddressCodec
 }
@@ -168,21 +261,94 @@ func (k *Keeper) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get last validator set")
 	}
+	syncState, err := k.GetRelaySyncState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get relay sync state")
+	}
+	if len(syncState.PendingValidatorSet) > 0 {
+		return k.applyForcedValidatorSet(ctx, current, syncState)
+	}
+	if syncState.Paused {
+		return nil, nil
+	}
+	if allowed, err := k.IsFeatureAllowed(ctx, types.FeatureValsetSync); err != nil {
+		return nil, errors.Wrap(err, "could not check the valset sync circuit breaker")
+	} else if !allowed {
+		return nil, nil
+	}
+
 	currentEpoch, err := k.GetCurrentEpoch(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get current epoch")
 	}
-	if current.Epoch == currentEpoch.Epoch {
+	transition, err := k.ValidatorSetTransition.Get(ctx)
+	inTransition := err == nil
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return nil, errors.Wrap(err, "could not get validator set transition")
+	}
+
+	var target []abci.ValidatorUpdate
+	switch {
+	case current.Epoch != currentEpoch.Epoch || syncState.Forced:
+		// a validator set pinned by MsgForceValidatorSet is replaced once the relay sync is resumed
+		rejected, err := k.RejectedValidatorSetEpoch.Get(ctx)
+		if err == nil && rejected == currentEpoch.Epoch {
+			// the validator set of the epoch was already rejected, wait for the next epoch
+			return nil, nil
+		}
+		if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+			return nil, errors.Wrap(err, "could not get rejected validator set epoch")
+		}
+		target, err = k.GetValidatorSet(ctx, currentEpoch.Epoch)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get new validator set")
+		}
+		// the relay signs the messages of the epoch with its validator set, even if the chain rejects it
+		if err := k.EpochValidatorSets.Set(ctx, currentEpoch.Epoch, types.LastValidatorSet{Epoch: currentEpoch.Epoch, Updates: target}); err != nil {
+			return nil, errors.Wrap(err, "could not set epoch validator set")
+		}
+	case inTransition:
+		target = transition.Target
+	default:
 		return nil, nil
 	}
-	newValset, err := k.GetValidatorSet(ctx, currentEpoch.Epoch)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get params")
+	}
+	newValset, complete, err := params.GuardValidatorSetChange(current.Updates, target)
+	if errors.IsOf(err, types.ErrUnsafeValidatorSetChange) {
+		return nil, k.rejectValidatorSet(ctx, currentEpoch.Epoch, err)
+	}
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get new validator set")
+		return nil, err
 	}
 
-	removed, added, updated := k.diffValidatorSets(current.Updates, newValset)
+	if current.Epoch != currentEpoch.Epoch {
+		// the previous validator set is still the last one, so hooks can evaluate the previous epoch
+		if err := k.Hooks().AfterEpochChanged(ctx, current.Epoch, currentEpoch.Epoch); err != nil {
+			return nil, err
+		}
+	}
+	if syncState.Forced {
+		syncState.Forced = false
+		if err := k.RelaySyncState.Set(ctx, syncState); err != nil {
+			return nil, errors.Wrap(err, "could not set relay sync state")
+		}
+	}
+	if err := k.setValidatorSetTransition(ctx, currentEpoch.Epoch, target, complete, inTransition); err != nil {
+		return nil, err
+	}
+	return k.applyValidatorSet(ctx, current.Updates, currentEpoch.Epoch, newValset)
+}
+
+// applyValidatorSet stores the new validator set, calls the validator hooks and returns the validator updates from
+// the current validator set.
+func (k *Keeper) applyValidatorSet(ctx context.Context, current []abci.ValidatorUpdate, epoch uint64, newValset []abci.ValidatorUpdate) ([]abci.ValidatorUpdate, error) {
+	removed, added, updated := k.diffValidatorSets(current, newValset)
 	if err := k.SetLastValidatorSet(ctx, &types.LastValidatorSet{
-		Epoch:   currentEpoch.Epoch,
+		Epoch:   epoch,
 		Updates: newValset,
 	}); err != nil {
 		return nil, errors.Wrap(err, "could not set last validator set")
@@ -215,6 +381,51 @@ func (k *Keeper) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error) {
 	return merged, nil
 }
 
+// rejectValidatorSet keeps the current validator set instead of the unsafe validator set of a relay epoch until
+// the next epoch.
+func (k *Keeper) rejectValidatorSet(ctx context.Context, epoch uint64, reason error) error {
+	if err := k.RejectedValidatorSetEpoch.Set(ctx, epoch); err != nil {
+		return errors.Wrap(err, "could not set rejected validator set epoch")
+	}
+	// a transition towards a previous epoch is abandoned at its current step
+	if err := k.ValidatorSetTransition.Remove(ctx); err != nil {
+		return errors.Wrap(err, "could not remove validator set transition")
+	}
+
+	k.logger.Error("rejected relay validator set", "epoch", epoch, "reason", reason)
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorSetRejected,
+			sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", epoch)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason.Error()),
+		),
+	)
+	return nil
+}
+
+// setValidatorSetTransition records the progress of a validator set applied over several blocks.
+func (k *Keeper) setValidatorSetTransition(ctx context.Context, epoch uint64, target []abci.ValidatorUpdate, complete, inTransition bool) error {
+	if complete {
+		if !inTransition {
+			return nil
+		}
+		if err := k.ValidatorSetTransition.Remove(ctx); err != nil {
+			return errors.Wrap(err, "could not remove validator set transition")
+		}
+	} else if err := k.ValidatorSetTransition.Set(ctx, types.ValidatorSetTransition{Epoch: epoch, Target: target}); err != nil {
+		return errors.Wrap(err, "could not set validator set transition")
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorSetTransition,
+			sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", epoch)),
+			sdk.NewAttribute(types.AttributeKeyComplete, fmt.Sprintf("%t", complete)),
+		),
+	)
+	return nil
+}
+
 func (k *Keeper) diffValidatorSets(old, new []abci.ValidatorUpdate) (removed, added, updated []abci.ValidatorUpdate) {
 	oldMap := make(map[string]abci.ValidatorUpdate)
 	newMap := make(map[string]abci.ValidatorUpdate)
@@ -244,6 +455,9 @@ func (k *Keeper) diffValidatorSets(old, new []abci.ValidatorUpdate) (removed, ad
 	return removed, added, updated
 }
 
+// SlashMessageType is the message type of the slash signature requests.
+const SlashMessageType = "slash"
+
 type slashMessage struct {
 	ValidatorPk     string         `json:"validatorPk"`
 	InfractionType  string         `json:"infractionType"`
@@ -269,14 +483,13 @@ func (k *Keeper) SlashWithInfractionReason(ctx context.Context, validatorPubKey
 	if err != nil {
 		return "", errors.Wrap(err, "could not get params")
 	}
-	resp, err := k.relayClient.SignMessage(ctx, &v1.SignMessageRequest{
-		KeyTag:  params.SigningKeyTag,
-		Message: dataBytes,
-	})
-	if err != nil {
-		return "", errors.Wrap(err, "could not sign message")
-	}
-	return resp.GetRequestId(), nil
+	// slash messages are signed as raw JSON, without the typed message domain separation, as settlement chains
+	// already consume them in this format
+	return k.requestSignature(ctx, types.TypedMessage{
+		Module:  types.ModuleName,
+		Type:    SlashMessageType,
+		Payload: dataBytes,
+	}, params.SigningKeyTag, dataBytes)
 }
 
 // IterateValidators iterates through the validator set and perform the provided function
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func (k msgServer) RegisterVoter(ctx context.Context, req *types.MsgRegisterVoter) (*types.MsgRegisterVoterResponse, error) {
+	voter, err := k.addressCodec.StringToBytes(req.Voter)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid voter address")
+	}
+
+	if len(req.ConsensusPubkey) != ed25519.PubKeySize {
+		return nil, errorsmod.Wrapf(types.ErrInvalidVoterRegistration, "expected %d bytes ed25519 consensus pubkey, got %d", ed25519.PubKeySize, len(req.ConsensusPubkey))
+	}
+	nonce, err := k.GetVoterNonce(ctx, req.ConsensusPubkey)
+	if err != nil {
+		return nil, err
+	}
+	if req.Nonce != nonce {
+		return nil, errorsmod.Wrapf(types.ErrInvalidVoterRegistration, "expected nonce %d, got %d", nonce, req.Nonce)
+	}
+	pubKey := &ed25519.PubKey{Key: req.ConsensusPubkey}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !pubKey.VerifySignature(types.VoterRegistrationSignBytes(sdkCtx.ChainID(), req.Voter, req.Nonce), req.Signature) {
+		return nil, errorsmod.Wrap(types.ErrInvalidVoterRegistration, "invalid consensus key signature")
+	}
+
+	if err := k.SetVoter(ctx, req.ConsensusPubkey, voter); err != nil {
+		return nil, err
+	}
+	if err := k.VoterNonces.Set(ctx, req.ConsensusPubkey, nonce+1); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterVoterResponse{}, nil
+}
+
+// GetVoterNonce returns the nonce the next voter registration of the validator with the given consensus pubkey must be
+// signed with.
+func (k *Keeper) GetVoterNonce(ctx context.Context, consensusPubKey []byte) (uint64, error) {
+	nonce, err := k.VoterNonces.Get(ctx, consensusPubKey)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return nonce, err
+}
+
+// SetVoter registers voter as the account voting in governance on behalf of the validator with the given consensus
+// pubkey, replacing any voter previously registered for it.
+func (k *Keeper) SetVoter(ctx context.Context, consensusPubKey []byte, voter sdk.AccAddress) error {
+	registered, err := k.Voters.Get(ctx, voter)
+	switch {
+	case err == nil && !bytes.Equal(registered, consensusPubKey):
+		return errorsmod.Wrapf(types.ErrVoterAlreadyRegistered, "voter %s", voter)
+	case err != nil && !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	previous, err := k.VotersByConsensusKey.Get(ctx, consensusPubKey)
+	if err == nil {
+		if err := k.Voters.Remove(ctx, previous); err != nil {
+			return err
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if err := k.Voters.Set(ctx, voter, consensusPubKey); err != nil {
+		return err
+	}
+	return k.VotersByConsensusKey.Set(ctx, consensusPubKey, voter)
+}
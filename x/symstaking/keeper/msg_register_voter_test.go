@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+const testChainID = "test-chain"
+
+func newMsgRegisterVoter(t *testing.T, privKey *ed25519.PrivKey, voter sdk.AccAddress, nonce uint64) *types.MsgRegisterVoter {
+	t.Helper()
+
+	signature, err := privKey.Sign(types.VoterRegistrationSignBytes(testChainID, voter.String(), nonce))
+	require.NoError(t, err)
+	return &types.MsgRegisterVoter{
+		Voter:           voter.String(),
+		ConsensusPubkey: privKey.PubKey().Bytes(),
+		Signature:       signature,
+		Nonce:           nonce,
+	}
+}
+
+func requireVoter(t *testing.T, ctx sdk.Context, k *keeper.Keeper, privKey *ed25519.PrivKey, voter sdk.AccAddress, nonce uint64) {
+	t.Helper()
+
+	res, err := keeper.NewQueryServerImpl(*k).Voter(ctx, &types.QueryVoterRequest{ConsensusPubkey: privKey.PubKey().Bytes()})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryVoterResponse{Voter: voter.String(), Nonce: nonce}, res)
+	if voter != nil {
+		consensusPubKey, err := k.Voters.Get(ctx, voter)
+		require.NoError(t, err)
+		require.Equal(t, privKey.PubKey().Bytes(), consensusPubKey)
+	}
+}
+
+func TestRegisterVoter(t *testing.T) {
+	ctx, k := setupKeeper(t, nil)
+	ctx = ctx.WithChainID(testChainID)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	privKey := ed25519.GenPrivKey()
+	voter := sdk.AccAddress("voter_______________")
+	otherVoter := sdk.AccAddress("other_voter_________")
+	requireVoter(t, ctx, k, privKey, nil, 0)
+
+	first := newMsgRegisterVoter(t, privKey, voter, 0)
+	_, err := msgServer.RegisterVoter(ctx, first)
+	require.NoError(t, err)
+	requireVoter(t, ctx, k, privKey, voter, 1)
+
+	// a new registration replaces the previous voter
+	_, err = msgServer.RegisterVoter(ctx, newMsgRegisterVoter(t, privKey, otherVoter, 1))
+	require.NoError(t, err)
+	requireVoter(t, ctx, k, privKey, otherVoter, 2)
+	has, err := k.Voters.Has(ctx, voter)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// a voter votes on behalf of a single validator
+	otherPrivKey := ed25519.GenPrivKey()
+	_, err = msgServer.RegisterVoter(ctx, newMsgRegisterVoter(t, otherPrivKey, otherVoter, 0))
+	require.ErrorIs(t, err, types.ErrVoterAlreadyRegistered)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgRegisterVoter
+		expErr string
+	}{
+		{
+			name:   "replayed registration",
+			msg:    first,
+			expErr: "expected nonce 2, got 0",
+		},
+		{
+			name: "signature over another nonce",
+			msg: func() *types.MsgRegisterVoter {
+				msg := newMsgRegisterVoter(t, privKey, voter, 1)
+				msg.Nonce = 2
+				return msg
+			}(),
+			expErr: "invalid consensus key signature",
+		},
+		{
+			name: "signature over another voter",
+			msg: func() *types.MsgRegisterVoter {
+				msg := newMsgRegisterVoter(t, privKey, otherVoter, 2)
+				msg.Voter = voter.String()
+				return msg
+			}(),
+			expErr: "invalid consensus key signature",
+		},
+		{
+			name: "signature by another key",
+			msg: func() *types.MsgRegisterVoter {
+				msg := newMsgRegisterVoter(t, otherPrivKey, voter, 2)
+				msg.ConsensusPubkey = privKey.PubKey().Bytes()
+				return msg
+			}(),
+			expErr: "invalid consensus key signature",
+		},
+		{
+			name: "invalid consensus pubkey",
+			msg: func() *types.MsgRegisterVoter {
+				msg := newMsgRegisterVoter(t, privKey, voter, 2)
+				msg.ConsensusPubkey = msg.ConsensusPubkey[1:]
+				return msg
+			}(),
+			expErr: "expected 32 bytes ed25519 consensus pubkey",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.RegisterVoter(ctx, tc.msg)
+			require.ErrorIs(t, err, types.ErrInvalidVoterRegistration)
+			require.ErrorContains(t, err, tc.expErr)
+
+			// the registration is left untouched
+			requireVoter(t, ctx, k, privKey, otherVoter, 2)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func (k msgServer) ForceValidatorSet(ctx context.Context, req *types.MsgForceValidatorSet) (*types.MsgForceValidatorSetResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updates, err := types.ForcedValidatorUpdates(req.Validators, params.MinValidators)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := k.GetRelaySyncState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !state.Paused {
+		state.PausedHeight = sdkCtx.BlockHeight()
+	}
+	state.Paused = true
+	state.Forced = true
+	state.ForcedHeight = sdkCtx.BlockHeight()
+	state.Reason = req.Reason
+	state.PendingValidatorSet = updates
+	if err := k.RelaySyncState.Set(ctx, state); err != nil {
+		return nil, err
+	}
+
+	var total int64
+	for _, val := range updates {
+		total += val.Power
+	}
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForceValidatorSet,
+			sdk.NewAttribute(types.AttributeKeyValidators, fmt.Sprintf("%d", len(updates))),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", total)),
+			sdk.NewAttribute(types.AttributeKeyReason, req.Reason),
+		),
+	)
+
+	return &types.MsgForceValidatorSetResponse{}, nil
+}
+
+func (k msgServer) PauseRelaySync(ctx context.Context, req *types.MsgPauseRelaySync) (*types.MsgPauseRelaySyncResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	state, err := k.GetRelaySyncState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !state.Paused {
+		state.PausedHeight = sdkCtx.BlockHeight()
+	}
+	state.Paused = true
+	state.Reason = req.Reason
+	if err := k.RelaySyncState.Set(ctx, state); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePauseRelaySync,
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", state.PausedHeight)),
+			sdk.NewAttribute(types.AttributeKeyReason, req.Reason),
+		),
+	)
+
+	return &types.MsgPauseRelaySyncResponse{}, nil
+}
+
+func (k msgServer) ResumeRelaySync(ctx context.Context, req *types.MsgResumeRelaySync) (*types.MsgResumeRelaySyncResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	state, err := k.GetRelaySyncState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !state.Paused {
+		return nil, types.ErrRelaySyncNotPaused
+	}
+	state.Paused = false
+	state.PausedHeight = 0
+	if err := k.RelaySyncState.Set(ctx, state); err != nil {
+		return nil, err
+	}
+	// the relay set of the current epoch is applied again, even if it was rejected before the pause
+	if err := k.RejectedValidatorSetEpoch.Remove(ctx); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResumeRelaySync,
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", sdkCtx.BlockHeight())),
+		),
+	)
+
+	return &types.MsgResumeRelaySyncResponse{}, nil
+}
+
+// validateAuthority checks that the signer of an authority gated message is the module authority.
+func (k msgServer) validateAuthority(address string) error {
+	authority, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, address)
+	}
+	return nil
+}
@@ -23,6 +23,9 @@ func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams)
 	if err := req.Params.Validate(); err != nil {
 		return nil, err
 	}
+	if _, err := k.keyTagProofVerifier(req.Params, req.Params.SigningKeyTag); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid signing key tag")
+	}
 
 	if err := k.Params.Set(ctx, req.Params); err != nil {
 		return nil, err
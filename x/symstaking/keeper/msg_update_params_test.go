@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	ctx, k := setupKeeper(t, nil)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.DefaultParams()
+	params.EpochCheckInterval = 42
+	govAuthority := authtypes.NewModuleAddress(types.GovModuleName)
+
+	// the authority is the bech32 account address of the gov module, as required to sign the message
+	consensusAuthority, err := bech32.ConvertAndEncode(sdk.GetConfig().GetBech32ConsensusAddrPrefix(), govAuthority)
+	require.NoError(t, err)
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: consensusAuthority, Params: params})
+	require.ErrorContains(t, err, "invalid authority address")
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: sdk.AccAddress("not_the_authority___").String(), Params: params})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// no aggregation proof of a signing key tag without verifier could be verified
+	invalid := params
+	invalid.SigningKeyTag = 15
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: govAuthority.String(), Params: invalid})
+	require.ErrorIs(t, err, types.ErrInvalidKeyTag)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: govAuthority.String(), Params: params})
+	require.NoError(t, err)
+	stored, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, stored)
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+// ShouldPollRelay reports whether the relay is polled for a new epoch at the given height: every EpochCheckInterval
+// blocks in the height poll schedule, and on every block of the grace window opened when a new relay epoch was
+// expected but not observed. The relay is never polled while the relay sync is paused by the authority, or while the
+// valset sync feature is tripped in the circuit breaker.
+func (k *Keeper) ShouldPollRelay(ctx context.Context, params types.Params, height int64) (bool, error) {
+	syncState, err := k.GetRelaySyncState(ctx)
+	if err != nil {
+		return false, err
+	}
+	if syncState.Paused {
+		return false, nil
+	}
+	if allowed, err := k.IsFeatureAllowed(ctx, types.FeatureValsetSync); err != nil || !allowed {
+		return false, err
+	}
+
+	if params.PollSchedule == types.PollSchedule_POLL_SCHEDULE_HEIGHT && height%params.EpochCheckInterval == 0 {
+		return true, nil
+	}
+
+	windowEnd, err := k.PollWindowEnd.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return height <= windowEnd, nil
+}
+
+// UpdatePollWindow updates the poll grace window after the relay was polled at the given height. The window is closed
+// once a new epoch is observed. In the height poll schedule, a check height without a new epoch, for instance because
+// the proposer could not reach its relay, opens a window of PollGraceBlocks blocks.
+func (k *Keeper) UpdatePollWindow(ctx context.Context, params types.Params, height int64, advanced bool) error {
+	if advanced {
+		return k.PollWindowEnd.Remove(ctx)
+	}
+	if params.PollSchedule != types.PollSchedule_POLL_SCHEDULE_HEIGHT || height%params.EpochCheckInterval != 0 || params.PollGraceBlocks == 0 {
+		return nil
+	}
+	return k.openPollWindow(ctx, height+params.PollGraceBlocks)
+}
+
+// openPollWindow extends the poll grace window up to end.
+func (k *Keeper) openPollWindow(ctx context.Context, end int64) error {
+	windowEnd, err := k.PollWindowEnd.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if windowEnd >= end {
+		return nil
+	}
+	return k.PollWindowEnd.Set(ctx, end)
+}
+
+// EpochHooks returns the x/epochs hooks scheduling the relay polls in the time poll schedule.
+func (k *Keeper) EpochHooks() epochstypes.EpochHooks {
+	return epochHooks{k}
+}
+
+type epochHooks struct {
+	k *Keeper
+}
+
+var _ epochstypes.EpochHooks = epochHooks{}
+
+// AfterEpochEnd implements epochstypes.EpochHooks.
+func (epochHooks) AfterEpochEnd(context.Context, string, int64) error {
+	return nil
+}
+
+// BeforeEpochStart opens the poll window at the start of every PollEpochIdentifier epoch in the time poll schedule.
+// Hooks run in BeginBlock, so the relay is polled from the next block on, for PollGraceBlocks more blocks.
+func (h epochHooks) BeforeEpochStart(ctx context.Context, epochIdentifier string, _ int64) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.PollSchedule != types.PollSchedule_POLL_SCHEDULE_TIME || epochIdentifier != params.PollEpochIdentifier {
+		return nil
+	}
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return h.k.openPollWindow(ctx, height+1+params.PollGraceBlocks)
+}
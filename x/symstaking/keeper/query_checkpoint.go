@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func (q queryServer) Checkpoint(ctx context.Context, req *types.QueryCheckpointRequest) (*types.QueryCheckpointResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	checkpoint, err := q.k.GetCheckpointProof(ctx, req.Height)
+	if errors.Is(err, types.ErrCheckpointNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get checkpoint: %v", err)
+	}
+
+	return &types.QueryCheckpointResponse{Checkpoint: checkpoint}, nil
+}
+
+func (q queryServer) Checkpoints(ctx context.Context, req *types.QueryCheckpointsRequest) (*types.QueryCheckpointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	checkpoints, pageRes, err := query.CollectionPaginate(ctx, q.k.Checkpoints, req.Pagination, func(_ int64, checkpoint types.Checkpoint) (types.CheckpointProof, error) {
+		return q.k.checkpointProof(ctx, checkpoint)
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get checkpoints: %v", err)
+	}
+
+	return &types.QueryCheckpointsResponse{Checkpoints: checkpoints, Pagination: pageRes}, nil
+}
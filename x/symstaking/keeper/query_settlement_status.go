@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func (q queryServer) SettlementStatus(ctx context.Context, req *types.QuerySettlementStatusRequest) (*types.QuerySettlementStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	resp, err := q.k.GetSettlementStatus(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get settlement status: %v", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func (q queryServer) SignatureRequest(ctx context.Context, req *types.QuerySignatureRequestRequest) (*types.QuerySignatureRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.RequestId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty request id")
+	}
+
+	signatureRequest, err := q.k.GetSignatureRequest(ctx, req.RequestId)
+	if errors.Is(err, types.ErrSignatureRequestNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get signature request: %v", err)
+	}
+
+	return &types.QuerySignatureRequestResponse{
+		SignatureRequest: signatureRequest,
+	}, nil
+}
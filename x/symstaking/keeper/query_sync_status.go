@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func (q queryServer) SyncStatus(ctx context.Context, req *types.QuerySyncStatusRequest) (*types.QuerySyncStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	state, err := q.k.GetRelaySyncState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get relay sync state: %v", err)
+	}
+	epoch, err := q.k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current epoch: %v", err)
+	}
+	valset, err := q.k.GetLastValidatorSet(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get last validator set: %v", err)
+	}
+
+	resp := &types.QuerySyncStatusResponse{
+		State:             state,
+		Epoch:             epoch.Epoch,
+		ValidatorSetEpoch: valset.Epoch,
+	}
+	transition, err := q.k.ValidatorSetTransition.Get(ctx)
+	if err == nil {
+		resp.Transition = &transition
+	} else if !errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to get validator set transition: %v", err)
+	}
+	resp.RejectedEpoch, err = q.k.RejectedValidatorSetEpoch.Get(ctx)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to get rejected validator set epoch: %v", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func (q queryServer) Voter(ctx context.Context, req *types.QueryVoterRequest) (*types.QueryVoterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.ConsensusPubkey) != ed25519.PubKeySize {
+		return nil, status.Errorf(codes.InvalidArgument, "expected %d bytes ed25519 consensus pubkey, got %d", ed25519.PubKeySize, len(req.ConsensusPubkey))
+	}
+
+	res := &types.QueryVoterResponse{}
+	voter, err := q.k.VotersByConsensusKey.Get(ctx, req.ConsensusPubkey)
+	switch {
+	case err == nil:
+		res.Voter, err = q.k.addressCodec.BytesToString(voter)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode voter: %v", err)
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, status.Errorf(codes.Internal, "failed to get voter: %v", err)
+	}
+
+	res.Nonce, err = q.k.GetVoterNonce(ctx, req.ConsensusPubkey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get voter nonce: %v", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+// GetRelaySyncState returns the relay sync state, the zero state if the relay sync was never overridden.
+func (k *Keeper) GetRelaySyncState(ctx context.Context) (types.RelaySyncState, error) {
+	state, err := k.RelaySyncState.Get(ctx)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return types.RelaySyncState{}, nil
+	}
+	return state, err
+}
+
+// applyForcedValidatorSet applies the validator set pinned by MsgForceValidatorSet, bypassing the validator set
+// change limits. The relay epoch of the validator set is kept, so the relay set of the next epoch is diffed against
+// the pinned set once the relay sync is resumed.
+func (k *Keeper) applyForcedValidatorSet(ctx context.Context, current *types.LastValidatorSet, state types.RelaySyncState) ([]abci.ValidatorUpdate, error) {
+	pending := state.PendingValidatorSet
+	state.PendingValidatorSet = nil
+	if err := k.RelaySyncState.Set(ctx, state); err != nil {
+		return nil, errors.Wrap(err, "could not set relay sync state")
+	}
+	// an unfinished relay validator set transition is superseded by the pinned set
+	if err := k.ValidatorSetTransition.Remove(ctx); err != nil {
+		return nil, errors.Wrap(err, "could not remove validator set transition")
+	}
+
+	k.logger.Warn("applying forced validator set", "validators", len(pending), "reason", state.Reason)
+	return k.applyValidatorSet(ctx, current.Updates, current.Epoch, pending)
+}
@@ -0,0 +1,345 @@
+package keeper_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/symbioticfi/relay/api/client/v1"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+type relayValidator struct {
+	pubKey []byte
+	power  int64
+}
+
+// setupKeeper returns a keeper whose mock relay serves the given validator sets per epoch, initialized at epoch 0.
+func setupKeeper(t *testing.T, sets map[uint64][]relayValidator) (sdk.Context, *keeper.Keeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := sdktestutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeight(1)
+	return ctx, newKeeper(ctx, key, sets)
+}
+
+// newKeeper returns a keeper using the given store key whose mock relay serves the given validator sets per epoch,
+// initialized at epoch 0.
+func newKeeper(ctx sdk.Context, key *storetypes.KVStoreKey, sets map[uint64][]relayValidator) *keeper.Keeper {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	relayClient := types.NewMockRelayClient(func(epoch uint64) []*v1.Validator {
+		vals := make([]*v1.Validator, len(sets[epoch]))
+		for i, val := range sets[epoch] {
+			vals[i] = &v1.Validator{
+				Operator:    "0xValidator" + strconv.Itoa(i),
+				VotingPower: strconv.FormatInt(val.power, 10),
+				IsActive:    true,
+				Keys:        []*v1.Key{{Tag: types.DefaultMockRelayKeyTag, Payload: val.pubKey}},
+			}
+		}
+		return vals
+	})
+
+	k := keeper.NewKeeper(
+		log.NewNopLogger(),
+		runtime.NewKVStoreService(key),
+		encCfg.Codec,
+		address.NewBech32Codec("cosmos"),
+		address.NewBech32Codec("cosmosvalcons"),
+		authtypes.NewModuleAddress(types.GovModuleName),
+		relayClient,
+	)
+	k.InitGenesis(ctx, *types.DefaultGenesis())
+
+	return k
+}
+
+func newRelayValidators(n int, power int64) []relayValidator {
+	vals := make([]relayValidator, n)
+	for i := range vals {
+		vals[i] = relayValidator{pubKey: ed25519.GenPrivKey().PubKey().Bytes(), power: power}
+	}
+	return vals
+}
+
+func requireValidatorSet(t *testing.T, ctx sdk.Context, k *keeper.Keeper, expected []relayValidator) {
+	t.Helper()
+
+	valset, err := k.GetLastValidatorSet(ctx)
+	require.NoError(t, err)
+	powers := map[string]int64{}
+	for _, val := range valset.Updates {
+		powers[string(val.PubKey.GetEd25519())] = val.Power
+	}
+	expPowers := map[string]int64{}
+	for _, val := range expected {
+		expPowers[string(val.pubKey)] = val.power
+	}
+	require.Equal(t, expPowers, powers)
+}
+
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestEndBlockRejectsUnsafeValidatorSet(t *testing.T) {
+	genesisSet := newRelayValidators(4, 10)
+	epoch2Set := append(genesisSet[:3:3], newRelayValidators(1, 10)...)
+	ctx, k := setupKeeper(t, map[uint64][]relayValidator{
+		0: genesisSet,
+		1: newRelayValidators(4, 10),
+		2: epoch2Set,
+	})
+
+	// the set of epoch 1 shares no validator with the current set
+	require.NoError(t, k.SetCurrentEpoch(ctx, &types.StoreEpoch{Epoch: 1}))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	updates, err := k.EndBlock(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+	require.True(t, hasEvent(ctx, types.EventTypeValidatorSetRejected))
+	requireValidatorSet(t, ctx, k, genesisSet)
+
+	rejected, err := k.RejectedValidatorSetEpoch.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), rejected)
+
+	// the rejected epoch is not evaluated again
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	updates, err = k.EndBlock(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+	require.False(t, hasEvent(ctx, types.EventTypeValidatorSetRejected))
+
+	// the set of epoch 2 keeps three of the four validators
+	require.NoError(t, k.SetCurrentEpoch(ctx, &types.StoreEpoch{Epoch: 2}))
+	updates, err = k.EndBlock(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	requireValidatorSet(t, ctx, k, epoch2Set)
+}
+
+func TestEndBlockAppliesValidatorSetGradually(t *testing.T) {
+	genesisSet := newRelayValidators(2, 10)
+	epoch1Set := append(genesisSet[:2:2], newRelayValidators(1, 20)...)
+	ctx, k := setupKeeper(t, map[uint64][]relayValidator{0: genesisSet, 1: epoch1Set})
+
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MaxPowerChange = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	// the new validator joins with 10 of its 20 power, then gets the rest in the next block
+	require.NoError(t, k.SetCurrentEpoch(ctx, &types.StoreEpoch{Epoch: 1}))
+	updates, err := k.EndBlock(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: updates[0].PubKey, Power: 10}}, updates)
+	transition, err := k.ValidatorSetTransition.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), transition.Epoch)
+
+	updates, err = k.EndBlock(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: updates[0].PubKey, Power: 20}}, updates)
+	requireValidatorSet(t, ctx, k, epoch1Set)
+	has, err := k.ValidatorSetTransition.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	updates, err = k.EndBlock(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+}
+
+func TestForceValidatorSet(t *testing.T) {
+	genesisSet := newRelayValidators(3, 10)
+	epoch1Set := newRelayValidators(3, 10)
+	ctx, k := setupKeeper(t, map[uint64][]relayValidator{0: genesisSet, 1: epoch1Set})
+	msgServer := keeper.NewMsgServerImpl(k)
+	queryServer := keeper.NewQueryServerImpl(*k)
+	authority := authtypes.NewModuleAddress(types.GovModuleName).String()
+
+	forcedSet := []relayValidator{genesisSet[0], {pubKey: ed25519.GenPrivKey().PubKey().Bytes(), power: 30}}
+	forced := make([]types.ForcedValidator, len(forcedSet))
+	for i, val := range forcedSet {
+		forced[i] = types.ForcedValidator{ConsensusPubkey: val.pubKey, Power: val.power}
+	}
+
+	_, err := msgServer.ForceValidatorSet(ctx, &types.MsgForceValidatorSet{
+		Authority:  sdk.AccAddress("not the authority").String(),
+		Validators: forced,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = msgServer.ForceValidatorSet(ctx, &types.MsgForceValidatorSet{
+		Authority:  authority,
+		Validators: append(forced, forced[0]),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidForcedValidatorSet)
+
+	_, err = msgServer.ResumeRelaySync(ctx, &types.MsgResumeRelaySync{Authority: authority})
+	require.ErrorIs(t, err, types.ErrRelaySyncNotPaused)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.ForceValidatorSet(ctx, &types.MsgForceValidatorSet{
+		Authority:  authority,
+		Validators: forced,
+		Reason:     "relay down",
+	})
+	require.NoError(t, err)
+	require.True(t, hasEvent(ctx, types.EventTypeForceValidatorSet))
+
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	poll, err := k.ShouldPollRelay(ctx, params, params.EpochCheckInterval)
+	require.NoError(t, err)
+	require.False(t, poll)
+
+	// the pinned set replaces the current set, bypassing the change limits
+	updates, err := k.EndBlock(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 3)
+	requireValidatorSet(t, ctx, k, forcedSet)
+
+	status, err := queryServer.SyncStatus(ctx, &types.QuerySyncStatusRequest{})
+	require.NoError(t, err)
+	require.True(t, status.State.Paused)
+	require.True(t, status.State.Forced)
+	require.Equal(t, "relay down", status.State.Reason)
+	require.Empty(t, status.State.PendingValidatorSet)
+
+	// new epochs are ignored while the relay sync is paused
+	require.NoError(t, k.SetCurrentEpoch(ctx, &types.StoreEpoch{Epoch: 1}))
+	updates, err = k.EndBlock(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+	requireValidatorSet(t, ctx, k, forcedSet)
+
+	// once resumed, the relay set of the current epoch replaces the pinned set
+	params.MinOverlap = math.LegacyZeroDec()
+	require.NoError(t, k.Params.Set(ctx, params))
+	_, err = msgServer.ResumeRelaySync(ctx, &types.MsgResumeRelaySync{Authority: authority})
+	require.NoError(t, err)
+	updates, err = k.EndBlock(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 5)
+	requireValidatorSet(t, ctx, k, epoch1Set)
+
+	status, err = queryServer.SyncStatus(ctx, &types.QuerySyncStatusRequest{})
+	require.NoError(t, err)
+	require.False(t, status.State.Paused)
+	require.False(t, status.State.Forced)
+	require.Equal(t, uint64(1), status.ValidatorSetEpoch)
+}
+
+// circuitBreaker is a fake circuit breaker tripping the features of its set.
+type circuitBreaker struct {
+	features map[string]bool
+}
+
+func (cb *circuitBreaker) RegisterFeatures(features ...string) {
+	for _, feature := range features {
+		cb.features[feature] = false
+	}
+}
+
+func (cb *circuitBreaker) IsFeatureAllowed(_ context.Context, feature string) (bool, error) {
+	return !cb.features[feature], nil
+}
+
+func TestValsetSyncCircuitBreaker(t *testing.T) {
+	genesisSet := newRelayValidators(2, 10)
+	epoch1Set := append(genesisSet[:2:2], newRelayValidators(1, 10)...)
+	ctx, k := setupKeeper(t, map[uint64][]relayValidator{0: genesisSet, 1: epoch1Set})
+
+	cb := &circuitBreaker{features: map[string]bool{}}
+	k.SetCircuitBreaker(cb)
+	require.Contains(t, cb.features, types.FeatureValsetSync)
+
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+
+	// the relay is neither polled nor the validator set updated while the feature is tripped
+	cb.features[types.FeatureValsetSync] = true
+	poll, err := k.ShouldPollRelay(ctx, params, params.EpochCheckInterval)
+	require.NoError(t, err)
+	require.False(t, poll)
+
+	require.NoError(t, k.SetCurrentEpoch(ctx, &types.StoreEpoch{Epoch: 1}))
+	updates, err := k.EndBlock(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+	requireValidatorSet(t, ctx, k, genesisSet)
+
+	// the sync catches up once the feature is reset
+	cb.features[types.FeatureValsetSync] = false
+	poll, err = k.ShouldPollRelay(ctx, params, params.EpochCheckInterval)
+	require.NoError(t, err)
+	require.True(t, poll)
+
+	updates, err = k.EndBlock(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	requireValidatorSet(t, ctx, k, epoch1Set)
+}
+
+func TestInitTestnet(t *testing.T) {
+	genesisSet := newRelayValidators(3, 10)
+	ctx, k := setupKeeper(t, map[uint64][]relayValidator{0: genesisSet, 1: newRelayValidators(3, 10)})
+	queryServer := keeper.NewQueryServerImpl(*k)
+	ctx = ctx.WithBlockHeight(100)
+
+	require.ErrorContains(t, k.InitTestnet(ctx, secp256k1.GenPrivKey().PubKey(), 10), "expected an ed25519 consensus key")
+
+	local := relayValidator{pubKey: ed25519.GenPrivKey().PubKey().Bytes(), power: 900}
+	require.NoError(t, k.InitTestnet(ctx, &ed25519.PubKey{Key: local.pubKey}, local.power))
+	requireValidatorSet(t, ctx, k, []relayValidator{local})
+
+	status, err := queryServer.SyncStatus(ctx, &types.QuerySyncStatusRequest{})
+	require.NoError(t, err)
+	require.True(t, status.State.Paused)
+	require.Equal(t, int64(100), status.State.PausedHeight)
+	require.Equal(t, keeper.TestnetRelaySyncReason, status.State.Reason)
+
+	// the epoch is pinned, new relay epochs are ignored until the relay sync is resumed
+	require.NoError(t, k.SetCurrentEpoch(ctx, &types.StoreEpoch{Epoch: 1}))
+	updates, err := k.EndBlock(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+	requireValidatorSet(t, ctx, k, []relayValidator{local})
+
+	// the relay client can be switched to a mock relay serving the local validator
+	k.SetRelayClient(types.NewMockRelayClient(func(uint64) []*v1.Validator {
+		return []*v1.Validator{{
+			VotingPower: strconv.FormatInt(local.power, 10),
+			Keys:        []*v1.Key{{Tag: types.DefaultMockRelayKeyTag, Payload: local.pubKey}},
+		}}
+	}))
+	valset, err := k.GetValidatorSet(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, valset, 1)
+	require.Equal(t, local.pubKey, valset[0].PubKey.GetEd25519())
+}
@@ -0,0 +1,362 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+
+	v1 "github.com/symbioticfi/relay/api/client/v1"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+var _ types.RelaySigner = (*Keeper)(nil)
+
+// RequestSignature persists a request to sign the domain-separated bytes of msg with the given key tag until its
+// aggregation proof is fetched from the relay. The relay is not called by the state machine, every node submits the
+// pending requests to its relay once the block is committed, see SubmitSignatureRequests. Key tags without aggregation
+// proof verifier are rejected, see ValidateKeyTag.
+func (k *Keeper) RequestSignature(ctx context.Context, msg types.TypedMessage, keyTag uint32) (string, error) {
+	if err := msg.Validate(); err != nil {
+		return "", err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return k.requestSignature(ctx, msg, keyTag, msg.SignBytes(sdkCtx.ChainID()))
+}
+
+// GetSignatureRequest returns the signature request with the given id.
+func (k *Keeper) GetSignatureRequest(ctx context.Context, requestID string) (types.SignatureRequest, error) {
+	req, err := k.SignatureRequests.Get(ctx, requestID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.SignatureRequest{}, errorsmod.Wrapf(types.ErrSignatureRequestNotFound, "request id %s", requestID)
+	}
+	return req, err
+}
+
+// ValidateKeyTag returns an error if the aggregation proofs of the messages signed with the key tag cannot be
+// verified, as the signature requests of such messages could only expire.
+func (k *Keeper) ValidateKeyTag(ctx context.Context, keyTag uint32) error {
+	_, err := k.aggregationProofVerifier(ctx, keyTag)
+	return err
+}
+
+func (k *Keeper) requestSignature(ctx context.Context, msg types.TypedMessage, keyTag uint32, message []byte) (string, error) {
+	if err := k.ValidateKeyTag(ctx, keyTag); err != nil {
+		return "", err
+	}
+
+	// pin the request to the epoch stored on chain so every validator asks its relay for the same request
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	requestID := types.SignatureRequestID(keyTag, epoch.Epoch, message)
+	existing, err := k.SignatureRequests.Get(ctx, requestID)
+	if err == nil && existing.Status != types.SignatureStatus_SIGNATURE_STATUS_EXPIRED {
+		// the same message was already requested in the epoch
+		return requestID, nil
+	} else if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return "", err
+	}
+
+	req := types.SignatureRequest{
+		RequestId:   requestID,
+		Module:      msg.Module,
+		MessageType: msg.Type,
+		KeyTag:      keyTag,
+		Message:     message,
+		Epoch:       epoch.Epoch,
+		Height:      sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		Status:      types.SignatureStatus_SIGNATURE_STATUS_PENDING,
+	}
+	if err := k.SignatureRequests.Set(ctx, requestID, req); err != nil {
+		return "", errorsmod.Wrap(err, "could not store signature request")
+	}
+	if err := k.PendingSignatureRequests.Set(ctx, collections.Join(req.Height, requestID)); err != nil {
+		return "", errorsmod.Wrap(err, "could not store pending signature request")
+	}
+	return requestID, nil
+}
+
+// removeSignatureRequest removes a signature request from the state, whatever its status.
+func (k *Keeper) removeSignatureRequest(ctx context.Context, requestID string) error {
+	req, err := k.SignatureRequests.Get(ctx, requestID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := k.PendingSignatureRequests.Remove(ctx, collections.Join(req.Height, requestID)); err != nil {
+		return err
+	}
+	return k.SignatureRequests.Remove(ctx, requestID)
+}
+
+// SubmitSignatureRequests submits the pending signature requests not submitted yet to the relay. It is called by every
+// node once a block is committed, outside of the state machine as the relay is node local: relay errors are logged and
+// the submission is retried after the next block.
+func (k *Keeper) SubmitSignatureRequests(ctx context.Context) error {
+	pending := make(map[string]bool)
+	err := k.PendingSignatureRequests.Walk(ctx, nil, func(key collections.Pair[int64, string]) (bool, error) {
+		pending[key.K2()] = true
+		if _, err := k.submitSignatureRequest(ctx, key.K2()); err != nil {
+			k.logger.Error("could not submit signature request to the relay", "request_id", key.K2(), "err", err)
+		}
+		return false, nil
+	})
+	k.relaySubmissions.prune(pending)
+	return err
+}
+
+// submitSignatureRequest submits a signature request to the relay unless already submitted, and returns the relay
+// request id.
+func (k *Keeper) submitSignatureRequest(ctx context.Context, requestID string) (string, error) {
+	if relayRequestID, ok := k.relaySubmissions.get(requestID); ok {
+		return relayRequestID, nil
+	}
+	req, err := k.GetSignatureRequest(ctx, requestID)
+	if err != nil {
+		return "", err
+	}
+	resp, err := k.relayClient.SignMessage(ctx, &v1.SignMessageRequest{
+		KeyTag:        req.KeyTag,
+		Message:       req.Message,
+		RequiredEpoch: &req.Epoch,
+	})
+	if err != nil {
+		return "", errorsmod.Wrap(err, "could not sign message")
+	}
+	k.relaySubmissions.set(requestID, resp.GetRequestId())
+	return resp.GetRequestId(), nil
+}
+
+// FetchSignatureProofs fetches from the relay the aggregation proofs of up to limit pending signature requests.
+// Requests the relay has no valid proof for yet are skipped. It is meant to be called by the block proposer only, as
+// the result depends on the local relay.
+func (k *Keeper) FetchSignatureProofs(ctx context.Context, limit int) ([]types.SignatureProof, error) {
+	var proofs []types.SignatureProof
+	err := k.PendingSignatureRequests.Walk(ctx, nil, func(key collections.Pair[int64, string]) (bool, error) {
+		requestID := key.K2()
+		relayRequestID, err := k.submitSignatureRequest(ctx, requestID)
+		if err != nil {
+			k.logger.Debug("signature request not submitted", "request_id", requestID, "err", err)
+			return false, nil
+		}
+		resp, err := k.relayClient.GetAggregationProof(ctx, &v1.GetAggregationProofRequest{RequestId: relayRequestID})
+		if err != nil || resp.GetAggregationProof() == nil {
+			k.logger.Debug("aggregation proof not available", "request_id", requestID, "err", err)
+			return false, nil
+		}
+		proof := types.SignatureProof{
+			RequestId: requestID,
+			Proof: types.AggregationProof{
+				MessageHash: resp.GetAggregationProof().GetMessageHash(),
+				Proof:       resp.GetAggregationProof().GetProof(),
+			},
+		}
+		// the proposal would be rejected with an invalid proof
+		if err := k.ValidateSignatureProof(ctx, proof); err != nil {
+			k.logger.Error("invalid aggregation proof", "request_id", requestID, "err", err)
+			return false, nil
+		}
+		proofs = append(proofs, proof)
+		return len(proofs) >= limit, nil
+	})
+	return proofs, err
+}
+
+// ValidateSignatureProof checks that the proof completes a pending signature request: the proof must be over the
+// hash of the requested message and aggregate signatures of a quorum of the relay validator set of the request epoch.
+func (k *Keeper) ValidateSignatureProof(ctx context.Context, proof types.SignatureProof) error {
+	req, err := k.SignatureRequests.Get(ctx, proof.RequestId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(types.ErrInvalidSignatureProof, "unknown request %s", proof.RequestId)
+	} else if err != nil {
+		return err
+	}
+	if req.Status != types.SignatureStatus_SIGNATURE_STATUS_PENDING {
+		return errorsmod.Wrapf(types.ErrInvalidSignatureProof, "request %s is not pending", proof.RequestId)
+	}
+
+	verifier, err := k.aggregationProofVerifier(ctx, req.KeyTag)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidSignatureProof, "request %s: %s", proof.RequestId, err)
+	}
+	if !bytes.Equal(proof.Proof.MessageHash, verifier.MessageHash(req.Message)) {
+		return errorsmod.Wrapf(types.ErrInvalidSignatureProof, "message hash mismatch for request %s", proof.RequestId)
+	}
+	if err := verifier.VerifyAggregationProof(ctx, req.Epoch, proof.Proof.MessageHash, proof.Proof.Proof); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidSignatureProof, "request %s: %s", proof.RequestId, err)
+	}
+	return nil
+}
+
+// SetSignatureProof stores the aggregation proof of a pending signature request and marks it completed.
+func (k *Keeper) SetSignatureProof(ctx context.Context, proof types.SignatureProof) error {
+	if err := k.ValidateSignatureProof(ctx, proof); err != nil {
+		return err
+	}
+	req, err := k.GetSignatureRequest(ctx, proof.RequestId)
+	if err != nil {
+		return err
+	}
+	req.Status = types.SignatureStatus_SIGNATURE_STATUS_COMPLETED
+	req.Proof = &proof.Proof
+	if err := k.SignatureRequests.Set(ctx, req.RequestId, req); err != nil {
+		return err
+	}
+	return k.PendingSignatureRequests.Remove(ctx, collections.Join(req.Height, req.RequestId))
+}
+
+// ExpireSignatureRequests marks the pending signature requests older than the signature request timeout as expired
+// and prunes the epoch validator sets no pending request is signed in anymore.
+func (k *Keeper) ExpireSignatureRequests(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "could not get params")
+	}
+	if params.SignatureRequestTimeout == 0 {
+		return nil
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var expired []collections.Pair[int64, string]
+	rng := collections.NewPrefixUntilPairRange[int64, string](sdkCtx.BlockHeight() - params.SignatureRequestTimeout)
+	if err := k.PendingSignatureRequests.Walk(ctx, rng, func(key collections.Pair[int64, string]) (bool, error) {
+		expired = append(expired, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		req, err := k.GetSignatureRequest(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		req.Status = types.SignatureStatus_SIGNATURE_STATUS_EXPIRED
+		if err := k.SignatureRequests.Set(ctx, req.RequestId, req); err != nil {
+			return err
+		}
+		if err := k.PendingSignatureRequests.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSignatureExpired,
+				sdk.NewAttribute(types.AttributeKeyRequestID, req.RequestId),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(req.Height, 10)),
+			),
+		)
+	}
+
+	return k.pruneEpochValidatorSets(ctx)
+}
+
+// pruneEpochValidatorSets removes the validator sets of the epochs before the current one no pending signature
+// request is signed in.
+func (k *Keeper) pruneEpochValidatorSets(ctx context.Context) error {
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	minEpoch := epoch.Epoch
+	// the pending requests are ordered by height, so the first one has the lowest epoch
+	if err := k.PendingSignatureRequests.Walk(ctx, nil, func(key collections.Pair[int64, string]) (bool, error) {
+		req, err := k.GetSignatureRequest(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		minEpoch = min(minEpoch, req.Epoch)
+		return true, nil
+	}); err != nil {
+		return err
+	}
+
+	rng := new(collections.Range[uint64]).EndExclusive(minEpoch)
+	return k.EpochValidatorSets.Clear(ctx, rng)
+}
+
+// aggregationProofVerifier returns the verifier of the aggregation proofs of the messages signed with a key tag.
+func (k *Keeper) aggregationProofVerifier(ctx context.Context, keyTag uint32) (types.AggregationProofVerifier, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "could not get params")
+	}
+	return k.keyTagProofVerifier(params, keyTag)
+}
+
+// keyTagProofVerifier returns the verifier of the aggregation proofs of the messages signed with a key tag under the
+// given params.
+func (k *Keeper) keyTagProofVerifier(params types.Params, keyTag uint32) (types.AggregationProofVerifier, error) {
+	if verifier, ok := k.proofVerifiers[keyTag]; ok {
+		return verifier, nil
+	}
+	if keyTag != params.ValidatorKeyTag {
+		return nil, errorsmod.Wrapf(types.ErrInvalidKeyTag, "no aggregation proof verifier for key tag %d", keyTag)
+	}
+	return consensusKeyProofVerifier{k: k}, nil
+}
+
+// consensusKeyProofVerifier verifies the aggregation proofs of the messages signed with the validator key tag
+// against the consensus keys of the relay validator set of the epoch.
+type consensusKeyProofVerifier struct {
+	k *Keeper
+}
+
+func (consensusKeyProofVerifier) MessageHash(message []byte) []byte {
+	return types.Ed25519MessageHash(message)
+}
+
+func (v consensusKeyProofVerifier) VerifyAggregationProof(ctx context.Context, epoch uint64, messageHash, proof []byte) error {
+	valset, err := v.k.EpochValidatorSets.Get(ctx, epoch)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(types.ErrInvalidSignatureProof, "no validator set for epoch %d", epoch)
+	} else if err != nil {
+		return err
+	}
+	return types.VerifyEd25519AggregationProof(valset.Updates, messageHash, proof)
+}
+
+// relaySubmissions maps the ids of the signature requests submitted to the local relay to the relay request ids. It
+// is node local, the requests are submitted again after a restart.
+type relaySubmissions struct {
+	mu  sync.Mutex
+	ids map[string]string
+}
+
+func newRelaySubmissions() *relaySubmissions {
+	return &relaySubmissions{ids: map[string]string{}}
+}
+
+func (s *relaySubmissions) get(requestID string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	relayRequestID, ok := s.ids[requestID]
+	return relayRequestID, ok
+}
+
+func (s *relaySubmissions) set(requestID, relayRequestID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ids[requestID] = relayRequestID
+}
+
+// prune forgets the requests which are not pending anymore.
+func (s *relaySubmissions) prune(pending map[string]bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for requestID := range s.ids {
+		if !pending[requestID] {
+			delete(s.ids, requestID)
+		}
+	}
+}
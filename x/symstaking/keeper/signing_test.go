@@ -0,0 +1,401 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/symbioticfi/relay/api/client/v1"
+	"google.golang.org/grpc"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+var testTypedMessage = types.TypedMessage{Module: "test", Type: "test", Payload: []byte("payload")}
+
+// setupSigningKeeper returns a keeper whose relay validator set of epoch 0 holds the returned keys with equal power.
+func setupSigningKeeper(t *testing.T) (sdk.Context, *keeper.Keeper, []cmted25519.PrivKey) {
+	t.Helper()
+
+	privKeys := make([]cmted25519.PrivKey, 4)
+	vals := make([]relayValidator, len(privKeys))
+	for i := range privKeys {
+		privKeys[i] = cmted25519.GenPrivKey()
+		vals[i] = relayValidator{pubKey: privKeys[i].PubKey().Bytes(), power: 10}
+	}
+	ctx, k := setupKeeper(t, map[uint64][]relayValidator{0: vals})
+	return ctx, k, privKeys
+}
+
+func signProof(t *testing.T, messageHash []byte, privKeys ...cmted25519.PrivKey) []byte {
+	t.Helper()
+
+	signatures := make([]types.Ed25519Signature, len(privKeys))
+	for i, privKey := range privKeys {
+		sig, err := privKey.Sign(messageHash)
+		require.NoError(t, err)
+		signatures[i] = types.Ed25519Signature{PubKey: privKey.PubKey().Bytes(), Signature: sig}
+	}
+	return types.EncodeEd25519AggregationProof(signatures)
+}
+
+type acceptingProofVerifier struct{}
+
+func (acceptingProofVerifier) MessageHash(message []byte) []byte {
+	return append([]byte("hash:"), message...)
+}
+
+func (acceptingProofVerifier) VerifyAggregationProof(context.Context, uint64, []byte, []byte) error {
+	return nil
+}
+
+type rejectingProofVerifier struct{}
+
+func (rejectingProofVerifier) MessageHash(message []byte) []byte {
+	return types.Ed25519MessageHash(message)
+}
+
+func (rejectingProofVerifier) VerifyAggregationProof(context.Context, uint64, []byte, []byte) error {
+	return errors.New("rejected proof")
+}
+
+func TestValidateSignatureProof(t *testing.T) {
+	ctx, k, privKeys := setupSigningKeeper(t)
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+
+	requestID, err := k.RequestSignature(ctx, testTypedMessage, params.ValidatorKeyTag)
+	require.NoError(t, err)
+	req, err := k.GetSignatureRequest(ctx, requestID)
+	require.NoError(t, err)
+	messageHash := types.Ed25519MessageHash(req.Message)
+	otherHash := types.Ed25519MessageHash([]byte("other message"))
+	outsider := cmted25519.GenPrivKey()
+
+	testCases := []struct {
+		name      string
+		requestID string
+		proof     types.AggregationProof
+		expErr    string
+	}{
+		{
+			name:      "unknown request",
+			requestID: "0x01",
+			proof:     types.AggregationProof{MessageHash: messageHash, Proof: signProof(t, messageHash, privKeys...)},
+			expErr:    "unknown request",
+		},
+		{
+			name:      "mismatched message hash",
+			requestID: requestID,
+			proof:     types.AggregationProof{MessageHash: otherHash, Proof: signProof(t, otherHash, privKeys...)},
+			expErr:    "message hash mismatch",
+		},
+		{
+			name:      "forged proof",
+			requestID: requestID,
+			proof:     types.AggregationProof{MessageHash: messageHash, Proof: []byte("forged proof")},
+			expErr:    "proof length",
+		},
+		{
+			name:      "forged signature",
+			requestID: requestID,
+			proof: types.AggregationProof{
+				MessageHash: messageHash,
+				Proof:       append(signProof(t, messageHash, privKeys[:3]...), signProof(t, otherHash, privKeys[3])...),
+			},
+			expErr: "invalid signature",
+		},
+		{
+			name:      "signer outside of the validator set",
+			requestID: requestID,
+			proof:     types.AggregationProof{MessageHash: messageHash, Proof: signProof(t, messageHash, append(privKeys, outsider)...)},
+			expErr:    "not in the validator set",
+		},
+		{
+			name:      "duplicate signer",
+			requestID: requestID,
+			proof:     types.AggregationProof{MessageHash: messageHash, Proof: signProof(t, messageHash, privKeys[0], privKeys[1], privKeys[1])},
+			expErr:    "duplicate signer",
+		},
+		{
+			name:      "two thirds of the voting power",
+			requestID: requestID,
+			proof:     types.AggregationProof{MessageHash: messageHash, Proof: signProof(t, messageHash, privKeys[:2]...)},
+			expErr:    "more than two thirds",
+		},
+		{
+			name:      "quorum of the validator set",
+			requestID: requestID,
+			proof:     types.AggregationProof{MessageHash: messageHash, Proof: signProof(t, messageHash, privKeys[:3]...)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := k.ValidateSignatureProof(ctx, types.SignatureProof{RequestId: tc.requestID, Proof: tc.proof})
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, types.ErrInvalidSignatureProof)
+			require.ErrorContains(t, err, tc.expErr)
+			require.ErrorIs(t, k.SetSignatureProof(ctx, types.SignatureProof{RequestId: tc.requestID, Proof: tc.proof}), types.ErrInvalidSignatureProof)
+		})
+	}
+
+	proof := types.SignatureProof{
+		RequestId: requestID,
+		Proof:     types.AggregationProof{MessageHash: messageHash, Proof: signProof(t, messageHash, privKeys...)},
+	}
+	require.NoError(t, k.SetSignatureProof(ctx, proof))
+	req, err = k.GetSignatureRequest(ctx, requestID)
+	require.NoError(t, err)
+	require.Equal(t, types.SignatureStatus_SIGNATURE_STATUS_COMPLETED, req.Status)
+	require.Equal(t, proof.Proof, *req.Proof)
+
+	// a completed request cannot be completed again
+	require.ErrorContains(t, k.ValidateSignatureProof(ctx, proof), "not pending")
+}
+
+func TestValidateSignatureProofKeyTagVerifier(t *testing.T) {
+	ctx, k, _ := setupSigningKeeper(t)
+
+	// the messages of other key tags than the validator one are never requested without verifier
+	_, err := k.RequestSignature(ctx, testTypedMessage, 15)
+	require.ErrorIs(t, err, types.ErrInvalidKeyTag)
+	require.ErrorContains(t, err, "no aggregation proof verifier for key tag 15")
+	require.ErrorIs(t, k.ValidateKeyTag(ctx, 15), types.ErrInvalidKeyTag)
+
+	k.SetAggregationProofVerifier(15, acceptingProofVerifier{})
+	require.NoError(t, k.ValidateKeyTag(ctx, 15))
+	requestID, err := k.RequestSignature(ctx, testTypedMessage, 15)
+	require.NoError(t, err)
+	req, err := k.GetSignatureRequest(ctx, requestID)
+	require.NoError(t, err)
+
+	messageHash := types.Ed25519MessageHash(req.Message)
+	proof := types.SignatureProof{RequestId: requestID, Proof: types.AggregationProof{MessageHash: messageHash}}
+	require.ErrorContains(t, k.ValidateSignatureProof(ctx, proof), "message hash mismatch")
+	proof.Proof.MessageHash = acceptingProofVerifier{}.MessageHash(req.Message)
+	require.NoError(t, k.ValidateSignatureProof(ctx, proof))
+	require.Panics(t, func() { k.SetAggregationProofVerifier(15, acceptingProofVerifier{}) })
+}
+
+// epochKeyProofVerifier verifies the ed25519 aggregation proofs of a key tag against the keys the relay validators
+// hold for it, which are their consensus keys in the tests.
+type epochKeyProofVerifier struct {
+	k *keeper.Keeper
+}
+
+func (epochKeyProofVerifier) MessageHash(message []byte) []byte {
+	return types.Ed25519MessageHash(message)
+}
+
+func (v epochKeyProofVerifier) VerifyAggregationProof(ctx context.Context, epoch uint64, messageHash, proof []byte) error {
+	valset, err := v.k.EpochValidatorSets.Get(ctx, epoch)
+	if err != nil {
+		return err
+	}
+	return types.VerifyEd25519AggregationProof(valset.Updates, messageHash, proof)
+}
+
+func TestSigningKeyTagVerifier(t *testing.T) {
+	ctx, k, privKeys := setupSigningKeeper(t)
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	params.SigningKeyTag = 15
+	params.CheckpointInterval = 1
+	require.NoError(t, k.Params.Set(ctx, params))
+	relayClient := types.NewMockRelayClient(func(uint64) []*v1.Validator { return nil })
+	relayClient.SetSigners(func(uint64) []cmted25519.PrivKey { return privKeys })
+	k.SetRelayClient(relayClient)
+	ctx = ctx.WithBlockHeight(2)
+
+	// the checkpoints and slashes are not requested with a signing key tag the app has no verifier for
+	require.ErrorIs(t, k.BeginBlock(ctx), types.ErrInvalidKeyTag)
+	_, err = k.SlashWithInfractionReason(ctx, privKeys[0].PubKey().Bytes(), 1, 10, sdkmath.LegacyNewDecWithPrec(1, 2), types.Infraction_INFRACTION_DOWNTIME)
+	require.ErrorIs(t, err, types.ErrInvalidKeyTag)
+
+	k.SetAggregationProofVerifier(15, epochKeyProofVerifier{k: k})
+	require.NoError(t, k.BeginBlock(ctx))
+	checkpoint, err := k.Checkpoints.Get(ctx, 1)
+	require.NoError(t, err)
+	slashID, err := k.SlashWithInfractionReason(ctx, privKeys[0].PubKey().Bytes(), 1, 10, sdkmath.LegacyNewDecWithPrec(1, 2), types.Infraction_INFRACTION_DOWNTIME)
+	require.NoError(t, err)
+
+	// the requests are submitted to the relay and completed with its verified proofs
+	require.NoError(t, k.SubmitSignatureRequests(ctx))
+	proofs, err := k.FetchSignatureProofs(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, proofs, 2)
+	for _, proof := range proofs {
+		require.NoError(t, k.SetSignatureProof(ctx, proof))
+	}
+	for _, requestID := range []string{checkpoint.RequestId, slashID} {
+		req, err := k.GetSignatureRequest(ctx, requestID)
+		require.NoError(t, err)
+		require.Equal(t, uint32(15), req.KeyTag)
+		require.Equal(t, types.SignatureStatus_SIGNATURE_STATUS_COMPLETED, req.Status)
+	}
+}
+
+func TestRequestSignature(t *testing.T) {
+	ctx, k, _ := setupSigningKeeper(t)
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	keyTag := params.ValidatorKeyTag
+
+	requestID, err := k.RequestSignature(ctx, testTypedMessage, keyTag)
+	require.NoError(t, err)
+	require.Equal(t, types.SignatureRequestID(keyTag, 0, testTypedMessage.SignBytes(ctx.ChainID())), requestID)
+	req, err := k.GetSignatureRequest(ctx, requestID)
+	require.NoError(t, err)
+	require.Equal(t, types.SignatureRequest{
+		RequestId:   requestID,
+		Module:      testTypedMessage.Module,
+		MessageType: testTypedMessage.Type,
+		KeyTag:      keyTag,
+		Message:     testTypedMessage.SignBytes(ctx.ChainID()),
+		Epoch:       0,
+		Height:      ctx.BlockHeight(),
+		Status:      types.SignatureStatus_SIGNATURE_STATUS_PENDING,
+	}, req)
+
+	// requesting the same message again in the epoch is a no-op
+	again, err := k.RequestSignature(ctx.WithBlockHeight(2), testTypedMessage, keyTag)
+	require.NoError(t, err)
+	require.Equal(t, requestID, again)
+	req, err = k.GetSignatureRequest(ctx, requestID)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight(), req.Height)
+
+	// the id depends on the key tag and epoch
+	k.SetAggregationProofVerifier(16, acceptingProofVerifier{})
+	otherTag, err := k.RequestSignature(ctx, testTypedMessage, 16)
+	require.NoError(t, err)
+	require.NotEqual(t, requestID, otherTag)
+	require.NoError(t, k.SetCurrentEpoch(ctx, &types.StoreEpoch{Epoch: 1}))
+	otherEpoch, err := k.RequestSignature(ctx, testTypedMessage, keyTag)
+	require.NoError(t, err)
+	require.NotEqual(t, requestID, otherEpoch)
+}
+
+// countingRelayClient counts the messages submitted to the relay, failing the submissions if err is set.
+type countingRelayClient struct {
+	*types.MockRelayClient
+	signCalls int
+	err       error
+}
+
+func (c *countingRelayClient) SignMessage(ctx context.Context, in *v1.SignMessageRequest, opts ...grpc.CallOption) (*v1.SignMessageResponse, error) {
+	c.signCalls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.MockRelayClient.SignMessage(ctx, in, opts...)
+}
+
+func TestSubmitSignatureRequests(t *testing.T) {
+	ctx, k, privKeys := setupSigningKeeper(t)
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+
+	relayClient := &countingRelayClient{
+		MockRelayClient: types.NewMockRelayClient(func(uint64) []*v1.Validator { return nil }),
+		err:             errors.New("relay unavailable"),
+	}
+	relayClient.SetSigners(func(uint64) []cmted25519.PrivKey { return privKeys })
+	k.SetRelayClient(relayClient)
+
+	// requests are stored without calling the relay
+	requestID, err := k.RequestSignature(ctx, testTypedMessage, params.ValidatorKeyTag)
+	require.NoError(t, err)
+	require.Zero(t, relayClient.signCalls)
+
+	// relay errors are retried on the next submission
+	require.NoError(t, k.SubmitSignatureRequests(ctx))
+	require.Equal(t, 1, relayClient.signCalls)
+	relayClient.err = nil
+	require.NoError(t, k.SubmitSignatureRequests(ctx))
+	require.Equal(t, 2, relayClient.signCalls)
+	require.NoError(t, k.SubmitSignatureRequests(ctx))
+	require.Equal(t, 2, relayClient.signCalls)
+
+	// the proofs are fetched by relay request id and returned by signature request id
+	proofs, err := k.FetchSignatureProofs(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+	require.Equal(t, requestID, proofs[0].RequestId)
+	require.Equal(t, 2, relayClient.signCalls)
+}
+
+func TestFetchSignatureProofs(t *testing.T) {
+	ctx, k, privKeys := setupSigningKeeper(t)
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+
+	validID, err := k.RequestSignature(ctx, testTypedMessage, params.ValidatorKeyTag)
+	require.NoError(t, err)
+	// the proof failing verification is skipped
+	k.SetAggregationProofVerifier(15, rejectingProofVerifier{})
+	_, err = k.RequestSignature(ctx, types.TypedMessage{Module: "test", Type: "test", Payload: []byte("other")}, 15)
+	require.NoError(t, err)
+
+	// the mock relay proofs carry no signatures without signers
+	relayClient := types.NewMockRelayClient(func(uint64) []*v1.Validator { return nil })
+	k.SetRelayClient(relayClient)
+	proofs, err := k.FetchSignatureProofs(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, proofs)
+
+	relayClient.SetSigners(func(uint64) []cmted25519.PrivKey { return privKeys })
+	proofs, err = k.FetchSignatureProofs(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+	require.Equal(t, validID, proofs[0].RequestId)
+	require.NoError(t, k.SetSignatureProof(ctx, proofs[0]))
+}
+
+func TestExpireSignatureRequests(t *testing.T) {
+	ctx, k, _ := setupSigningKeeper(t)
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	params.SignatureRequestTimeout = 10
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	requestID, err := k.RequestSignature(ctx, testTypedMessage, params.ValidatorKeyTag)
+	require.NoError(t, err)
+
+	// the relay validator set of epoch 0 is kept while a request of the epoch is pending
+	require.NoError(t, k.SetCurrentEpoch(ctx, &types.StoreEpoch{Epoch: 1}))
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlock(ctx))
+	req, err := k.GetSignatureRequest(ctx, requestID)
+	require.NoError(t, err)
+	require.Equal(t, types.SignatureStatus_SIGNATURE_STATUS_PENDING, req.Status)
+	require.False(t, hasEvent(ctx, types.EventTypeSignatureExpired))
+	has, err := k.EpochValidatorSets.Has(ctx, 0)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlock(ctx))
+	req, err = k.GetSignatureRequest(ctx, requestID)
+	require.NoError(t, err)
+	require.Equal(t, types.SignatureStatus_SIGNATURE_STATUS_EXPIRED, req.Status)
+	require.True(t, hasEvent(ctx, types.EventTypeSignatureExpired))
+	has, err = k.PendingSignatureRequests.Has(ctx, collections.Join(req.Height, requestID))
+	require.NoError(t, err)
+	require.False(t, has)
+	_, err = k.EpochValidatorSets.Get(ctx, 0)
+	require.True(t, errors.Is(err, collections.ErrNotFound))
+
+	require.ErrorContains(t, k.ValidateSignatureProof(ctx, types.SignatureProof{RequestId: requestID}), "not pending")
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+// TestnetRelaySyncReason is the reason of the relay sync pause of an in-place testnet.
+const TestnetRelaySyncReason = "in-place testnet"
+
+// SetRelayClient replaces the relay client, e.g. by a mock relay client serving the validator set of an in-place
+// testnet.
+func (k *Keeper) SetRelayClient(relayClient types.RelayClient) {
+	k.relayClient = relayClient
+	k.relaySubmissions = newRelaySubmissions()
+}
+
+// InitTestnet rewrites the state of a network forked into an in-place testnet, so that the local validator controls
+// it: the last validator set is replaced by the local validator with the given consensus key and power, and the
+// relay sync is paused to pin the current epoch until the authority resumes it. The validator hooks are called as
+// for any other validator set change, but no validator updates are returned as the in-place testnet rewrites the
+// CometBFT validator set itself.
+func (k *Keeper) InitTestnet(ctx context.Context, consPubKey cryptotypes.PubKey, power int64) error {
+	if _, ok := consPubKey.(*ed25519.PubKey); !ok {
+		return fmt.Errorf("expected an ed25519 consensus key, got %s", consPubKey.Type())
+	}
+	if power <= 0 {
+		return fmt.Errorf("validator power must be positive, got %d", power)
+	}
+
+	current, err := k.GetLastValidatorSet(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get last validator set")
+	}
+	currentEpoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get current epoch")
+	}
+
+	// the relay progress of the forked network is dropped
+	if err := k.ValidatorSetTransition.Remove(ctx); err != nil {
+		return errors.Wrap(err, "could not remove validator set transition")
+	}
+	if err := k.RejectedValidatorSetEpoch.Remove(ctx); err != nil {
+		return errors.Wrap(err, "could not remove rejected validator set epoch")
+	}
+	if err := k.PollWindowEnd.Remove(ctx); err != nil {
+		return errors.Wrap(err, "could not remove poll window end")
+	}
+	if err := k.RelaySyncState.Set(ctx, types.RelaySyncState{
+		Paused:       true,
+		PausedHeight: sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		Reason:       TestnetRelaySyncReason,
+	}); err != nil {
+		return errors.Wrap(err, "could not set relay sync state")
+	}
+
+	validator := abci.ValidatorUpdate{
+		PubKey: cmtprotocrypto.PublicKey{Sum: &cmtprotocrypto.PublicKey_Ed25519{Ed25519: consPubKey.Bytes()}},
+		Power:  power,
+	}
+	_, err = k.applyValidatorSet(ctx, current.Updates, currentEpoch.Epoch, []abci.ValidatorUpdate{validator})
+	return err
+}
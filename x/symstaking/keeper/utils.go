@@ -44,20 +44,30 @@ func (k *Keeper) GetValidatorSet(ctx context.Context, epoch uint64) ([]types.Val
 }
 
 func (k *Keeper) GetLatestEpoch(ctx context.Context) (uint64, error) {
-	// list through all settlement chains and find the lowest committed epoch
-	resp, err := k.relayClient.GetLastAllCommitted(ctx, &v1.GetLastAllCommittedRequest{})
+	status, err := k.GetSettlementStatus(ctx)
 	if err != nil {
 		return 0, err
 	}
+	return status.SelectedEpoch, nil
+}
 
-	// find the lowest epoch
-	responseEpoch := uint64(0)
-	for _, chainInfo := range resp.EpochInfos {
-		if responseEpoch == 0 || chainInfo.LastCommittedEpoch < responseEpoch {
-			responseEpoch = chainInfo.LastCommittedEpoch
-		}
+// GetSettlementStatus fetches the last committed epoch of every settlement chain from the relay and selects the
+// epoch to advance to according to the configured epoch selection policy.
+func (k *Keeper) GetSettlementStatus(ctx context.Context) (*symStakingTypes.QuerySettlementStatusResponse, error) {
+	resp, err := k.relayClient.GetLastAllCommitted(ctx, &v1.GetLastAllCommittedRequest{})
+	if err != nil {
+		return nil, err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get params")
+	}
+
+	committed := make(map[uint64]uint64, len(resp.EpochInfos))
+	for chainID, chainInfo := range resp.EpochInfos {
+		committed[chainID] = chainInfo.LastCommittedEpoch
 	}
-	return responseEpoch, nil
+	return params.SettlementStatus(committed)
 }
 
 // extractConsensusPubKey extracts the consensus public key from the key list
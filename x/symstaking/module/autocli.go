@@ -23,6 +23,40 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query currentEpoch",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
 				},
+				{
+					RpcMethod: "SettlementStatus",
+					Use:       "settlement-status",
+					Short:     "Query the committed epoch of every settlement chain and the epoch selected from them",
+				},
+				{
+					RpcMethod:      "SignatureRequest",
+					Use:            "signature-request [request-id]",
+					Short:          "Query a relay signature request and its aggregation proof",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "request_id"}},
+				},
+				{
+					RpcMethod:      "Checkpoint",
+					Use:            "checkpoint [height]",
+					Short:          "Query the app hash checkpoint at a height and its aggregation proof",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+				},
+				{
+					RpcMethod: "Checkpoints",
+					Use:       "checkpoints",
+					Short:     "Query all app hash checkpoints and their aggregation proofs",
+				},
+				{
+					RpcMethod: "SyncStatus",
+					Use:       "sync-status",
+					Short:     "Query the synchronization of the validator set with the relay and its manual overrides",
+				},
+				{
+					RpcMethod:      "Voter",
+					Use:            "voter [consensus-pubkey]",
+					Short:          "Query the governance voter registered by a validator and the nonce of its next registration",
+					Long:           "Query the governance voter registered by the validator with the given hex or base64 encoded ed25519 consensus pubkey and the nonce of its next voter registration",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "consensus_pubkey"}},
+				},
 
 				// this line is used by ignite scaffolding # autocli/query
 			},
@@ -35,6 +69,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "ForceValidatorSet",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "PauseRelaySync",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "ResumeRelaySync",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RegisterVoter",
+					Skip:      true, // skipped because it requires a consensus key signature, see cli.NewRegisterVoterCmd
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -6,14 +6,10 @@ import (
 	"os"
 	"slices"
 	"sort"
-	"time"
 
-	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	v1 "github.com/symbioticfi/relay/api/client/v1"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
@@ -23,12 +19,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/symstaking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
 )
 
 const (
-	MockRelayRPCAddress = "mock-rpc"
+	MockRelayRPCAddress = types.MockRelayRPCAddress
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -47,14 +45,18 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
-	Config                *types.Module
-	StoreService          store.KVStoreService
-	Cdc                   codec.Codec
-	AddressCodec          runtime.ConsensusAddressCodec
+	Config       *types.Module
+	StoreService store.KVStoreService
+	Cdc          codec.Codec
+	// AddressCodec is the account address codec: the authority of the authority gated messages and the governance
+	// voters are account addresses, as required to sign the messages.
+	AddressCodec          address.Codec
 	ConsensusAddressCodec runtime.ConsensusAddressCodec
 
 	AuthKeeper types.AuthKeeper
 	BankKeeper types.BankKeeper
+	// CircuitBreaker is optional, the valset sync can't be tripped without it
+	CircuitBreaker types.CircuitBreaker `optional:"true"`
 
 	Logger log.Logger
 }
@@ -64,6 +66,14 @@ type ModuleOutputs struct {
 
 	SymstakingKeeper *keeper.Keeper
 	Module           appmodule.AppModule
+	// EpochHooks schedule the relay polls in the time poll schedule, they are
+	// no-ops in the height poll schedule.
+	EpochHooks epochstypes.EpochHooksWrapper
+
+	// x/gov tally functions, they fall back to the x/gov defaults unless the
+	// governance_tally_mode param is set to symstaking.
+	CalculateVoteResultsAndVotingPowerFn govkeeper.CalculateVoteResultsAndVotingPowerFn
+	TotalVotingPowerFn                   govkeeper.TotalVotingPowerFn
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -79,15 +89,23 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Logger.Info("no relay client rpc address configured, defaulting to mock relay client (SYMBIOTIC_KEY_FILE based)", "address", MockRelayRPCAddress)
 	}
 
-	var client types.RelayClient
+	keyFile := os.Getenv("SYMBIOTIC_KEY_FILE")
 	if in.Config.RelayClientRpc == MockRelayRPCAddress {
-		client = types.NewMockRelayClient(types.ValidatorFromFileGetter(os.Getenv("SYMBIOTIC_KEY_FILE")))
-	} else {
-		conn, err := GetGRPCConnection(in.Config.RelayClientRpc)
-		if err != nil {
-			panic(err)
+		if keyFile != "" {
+			schedule, err := types.ReadMockRelaySchedule(keyFile)
+			if err != nil {
+				panic(err)
+			}
+			if err := schedule.Validate(); err != nil {
+				panic(fmt.Errorf("invalid mock relay schedule %s: %w", keyFile, err))
+			}
+		} else {
+			in.Logger.Warn("SYMBIOTIC_KEY_FILE is not set, the mock relay client has no validator schedule")
 		}
-		client = v1.NewSymbioticClient(conn)
+	}
+	client, err := types.NewRelayClient(in.Config.RelayClientRpc, keyFile)
+	if err != nil {
+		panic(err)
 	}
 
 	k := keeper.NewKeeper(
@@ -99,9 +117,18 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority,
 		client,
 	)
+	if in.CircuitBreaker != nil {
+		k.SetCircuitBreaker(in.CircuitBreaker)
+	}
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{SymstakingKeeper: k, Module: m}
+	return ModuleOutputs{
+		SymstakingKeeper:                     k,
+		Module:                               m,
+		EpochHooks:                           epochstypes.EpochHooksWrapper{EpochHooks: k.EpochHooks()},
+		CalculateVoteResultsAndVotingPowerFn: k.CalculateVoteResultsAndVotingPower,
+		TotalVotingPowerFn:                   k.TotalVotingPower,
+	}
 }
 
 func InvokeSetStakingHooks(
@@ -142,19 +169,7 @@ func InvokeSetStakingHooks(
 	return nil
 }
 
+// GetGRPCConnection returns a gRPC connection to the relay sidecar.
 func GetGRPCConnection(address string) (*grpc.ClientConn, error) {
-	retryOpts := []grpc_retry.CallOption{
-		grpc_retry.WithMax(3),
-		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(time.Second)),
-	}
-	unaryInterceptors := []grpc.UnaryClientInterceptor{grpc_retry.UnaryClientInterceptor(retryOpts...)}
-	opts := []grpc.DialOption{
-		grpc.WithStreamInterceptor(grpc_retry.StreamClientInterceptor(retryOpts...)),
-		grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(unaryInterceptors...)),
-		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(100*1024*1024), grpc.MaxCallSendMsgSize(100*1024*1024)),
-	}
-
-	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
-
-	return grpc.NewClient(address, opts...)
+	return types.GetGRPCConnection(address)
 }
@@ -7,6 +7,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
 	"cosmossdk.io/core/appmodule"
@@ -16,6 +17,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/symstaking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
 )
@@ -25,9 +27,10 @@ var (
 	_ module.AppModule      = (*AppModule)(nil)
 	_ module.HasABCIGenesis = (*AppModule)(nil)
 
-	_ appmodule.AppModule       = (*AppModule)(nil)
-	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
-	_ module.HasABCIEndBlock    = (*AppModule)(nil)
+	_ appmodule.AppModule            = (*AppModule)(nil)
+	_ appmodule.HasBeginBlocker      = (*AppModule)(nil)
+	_ module.HasABCIEndBlock         = (*AppModule)(nil)
+	_ appmodule.HasPrepareCheckState = (*AppModule)(nil)
 )
 
 // AppModule implements the AppModule interface that defines the inter-dependent methods that modules need to implement
@@ -70,6 +73,11 @@ func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtim
 	}
 }
 
+// GetTxCmd returns the root tx command for the symstaking module.
+func (AppModule) GetTxCmd() *cobra.Command {
+	return cli.NewTxCmd()
+}
+
 // RegisterInterfaces registers a module's interface types and their concrete implementations as proto.Message.
 func (AppModule) RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registrar)
@@ -131,9 +139,10 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMe
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
-// The begin block implementation is optional.
-func (am AppModule) BeginBlock(_ context.Context) error {
-	return nil
+// It expires the signature requests without aggregation proof and checkpoints the app hash to the settlement chains
+// every CheckpointInterval blocks.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.keeper.BeginBlock(ctx)
 }
 
 // EndBlock contains the logic that is automatically triggered at the end of each block.
@@ -141,3 +150,9 @@ func (am AppModule) BeginBlock(_ context.Context) error {
 func (am AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error) {
 	return am.keeper.EndBlock(ctx)
 }
+
+// PrepareCheckState is triggered on every node once a block is committed. It submits the pending signature requests
+// to the local relay, outside of the state machine.
+func (am AppModule) PrepareCheckState(ctx context.Context) error {
+	return am.keeper.SubmitSignatureRequests(ctx)
+}
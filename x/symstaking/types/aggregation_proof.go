@@ -0,0 +1,103 @@
+package types
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+
+	"cosmossdk.io/math"
+)
+
+// AggregationProofVerifier verifies the aggregation proofs produced by the relay for the messages signed with a key
+// tag.
+type AggregationProofVerifier interface {
+	// MessageHash returns the hash of a message as signed by the relay.
+	MessageHash(message []byte) []byte
+	// VerifyAggregationProof verifies that the proof aggregates signatures of the message hash by a quorum of the
+	// relay validator set of the epoch.
+	VerifyAggregationProof(ctx context.Context, epoch uint64, messageHash, proof []byte) error
+}
+
+// ed25519ProofSignatureSize is the size of the public key and signature of a signer in an ed25519 aggregation proof.
+const ed25519ProofSignatureSize = ed25519.PubKeySize + ed25519.SignatureSize
+
+// Ed25519Signature is the signature of a message hash by a validator consensus key.
+type Ed25519Signature struct {
+	PubKey    []byte
+	Signature []byte
+}
+
+// Ed25519MessageHash returns the hash of a message signed with the validator key tag.
+func Ed25519MessageHash(message []byte) []byte {
+	hash := sha256.Sum256(message)
+	return hash[:]
+}
+
+// EncodeEd25519AggregationProof encodes the aggregation proof of a message signed with the validator key tag: the
+// concatenation of the public key and signature of each signer.
+func EncodeEd25519AggregationProof(signatures []Ed25519Signature) []byte {
+	proof := make([]byte, 0, len(signatures)*ed25519ProofSignatureSize)
+	for _, sig := range signatures {
+		proof = append(proof, sig.PubKey...)
+		proof = append(proof, sig.Signature...)
+	}
+	return proof
+}
+
+// DecodeEd25519AggregationProof decodes the signatures of an aggregation proof of a message signed with the validator
+// key tag.
+func DecodeEd25519AggregationProof(proof []byte) ([]Ed25519Signature, error) {
+	if len(proof) == 0 || len(proof)%ed25519ProofSignatureSize != 0 {
+		return nil, fmt.Errorf("proof length must be a positive multiple of %d, got %d", ed25519ProofSignatureSize, len(proof))
+	}
+
+	signatures := make([]Ed25519Signature, 0, len(proof)/ed25519ProofSignatureSize)
+	for i := 0; i < len(proof); i += ed25519ProofSignatureSize {
+		signatures = append(signatures, Ed25519Signature{
+			PubKey:    proof[i : i+ed25519.PubKeySize],
+			Signature: proof[i+ed25519.PubKeySize : i+ed25519ProofSignatureSize],
+		})
+	}
+	return signatures, nil
+}
+
+// VerifyEd25519AggregationProof verifies that the proof holds valid signatures of the message hash by validators of
+// the validator set holding more than two thirds of its voting power.
+func VerifyEd25519AggregationProof(validators []abci.ValidatorUpdate, messageHash, proof []byte) error {
+	signatures, err := DecodeEd25519AggregationProof(proof)
+	if err != nil {
+		return err
+	}
+
+	powers := make(map[string]int64, len(validators))
+	totalPower := math.ZeroInt()
+	for _, val := range validators {
+		powers[string(val.PubKey.GetEd25519())] = val.Power
+		totalPower = totalPower.AddRaw(val.Power)
+	}
+
+	signed := make(map[string]bool, len(signatures))
+	signedPower := math.ZeroInt()
+	for _, sig := range signatures {
+		power, ok := powers[string(sig.PubKey)]
+		if !ok {
+			return fmt.Errorf("signer %X is not in the validator set", sig.PubKey)
+		}
+		if signed[string(sig.PubKey)] {
+			return fmt.Errorf("duplicate signer %X", sig.PubKey)
+		}
+		if !ed25519.PubKey(sig.PubKey).VerifySignature(messageHash, sig.Signature) {
+			return fmt.Errorf("invalid signature of signer %X", sig.PubKey)
+		}
+		signed[string(sig.PubKey)] = true
+		signedPower = signedPower.AddRaw(power)
+	}
+
+	if signedPower.MulRaw(3).LTE(totalPower.MulRaw(2)) {
+		return fmt.Errorf("signers hold %s of the %s voting power, more than two thirds is required", signedPower, totalPower)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+// CheckpointMessageType is the message type of the checkpoint signature requests.
+const CheckpointMessageType = "checkpoint"
+
+// Payload returns the payload of the typed message signed by the relay for the checkpoint. The chain id is part of
+// the typed message domain. The payload is encoded as:
+//
+//	height (uint64) || epoch (uint64) || len(app_hash) (uint32) || app_hash || len(validator_set_hash) (uint32) || validator_set_hash
+//
+// with all integers big endian.
+func (c Checkpoint) Payload() []byte {
+	bz := make([]byte, 0, 8+8+4+len(c.AppHash)+4+len(c.ValidatorSetHash))
+	bz = binary.BigEndian.AppendUint64(bz, uint64(c.Height))
+	bz = binary.BigEndian.AppendUint64(bz, c.Epoch)
+	bz = binary.BigEndian.AppendUint32(bz, uint32(len(c.AppHash)))
+	bz = append(bz, c.AppHash...)
+	bz = binary.BigEndian.AppendUint32(bz, uint32(len(c.ValidatorSetHash)))
+	bz = append(bz, c.ValidatorSetHash...)
+	return bz
+}
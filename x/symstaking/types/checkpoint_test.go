@@ -0,0 +1,31 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func TestCheckpoint_Payload(t *testing.T) {
+	checkpoint := types.Checkpoint{
+		Height:           0x0102,
+		Epoch:            7,
+		AppHash:          []byte{0xaa, 0xbb},
+		ValidatorSetHash: []byte{0xcc},
+		RequestId:        "ignored",
+	}
+
+	require.Equal(t, []byte{
+		0, 0, 0, 0, 0, 0, 1, 2,
+		0, 0, 0, 0, 0, 0, 0, 7,
+		0, 0, 0, 2, 0xaa, 0xbb,
+		0, 0, 0, 1, 0xcc,
+	}, checkpoint.Payload())
+
+	// the request id is assigned after signing and is not part of the payload
+	withoutRequestID := checkpoint
+	withoutRequestID.RequestId = ""
+	require.Equal(t, checkpoint.Payload(), withoutRequestID.Payload())
+}
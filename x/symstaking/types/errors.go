@@ -10,4 +10,29 @@ import (
 var (
 	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrInvalidKeyTag = errors.Register(ModuleName, 1101, "invalid key tag for validator")
+
+	ErrInvalidEpochSelection   = errors.Register(ModuleName, 1102, "invalid epoch selection policy")
+	ErrSettlementChainNotFound = errors.Register(ModuleName, 1103, "settlement chain not found")
+	ErrSettlementQuorumNotMet  = errors.Register(ModuleName, 1104, "settlement quorum not met")
+
+	ErrInvalidTypedMessage      = errors.Register(ModuleName, 1105, "invalid typed message")
+	ErrSignatureRequestNotFound = errors.Register(ModuleName, 1106, "signature request not found")
+	ErrInvalidSignatureProof    = errors.Register(ModuleName, 1107, "invalid signature proof")
+
+	ErrInvalidCheckpointInterval = errors.Register(ModuleName, 1108, "invalid checkpoint interval")
+	ErrCheckpointNotFound        = errors.Register(ModuleName, 1109, "checkpoint not found")
+
+	ErrInvalidTallyMode         = errors.Register(ModuleName, 1110, "invalid governance tally mode")
+	ErrInvalidVoterRegistration = errors.Register(ModuleName, 1111, "invalid voter registration")
+	ErrVoterAlreadyRegistered   = errors.Register(ModuleName, 1112, "voter already registered for another validator")
+
+	ErrInvalidPollSchedule = errors.Register(ModuleName, 1113, "invalid relay poll schedule")
+
+	ErrInvalidValidatorSetGuard = errors.Register(ModuleName, 1114, "invalid validator set change limits")
+	ErrUnsafeValidatorSetChange = errors.Register(ModuleName, 1115, "unsafe validator set change")
+
+	ErrInvalidForcedValidatorSet = errors.Register(ModuleName, 1116, "invalid forced validator set")
+	ErrRelaySyncNotPaused        = errors.Register(ModuleName, 1117, "relay sync is not paused")
+
+	ErrInvalidSignatureRequestTimeout = errors.Register(ModuleName, 1118, "invalid signature request timeout")
 )
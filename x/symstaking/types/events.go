@@ -0,0 +1,20 @@
+package types
+
+// Symstaking module event types
+const (
+	EventTypeCheckpoint             = "checkpoint"
+	EventTypeValidatorSetRejected   = "validator_set_rejected"
+	EventTypeValidatorSetTransition = "validator_set_transition"
+	EventTypeForceValidatorSet      = "force_validator_set"
+	EventTypePauseRelaySync         = "pause_relay_sync"
+	EventTypeResumeRelaySync        = "resume_relay_sync"
+	EventTypeSignatureExpired       = "signature_expired"
+
+	AttributeKeyHeight     = "height"
+	AttributeKeyRequestID  = "request_id"
+	AttributeKeyEpoch      = "epoch"
+	AttributeKeyReason     = "reason"
+	AttributeKeyComplete   = "complete"
+	AttributeKeyValidators = "validators"
+	AttributeKeyPower      = "power"
+)
@@ -39,6 +39,7 @@ type SymStakingHooks interface {
 	AfterValidatorCreated(ctx context.Context, consPubKey cryptotypes.PubKey) error  // Must be called when a validator is created
 	AfterValidatorModified(ctx context.Context, consPubKey cryptotypes.PubKey) error // Must be called when a validator's state changes
 	AfterValidatorRemoved(ctx context.Context, consPubKey cryptotypes.PubKey) error  // Must be called when a validator is deleted
+	AfterEpochChanged(ctx context.Context, previousEpoch, epoch uint64) error        // Must be called when the validator set of a new epoch is applied
 }
 
 // SymStakingHooksWrapper is a wrapper for modules to inject StakingHooks using depinject.
@@ -46,3 +47,10 @@ type SymStakingHooksWrapper struct{ SymStakingHooks }
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (SymStakingHooksWrapper) IsOnePerModuleType() {}
+
+// CircuitBreaker defines the expected interface for the circuit module, it lets the authorized accounts pause the
+// named features of the module during incidents.
+type CircuitBreaker interface {
+	RegisterFeatures(features ...string)
+	IsFeatureAllowed(ctx context.Context, feature string) (bool, error)
+}
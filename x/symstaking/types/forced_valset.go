@@ -0,0 +1,50 @@
+package types
+
+import (
+	"encoding/hex"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+)
+
+// ForcedValidatorUpdates validates a validator set pinned by MsgForceValidatorSet for CometBFT and returns it as
+// validator updates. The set must hold at least minValidators validators with distinct ed25519 consensus keys and
+// positive powers, and its total voting power must not exceed the CometBFT maximum.
+func ForcedValidatorUpdates(vals []ForcedValidator, minValidators uint32) ([]abci.ValidatorUpdate, error) {
+	if len(vals) == 0 || len(vals) < int(minValidators) {
+		return nil, errorsmod.Wrapf(ErrInvalidForcedValidatorSet, "validator set of %d validators is below the minimum of %d", len(vals), max(minValidators, 1))
+	}
+
+	seen := make(map[string]struct{}, len(vals))
+	updates := make([]abci.ValidatorUpdate, len(vals))
+	var total int64
+	for i, val := range vals {
+		if len(val.ConsensusPubkey) != ed25519.PubKeySize {
+			return nil, errorsmod.Wrapf(ErrInvalidForcedValidatorSet, "validator %d: expected %d bytes ed25519 consensus pubkey, got %d", i, ed25519.PubKeySize, len(val.ConsensusPubkey))
+		}
+		key := hex.EncodeToString(val.ConsensusPubkey)
+		if _, ok := seen[key]; ok {
+			return nil, errorsmod.Wrapf(ErrInvalidForcedValidatorSet, "duplicate consensus pubkey %s", key)
+		}
+		seen[key] = struct{}{}
+
+		if val.Power <= 0 {
+			return nil, errorsmod.Wrapf(ErrInvalidForcedValidatorSet, "validator %s: power must be positive, got %d", key, val.Power)
+		}
+		if val.Power > cmttypes.MaxTotalVotingPower-total {
+			return nil, errorsmod.Wrapf(ErrInvalidForcedValidatorSet, "total voting power exceeds the maximum of %d", cmttypes.MaxTotalVotingPower)
+		}
+		total += val.Power
+
+		updates[i] = abci.ValidatorUpdate{
+			PubKey: cmtprotocrypto.PublicKey{Sum: &cmtprotocrypto.PublicKey_Ed25519{Ed25519: val.ConsensusPubkey}},
+			Power:  val.Power,
+		}
+	}
+	return updates, nil
+}
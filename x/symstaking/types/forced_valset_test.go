@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func TestForcedValidatorUpdates(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey().Bytes()
+	pk2 := ed25519.GenPrivKey().PubKey().Bytes()
+
+	tests := []struct {
+		desc   string
+		vals   []types.ForcedValidator
+		min    uint32
+		expErr string
+	}{
+		{desc: "valid", vals: []types.ForcedValidator{{ConsensusPubkey: pk1, Power: 10}, {ConsensusPubkey: pk2, Power: 20}}, min: 1},
+		{desc: "empty", vals: nil, min: 0, expErr: "validator set of 0 validators is below the minimum of 1"},
+		{desc: "below minimum", vals: []types.ForcedValidator{{ConsensusPubkey: pk1, Power: 10}}, min: 2, expErr: "below the minimum of 2"},
+		{desc: "invalid key", vals: []types.ForcedValidator{{ConsensusPubkey: pk1[:31], Power: 10}}, min: 1, expErr: "expected 32 bytes ed25519 consensus pubkey, got 31"},
+		{desc: "duplicate key", vals: []types.ForcedValidator{{ConsensusPubkey: pk1, Power: 10}, {ConsensusPubkey: pk1, Power: 20}}, min: 1, expErr: "duplicate consensus pubkey"},
+		{desc: "zero power", vals: []types.ForcedValidator{{ConsensusPubkey: pk1, Power: 0}}, min: 1, expErr: "power must be positive"},
+		{
+			desc:   "total power overflow",
+			vals:   []types.ForcedValidator{{ConsensusPubkey: pk1, Power: cmttypes.MaxTotalVotingPower}, {ConsensusPubkey: pk2, Power: 1}},
+			min:    1,
+			expErr: "total voting power exceeds the maximum",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			updates, err := types.ForcedValidatorUpdates(tc.vals, tc.min)
+			if tc.expErr != "" {
+				require.ErrorIs(t, err, types.ErrInvalidForcedValidatorSet)
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, updates, len(tc.vals))
+			for i, val := range tc.vals {
+				require.Equal(t, val.ConsensusPubkey, updates[i].PubKey.GetEd25519())
+				require.Equal(t, val.Power, updates[i].Power)
+			}
+		})
+	}
+}
@@ -42,3 +42,12 @@ func (h MultiSymStakingHooks) AfterValidatorRemoved(ctx context.Context, consPub
 	}
 	return nil
 }
+
+func (h MultiSymStakingHooks) AfterEpochChanged(ctx context.Context, previousEpoch, epoch uint64) error {
+	for i := range h {
+		if err := h[i].AfterEpochChanged(ctx, previousEpoch, epoch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
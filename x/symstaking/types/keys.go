@@ -13,7 +13,37 @@ const (
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
+
+	// FeatureValsetSync is the circuit breaker feature of the relay validator set sync, tripping it stops the relay
+	// polls and the validator set updates until it is reset.
+	FeatureValsetSync = ModuleName + "/valset-sync"
 )
 
-// ParamsKey is the prefix to retrieve all Params
-var ParamsKey = collections.NewPrefix("p_symstaking")
+var (
+	// ParamsKey is the prefix to retrieve all Params
+	ParamsKey = collections.NewPrefix("p_symstaking")
+
+	// SignatureRequestsKey is the prefix to retrieve relay signature requests by id
+	SignatureRequestsKey = collections.NewPrefix(1)
+	// PendingSignatureRequestsKey is the prefix of the heights and ids of signature requests awaiting an aggregation
+	// proof
+	PendingSignatureRequestsKey = collections.NewPrefix(2)
+	// CheckpointsKey is the prefix to retrieve app hash checkpoints by height
+	CheckpointsKey = collections.NewPrefix(3)
+	// VotersKey is the prefix to retrieve the validator consensus pubkey a governance voter account votes for
+	VotersKey = collections.NewPrefix(4)
+	// VotersByConsensusKeyKey is the prefix to retrieve the governance voter account of a validator consensus pubkey
+	VotersByConsensusKeyKey = collections.NewPrefix(5)
+	// PollWindowEndKey is the key of the last height of the current relay poll grace window
+	PollWindowEndKey = collections.NewPrefix(6)
+	// ValidatorSetTransitionKey is the key of the relay validator set being applied over several blocks
+	ValidatorSetTransitionKey = collections.NewPrefix(7)
+	// RejectedValidatorSetEpochKey is the key of the last relay epoch whose validator set was rejected
+	RejectedValidatorSetEpochKey = collections.NewPrefix(8)
+	// RelaySyncStateKey is the key of the relay sync state
+	RelaySyncStateKey = collections.NewPrefix(9)
+	// EpochValidatorSetsKey is the prefix to retrieve the relay validator set of an epoch by epoch
+	EpochValidatorSetsKey = collections.NewPrefix(10)
+	// VoterNoncesKey is the prefix to retrieve the nonce of the next voter registration of a validator consensus pubkey
+	VoterNoncesKey = collections.NewPrefix(11)
+)
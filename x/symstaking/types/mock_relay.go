@@ -5,10 +5,11 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"os"
+	"strconv"
 
 	"github.com/cometbft/cometbft/crypto/ed25519"
-	"github.com/cometbft/cometbft/p2p"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	v1 "github.com/symbioticfi/relay/api/client/v1"
 	"golang.org/x/net/context"
@@ -16,17 +17,32 @@ import (
 )
 
 type MockRelayValidatorGetter func(epoch uint64) []*v1.Validator
+
+// MockRelaySignerGetter returns the consensus private keys of the validators of an epoch signing with the mock relay.
+type MockRelaySignerGetter func(epoch uint64) []ed25519.PrivKey
+
 type MockRelayClient struct {
 	validatorDataGetter MockRelayValidatorGetter
+	signerGetter        MockRelaySignerGetter
 	currentEpoch        uint64
+	signedMessages      map[string][]byte
+	signedEpochs        map[string]uint64
 }
 
 func NewMockRelayClient(getter MockRelayValidatorGetter) *MockRelayClient {
 	return &MockRelayClient{
 		validatorDataGetter: getter,
+		signedMessages:      map[string][]byte{},
+		signedEpochs:        map[string]uint64{},
 	}
 }
 
+// SetSigners sets the validators signing the messages of the mock relay, so that its aggregation proofs are ed25519
+// aggregation proofs of the message hashes.
+func (m *MockRelayClient) SetSigners(getter MockRelaySignerGetter) {
+	m.signerGetter = getter
+}
+
 func (m *MockRelayClient) GetCurrentEpoch(ctx context.Context, in *v1.GetCurrentEpochRequest, opts ...grpc.CallOption) (*v1.GetCurrentEpochResponse, error) {
 	return &v1.GetCurrentEpochResponse{
 		Epoch: m.currentEpoch,
@@ -60,70 +76,312 @@ func (m *MockRelayClient) SignMessage(ctx context.Context, in *v1.SignMessageReq
 	if err != nil {
 		return nil, err
 	}
+	requestID := hexutil.Encode(hasher.Sum(nil))
+	m.signedMessages[requestID] = hasher.Sum(nil)
+	m.signedEpochs[requestID] = m.currentEpoch
+	if in.RequiredEpoch != nil {
+		m.signedEpochs[requestID] = *in.RequiredEpoch
+	}
 	return &v1.SignMessageResponse{
-		RequestId: hexutil.Encode(hasher.Sum(nil)),
+		RequestId: requestID,
 		Epoch:     m.currentEpoch,
 	}, nil
 }
 
-func ValidatorFromFileGetter(filePath string) MockRelayValidatorGetter {
-	return func(epoch uint64) []*v1.Validator {
-		data, _, err := readKeysFromFile(filePath)
-		if err != nil {
-			panic(err)
-		}
+// GetAggregationProof returns a mock proof for any message previously passed to SignMessage. The proof is the ed25519
+// aggregation proof of the message hash by the signers of the request epoch if set with SetSigners, otherwise the
+// sha256 hash of the message hash, which carries no cryptographic meaning.
+func (m *MockRelayClient) GetAggregationProof(ctx context.Context, in *v1.GetAggregationProofRequest, opts ...grpc.CallOption) (*v1.GetAggregationProofResponse, error) {
+	messageHash, ok := m.signedMessages[in.RequestId]
+	if !ok {
+		return nil, fmt.Errorf("unknown request id %s", in.RequestId)
+	}
 
-		var targetEpoch uint64 = 0
-		for e := range data {
-			if e <= epoch && e > targetEpoch {
-				targetEpoch = e
+	var proof []byte
+	if m.signerGetter != nil {
+		var signatures []Ed25519Signature
+		for _, privKey := range m.signerGetter(m.signedEpochs[in.RequestId]) {
+			sig, err := privKey.Sign(messageHash)
+			if err != nil {
+				return nil, err
 			}
+			signatures = append(signatures, Ed25519Signature{PubKey: privKey.PubKey().Bytes(), Signature: sig})
 		}
+		proof = EncodeEd25519AggregationProof(signatures)
+	} else {
+		hash := sha256.Sum256(messageHash)
+		proof = hash[:]
+	}
+	return &v1.GetAggregationProofResponse{
+		AggregationProof: &v1.AggregationProof{
+			MessageHash: messageHash,
+			Proof:       proof,
+			RequestId:   in.RequestId,
+		},
+	}, nil
+}
 
-		keys := data[targetEpoch]
-		vals := make([]*v1.Validator, len(keys))
-		for i := range keys {
-			vals[i] = &v1.Validator{
-				Operator:    fmt.Sprintf("0xValidator%v", i),
-				VotingPower: "10000",
-				IsActive:    true,
-				Keys: []*v1.Key{
-					{Tag: 43, Payload: keys[i].PubKey().Bytes()},
-				},
-			}
+// GetAggregationStatus returns the status of any message previously passed to SignMessage as signed by all
+// validators of the current epoch.
+func (m *MockRelayClient) GetAggregationStatus(ctx context.Context, in *v1.GetAggregationStatusRequest, opts ...grpc.CallOption) (*v1.GetAggregationStatusResponse, error) {
+	if _, ok := m.signedMessages[in.RequestId]; !ok {
+		return nil, fmt.Errorf("unknown request id %s", in.RequestId)
+	}
+
+	votingPower := new(big.Int)
+	operators := []string{}
+	for _, val := range m.validatorDataGetter(m.currentEpoch) {
+		power, ok := new(big.Int).SetString(val.VotingPower, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid voting power %s of validator %s", val.VotingPower, val.Operator)
 		}
-		return vals
+		votingPower.Add(votingPower, power)
+		operators = append(operators, val.Operator)
+	}
+	return &v1.GetAggregationStatusResponse{
+		CurrentVotingPower: votingPower.String(),
+		SignerOperators:    operators,
+	}, nil
+}
+
+const (
+	// DefaultMockRelayPower is the voting power of a mock relay validator without power.
+	DefaultMockRelayPower = "10000"
+	// DefaultMockRelayKeyTag is the key tag of a mock relay validator without key tag.
+	DefaultMockRelayKeyTag = uint32(43)
+)
+
+// MockRelayValidator is a validator of a mock relay epoch schedule.
+type MockRelayValidator struct {
+	// PrivKey is the hex encoded ed25519 consensus private key of the validator.
+	PrivKey string `json:"priv_key,omitempty"`
+	// PubKey is the hex encoded ed25519 consensus public key of the validator, set instead of PrivKey when the
+	// private key is kept out of the schedule, e.g. for the local validator of an in-place testnet.
+	PubKey string `json:"pub_key,omitempty"`
+	// Power is the voting power of the validator, DefaultMockRelayPower if empty.
+	Power string `json:"power,omitempty"`
+	// Operator is the operator address of the validator, derived from its index in the epoch if empty.
+	Operator string `json:"operator,omitempty"`
+	// KeyTag is the key tag of the consensus key, DefaultMockRelayKeyTag if zero.
+	KeyTag uint32 `json:"key_tag,omitempty"`
+}
+
+// UnmarshalJSON also accepts a bare hex encoded private key, the format of the
+// schedules written before powers, operators and key tags were supported.
+func (v *MockRelayValidator) UnmarshalJSON(data []byte) error {
+	var privKey string
+	if err := json.Unmarshal(data, &privKey); err == nil {
+		*v = MockRelayValidator{PrivKey: privKey}
+		return nil
 	}
+
+	type validator MockRelayValidator
+	return json.Unmarshal(data, (*validator)(v))
 }
 
-// read file where each like is a hex encoded key, copnverrt to aray of byte arrays
-func readKeysFromFile(filePath string) (map[uint64][]p2p.NodeKey, uint64, error) {
+// MockRelaySchedule maps the first epoch of a validator set to the validators
+// of that set. The validator set of an epoch is the one of the largest epoch
+// of the schedule lower or equal to it.
+type MockRelaySchedule map[uint64][]MockRelayValidator
+
+// ReadMockRelaySchedule reads a mock relay schedule from a JSON file.
+func ReadMockRelaySchedule(filePath string) (MockRelaySchedule, error) {
 	data, err := os.ReadFile(filePath)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
-	typedData := map[uint64][]string{}
-	if err := json.Unmarshal(data, &typedData); err != nil {
-		return nil, 0, err
+	schedule := MockRelaySchedule{}
+	if err := json.Unmarshal(data, &schedule); err != nil {
+		return nil, fmt.Errorf("failed to decode mock relay schedule %s: %w", filePath, err)
 	}
-	largestEpoch := uint64(0)
-	keys := map[uint64][]p2p.NodeKey{}
-	for epoch, k := range typedData {
-		if epoch > largestEpoch {
-			largestEpoch = epoch
-		}
-		finalKeys := []p2p.NodeKey{}
-		for _, key := range k {
-			if key == "" {
-				continue
-			}
-			decoded, err := hex.DecodeString(key)
+	return schedule, nil
+}
+
+// WriteMockRelaySchedule writes a mock relay schedule to a JSON file.
+func WriteMockRelaySchedule(filePath string, schedule MockRelaySchedule) error {
+	data, err := json.MarshalIndent(schedule, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, data, 0o600)
+}
+
+// Validate checks that the schedule starts at epoch 0 and that every validator
+// has a valid private or public key, power and key tag.
+func (s MockRelaySchedule) Validate() error {
+	if _, ok := s[0]; !ok {
+		return fmt.Errorf("mock relay schedule has no validator set for epoch 0")
+	}
+
+	for epoch, validators := range s {
+		keys := map[string]bool{}
+		pubKeys := map[string]bool{}
+		operators := map[string]bool{}
+		for i, val := range validators {
+			pubKey, err := val.pubKey()
 			if err != nil {
-				return nil, 0, err
+				return fmt.Errorf("epoch %d validator %d: %w", epoch, i, err)
 			}
-			finalKeys = append(finalKeys, p2p.NodeKey{PrivKey: ed25519.PrivKey(decoded)})
+			if val.PrivKey != "" {
+				if keys[val.PrivKey] {
+					return fmt.Errorf("epoch %d validator %d: duplicate private key", epoch, i)
+				}
+				keys[val.PrivKey] = true
+			}
+			if pubKeys[string(pubKey)] {
+				return fmt.Errorf("epoch %d validator %d: duplicate public key", epoch, i)
+			}
+			pubKeys[string(pubKey)] = true
+
+			if val.Operator != "" {
+				if operators[val.Operator] {
+					return fmt.Errorf("epoch %d validator %d: duplicate operator %s", epoch, i, val.Operator)
+				}
+				operators[val.Operator] = true
+			}
+
+			if val.Power != "" {
+				power, err := strconv.ParseInt(val.Power, 10, 64)
+				if err != nil || power <= 0 {
+					return fmt.Errorf("epoch %d validator %d: power must be a positive integer, got %q", epoch, i, val.Power)
+				}
+			}
+
+			if val.KeyTag != 0 && val.KeyTag>>4 != 2 {
+				return fmt.Errorf("epoch %d validator %d: expected key tag to be of type 2 (indicating a ed25519 key), got %d", epoch, i, val.KeyTag>>4)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidatorsAt returns the relay validators of an epoch.
+func (s MockRelaySchedule) ValidatorsAt(epoch uint64) ([]*v1.Validator, error) {
+	validators := s[s.scheduledEpoch(epoch)]
+	vals := make([]*v1.Validator, 0, len(validators))
+	for i, val := range validators {
+		pubKey, err := val.pubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		operator := val.Operator
+		if operator == "" {
+			operator = fmt.Sprintf("0xValidator%v", i)
+		}
+		power := val.Power
+		if power == "" {
+			power = DefaultMockRelayPower
+		}
+		keyTag := val.KeyTag
+		if keyTag == 0 {
+			keyTag = DefaultMockRelayKeyTag
+		}
+
+		vals = append(vals, &v1.Validator{
+			Operator:    operator,
+			VotingPower: power,
+			IsActive:    true,
+			Keys: []*v1.Key{
+				{Tag: keyTag, Payload: pubKey},
+			},
+		})
+	}
+	return vals, nil
+}
+
+// PrivKeysAt returns the consensus private keys of the validators of an epoch, skipping the validators without one.
+func (s MockRelaySchedule) PrivKeysAt(epoch uint64) ([]ed25519.PrivKey, error) {
+	var privKeys []ed25519.PrivKey
+	for _, val := range s[s.scheduledEpoch(epoch)] {
+		if val.PrivKey == "" {
+			continue
+		}
+		privKey, err := val.privKey()
+		if err != nil {
+			return nil, err
+		}
+		privKeys = append(privKeys, privKey)
+	}
+	return privKeys, nil
+}
+
+// scheduledEpoch returns the first epoch of the validator set of an epoch.
+func (s MockRelaySchedule) scheduledEpoch(epoch uint64) uint64 {
+	var targetEpoch uint64 = 0
+	for e := range s {
+		if e <= epoch && e > targetEpoch {
+			targetEpoch = e
+		}
+	}
+	return targetEpoch
+}
+
+// pubKey returns the consensus public key of the validator, derived from its private key if it has one.
+func (v MockRelayValidator) pubKey() ([]byte, error) {
+	if v.PubKey == "" {
+		privKey, err := v.privKey()
+		if err != nil {
+			return nil, err
+		}
+		return privKey.PubKey().Bytes(), nil
+	}
+	if v.PrivKey != "" {
+		return nil, fmt.Errorf("only one of the private and public keys can be set")
+	}
+
+	decoded, err := hex.DecodeString(v.PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	if len(decoded) != ed25519.PubKeySize {
+		return nil, fmt.Errorf("invalid public key length: expected %d bytes, got %d", ed25519.PubKeySize, len(decoded))
+	}
+	return decoded, nil
+}
+
+func (v MockRelayValidator) privKey() (ed25519.PrivKey, error) {
+	decoded, err := hex.DecodeString(v.PrivKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	if len(decoded) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid private key length: expected %d bytes, got %d", ed25519.PrivateKeySize, len(decoded))
+	}
+	return ed25519.PrivKey(decoded), nil
+}
+
+// ValidatorFromFileGetter returns the validators of the mock relay schedule in
+// the file. The file is read on every call, so the schedule can be edited while
+// the chain is running.
+func ValidatorFromFileGetter(filePath string) MockRelayValidatorGetter {
+	return func(epoch uint64) []*v1.Validator {
+		schedule, err := ReadMockRelaySchedule(filePath)
+		if err != nil {
+			panic(err)
+		}
+
+		vals, err := schedule.ValidatorsAt(epoch)
+		if err != nil {
+			panic(err)
+		}
+		return vals
+	}
+}
+
+// SignersFromFileGetter returns the private keys of the validators of the mock relay schedule in the file. The file is
+// read on every call, so the schedule can be edited while the chain is running.
+func SignersFromFileGetter(filePath string) MockRelaySignerGetter {
+	return func(epoch uint64) []ed25519.PrivKey {
+		schedule, err := ReadMockRelaySchedule(filePath)
+		if err != nil {
+			panic(err)
+		}
+
+		privKeys, err := schedule.PrivKeysAt(epoch)
+		if err != nil {
+			panic(err)
 		}
-		keys[epoch] = finalKeys
+		return privKeys
 	}
-	return keys, largestEpoch, nil
 }
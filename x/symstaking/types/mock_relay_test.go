@@ -0,0 +1,130 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func TestMockRelaySchedule(t *testing.T) {
+	key1 := ed25519.GenPrivKey()
+	key2 := ed25519.GenPrivKey()
+	hex1, hex2 := hex.EncodeToString(key1.Bytes()), hex.EncodeToString(key2.Bytes())
+
+	// bare private keys and validator objects can be mixed
+	var schedule types.MockRelaySchedule
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"0": ["`+hex1+`"],
+		"10": ["`+hex1+`", {"priv_key": "`+hex2+`", "power": "20000", "operator": "0xabc", "key_tag": 43}]
+	}`), &schedule))
+	require.NoError(t, schedule.Validate())
+
+	vals, err := schedule.ValidatorsAt(5)
+	require.NoError(t, err)
+	require.Len(t, vals, 1)
+	require.Equal(t, "0xValidator0", vals[0].Operator)
+	require.Equal(t, types.DefaultMockRelayPower, vals[0].VotingPower)
+	require.Equal(t, types.DefaultMockRelayKeyTag, vals[0].Keys[0].Tag)
+	require.Equal(t, key1.PubKey().Bytes(), vals[0].Keys[0].Payload)
+
+	vals, err = schedule.ValidatorsAt(12)
+	require.NoError(t, err)
+	require.Len(t, vals, 2)
+	require.Equal(t, "0xabc", vals[1].Operator)
+	require.Equal(t, "20000", vals[1].VotingPower)
+	require.Equal(t, key2.PubKey().Bytes(), vals[1].Keys[0].Payload)
+}
+
+func TestWriteMockRelaySchedule(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey().Bytes()
+	schedule := types.MockRelaySchedule{0: {{PubKey: hex.EncodeToString(pubKey), Power: "30000", Operator: "0xlocal"}}}
+
+	path := filepath.Join(t.TempDir(), "valkeys.json")
+	require.NoError(t, types.WriteMockRelaySchedule(path, schedule))
+	read, err := types.ReadMockRelaySchedule(path)
+	require.NoError(t, err)
+	require.Equal(t, schedule, read)
+	require.NoError(t, read.Validate())
+
+	// validators without a private key are served from their public key
+	vals, err := read.ValidatorsAt(7)
+	require.NoError(t, err)
+	require.Len(t, vals, 1)
+	require.Equal(t, "0xlocal", vals[0].Operator)
+	require.Equal(t, "30000", vals[0].VotingPower)
+	require.Equal(t, pubKey, vals[0].Keys[0].Payload)
+}
+
+func TestMockRelaySchedule_Validate(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	key := hex.EncodeToString(privKey.Bytes())
+	pubKey := hex.EncodeToString(privKey.PubKey().Bytes())
+
+	tests := []struct {
+		name     string
+		schedule types.MockRelaySchedule
+		expErr   string
+	}{
+		{
+			name:     "valid",
+			schedule: types.MockRelaySchedule{0: {{PrivKey: key, Power: "1"}}},
+		},
+		{
+			name:     "missing epoch 0",
+			schedule: types.MockRelaySchedule{5: {{PrivKey: key}}},
+			expErr:   "no validator set for epoch 0",
+		},
+		{
+			name:     "invalid private key",
+			schedule: types.MockRelaySchedule{0: {{PrivKey: "abcd"}}},
+			expErr:   "invalid private key length",
+		},
+		{
+			name:     "duplicate private key",
+			schedule: types.MockRelaySchedule{0: {{PrivKey: key}, {PrivKey: key}}},
+			expErr:   "duplicate private key",
+		},
+		{
+			name:     "invalid public key",
+			schedule: types.MockRelaySchedule{0: {{PubKey: "abcd"}}},
+			expErr:   "invalid public key length",
+		},
+		{
+			name:     "private and public keys",
+			schedule: types.MockRelaySchedule{0: {{PrivKey: key, PubKey: pubKey}}},
+			expErr:   "only one of the private and public keys",
+		},
+		{
+			name:     "duplicate public key",
+			schedule: types.MockRelaySchedule{0: {{PrivKey: key}, {PubKey: pubKey}}},
+			expErr:   "duplicate public key",
+		},
+		{
+			name:     "invalid power",
+			schedule: types.MockRelaySchedule{0: {{PrivKey: key, Power: "-1"}}},
+			expErr:   "power must be a positive integer",
+		},
+		{
+			name:     "invalid key tag",
+			schedule: types.MockRelaySchedule{0: {{PrivKey: key, KeyTag: 15}}},
+			expErr:   "expected key tag to be of type 2",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 )
 
 // NewParams creates a new Params instance.
@@ -9,7 +10,20 @@ func NewParams() Params {
 	return Params{
 		ValidatorKeyTag:    43, // type 2 (Ed25519) with id 11 (suggested for validator keys)
 		EpochCheckInterval: 10, // every 10 cosmos blocks
-		SigningKeyTag:      15, // Default symbiotic signing key
+		SigningKeyTag:      43, // the validator key tag, whose aggregation proofs are verified out of the box
+		// poll every EpochCheckInterval blocks, PollEpochIdentifier is only used by the time schedule
+		PollSchedule:        PollSchedule_POLL_SCHEDULE_HEIGHT,
+		PollEpochIdentifier: "minute",
+		// lowest committed epoch across all settlement chains
+		EpochSelectionPolicy: EpochSelectionPolicy_EPOCH_SELECTION_POLICY_MINIMUM,
+		// never empty the set and keep a third of the voting power across updates, without limiting the power change
+		MaxPowerChange:         math.LegacyZeroDec(),
+		MinOverlap:             math.LegacyOneDec().QuoInt64(3),
+		MinValidators:          1,
+		ValidatorSetChangeMode: ValidatorSetChangeMode_VALIDATOR_SET_CHANGE_MODE_GRADUAL,
+		// give the relay 1000 cosmos blocks to aggregate a signature
+		SignatureRequestTimeout: 1000,
+		CheckpointRetention:     100,
 	}
 }
 
@@ -23,5 +37,87 @@ func (p Params) Validate() error {
 	if p.ValidatorKeyTag>>4 != 2 {
 		return errorsmod.Wrapf(ErrInvalidKeyTag, "expected key tag to be of type 2 (indicating a ed25519 key), got %d", p.ValidatorKeyTag>>4)
 	}
+	if _, ok := GovernanceTallyMode_name[int32(p.GovernanceTallyMode)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidTallyMode, "unknown governance tally mode %s", p.GovernanceTallyMode)
+	}
+	if p.CheckpointInterval < 0 {
+		return errorsmod.Wrapf(ErrInvalidCheckpointInterval, "checkpoint interval cannot be negative: %d", p.CheckpointInterval)
+	}
+	if p.SignatureRequestTimeout < 0 {
+		return errorsmod.Wrapf(ErrInvalidSignatureRequestTimeout, "signature request timeout cannot be negative: %d", p.SignatureRequestTimeout)
+	}
+	if err := p.validatePollSchedule(); err != nil {
+		return err
+	}
+	if err := p.validateValidatorSetGuard(); err != nil {
+		return err
+	}
+	return p.validateEpochSelection()
+}
+
+func (p Params) validateValidatorSetGuard() error {
+	if p.MaxPowerChange.IsNil() || p.MaxPowerChange.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidValidatorSetGuard, "max power change must be non-negative: %s", p.MaxPowerChange)
+	}
+	if p.MinOverlap.IsNil() || p.MinOverlap.IsNegative() || p.MinOverlap.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidValidatorSetGuard, "min overlap must be between 0 and 1: %s", p.MinOverlap)
+	}
+	if p.MinValidators == 0 {
+		return errorsmod.Wrap(ErrInvalidValidatorSetGuard, "min validators must be positive")
+	}
+	if _, ok := ValidatorSetChangeMode_name[int32(p.ValidatorSetChangeMode)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidValidatorSetGuard, "unknown validator set change mode %s", p.ValidatorSetChangeMode)
+	}
 	return nil
 }
+
+func (p Params) validatePollSchedule() error {
+	if p.PollGraceBlocks < 0 {
+		return errorsmod.Wrapf(ErrInvalidPollSchedule, "poll grace blocks cannot be negative: %d", p.PollGraceBlocks)
+	}
+
+	switch p.PollSchedule {
+	case PollSchedule_POLL_SCHEDULE_HEIGHT:
+		if p.EpochCheckInterval <= 0 {
+			return errorsmod.Wrapf(ErrInvalidPollSchedule, "height poll schedule requires a positive epoch check interval, got %d", p.EpochCheckInterval)
+		}
+		return nil
+	case PollSchedule_POLL_SCHEDULE_TIME:
+		if p.PollEpochIdentifier == "" {
+			return errorsmod.Wrap(ErrInvalidPollSchedule, "time poll schedule requires a poll epoch identifier")
+		}
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrInvalidPollSchedule, "unknown poll schedule %s", p.PollSchedule)
+	}
+}
+
+func (p Params) validateEpochSelection() error {
+	seen := make(map[uint64]struct{}, len(p.SettlementChainIds))
+	for _, id := range p.SettlementChainIds {
+		if _, ok := seen[id]; ok {
+			return errorsmod.Wrapf(ErrInvalidEpochSelection, "duplicate settlement chain id %d", id)
+		}
+		seen[id] = struct{}{}
+	}
+
+	switch p.EpochSelectionPolicy {
+	case EpochSelectionPolicy_EPOCH_SELECTION_POLICY_MINIMUM:
+		return nil
+	case EpochSelectionPolicy_EPOCH_SELECTION_POLICY_ALLOW_LIST:
+		if len(p.SettlementChainIds) == 0 {
+			return errorsmod.Wrap(ErrInvalidEpochSelection, "allow list policy requires at least one settlement chain id")
+		}
+		return nil
+	case EpochSelectionPolicy_EPOCH_SELECTION_POLICY_QUORUM:
+		if p.SettlementQuorum == 0 {
+			return errorsmod.Wrap(ErrInvalidEpochSelection, "quorum policy requires a settlement quorum greater than zero")
+		}
+		if len(p.SettlementChainIds) > 0 && int(p.SettlementQuorum) > len(p.SettlementChainIds) {
+			return errorsmod.Wrapf(ErrInvalidEpochSelection, "settlement quorum %d exceeds the number of settlement chains %d", p.SettlementQuorum, len(p.SettlementChainIds))
+		}
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrInvalidEpochSelection, "unknown epoch selection policy %s", p.EpochSelectionPolicy)
+	}
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -24,6 +26,126 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ValidatorSetChangeMode defines how a validator set update exceeding the maximum power change is handled.
+type ValidatorSetChangeMode int32
+
+const (
+	// VALIDATOR_SET_CHANGE_MODE_GRADUAL applies the update over several blocks, each changing at most max_power_change
+	// of the voting power.
+	ValidatorSetChangeMode_VALIDATOR_SET_CHANGE_MODE_GRADUAL ValidatorSetChangeMode = 0
+	// VALIDATOR_SET_CHANGE_MODE_REJECT rejects the update and keeps the current validator set until the next relay
+	// epoch.
+	ValidatorSetChangeMode_VALIDATOR_SET_CHANGE_MODE_REJECT ValidatorSetChangeMode = 1
+)
+
+var ValidatorSetChangeMode_name = map[int32]string{
+	0: "VALIDATOR_SET_CHANGE_MODE_GRADUAL",
+	1: "VALIDATOR_SET_CHANGE_MODE_REJECT",
+}
+
+var ValidatorSetChangeMode_value = map[string]int32{
+	"VALIDATOR_SET_CHANGE_MODE_GRADUAL": 0,
+	"VALIDATOR_SET_CHANGE_MODE_REJECT":  1,
+}
+
+func (x ValidatorSetChangeMode) String() string {
+	return proto.EnumName(ValidatorSetChangeMode_name, int32(x))
+}
+
+func (ValidatorSetChangeMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ed784eb28eb04a7e, []int{0}
+}
+
+// PollSchedule defines when the relay is polled for a new epoch.
+type PollSchedule int32
+
+const (
+	// POLL_SCHEDULE_HEIGHT polls the relay every epoch_check_interval blocks.
+	PollSchedule_POLL_SCHEDULE_HEIGHT PollSchedule = 0
+	// POLL_SCHEDULE_TIME polls the relay at the start of every poll_epoch_identifier x/epochs epoch.
+	PollSchedule_POLL_SCHEDULE_TIME PollSchedule = 1
+)
+
+var PollSchedule_name = map[int32]string{
+	0: "POLL_SCHEDULE_HEIGHT",
+	1: "POLL_SCHEDULE_TIME",
+}
+
+var PollSchedule_value = map[string]int32{
+	"POLL_SCHEDULE_HEIGHT": 0,
+	"POLL_SCHEDULE_TIME":   1,
+}
+
+func (x PollSchedule) String() string {
+	return proto.EnumName(PollSchedule_name, int32(x))
+}
+
+func (PollSchedule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ed784eb28eb04a7e, []int{1}
+}
+
+// GovernanceTallyMode defines the source of the voting power used to tally governance proposals.
+type GovernanceTallyMode int32
+
+const (
+	// GOVERNANCE_TALLY_MODE_STAKING tallies proposals with the x/staking delegations.
+	GovernanceTallyMode_GOVERNANCE_TALLY_MODE_STAKING GovernanceTallyMode = 0
+	// GOVERNANCE_TALLY_MODE_SYMSTAKING tallies proposals with the power of the current relay validator set. Only the
+	// votes of accounts registered as voter for a validator are counted.
+	GovernanceTallyMode_GOVERNANCE_TALLY_MODE_SYMSTAKING GovernanceTallyMode = 1
+)
+
+var GovernanceTallyMode_name = map[int32]string{
+	0: "GOVERNANCE_TALLY_MODE_STAKING",
+	1: "GOVERNANCE_TALLY_MODE_SYMSTAKING",
+}
+
+var GovernanceTallyMode_value = map[string]int32{
+	"GOVERNANCE_TALLY_MODE_STAKING":    0,
+	"GOVERNANCE_TALLY_MODE_SYMSTAKING": 1,
+}
+
+func (x GovernanceTallyMode) String() string {
+	return proto.EnumName(GovernanceTallyMode_name, int32(x))
+}
+
+func (GovernanceTallyMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ed784eb28eb04a7e, []int{2}
+}
+
+// EpochSelectionPolicy defines how the epoch is selected across multiple settlement chains.
+type EpochSelectionPolicy int32
+
+const (
+	// EPOCH_SELECTION_POLICY_MINIMUM selects the lowest last committed epoch across all settlement chains.
+	EpochSelectionPolicy_EPOCH_SELECTION_POLICY_MINIMUM EpochSelectionPolicy = 0
+	// EPOCH_SELECTION_POLICY_ALLOW_LIST selects the lowest last committed epoch across the allow-listed settlement
+	// chains only.
+	EpochSelectionPolicy_EPOCH_SELECTION_POLICY_ALLOW_LIST EpochSelectionPolicy = 1
+	// EPOCH_SELECTION_POLICY_QUORUM selects the highest epoch committed by at least settlement_quorum settlement chains.
+	EpochSelectionPolicy_EPOCH_SELECTION_POLICY_QUORUM EpochSelectionPolicy = 2
+)
+
+var EpochSelectionPolicy_name = map[int32]string{
+	0: "EPOCH_SELECTION_POLICY_MINIMUM",
+	1: "EPOCH_SELECTION_POLICY_ALLOW_LIST",
+	2: "EPOCH_SELECTION_POLICY_QUORUM",
+}
+
+var EpochSelectionPolicy_value = map[string]int32{
+	"EPOCH_SELECTION_POLICY_MINIMUM":    0,
+	"EPOCH_SELECTION_POLICY_ALLOW_LIST": 1,
+	"EPOCH_SELECTION_POLICY_QUORUM":     2,
+}
+
+func (x EpochSelectionPolicy) String() string {
+	return proto.EnumName(EpochSelectionPolicy_name, int32(x))
+}
+
+func (EpochSelectionPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ed784eb28eb04a7e, []int{3}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// validator_key_tag defines the key tag that will be used to derive the validator consensus pubkey from relays key
@@ -31,8 +153,49 @@ type Params struct {
 	ValidatorKeyTag uint32 `protobuf:"varint,1,opt,name=validator_key_tag,json=validatorKeyTag,proto3" json:"validator_key_tag,omitempty"`
 	// epoch_check_interval defines the cosmos block interval to check for epoch transition
 	EpochCheckInterval int64 `protobuf:"varint,2,opt,name=epoch_check_interval,json=epochCheckInterval,proto3" json:"epoch_check_interval,omitempty"`
-	// signing_key_tag defines the key tag that will be used to sign messages on relay like the slash message
+	// signing_key_tag defines the key tag that will be used to sign messages on relay like the slash message. It must
+	// be the validator key tag unless the app sets an aggregation proof verifier for it, as no proof of the messages
+	// signed with it could be verified otherwise.
 	SigningKeyTag uint32 `protobuf:"varint,3,opt,name=signing_key_tag,json=signingKeyTag,proto3" json:"signing_key_tag,omitempty"`
+	// epoch_selection_policy defines how the epoch to advance to is selected from the last committed epochs of the
+	// settlement chains
+	EpochSelectionPolicy EpochSelectionPolicy `protobuf:"varint,4,opt,name=epoch_selection_policy,json=epochSelectionPolicy,proto3,enum=cosmos.symstaking.v1.EpochSelectionPolicy" json:"epoch_selection_policy,omitempty"`
+	// settlement_chain_ids defines the settlement chains taken into account by the allow list and quorum policies.
+	// For the quorum policy an empty list means all settlement chains known to the relay.
+	SettlementChainIds []uint64 `protobuf:"varint,5,rep,packed,name=settlement_chain_ids,json=settlementChainIds,proto3" json:"settlement_chain_ids,omitempty"`
+	// settlement_quorum defines the number of settlement chains that must have committed an epoch before it is selected
+	// by the quorum policy
+	SettlementQuorum uint32 `protobuf:"varint,6,opt,name=settlement_quorum,json=settlementQuorum,proto3" json:"settlement_quorum,omitempty"`
+	// checkpoint_interval defines the cosmos block interval at which the app hash is checkpointed to the settlement
+	// chains through a relay signature. Zero disables checkpointing.
+	CheckpointInterval int64 `protobuf:"varint,7,opt,name=checkpoint_interval,json=checkpointInterval,proto3" json:"checkpoint_interval,omitempty"`
+	// governance_tally_mode defines the voting power x/gov tallies proposals with when the symstaking tally function is
+	// installed in x/gov
+	GovernanceTallyMode GovernanceTallyMode `protobuf:"varint,8,opt,name=governance_tally_mode,json=governanceTallyMode,proto3,enum=cosmos.symstaking.v1.GovernanceTallyMode" json:"governance_tally_mode,omitempty"`
+	// poll_schedule defines when the relay is polled for a new epoch
+	PollSchedule PollSchedule `protobuf:"varint,9,opt,name=poll_schedule,json=pollSchedule,proto3,enum=cosmos.symstaking.v1.PollSchedule" json:"poll_schedule,omitempty"`
+	// poll_epoch_identifier defines the x/epochs epoch at the start of which a new relay epoch is expected in the time
+	// poll schedule, eg. "minute"
+	PollEpochIdentifier string `protobuf:"bytes,10,opt,name=poll_epoch_identifier,json=pollEpochIdentifier,proto3" json:"poll_epoch_identifier,omitempty"`
+	// poll_grace_blocks defines the number of blocks the relay is polled on every block after a new relay epoch was
+	// expected but not observed
+	PollGraceBlocks int64 `protobuf:"varint,11,opt,name=poll_grace_blocks,json=pollGraceBlocks,proto3" json:"poll_grace_blocks,omitempty"`
+	// max_power_change defines the maximum fraction of the current total voting power that may change in a single
+	// validator set update, summing the absolute power change of every validator. Zero disables the limit.
+	MaxPowerChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=max_power_change,json=maxPowerChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_power_change"`
+	// min_overlap defines the minimum fraction of the current total voting power that must be held by validators
+	// remaining in a new relay validator set. Zero disables the check.
+	MinOverlap cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=min_overlap,json=minOverlap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_overlap"`
+	// min_validators defines the minimum number of validators of a new relay validator set. Smaller sets are rejected.
+	MinValidators uint32 `protobuf:"varint,14,opt,name=min_validators,json=minValidators,proto3" json:"min_validators,omitempty"`
+	// validator_set_change_mode defines how a relay validator set exceeding max_power_change is handled
+	ValidatorSetChangeMode ValidatorSetChangeMode `protobuf:"varint,15,opt,name=validator_set_change_mode,json=validatorSetChangeMode,proto3,enum=cosmos.symstaking.v1.ValidatorSetChangeMode" json:"validator_set_change_mode,omitempty"`
+	// signature_request_timeout defines the number of blocks after which a relay signature request without an
+	// aggregation proof expires. Zero disables the expiry.
+	SignatureRequestTimeout int64 `protobuf:"varint,16,opt,name=signature_request_timeout,json=signatureRequestTimeout,proto3" json:"signature_request_timeout,omitempty"`
+	// checkpoint_retention defines the number of the latest checkpoints kept in the state, along with their signature
+	// requests. Zero keeps every checkpoint.
+	CheckpointRetention uint32 `protobuf:"varint,17,opt,name=checkpoint_retention,json=checkpointRetention,proto3" json:"checkpoint_retention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -89,32 +252,161 @@ func (m *Params) GetSigningKeyTag() uint32 {
 	return 0
 }
 
+func (m *Params) GetEpochSelectionPolicy() EpochSelectionPolicy {
+	if m != nil {
+		return m.EpochSelectionPolicy
+	}
+	return EpochSelectionPolicy_EPOCH_SELECTION_POLICY_MINIMUM
+}
+
+func (m *Params) GetSettlementChainIds() []uint64 {
+	if m != nil {
+		return m.SettlementChainIds
+	}
+	return nil
+}
+
+func (m *Params) GetSettlementQuorum() uint32 {
+	if m != nil {
+		return m.SettlementQuorum
+	}
+	return 0
+}
+
+func (m *Params) GetCheckpointInterval() int64 {
+	if m != nil {
+		return m.CheckpointInterval
+	}
+	return 0
+}
+
+func (m *Params) GetGovernanceTallyMode() GovernanceTallyMode {
+	if m != nil {
+		return m.GovernanceTallyMode
+	}
+	return GovernanceTallyMode_GOVERNANCE_TALLY_MODE_STAKING
+}
+
+func (m *Params) GetPollSchedule() PollSchedule {
+	if m != nil {
+		return m.PollSchedule
+	}
+	return PollSchedule_POLL_SCHEDULE_HEIGHT
+}
+
+func (m *Params) GetPollEpochIdentifier() string {
+	if m != nil {
+		return m.PollEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetPollGraceBlocks() int64 {
+	if m != nil {
+		return m.PollGraceBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMinValidators() uint32 {
+	if m != nil {
+		return m.MinValidators
+	}
+	return 0
+}
+
+func (m *Params) GetValidatorSetChangeMode() ValidatorSetChangeMode {
+	if m != nil {
+		return m.ValidatorSetChangeMode
+	}
+	return ValidatorSetChangeMode_VALIDATOR_SET_CHANGE_MODE_GRADUAL
+}
+
+func (m *Params) GetSignatureRequestTimeout() int64 {
+	if m != nil {
+		return m.SignatureRequestTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetCheckpointRetention() uint32 {
+	if m != nil {
+		return m.CheckpointRetention
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.symstaking.v1.ValidatorSetChangeMode", ValidatorSetChangeMode_name, ValidatorSetChangeMode_value)
+	proto.RegisterEnum("cosmos.symstaking.v1.PollSchedule", PollSchedule_name, PollSchedule_value)
+	proto.RegisterEnum("cosmos.symstaking.v1.GovernanceTallyMode", GovernanceTallyMode_name, GovernanceTallyMode_value)
+	proto.RegisterEnum("cosmos.symstaking.v1.EpochSelectionPolicy", EpochSelectionPolicy_name, EpochSelectionPolicy_value)
 	proto.RegisterType((*Params)(nil), "cosmos.symstaking.v1.Params")
 }
 
 func init() { proto.RegisterFile("cosmos/symstaking/v1/params.proto", fileDescriptor_ed784eb28eb04a7e) }
 
 var fileDescriptor_ed784eb28eb04a7e = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xae, 0xcc, 0x2d, 0x2e, 0x49, 0xcc, 0xce, 0xcc, 0x4b, 0xd7, 0x2f, 0x33,
-	0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81,
-	0x28, 0xd1, 0x43, 0x28, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07,
-	0x93, 0x10, 0x85, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55,
-	0x3a, 0xc9, 0xc8, 0xc5, 0x16, 0x00, 0x36, 0x4f, 0x48, 0x8b, 0x4b, 0xb0, 0x2c, 0x31, 0x27, 0x33,
-	0x25, 0xb1, 0x24, 0xbf, 0x28, 0x3e, 0x3b, 0xb5, 0x32, 0xbe, 0x24, 0x31, 0x5d, 0x82, 0x51, 0x81,
-	0x51, 0x83, 0x37, 0x88, 0x1f, 0x2e, 0xe1, 0x9d, 0x5a, 0x19, 0x92, 0x98, 0x2e, 0x64, 0xc0, 0x25,
-	0x92, 0x5a, 0x90, 0x9f, 0x9c, 0x11, 0x9f, 0x9c, 0x91, 0x9a, 0x9c, 0x1d, 0x9f, 0x99, 0x57, 0x92,
-	0x5a, 0x54, 0x96, 0x98, 0x23, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x1c, 0x24, 0x04, 0x96, 0x73, 0x06,
-	0x49, 0x79, 0x42, 0x65, 0x84, 0xd4, 0xb8, 0xf8, 0x8b, 0x33, 0xd3, 0xf3, 0x32, 0xf3, 0xd2, 0xe1,
-	0x66, 0x33, 0x83, 0xcd, 0xe6, 0x85, 0x0a, 0x43, 0x4c, 0xb6, 0xb2, 0x7c, 0xb1, 0x40, 0x9e, 0xb1,
-	0xeb, 0xf9, 0x06, 0x2d, 0x83, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d,
-	0x68, 0x30, 0x40, 0x28, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x0a, 0xe4, 0x30, 0x81, 0x78, 0xc0, 0xc9,
-	0xf3, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xf4, 0x89, 0x37, 0xab, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x3a, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa1,
-	0x9b, 0x6b, 0x86, 0x81, 0x01, 0x00, 0x00,
+	// 932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x1c, 0xf5, 0x36, 0x25, 0xb4, 0xd3, 0xfc, 0xd9, 0x4c, 0xdc, 0xb0, 0x29, 0xc2, 0x71, 0x22, 0x8a,
+	0x8c, 0xa1, 0x76, 0x53, 0x24, 0x24, 0x7a, 0xc2, 0x59, 0xaf, 0xec, 0xa5, 0x6b, 0xaf, 0xbb, 0x5e,
+	0xa7, 0x0a, 0x12, 0x4c, 0x27, 0xeb, 0x61, 0x3d, 0xf2, 0xee, 0xce, 0x76, 0x77, 0x6c, 0xe2, 0x3b,
+	0x27, 0x4e, 0x7c, 0x04, 0x8e, 0x1c, 0x7b, 0xe0, 0x43, 0xf4, 0x58, 0x71, 0x42, 0x1c, 0x2a, 0x94,
+	0x1c, 0xc2, 0x97, 0x40, 0x42, 0x3b, 0xe3, 0xd8, 0x46, 0x38, 0x12, 0x52, 0x2f, 0xb6, 0xf7, 0xbd,
+	0xf7, 0x7b, 0xfb, 0xfb, 0x37, 0x63, 0xb0, 0xef, 0xb1, 0x34, 0x64, 0x69, 0x35, 0x9d, 0x84, 0x29,
+	0xc7, 0x43, 0x1a, 0xf9, 0xd5, 0xf1, 0x61, 0x35, 0xc6, 0x09, 0x0e, 0xd3, 0x4a, 0x9c, 0x30, 0xce,
+	0x60, 0x5e, 0x4a, 0x2a, 0x73, 0x49, 0x65, 0x7c, 0x78, 0x6f, 0x0b, 0x87, 0x34, 0x62, 0x55, 0xf1,
+	0x29, 0x85, 0xf7, 0x76, 0xa5, 0x10, 0x89, 0xa7, 0xea, 0x34, 0x4a, 0x52, 0x79, 0x9f, 0xf9, 0x4c,
+	0xe2, 0xd9, 0x2f, 0x89, 0x1e, 0xfc, 0x7d, 0x0b, 0xac, 0x76, 0xc4, 0xab, 0x60, 0x19, 0x6c, 0x8d,
+	0x71, 0x40, 0xfb, 0x98, 0xb3, 0x04, 0x0d, 0xc9, 0x04, 0x71, 0xec, 0x6b, 0x4a, 0x51, 0x29, 0xad,
+	0x3b, 0x9b, 0x33, 0xe2, 0x09, 0x99, 0xb8, 0xd8, 0x87, 0x0f, 0x41, 0x9e, 0xc4, 0xcc, 0x1b, 0x20,
+	0x6f, 0x40, 0xbc, 0x21, 0xa2, 0x11, 0x27, 0xc9, 0x18, 0x07, 0xda, 0x8d, 0xa2, 0x52, 0x5a, 0x71,
+	0xa0, 0xe0, 0xf4, 0x8c, 0x32, 0xa7, 0x0c, 0xfc, 0x08, 0x6c, 0xa6, 0xd4, 0x8f, 0x68, 0xe4, 0xcf,
+	0xbc, 0x57, 0x84, 0xf7, 0xfa, 0x14, 0x9e, 0x3a, 0x3f, 0x07, 0x3b, 0xd2, 0x39, 0x25, 0x01, 0xf1,
+	0x38, 0x65, 0x11, 0x8a, 0x59, 0x40, 0xbd, 0x89, 0x76, 0xb3, 0xa8, 0x94, 0x36, 0x1e, 0x95, 0x2b,
+	0xcb, 0x7a, 0x51, 0x31, 0xb2, 0x98, 0xee, 0x55, 0x48, 0x47, 0x44, 0x38, 0x79, 0xb2, 0x04, 0xcd,
+	0x72, 0x4f, 0x09, 0xe7, 0x01, 0x09, 0x49, 0xc4, 0x91, 0x37, 0xc0, 0x34, 0x42, 0xb4, 0x9f, 0x6a,
+	0xef, 0x14, 0x57, 0x4a, 0x37, 0x1d, 0x38, 0xe7, 0xf4, 0x8c, 0x32, 0xfb, 0x29, 0xfc, 0x04, 0x6c,
+	0x2d, 0x44, 0xbc, 0x18, 0xb1, 0x64, 0x14, 0x6a, 0xab, 0x22, 0x7b, 0x75, 0x4e, 0x3c, 0x15, 0x38,
+	0xac, 0x82, 0x6d, 0xd1, 0x94, 0x98, 0xd1, 0x88, 0xcf, 0x3b, 0xf3, 0xae, 0xec, 0xcc, 0x9c, 0x9a,
+	0x75, 0xe6, 0x1b, 0x70, 0xd7, 0x67, 0x63, 0x92, 0x44, 0x38, 0xf2, 0x08, 0xe2, 0x38, 0x08, 0x26,
+	0x28, 0x64, 0x7d, 0xa2, 0xdd, 0x12, 0x05, 0x7f, 0xbc, 0xbc, 0xe0, 0xc6, 0x2c, 0xc4, 0xcd, 0x22,
+	0x5a, 0xac, 0x4f, 0x9c, 0x6d, 0xff, 0xbf, 0x20, 0x6c, 0x80, 0xf5, 0x98, 0x05, 0x01, 0x4a, 0xbd,
+	0x01, 0xe9, 0x8f, 0x02, 0xa2, 0xdd, 0x16, 0xb6, 0x07, 0xcb, 0x6d, 0x3b, 0x2c, 0x08, 0xba, 0x53,
+	0xa5, 0xb3, 0x16, 0x2f, 0x3c, 0xc1, 0x47, 0xe0, 0xae, 0x30, 0x92, 0xe3, 0xa1, 0x7d, 0x12, 0x71,
+	0xfa, 0x1d, 0x25, 0x89, 0x06, 0x8a, 0x4a, 0xe9, 0xb6, 0xb3, 0x9d, 0x91, 0x62, 0x0c, 0xe6, 0x8c,
+	0xca, 0x76, 0x4a, 0xc4, 0xf8, 0x09, 0xf6, 0x08, 0x3a, 0x0d, 0x98, 0x37, 0x4c, 0xb5, 0x3b, 0xa2,
+	0x15, 0x9b, 0x19, 0xd1, 0xc8, 0xf0, 0x23, 0x01, 0xc3, 0xe7, 0x40, 0x0d, 0xf1, 0x19, 0x8a, 0xd9,
+	0xf7, 0x24, 0xc9, 0xc6, 0x12, 0xf9, 0x44, 0x5b, 0x2b, 0x2a, 0xa5, 0xb5, 0xa3, 0xcf, 0x5f, 0xbd,
+	0xd9, 0xcb, 0xfd, 0xf1, 0x66, 0xef, 0x7d, 0x99, 0x72, 0xda, 0x1f, 0x56, 0x28, 0xab, 0x86, 0x98,
+	0x0f, 0x2a, 0x16, 0xf1, 0xb1, 0x37, 0xa9, 0x13, 0xef, 0xb7, 0x5f, 0x1f, 0x80, 0x69, 0x45, 0x75,
+	0xe2, 0xfd, 0x72, 0xf9, 0xb2, 0xac, 0x38, 0x1b, 0x21, 0x3e, 0xeb, 0x64, 0x76, 0xba, 0x70, 0x83,
+	0xcf, 0xc0, 0x9d, 0x90, 0x46, 0x28, 0x6b, 0x52, 0x80, 0x63, 0x6d, 0xfd, 0xad, 0xcc, 0x41, 0x48,
+	0x23, 0x5b, 0x3a, 0xc1, 0xfb, 0x60, 0x23, 0x33, 0x9e, 0x9d, 0x92, 0x54, 0xdb, 0x90, 0xbb, 0x1d,
+	0xd2, 0xe8, 0x78, 0x06, 0x42, 0x1f, 0xec, 0xce, 0x4f, 0x58, 0x4a, 0xf8, 0xb4, 0x4a, 0x39, 0xed,
+	0x4d, 0x31, 0x96, 0x4f, 0x97, 0x8f, 0x65, 0x66, 0xd2, 0x25, 0x5c, 0x16, 0x23, 0x06, 0xbe, 0x33,
+	0x5e, 0x8a, 0xc3, 0xc7, 0x60, 0x37, 0x3b, 0x55, 0x98, 0x8f, 0x12, 0x82, 0x12, 0xf2, 0x62, 0x44,
+	0x52, 0x8e, 0x38, 0x0d, 0x09, 0x1b, 0x71, 0x4d, 0x15, 0xed, 0x7f, 0x6f, 0x26, 0x70, 0x24, 0xef,
+	0x4a, 0x1a, 0x1e, 0x82, 0xfc, 0xc2, 0xfe, 0x26, 0x84, 0x67, 0xc3, 0x64, 0x91, 0xb6, 0x25, 0x2a,
+	0x5a, 0xd8, 0x6d, 0xe7, 0x8a, 0x7a, 0xfc, 0xc5, 0x5f, 0x3f, 0xef, 0x29, 0x3f, 0x5e, 0xbe, 0x2c,
+	0x3f, 0xf4, 0x29, 0x1f, 0x8c, 0x4e, 0x2b, 0x1e, 0x0b, 0xa7, 0x97, 0xcf, 0xf4, 0xeb, 0x41, 0xda,
+	0x1f, 0x56, 0xcf, 0x16, 0xaf, 0x38, 0x79, 0xe9, 0x94, 0x09, 0xd8, 0x59, 0x5e, 0x1b, 0xbc, 0x0f,
+	0xf6, 0x8f, 0x6b, 0x96, 0x59, 0xaf, 0xb9, 0xb6, 0x83, 0xba, 0x86, 0x8b, 0xf4, 0x66, 0xad, 0xdd,
+	0x30, 0x50, 0xcb, 0xae, 0x1b, 0xa8, 0xe1, 0xd4, 0xea, 0xbd, 0x9a, 0xa5, 0xe6, 0xe0, 0x87, 0xa0,
+	0x78, 0xbd, 0xcc, 0x31, 0xbe, 0x32, 0x74, 0x57, 0x55, 0xca, 0x5f, 0x82, 0xb5, 0xc5, 0xcd, 0x86,
+	0x1a, 0xc8, 0x77, 0x6c, 0xcb, 0x42, 0x5d, 0xbd, 0x69, 0xd4, 0x7b, 0x96, 0x81, 0x9a, 0x86, 0xd9,
+	0x68, 0xba, 0x6a, 0x0e, 0xee, 0x00, 0xf8, 0x6f, 0xc6, 0x35, 0x5b, 0x86, 0xaa, 0x94, 0xbf, 0x05,
+	0xdb, 0x4b, 0x8e, 0x1c, 0xdc, 0x07, 0x1f, 0x34, 0xec, 0x63, 0xc3, 0x69, 0xd7, 0xda, 0xba, 0x81,
+	0xdc, 0x9a, 0x65, 0x9d, 0xc8, 0x57, 0x77, 0xdd, 0xda, 0x13, 0xb3, 0xdd, 0x90, 0x19, 0x5e, 0x23,
+	0x39, 0x69, 0x5d, 0xa9, 0x94, 0xf2, 0x0f, 0x0a, 0xc8, 0x2f, 0xbb, 0xc4, 0xe0, 0x01, 0x28, 0x18,
+	0x1d, 0x5b, 0x6f, 0xa2, 0xae, 0x61, 0x19, 0xba, 0x6b, 0xda, 0x6d, 0xd4, 0xb1, 0x2d, 0x53, 0x3f,
+	0x41, 0x2d, 0xb3, 0x6d, 0xb6, 0x7a, 0x2d, 0x35, 0x97, 0xf5, 0xea, 0x1a, 0x4d, 0xcd, 0xb2, 0xec,
+	0x67, 0xc8, 0x32, 0xbb, 0xae, 0xaa, 0x64, 0xc9, 0x5e, 0x23, 0x7b, 0xda, 0xb3, 0x9d, 0x5e, 0x4b,
+	0xbd, 0x71, 0x64, 0xbe, 0x3a, 0x2f, 0x28, 0xaf, 0xcf, 0x0b, 0xca, 0x9f, 0xe7, 0x05, 0xe5, 0xa7,
+	0x8b, 0x42, 0xee, 0xf5, 0x45, 0x21, 0xf7, 0xfb, 0x45, 0x21, 0xf7, 0x75, 0xf5, 0xff, 0xcf, 0x96,
+	0x4f, 0x62, 0x92, 0x9e, 0xae, 0x8a, 0x7f, 0x98, 0xcf, 0xfe, 0x19, 0x00, 0xdf, 0xeb, 0xdb, 0x18,
+	0xe0, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -145,6 +437,53 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigningKeyTag != that1.SigningKeyTag {
 		return false
 	}
+	if this.EpochSelectionPolicy != that1.EpochSelectionPolicy {
+		return false
+	}
+	if len(this.SettlementChainIds) != len(that1.SettlementChainIds) {
+		return false
+	}
+	for i := range this.SettlementChainIds {
+		if this.SettlementChainIds[i] != that1.SettlementChainIds[i] {
+			return false
+		}
+	}
+	if this.SettlementQuorum != that1.SettlementQuorum {
+		return false
+	}
+	if this.CheckpointInterval != that1.CheckpointInterval {
+		return false
+	}
+	if this.GovernanceTallyMode != that1.GovernanceTallyMode {
+		return false
+	}
+	if this.PollSchedule != that1.PollSchedule {
+		return false
+	}
+	if this.PollEpochIdentifier != that1.PollEpochIdentifier {
+		return false
+	}
+	if this.PollGraceBlocks != that1.PollGraceBlocks {
+		return false
+	}
+	if !this.MaxPowerChange.Equal(that1.MaxPowerChange) {
+		return false
+	}
+	if !this.MinOverlap.Equal(that1.MinOverlap) {
+		return false
+	}
+	if this.MinValidators != that1.MinValidators {
+		return false
+	}
+	if this.ValidatorSetChangeMode != that1.ValidatorSetChangeMode {
+		return false
+	}
+	if this.SignatureRequestTimeout != that1.SignatureRequestTimeout {
+		return false
+	}
+	if this.CheckpointRetention != that1.CheckpointRetention {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -167,6 +506,105 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CheckpointRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CheckpointRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.SignatureRequestTimeout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignatureRequestTimeout))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ValidatorSetChangeMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ValidatorSetChangeMode))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MinValidators != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinValidators))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.MinOverlap.Size()
+		i -= size
+		if _, err := m.MinOverlap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MaxPowerChange.Size()
+		i -= size
+		if _, err := m.MaxPowerChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.PollGraceBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PollGraceBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.PollEpochIdentifier) > 0 {
+		i -= len(m.PollEpochIdentifier)
+		copy(dAtA[i:], m.PollEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PollEpochIdentifier)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.PollSchedule != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PollSchedule))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.GovernanceTallyMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GovernanceTallyMode))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CheckpointInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CheckpointInterval))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SettlementQuorum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SettlementQuorum))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SettlementChainIds) > 0 {
+		dAtA2 := make([]byte, len(m.SettlementChainIds)*10)
+		var j1 int
+		for _, num := range m.SettlementChainIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EpochSelectionPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochSelectionPolicy))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.SigningKeyTag != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.SigningKeyTag))
 		i--
@@ -211,6 +649,51 @@ func (m *Params) Size() (n int) {
 	if m.SigningKeyTag != 0 {
 		n += 1 + sovParams(uint64(m.SigningKeyTag))
 	}
+	if m.EpochSelectionPolicy != 0 {
+		n += 1 + sovParams(uint64(m.EpochSelectionPolicy))
+	}
+	if len(m.SettlementChainIds) > 0 {
+		l = 0
+		for _, e := range m.SettlementChainIds {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if m.SettlementQuorum != 0 {
+		n += 1 + sovParams(uint64(m.SettlementQuorum))
+	}
+	if m.CheckpointInterval != 0 {
+		n += 1 + sovParams(uint64(m.CheckpointInterval))
+	}
+	if m.GovernanceTallyMode != 0 {
+		n += 1 + sovParams(uint64(m.GovernanceTallyMode))
+	}
+	if m.PollSchedule != 0 {
+		n += 1 + sovParams(uint64(m.PollSchedule))
+	}
+	l = len(m.PollEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.PollGraceBlocks != 0 {
+		n += 1 + sovParams(uint64(m.PollGraceBlocks))
+	}
+	l = m.MaxPowerChange.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinOverlap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MinValidators != 0 {
+		n += 1 + sovParams(uint64(m.MinValidators))
+	}
+	if m.ValidatorSetChangeMode != 0 {
+		n += 1 + sovParams(uint64(m.ValidatorSetChangeMode))
+	}
+	if m.SignatureRequestTimeout != 0 {
+		n += 2 + sovParams(uint64(m.SignatureRequestTimeout))
+	}
+	if m.CheckpointRetention != 0 {
+		n += 2 + sovParams(uint64(m.CheckpointRetention))
+	}
 	return n
 }
 
@@ -306,6 +789,370 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSelectionPolicy", wireType)
+			}
+			m.EpochSelectionPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochSelectionPolicy |= EpochSelectionPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SettlementChainIds = append(m.SettlementChainIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SettlementChainIds) == 0 {
+					m.SettlementChainIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SettlementChainIds = append(m.SettlementChainIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementChainIds", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementQuorum", wireType)
+			}
+			m.SettlementQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointInterval", wireType)
+			}
+			m.CheckpointInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceTallyMode", wireType)
+			}
+			m.GovernanceTallyMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GovernanceTallyMode |= GovernanceTallyMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollSchedule", wireType)
+			}
+			m.PollSchedule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollSchedule |= PollSchedule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollGraceBlocks", wireType)
+			}
+			m.PollGraceBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollGraceBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPowerChange", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPowerChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOverlap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOverlap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidators", wireType)
+			}
+			m.MinValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetChangeMode", wireType)
+			}
+			m.ValidatorSetChangeMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetChangeMode |= ValidatorSetChangeMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureRequestTimeout", wireType)
+			}
+			m.SignatureRequestTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureRequestTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointRetention", wireType)
+			}
+			m.CheckpointRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointRetention |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
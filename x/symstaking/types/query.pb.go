@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -277,456 +279,2853 @@ func (m *QueryLastValidatorSetResponse) GetLastValidatorSet() *LastValidatorSet
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.symstaking.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.symstaking.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "cosmos.symstaking.v1.QueryCurrentEpochRequest")
-	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "cosmos.symstaking.v1.QueryCurrentEpochResponse")
-	proto.RegisterType((*QueryLastValidatorSetRequest)(nil), "cosmos.symstaking.v1.QueryLastValidatorSetRequest")
-	proto.RegisterType((*QueryLastValidatorSetResponse)(nil), "cosmos.symstaking.v1.QueryLastValidatorSetResponse")
+// QuerySettlementStatusRequest defines the QuerySettlementStatusRequest message.
+type QuerySettlementStatusRequest struct {
 }
 
-func init() { proto.RegisterFile("cosmos/symstaking/v1/query.proto", fileDescriptor_3fff9784a941999b) }
-
-var fileDescriptor_3fff9784a941999b = []byte{
-	// 468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x8b, 0x13, 0x31,
-	0x14, 0x9f, 0xa8, 0x2d, 0x18, 0x3d, 0xac, 0xb1, 0x87, 0x75, 0x1c, 0xe3, 0xee, 0xac, 0xca, 0xae,
-	0xe2, 0x84, 0xed, 0x5e, 0xbc, 0x09, 0x15, 0x0f, 0x82, 0x07, 0x5d, 0x65, 0x0f, 0x5e, 0x4a, 0x3a,
-	0x0d, 0xd3, 0xa1, 0x33, 0x93, 0xe9, 0x24, 0x53, 0xec, 0x55, 0xbf, 0x80, 0xe0, 0xd5, 0xb3, 0x78,
-	0xf4, 0x63, 0xf4, 0x58, 0x10, 0xc1, 0x93, 0x48, 0x2b, 0xf8, 0x35, 0xa4, 0x49, 0x06, 0x6b, 0x9b,
-	0x96, 0xee, 0xa5, 0x0d, 0xef, 0xfd, 0xfe, 0xe5, 0xbd, 0x0c, 0xdc, 0x0b, 0xb9, 0x48, 0xb9, 0x20,
-	0x62, 0x94, 0x0a, 0x49, 0xfb, 0x71, 0x16, 0x91, 0xe1, 0x31, 0x19, 0x94, 0xac, 0x18, 0x05, 0x79,
-	0xc1, 0x25, 0x47, 0x0d, 0x8d, 0x08, 0xfe, 0x21, 0x82, 0xe1, 0xb1, 0x7b, 0x8d, 0xa6, 0x71, 0xc6,
-	0x89, 0xfa, 0xd5, 0x40, 0xb7, 0x11, 0xf1, 0x88, 0xab, 0x23, 0x99, 0x9f, 0x4c, 0xd5, 0x8b, 0x38,
-	0x8f, 0x12, 0x46, 0x68, 0x1e, 0x13, 0x9a, 0x65, 0x5c, 0x52, 0x19, 0xf3, 0x4c, 0x98, 0xee, 0xbe,
-	0xd5, 0x3e, 0xa7, 0x05, 0x4d, 0x2b, 0x88, 0x6f, 0x85, 0x54, 0x51, 0x14, 0xc6, 0x6f, 0x40, 0xf4,
-	0x72, 0x1e, 0xf9, 0x85, 0x22, 0x9e, 0xb2, 0x41, 0xc9, 0x84, 0xf4, 0xcf, 0xe0, 0xf5, 0xff, 0xaa,
-	0x22, 0xe7, 0x99, 0x60, 0xe8, 0x31, 0xac, 0x6b, 0x83, 0x5d, 0xb0, 0x07, 0x0e, 0xaf, 0x34, 0xbd,
-	0xc0, 0x76, 0xc3, 0x40, 0xb3, 0x5a, 0x97, 0xc7, 0x3f, 0x6f, 0x3b, 0x5f, 0xfe, 0x7c, 0xbd, 0x0f,
-	0x4e, 0x0d, 0xcd, 0x77, 0xe1, 0xae, 0xd2, 0x7d, 0x52, 0x16, 0x05, 0xcb, 0xe4, 0xd3, 0x9c, 0x87,
-	0xbd, 0xca, 0xf3, 0x11, 0xbc, 0x61, 0xe9, 0x19, 0xe7, 0x9b, 0xb0, 0xc6, 0xe6, 0x05, 0x65, 0x7c,
-	0xa9, 0x55, 0xd3, 0xb2, 0xba, 0xe6, 0x63, 0xe8, 0x29, 0xe6, 0x73, 0x2a, 0xe4, 0x19, 0x4d, 0xe2,
-	0x2e, 0x95, 0xbc, 0x78, 0xc5, 0x64, 0xa5, 0x5c, 0xc2, 0x5b, 0x6b, 0xfa, 0x46, 0xfd, 0x35, 0x44,
-	0x09, 0x15, 0xb2, 0x3d, 0xac, 0x9a, 0x6d, 0xc1, 0xa4, 0xb9, 0xe3, 0x3d, 0xfb, 0x1d, 0x57, 0xb4,
-	0x76, 0x92, 0xa5, 0x4a, 0xf3, 0xfb, 0x45, 0x58, 0x53, 0xbe, 0xe8, 0x3d, 0x80, 0x75, 0x3d, 0x14,
-	0x74, 0x68, 0x97, 0x5b, 0xdd, 0x81, 0x7b, 0xb4, 0x05, 0x52, 0xe7, 0xf7, 0xef, 0xbc, 0xfb, 0xf6,
-	0xfb, 0xe3, 0x05, 0x8c, 0x3c, 0xb2, 0xe1, 0x51, 0xa0, 0x4f, 0x00, 0x5e, 0x5d, 0x1c, 0x2e, 0x0a,
-	0x36, 0x38, 0x58, 0x36, 0xe4, 0x92, 0xad, 0xf1, 0x26, 0xd7, 0x03, 0x95, 0xeb, 0x2e, 0x3a, 0xb0,
-	0xe7, 0x0a, 0x35, 0xa7, 0xad, 0xb6, 0x88, 0x3e, 0x03, 0xb8, 0xb3, 0x3c, 0x55, 0xd4, 0xdc, 0x60,
-	0xb9, 0x66, 0xdd, 0xee, 0xc9, 0xb9, 0x38, 0x26, 0xea, 0x91, 0x8a, 0x7a, 0x80, 0xf6, 0xed, 0x51,
-	0xab, 0xe7, 0x21, 0x98, 0x6c, 0x3d, 0x1b, 0x4f, 0x31, 0x98, 0x4c, 0x31, 0xf8, 0x35, 0xc5, 0xe0,
-	0xc3, 0x0c, 0x3b, 0x93, 0x19, 0x76, 0x7e, 0xcc, 0xb0, 0xf3, 0x86, 0x44, 0xb1, 0xec, 0x95, 0x9d,
-	0x20, 0xe4, 0x69, 0x25, 0xa3, 0xff, 0x1e, 0x8a, 0x6e, 0x9f, 0xbc, 0x5d, 0xd4, 0x94, 0xa3, 0x9c,
-	0x89, 0x4e, 0x5d, 0x7d, 0x84, 0x27, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x90, 0x8b, 0xb0, 0x20,
-	0x4c, 0x04, 0x00, 0x00,
+func (m *QuerySettlementStatusRequest) Reset()         { *m = QuerySettlementStatusRequest{} }
+func (m *QuerySettlementStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementStatusRequest) ProtoMessage()    {}
+func (*QuerySettlementStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff9784a941999b, []int{6}
+}
+func (m *QuerySettlementStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlementStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettlementStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementStatusRequest.Merge(m, src)
+}
+func (m *QuerySettlementStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementStatusRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QuerySettlementStatusRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// CurrentEpoch Queries a list of CurrentEpoch items.
-	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
-	// CurrentEpoch Queries a list of CurrentEpoch items.
-	LastValidatorSet(ctx context.Context, in *QueryLastValidatorSetRequest, opts ...grpc.CallOption) (*QueryLastValidatorSetResponse, error)
+// QuerySettlementStatusResponse defines the QuerySettlementStatusResponse message.
+type QuerySettlementStatusResponse struct {
+	// policy is the epoch selection policy in effect.
+	Policy EpochSelectionPolicy `protobuf:"varint,1,opt,name=policy,proto3,enum=cosmos.symstaking.v1.EpochSelectionPolicy" json:"policy,omitempty"`
+	// selected_epoch is the epoch selected by the policy from the settlement chains.
+	SelectedEpoch uint64 `protobuf:"varint,2,opt,name=selected_epoch,json=selectedEpoch,proto3" json:"selected_epoch,omitempty"`
+	// gating_chain_id is the settlement chain holding back the selected epoch.
+	GatingChainId uint64 `protobuf:"varint,3,opt,name=gating_chain_id,json=gatingChainId,proto3" json:"gating_chain_id,omitempty"`
+	// chains lists the status of every settlement chain known to the relay.
+	Chains []SettlementChainStatus `protobuf:"bytes,4,rep,name=chains,proto3" json:"chains"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySettlementStatusResponse) Reset()         { *m = QuerySettlementStatusResponse{} }
+func (m *QuerySettlementStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementStatusResponse) ProtoMessage()    {}
+func (*QuerySettlementStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff9784a941999b, []int{7}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySettlementStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlementStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettlementStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementStatusResponse.Merge(m, src)
+}
+func (m *QuerySettlementStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementStatusResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySettlementStatusResponse proto.InternalMessageInfo
+
+func (m *QuerySettlementStatusResponse) GetPolicy() EpochSelectionPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return out, nil
+	return EpochSelectionPolicy_EPOCH_SELECTION_POLICY_MINIMUM
 }
 
-func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error) {
-	out := new(QueryCurrentEpochResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Query/CurrentEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySettlementStatusResponse) GetSelectedEpoch() uint64 {
+	if m != nil {
+		return m.SelectedEpoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) LastValidatorSet(ctx context.Context, in *QueryLastValidatorSetRequest, opts ...grpc.CallOption) (*QueryLastValidatorSetResponse, error) {
-	out := new(QueryLastValidatorSetResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Query/LastValidatorSet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySettlementStatusResponse) GetGatingChainId() uint64 {
+	if m != nil {
+		return m.GatingChainId
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// CurrentEpoch Queries a list of CurrentEpoch items.
-	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
-	// CurrentEpoch Queries a list of CurrentEpoch items.
-	LastValidatorSet(context.Context, *QueryLastValidatorSetRequest) (*QueryLastValidatorSetResponse, error)
+func (m *QuerySettlementStatusResponse) GetChains() []SettlementChainStatus {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QuerySignatureRequestRequest defines the QuerySignatureRequestRequest message.
+type QuerySignatureRequestRequest struct {
+	// request_id is the id of the signature request.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySignatureRequestRequest) Reset()         { *m = QuerySignatureRequestRequest{} }
+func (m *QuerySignatureRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignatureRequestRequest) ProtoMessage()    {}
+func (*QuerySignatureRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff9784a941999b, []int{8}
 }
-func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
+func (m *QuerySignatureRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LastValidatorSet(ctx context.Context, req *QueryLastValidatorSetRequest) (*QueryLastValidatorSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastValidatorSet not implemented")
+func (m *QuerySignatureRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignatureRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QuerySignatureRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignatureRequestRequest.Merge(m, src)
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.symstaking.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QuerySignatureRequestRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCurrentEpochRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CurrentEpoch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.symstaking.v1.Query/CurrentEpoch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CurrentEpoch(ctx, req.(*QueryCurrentEpochRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QuerySignatureRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignatureRequestRequest.DiscardUnknown(m)
 }
 
-func _Query_LastValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLastValidatorSetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastValidatorSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.symstaking.v1.Query/LastValidatorSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastValidatorSet(ctx, req.(*QueryLastValidatorSetRequest))
+var xxx_messageInfo_QuerySignatureRequestRequest proto.InternalMessageInfo
+
+func (m *QuerySignatureRequestRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.symstaking.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "CurrentEpoch",
-			Handler:    _Query_CurrentEpoch_Handler,
-		},
-		{
-			MethodName: "LastValidatorSet",
-			Handler:    _Query_LastValidatorSet_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/symstaking/v1/query.proto",
+// QuerySignatureRequestResponse defines the QuerySignatureRequestResponse message.
+type QuerySignatureRequestResponse struct {
+	SignatureRequest SignatureRequest `protobuf:"bytes,1,opt,name=signature_request,json=signatureRequest,proto3" json:"signature_request"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySignatureRequestResponse) Reset()         { *m = QuerySignatureRequestResponse{} }
+func (m *QuerySignatureRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignatureRequestResponse) ProtoMessage()    {}
+func (*QuerySignatureRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff9784a941999b, []int{9}
+}
+func (m *QuerySignatureRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignatureRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignatureRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySignatureRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignatureRequestResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySignatureRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignatureRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignatureRequestResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySignatureRequestResponse proto.InternalMessageInfo
+
+func (m *QuerySignatureRequestResponse) GetSignatureRequest() SignatureRequest {
+	if m != nil {
+		return m.SignatureRequest
 	}
-	return dAtA[:n], nil
+	return SignatureRequest{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCheckpointRequest defines the QueryCheckpointRequest message.
+type QueryCheckpointRequest struct {
+	// height is the height of the checkpoint.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCheckpointRequest) Reset()         { *m = QueryCheckpointRequest{} }
+func (m *QueryCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointRequest) ProtoMessage()    {}
+func (*QueryCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff9784a941999b, []int{10}
+}
+func (m *QueryCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointRequest.Merge(m, src)
+}
+func (m *QueryCheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCurrentEpochRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCheckpointRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryCurrentEpochRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCheckpointResponse defines the QueryCheckpointResponse message.
+type QueryCheckpointResponse struct {
+	Checkpoint CheckpointProof `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint"`
 }
 
-func (m *QueryCurrentEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCheckpointResponse) Reset()         { *m = QueryCheckpointResponse{} }
+func (m *QueryCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointResponse) ProtoMessage()    {}
+func (*QueryCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff9784a941999b, []int{11}
+}
+func (m *QueryCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointResponse.Merge(m, src)
+}
+func (m *QueryCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCurrentEpochResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCheckpointResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointResponse) GetCheckpoint() CheckpointProof {
+	if m != nil {
+		return m.Checkpoint
 	}
-	return dAtA[:n], nil
+	return CheckpointProof{}
 }
 
-func (m *QueryCurrentEpochResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCheckpointsRequest defines the QueryCheckpointsRequest message.
+type QueryCheckpointsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryCheckpointsRequest) Reset()         { *m = QueryCheckpointsRequest{} }
+func (m *QueryCheckpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointsRequest) ProtoMessage()    {}
+func (*QueryCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff9784a941999b, []int{12}
+}
+func (m *QueryCheckpointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointsRequest.Merge(m, src)
+}
+func (m *QueryCheckpointsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLastValidatorSetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCheckpointsRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLastValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCheckpointsResponse defines the QueryCheckpointsResponse message.
+type QueryCheckpointsResponse struct {
+	Checkpoints []CheckpointProof `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLastValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCheckpointsResponse) Reset()         { *m = QueryCheckpointsResponse{} }
+func (m *QueryCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointsResponse) ProtoMessage()    {}
+func (*QueryCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff9784a941999b, []int{13}
+}
+func (m *QueryCheckpointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointsResponse.Merge(m, src)
+}
+func (m *QueryCheckpointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLastValidatorSetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCheckpointsResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointsResponse) GetCheckpoints() []CheckpointProof {
+	if m != nil {
+		return m.Checkpoints
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLastValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCheckpointsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryLastValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastValidatorSet != nil {
-		{
-			size, err := m.LastValidatorSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QuerySyncStatusRequest defines the QuerySyncStatusRequest message.
+type QuerySyncStatusRequest struct {
+}
+
+func (m *QuerySyncStatusRequest) Reset()         { *m = QuerySyncStatusRequest{} }
+func (m *QuerySyncStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySyncStatusRequest) ProtoMessage()    {}
+func (*QuerySyncStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff9784a941999b, []int{14}
+}
+func (m *QuerySyncStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySyncStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySyncStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySyncStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySyncStatusRequest.Merge(m, src)
+}
+func (m *QuerySyncStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySyncStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySyncStatusRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QuerySyncStatusRequest proto.InternalMessageInfo
+
+// QuerySyncStatusResponse defines the QuerySyncStatusResponse message.
+type QuerySyncStatusResponse struct {
+	// state is the relay sync state, including manual overrides.
+	State RelaySyncState `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+	// epoch is the current relay epoch.
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// validator_set_epoch is the relay epoch of the current validator set.
+	ValidatorSetEpoch uint64 `protobuf:"varint,3,opt,name=validator_set_epoch,json=validatorSetEpoch,proto3" json:"validator_set_epoch,omitempty"`
+	// transition is the relay validator set being applied over several blocks, if any.
+	Transition *ValidatorSetTransition `protobuf:"bytes,4,opt,name=transition,proto3" json:"transition,omitempty"`
+	// rejected_epoch is the last relay epoch whose validator set was rejected as unsafe, zero if none.
+	RejectedEpoch uint64 `protobuf:"varint,5,opt,name=rejected_epoch,json=rejectedEpoch,proto3" json:"rejected_epoch,omitempty"`
+}
+
+func (m *QuerySyncStatusResponse) Reset()         { *m = QuerySyncStatusResponse{} }
+func (m *QuerySyncStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySyncStatusResponse) ProtoMessage()    {}
+func (*QuerySyncStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff9784a941999b, []int{15}
+}
+func (m *QuerySyncStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySyncStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySyncStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySyncStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySyncStatusResponse.Merge(m, src)
+}
+func (m *QuerySyncStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySyncStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySyncStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySyncStatusResponse proto.InternalMessageInfo
+
+func (m *QuerySyncStatusResponse) GetState() RelaySyncState {
+	if m != nil {
+		return m.State
 	}
-	var l int
-	_ = l
-	return n
+	return RelaySyncState{}
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySyncStatusResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryCurrentEpochRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySyncStatusResponse) GetValidatorSetEpoch() uint64 {
+	if m != nil {
+		return m.ValidatorSetEpoch
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryCurrentEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySyncStatusResponse) GetTransition() *ValidatorSetTransition {
+	if m != nil {
+		return m.Transition
 	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
+	return nil
+}
+
+func (m *QuerySyncStatusResponse) GetRejectedEpoch() uint64 {
+	if m != nil {
+		return m.RejectedEpoch
 	}
-	return n
+	return 0
 }
 
-func (m *QueryLastValidatorSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryVoterRequest is the request type for the Query/Voter RPC method.
+type QueryVoterRequest struct {
+	// consensus_pubkey is the ed25519 consensus public key of the validator.
+	ConsensusPubkey []byte `protobuf:"bytes,1,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+}
+
+func (m *QueryVoterRequest) Reset()         { *m = QueryVoterRequest{} }
+func (m *QueryVoterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoterRequest) ProtoMessage()    {}
+func (*QueryVoterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff9784a941999b, []int{16}
+}
+func (m *QueryVoterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoterRequest.Merge(m, src)
+}
+func (m *QueryVoterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoterRequest proto.InternalMessageInfo
+
+func (m *QueryVoterRequest) GetConsensusPubkey() []byte {
+	if m != nil {
+		return m.ConsensusPubkey
+	}
+	return nil
+}
+
+// QueryVoterResponse is the response type for the Query/Voter RPC method.
+type QueryVoterResponse struct {
+	// voter is the account voting in governance on behalf of the validator, empty if none is registered.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// nonce is the nonce the next voter registration of the validator must be signed with.
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *QueryVoterResponse) Reset()         { *m = QueryVoterResponse{} }
+func (m *QueryVoterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoterResponse) ProtoMessage()    {}
+func (*QueryVoterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff9784a941999b, []int{17}
+}
+func (m *QueryVoterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoterResponse.Merge(m, src)
+}
+func (m *QueryVoterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoterResponse proto.InternalMessageInfo
+
+func (m *QueryVoterResponse) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *QueryVoterResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.symstaking.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.symstaking.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "cosmos.symstaking.v1.QueryCurrentEpochRequest")
+	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "cosmos.symstaking.v1.QueryCurrentEpochResponse")
+	proto.RegisterType((*QueryLastValidatorSetRequest)(nil), "cosmos.symstaking.v1.QueryLastValidatorSetRequest")
+	proto.RegisterType((*QueryLastValidatorSetResponse)(nil), "cosmos.symstaking.v1.QueryLastValidatorSetResponse")
+	proto.RegisterType((*QuerySettlementStatusRequest)(nil), "cosmos.symstaking.v1.QuerySettlementStatusRequest")
+	proto.RegisterType((*QuerySettlementStatusResponse)(nil), "cosmos.symstaking.v1.QuerySettlementStatusResponse")
+	proto.RegisterType((*QuerySignatureRequestRequest)(nil), "cosmos.symstaking.v1.QuerySignatureRequestRequest")
+	proto.RegisterType((*QuerySignatureRequestResponse)(nil), "cosmos.symstaking.v1.QuerySignatureRequestResponse")
+	proto.RegisterType((*QueryCheckpointRequest)(nil), "cosmos.symstaking.v1.QueryCheckpointRequest")
+	proto.RegisterType((*QueryCheckpointResponse)(nil), "cosmos.symstaking.v1.QueryCheckpointResponse")
+	proto.RegisterType((*QueryCheckpointsRequest)(nil), "cosmos.symstaking.v1.QueryCheckpointsRequest")
+	proto.RegisterType((*QueryCheckpointsResponse)(nil), "cosmos.symstaking.v1.QueryCheckpointsResponse")
+	proto.RegisterType((*QuerySyncStatusRequest)(nil), "cosmos.symstaking.v1.QuerySyncStatusRequest")
+	proto.RegisterType((*QuerySyncStatusResponse)(nil), "cosmos.symstaking.v1.QuerySyncStatusResponse")
+	proto.RegisterType((*QueryVoterRequest)(nil), "cosmos.symstaking.v1.QueryVoterRequest")
+	proto.RegisterType((*QueryVoterResponse)(nil), "cosmos.symstaking.v1.QueryVoterResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/symstaking/v1/query.proto", fileDescriptor_3fff9784a941999b) }
+
+var fileDescriptor_3fff9784a941999b = []byte{
+	// 1170 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x4e, 0x6d, 0xa9, 0x2f, 0xfd, 0x91, 0x4c, 0xa3, 0xd6, 0xdd, 0x26, 0x26, 0xdd,
+	0x34, 0xe4, 0x47, 0x93, 0x5d, 0xe2, 0xa8, 0x82, 0x4b, 0x41, 0xa4, 0x2a, 0x28, 0x52, 0x85, 0xcc,
+	0xba, 0xca, 0x81, 0x03, 0xd6, 0x64, 0x3d, 0xac, 0x97, 0xd8, 0x33, 0xee, 0xce, 0xd8, 0xc2, 0xaa,
+	0xaa, 0x4a, 0xf0, 0x0f, 0x54, 0x2a, 0x12, 0x17, 0xb8, 0x22, 0x90, 0x38, 0x70, 0x40, 0x42, 0xe2,
+	0x2f, 0xe8, 0xb1, 0x82, 0x0b, 0x27, 0x84, 0x12, 0x24, 0xfe, 0x0d, 0xb4, 0x33, 0xb3, 0xde, 0xb5,
+	0xbd, 0x76, 0x1c, 0x2e, 0xf1, 0xfa, 0xcd, 0xfb, 0xbe, 0xf7, 0x99, 0xb7, 0x33, 0xef, 0x39, 0xb0,
+	0xe2, 0x31, 0xde, 0x62, 0xdc, 0xe1, 0xbd, 0x16, 0x17, 0xf8, 0x38, 0xa0, 0xbe, 0xd3, 0xdd, 0x75,
+	0x9e, 0x74, 0x48, 0xd8, 0xb3, 0xdb, 0x21, 0x13, 0x0c, 0x2d, 0x2a, 0x0f, 0x3b, 0xf1, 0xb0, 0xbb,
+	0xbb, 0xe6, 0x02, 0x6e, 0x05, 0x94, 0x39, 0xf2, 0xaf, 0x72, 0x34, 0x6f, 0x2a, 0xc7, 0x9a, 0xfc,
+	0xe6, 0x68, 0x95, 0x5a, 0x5a, 0xf4, 0x99, 0xcf, 0x94, 0x3d, 0x7a, 0xd2, 0xd6, 0x25, 0x9f, 0x31,
+	0xbf, 0x49, 0x1c, 0xdc, 0x0e, 0x1c, 0x4c, 0x29, 0x13, 0x58, 0x04, 0x8c, 0xc6, 0x9a, 0x2d, 0x4d,
+	0x76, 0x84, 0x39, 0x51, 0x40, 0x4e, 0x77, 0xf7, 0x88, 0x08, 0xbc, 0xeb, 0xb4, 0xb1, 0x1f, 0x50,
+	0xe9, 0xac, 0x7d, 0xd7, 0x32, 0x77, 0xe1, 0x35, 0x88, 0x77, 0xdc, 0x66, 0x01, 0x15, 0xda, 0xed,
+	0x76, 0xa6, 0x5b, 0x1b, 0x87, 0xb8, 0x15, 0x67, 0xb5, 0x32, 0x5d, 0x78, 0xe0, 0xd3, 0x68, 0xe3,
+	0x13, 0x7d, 0xd4, 0xa3, 0xf2, 0xb1, 0x16, 0x01, 0x7d, 0x1c, 0x31, 0x57, 0x64, 0x70, 0x97, 0x3c,
+	0xe9, 0x10, 0x2e, 0xac, 0x43, 0xb8, 0x36, 0x60, 0xe5, 0x6d, 0x46, 0x39, 0x41, 0xef, 0x41, 0x41,
+	0x41, 0x14, 0x8d, 0x15, 0x63, 0x63, 0xae, 0xbc, 0x64, 0x67, 0xd5, 0xdc, 0x56, 0xaa, 0xfd, 0x8b,
+	0xaf, 0xfe, 0x7a, 0x63, 0xe6, 0x87, 0x7f, 0x7f, 0xde, 0x32, 0x5c, 0x2d, 0xb3, 0x4c, 0x28, 0xca,
+	0xb8, 0x0f, 0x3a, 0x61, 0x48, 0xa8, 0x78, 0xd8, 0x66, 0x5e, 0x23, 0xce, 0xf9, 0x0e, 0xdc, 0xcc,
+	0x58, 0xd3, 0x99, 0x6f, 0x41, 0x9e, 0x44, 0x06, 0x99, 0xf8, 0xc2, 0x7e, 0x5e, 0x85, 0x55, 0x36,
+	0xab, 0x04, 0x4b, 0x52, 0xf9, 0x08, 0x73, 0x71, 0x88, 0x9b, 0x41, 0x1d, 0x0b, 0x16, 0x56, 0x89,
+	0x88, 0x23, 0x77, 0x60, 0x79, 0xcc, 0xba, 0x8e, 0xfe, 0x18, 0x50, 0x13, 0x73, 0x51, 0xeb, 0xc6,
+	0x8b, 0x35, 0x4e, 0x84, 0xde, 0xe3, 0x9b, 0xd9, 0x7b, 0x1c, 0x89, 0x35, 0xdf, 0x1c, 0xb2, 0xf4,
+	0xb1, 0xaa, 0x44, 0x88, 0x26, 0x69, 0x11, 0x2a, 0xaa, 0x02, 0x8b, 0x4e, 0xbf, 0xc8, 0x2f, 0x72,
+	0xb0, 0x3c, 0xc6, 0x41, 0x73, 0xed, 0x43, 0xa1, 0xcd, 0x9a, 0x81, 0xd7, 0x93, 0x2c, 0x57, 0xca,
+	0x5b, 0xd9, 0x2c, 0xb2, 0x54, 0x55, 0xd2, 0x24, 0x5e, 0x74, 0xd4, 0x2a, 0x52, 0xe1, 0x6a, 0x25,
+	0xda, 0x86, 0x2b, 0x5c, 0x2e, 0x91, 0x7a, 0x4d, 0x95, 0x30, 0x97, 0x2e, 0xe1, 0xe5, 0x78, 0x51,
+	0x06, 0x41, 0x3b, 0x70, 0xd5, 0xc7, 0x22, 0xa0, 0x7e, 0xcd, 0x6b, 0xe0, 0x80, 0xd6, 0x82, 0x7a,
+	0x71, 0x76, 0xc0, 0x5d, 0xad, 0x3e, 0x88, 0x16, 0x0f, 0xea, 0xe8, 0x23, 0x28, 0x48, 0x3f, 0x5e,
+	0xbc, 0xb0, 0x32, 0xbb, 0x31, 0x57, 0xbe, 0x9b, 0x0d, 0x98, 0x6c, 0x50, 0x0a, 0xd5, 0x2e, 0x07,
+	0xce, 0x87, 0x8a, 0x62, 0xdd, 0x8f, 0x4b, 0x16, 0xf8, 0x14, 0x8b, 0x4e, 0x48, 0x74, 0xad, 0xf4,
+	0x07, 0x5a, 0x06, 0x08, 0xd5, 0x63, 0x44, 0x16, 0x15, 0xe5, 0xa2, 0x7b, 0x51, 0x5b, 0x0e, 0xea,
+	0xd6, 0x73, 0x58, 0x1e, 0x23, 0xd7, 0x05, 0xfd, 0x14, 0x16, 0x78, 0xbc, 0x56, 0xd3, 0xba, 0xc9,
+	0xef, 0x79, 0x38, 0x54, 0x9a, 0x7a, 0x9e, 0x0f, 0x2d, 0x5a, 0x6f, 0xc1, 0x75, 0x75, 0x86, 0xfb,
+	0x37, 0x3a, 0x26, 0xbf, 0x0e, 0x85, 0x06, 0x09, 0xfc, 0x86, 0x4a, 0x37, 0xeb, 0xea, 0x6f, 0xd6,
+	0x31, 0xdc, 0x18, 0x51, 0x68, 0xd8, 0x0a, 0x40, 0xd2, 0x19, 0x34, 0xe5, 0x5a, 0x36, 0x65, 0xa2,
+	0xae, 0x84, 0x8c, 0x7d, 0x96, 0x86, 0x4c, 0xc5, 0xb0, 0xf0, 0x48, 0xb2, 0xf8, 0x30, 0xa2, 0x0f,
+	0x00, 0x92, 0x6e, 0x35, 0x5c, 0x92, 0xa8, 0xb5, 0xd9, 0xaa, 0xd7, 0xea, 0xd6, 0x66, 0x57, 0xb0,
+	0xdf, 0xaf, 0x6e, 0x4a, 0x69, 0xfd, 0x6a, 0x40, 0x71, 0x34, 0x87, 0xde, 0x91, 0x0b, 0x73, 0x09,
+	0x4d, 0xd4, 0x44, 0x66, 0xff, 0xd7, 0x96, 0xd2, 0x41, 0xd0, 0x87, 0x03, 0xe0, 0x39, 0x09, 0xbe,
+	0x7e, 0x26, 0xb8, 0x02, 0x1a, 0x20, 0x2f, 0xea, 0x77, 0x57, 0xed, 0x51, 0x6f, 0xf0, 0xa2, 0xfe,
+	0x98, 0x83, 0x1b, 0x23, 0x4b, 0x7a, 0x4b, 0x0f, 0x21, 0xcf, 0x05, 0x16, 0x44, 0x97, 0xec, 0x4e,
+	0xf6, 0x66, 0x5c, 0xd2, 0xc4, 0x7d, 0x35, 0x49, 0xef, 0x45, 0xa9, 0x93, 0xfe, 0x96, 0x1b, 0xed,
+	0x6f, 0xe8, 0x1e, 0x5c, 0x1b, 0xe8, 0x4c, 0xfa, 0x1e, 0x0f, 0x5c, 0xcc, 0x85, 0x6e, 0xaa, 0xf5,
+	0xa8, 0xbb, 0xfc, 0x08, 0x40, 0x84, 0x98, 0xf2, 0x40, 0x56, 0xe6, 0x82, 0xe4, 0xdb, 0xce, 0xe6,
+	0x4b, 0xf7, 0xad, 0xc7, 0x7d, 0x8d, 0x9b, 0xd2, 0xa3, 0x35, 0xb8, 0x12, 0x92, 0xcf, 0xd3, 0x7d,
+	0x24, 0x1f, 0xe5, 0x77, 0x2f, 0xc7, 0x56, 0x99, 0xd4, 0x7a, 0x17, 0x16, 0x64, 0xa9, 0x0e, 0x99,
+	0x20, 0x61, 0x7c, 0xb8, 0x36, 0x61, 0xde, 0x8b, 0xaa, 0x45, 0x79, 0x87, 0xd7, 0xda, 0x9d, 0xa3,
+	0x63, 0xa2, 0x3a, 0xda, 0x25, 0xf7, 0x6a, 0xdf, 0x5e, 0x91, 0x66, 0x0b, 0x03, 0x4a, 0xeb, 0x75,
+	0x95, 0x6d, 0xc8, 0x77, 0x23, 0x83, 0xba, 0xf2, 0xfb, 0xc5, 0xdf, 0x7f, 0xd9, 0x89, 0xc7, 0xfd,
+	0xfb, 0xf5, 0x7a, 0x48, 0x38, 0xaf, 0x8a, 0x30, 0xa0, 0xbe, 0xab, 0xdc, 0xa2, 0x72, 0x52, 0x46,
+	0x3d, 0x32, 0x54, 0x4e, 0x69, 0x2b, 0xbf, 0x9c, 0x83, 0xbc, 0xcc, 0x81, 0xbe, 0x32, 0xa0, 0xa0,
+	0x86, 0x15, 0xda, 0xc8, 0x2e, 0xcc, 0xe8, 0x6c, 0x34, 0x37, 0xa7, 0xf0, 0x54, 0xd8, 0xd6, 0x9d,
+	0x2f, 0xff, 0xf8, 0xe7, 0x65, 0xae, 0x84, 0x96, 0x9c, 0x09, 0x03, 0x1d, 0x7d, 0x6b, 0xc0, 0xa5,
+	0xf4, 0xd0, 0x43, 0xf6, 0x84, 0x0c, 0x19, 0x93, 0xd3, 0x74, 0xa6, 0xf6, 0xd7, 0x5c, 0x77, 0x25,
+	0xd7, 0x1a, 0x5a, 0xcd, 0xe6, 0xf2, 0x94, 0x46, 0xbd, 0x66, 0xf4, 0xbd, 0x01, 0xf3, 0xc3, 0xd3,
+	0x0e, 0x95, 0x27, 0xa4, 0x1c, 0x33, 0x86, 0xcd, 0xbd, 0x73, 0x69, 0x34, 0xea, 0xa6, 0x44, 0x5d,
+	0x45, 0xb7, 0xb3, 0x51, 0xe3, 0xb1, 0xcd, 0x89, 0x40, 0x3f, 0x19, 0x30, 0x3f, 0x3c, 0x4a, 0x27,
+	0x82, 0x8e, 0x19, 0xcc, 0xe6, 0xde, 0xb9, 0x34, 0x1a, 0xd4, 0x91, 0xa0, 0x9b, 0x68, 0x3d, 0x1b,
+	0x94, 0xf7, 0x75, 0x35, 0xae, 0xc8, 0x7e, 0x8b, 0x70, 0x87, 0x06, 0xc8, 0x64, 0xdc, 0xec, 0xa1,
+	0x68, 0xee, 0x9d, 0x4b, 0xa3, 0x71, 0xef, 0x4b, 0xdc, 0xb7, 0xd1, 0x3d, 0x67, 0xec, 0x0f, 0xc9,
+	0x81, 0x29, 0xc9, 0x9d, 0xa7, 0xc9, 0xe4, 0x7d, 0x86, 0xbe, 0x33, 0x00, 0x92, 0x0e, 0x8d, 0xb6,
+	0x27, 0x9d, 0xc0, 0xe1, 0x59, 0x68, 0xee, 0x4c, 0xe9, 0xad, 0x51, 0xcb, 0x12, 0x75, 0x1b, 0x6d,
+	0x39, 0x67, 0xfc, 0x7a, 0xe6, 0xce, 0x53, 0x35, 0x55, 0x9f, 0xa1, 0x6f, 0x0c, 0x98, 0x4b, 0x42,
+	0x71, 0x34, 0x5d, 0xca, 0xfe, 0x09, 0xb0, 0xa7, 0x75, 0x9f, 0xee, 0x94, 0xa6, 0xe7, 0xd5, 0xd7,
+	0x06, 0x40, 0x32, 0x47, 0x26, 0x56, 0x6e, 0x64, 0x12, 0x99, 0x3b, 0x53, 0x7a, 0x4f, 0x87, 0xc5,
+	0x7b, 0xd4, 0x8b, 0x4f, 0xe3, 0x73, 0xc8, 0xcb, 0x96, 0x8b, 0xd6, 0x27, 0xa4, 0x48, 0x37, 0x75,
+	0x73, 0xe3, 0x6c, 0x47, 0x8d, 0xb1, 0x2a, 0x31, 0x96, 0xd1, 0xad, 0x6c, 0x0c, 0xd9, 0xb2, 0xf7,
+	0x0f, 0x5e, 0x9d, 0x94, 0x8c, 0xd7, 0x27, 0x25, 0xe3, 0xef, 0x93, 0x92, 0xf1, 0xe2, 0xb4, 0x34,
+	0xf3, 0xfa, 0xb4, 0x34, 0xf3, 0xe7, 0x69, 0x69, 0xe6, 0x13, 0xc7, 0x0f, 0x44, 0xa3, 0x73, 0x64,
+	0x7b, 0xac, 0x15, 0x07, 0x50, 0x1f, 0x3b, 0xbc, 0x7e, 0xec, 0x7c, 0x91, 0x8e, 0x26, 0x7a, 0x6d,
+	0xc2, 0x8f, 0x0a, 0xf2, 0x5f, 0x9b, 0xbd, 0xff, 0x06, 0x00, 0x0e, 0x9f, 0xcf, 0x90, 0x34, 0x0e,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// CurrentEpoch Queries a list of CurrentEpoch items.
+	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// CurrentEpoch Queries a list of CurrentEpoch items.
+	LastValidatorSet(ctx context.Context, in *QueryLastValidatorSetRequest, opts ...grpc.CallOption) (*QueryLastValidatorSetResponse, error)
+	// SettlementStatus queries the committed epoch of every settlement chain and the epoch selected from them.
+	SettlementStatus(ctx context.Context, in *QuerySettlementStatusRequest, opts ...grpc.CallOption) (*QuerySettlementStatusResponse, error)
+	// SignatureRequest queries a relay signature request and its aggregation proof by request id.
+	SignatureRequest(ctx context.Context, in *QuerySignatureRequestRequest, opts ...grpc.CallOption) (*QuerySignatureRequestResponse, error)
+	// Checkpoint queries the app hash checkpoint at a given height and its aggregation proof once available.
+	Checkpoint(ctx context.Context, in *QueryCheckpointRequest, opts ...grpc.CallOption) (*QueryCheckpointResponse, error)
+	// Checkpoints queries all app hash checkpoints and their aggregation proofs once available.
+	Checkpoints(ctx context.Context, in *QueryCheckpointsRequest, opts ...grpc.CallOption) (*QueryCheckpointsResponse, error)
+	// SyncStatus queries the synchronization of the validator set with the relay.
+	SyncStatus(ctx context.Context, in *QuerySyncStatusRequest, opts ...grpc.CallOption) (*QuerySyncStatusResponse, error)
+	// Voter queries the governance voter registered by a validator and the nonce of its next voter registration.
+	Voter(ctx context.Context, in *QueryVoterRequest, opts ...grpc.CallOption) (*QueryVoterResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error) {
+	out := new(QueryCurrentEpochResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Query/CurrentEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastValidatorSet(ctx context.Context, in *QueryLastValidatorSetRequest, opts ...grpc.CallOption) (*QueryLastValidatorSetResponse, error) {
+	out := new(QueryLastValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Query/LastValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SettlementStatus(ctx context.Context, in *QuerySettlementStatusRequest, opts ...grpc.CallOption) (*QuerySettlementStatusResponse, error) {
+	out := new(QuerySettlementStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Query/SettlementStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SignatureRequest(ctx context.Context, in *QuerySignatureRequestRequest, opts ...grpc.CallOption) (*QuerySignatureRequestResponse, error) {
+	out := new(QuerySignatureRequestResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Query/SignatureRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Checkpoint(ctx context.Context, in *QueryCheckpointRequest, opts ...grpc.CallOption) (*QueryCheckpointResponse, error) {
+	out := new(QueryCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Query/Checkpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Checkpoints(ctx context.Context, in *QueryCheckpointsRequest, opts ...grpc.CallOption) (*QueryCheckpointsResponse, error) {
+	out := new(QueryCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Query/Checkpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SyncStatus(ctx context.Context, in *QuerySyncStatusRequest, opts ...grpc.CallOption) (*QuerySyncStatusResponse, error) {
+	out := new(QuerySyncStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Query/SyncStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Voter(ctx context.Context, in *QueryVoterRequest, opts ...grpc.CallOption) (*QueryVoterResponse, error) {
+	out := new(QueryVoterResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Query/Voter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// CurrentEpoch Queries a list of CurrentEpoch items.
+	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// CurrentEpoch Queries a list of CurrentEpoch items.
+	LastValidatorSet(context.Context, *QueryLastValidatorSetRequest) (*QueryLastValidatorSetResponse, error)
+	// SettlementStatus queries the committed epoch of every settlement chain and the epoch selected from them.
+	SettlementStatus(context.Context, *QuerySettlementStatusRequest) (*QuerySettlementStatusResponse, error)
+	// SignatureRequest queries a relay signature request and its aggregation proof by request id.
+	SignatureRequest(context.Context, *QuerySignatureRequestRequest) (*QuerySignatureRequestResponse, error)
+	// Checkpoint queries the app hash checkpoint at a given height and its aggregation proof once available.
+	Checkpoint(context.Context, *QueryCheckpointRequest) (*QueryCheckpointResponse, error)
+	// Checkpoints queries all app hash checkpoints and their aggregation proofs once available.
+	Checkpoints(context.Context, *QueryCheckpointsRequest) (*QueryCheckpointsResponse, error)
+	// SyncStatus queries the synchronization of the validator set with the relay.
+	SyncStatus(context.Context, *QuerySyncStatusRequest) (*QuerySyncStatusResponse, error)
+	// Voter queries the governance voter registered by a validator and the nonce of its next voter registration.
+	Voter(context.Context, *QueryVoterRequest) (*QueryVoterResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
+}
+func (*UnimplementedQueryServer) LastValidatorSet(ctx context.Context, req *QueryLastValidatorSetRequest) (*QueryLastValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastValidatorSet not implemented")
+}
+func (*UnimplementedQueryServer) SettlementStatus(ctx context.Context, req *QuerySettlementStatusRequest) (*QuerySettlementStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettlementStatus not implemented")
+}
+func (*UnimplementedQueryServer) SignatureRequest(ctx context.Context, req *QuerySignatureRequestRequest) (*QuerySignatureRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignatureRequest not implemented")
+}
+func (*UnimplementedQueryServer) Checkpoint(ctx context.Context, req *QueryCheckpointRequest) (*QueryCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoint not implemented")
+}
+func (*UnimplementedQueryServer) Checkpoints(ctx context.Context, req *QueryCheckpointsRequest) (*QueryCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoints not implemented")
+}
+func (*UnimplementedQueryServer) SyncStatus(ctx context.Context, req *QuerySyncStatusRequest) (*QuerySyncStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncStatus not implemented")
+}
+func (*UnimplementedQueryServer) Voter(ctx context.Context, req *QueryVoterRequest) (*QueryVoterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Voter not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symstaking.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symstaking.v1.Query/CurrentEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentEpoch(ctx, req.(*QueryCurrentEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symstaking.v1.Query/LastValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastValidatorSet(ctx, req.(*QueryLastValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SettlementStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettlementStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettlementStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symstaking.v1.Query/SettlementStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettlementStatus(ctx, req.(*QuerySettlementStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignatureRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignatureRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignatureRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symstaking.v1.Query/SignatureRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignatureRequest(ctx, req.(*QuerySignatureRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symstaking.v1.Query/Checkpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Checkpoint(ctx, req.(*QueryCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Checkpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Checkpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symstaking.v1.Query/Checkpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Checkpoints(ctx, req.(*QueryCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symstaking.v1.Query/SyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SyncStatus(ctx, req.(*QuerySyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Voter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Voter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symstaking.v1.Query/Voter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Voter(ctx, req.(*QueryVoterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.symstaking.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "CurrentEpoch",
+			Handler:    _Query_CurrentEpoch_Handler,
+		},
+		{
+			MethodName: "LastValidatorSet",
+			Handler:    _Query_LastValidatorSet_Handler,
+		},
+		{
+			MethodName: "SettlementStatus",
+			Handler:    _Query_SettlementStatus_Handler,
+		},
+		{
+			MethodName: "SignatureRequest",
+			Handler:    _Query_SignatureRequest_Handler,
+		},
+		{
+			MethodName: "Checkpoint",
+			Handler:    _Query_Checkpoint_Handler,
+		},
+		{
+			MethodName: "Checkpoints",
+			Handler:    _Query_Checkpoints_Handler,
+		},
+		{
+			MethodName: "SyncStatus",
+			Handler:    _Query_SyncStatus_Handler,
+		},
+		{
+			MethodName: "Voter",
+			Handler:    _Query_Voter_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/symstaking/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastValidatorSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastValidatorSet != nil {
+		{
+			size, err := m.LastValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.GatingChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GatingChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SelectedEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SelectedEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Policy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignatureRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignatureRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignatureRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignatureRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignatureRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignatureRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SignatureRequest.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySyncStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySyncStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySyncStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySyncStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySyncStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySyncStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectedEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RejectedEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Transition != nil {
+		{
+			size, err := m.Transition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ValidatorSetEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorSetEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCurrentEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryLastValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastValidatorSet != nil {
+		l = m.LastValidatorSet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySettlementStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySettlementStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		n += 1 + sovQuery(uint64(m.Policy))
+	}
+	if m.SelectedEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.SelectedEpoch))
+	}
+	if m.GatingChainId != 0 {
+		n += 1 + sovQuery(uint64(m.GatingChainId))
+	}
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySignatureRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignatureRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SignatureRequest.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Checkpoint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCheckpointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySyncStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySyncStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.ValidatorSetEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorSetEpoch))
+	}
+	if m.Transition != nil {
+		l = m.Transition.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RejectedEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.RejectedEpoch))
+	}
+	return n
+}
+
+func (m *QueryVoterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastValidatorSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastValidatorSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastValidatorSet == nil {
+				m.LastValidatorSet = &LastValidatorSet{}
+			}
+			if err := m.LastValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= EpochSelectionPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedEpoch", wireType)
+			}
+			m.SelectedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelectedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatingChainId", wireType)
+			}
+			m.GatingChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatingChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, SettlementChainStatus{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignatureRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignatureRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignatureRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignatureRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignatureRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignatureRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignatureRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryLastValidatorSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LastValidatorSet != nil {
-		l = m.LastValidatorSet.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -749,12 +3148,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -776,7 +3211,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -799,15 +3234,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -834,7 +3269,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Checkpoints = append(m.Checkpoints, CheckpointProof{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -859,7 +3331,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySyncStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -882,10 +3354,10 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySyncStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySyncStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -909,7 +3381,7 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySyncStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -932,13 +3404,46 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySyncStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySyncStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
@@ -957,6 +3462,80 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetEpoch", wireType)
+			}
+			m.ValidatorSetEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transition == nil {
+				m.Transition = &ValidatorSetTransition{}
+			}
+			if err := m.Transition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedEpoch", wireType)
+			}
+			m.RejectedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -978,7 +3557,7 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastValidatorSetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1001,12 +3580,46 @@ func (m *QueryLastValidatorSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastValidatorSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = append(m.ConsensusPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1028,7 +3641,7 @@ func (m *QueryLastValidatorSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastValidatorSetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1051,17 +3664,17 @@ func (m *QueryLastValidatorSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastValidatorSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastValidatorSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1071,28 +3684,43 @@ func (m *QueryLastValidatorSetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastValidatorSet == nil {
-				m.LastValidatorSet = &LastValidatorSet{}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			if err := m.LastValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	v1 "github.com/symbioticfi/relay/api/client/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// MockRelayRPCAddress is the relay rpc address selecting the mock relay client.
+const MockRelayRPCAddress = "mock-rpc"
+
+// NewRelayClient returns a client of the relay sidecar listening at address, or
+// a mock relay client reading its validator schedule from keyFile if address is
+// MockRelayRPCAddress.
+func NewRelayClient(address, keyFile string) (RelayClient, error) {
+	if address == MockRelayRPCAddress {
+		client := NewMockRelayClient(ValidatorFromFileGetter(keyFile))
+		client.SetSigners(SignersFromFileGetter(keyFile))
+		return client, nil
+	}
+
+	conn, err := GetGRPCConnection(address)
+	if err != nil {
+		return nil, err
+	}
+	return v1.NewSymbioticClient(conn), nil
+}
+
+// GetGRPCConnection returns a gRPC connection to the relay sidecar, retrying
+// failed calls.
+func GetGRPCConnection(address string) (*grpc.ClientConn, error) {
+	retryOpts := []grpc_retry.CallOption{
+		grpc_retry.WithMax(3),
+		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(time.Second)),
+	}
+	unaryInterceptors := []grpc.UnaryClientInterceptor{grpc_retry.UnaryClientInterceptor(retryOpts...)}
+	opts := []grpc.DialOption{
+		grpc.WithStreamInterceptor(grpc_retry.StreamClientInterceptor(retryOpts...)),
+		grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(unaryInterceptors...)),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(100*1024*1024), grpc.MaxCallSendMsgSize(100*1024*1024)),
+	}
+
+	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+
+	return grpc.NewClient(address, opts...)
+}
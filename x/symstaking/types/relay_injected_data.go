@@ -0,0 +1,40 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// RelayInjectedTxPrefix is prepended to the relay data the block proposer injects as the first tx of a block, so
+// that the injected tx is never confused with a user tx: a leading zero byte is an invalid protobuf field tag, so no
+// user tx can start with the prefix.
+const RelayInjectedTxPrefix = "\x00symstaking/relay-injected-data"
+
+// EncodeRelayInjectedTx encodes the relay data injected by the block proposer as the first tx of a block.
+func EncodeRelayInjectedTx(data *RelayInjectedData) ([]byte, error) {
+	bz, err := proto.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(RelayInjectedTxPrefix), bz...), nil
+}
+
+// IsRelayInjectedTx returns whether a tx holds relay data injected by the block proposer.
+func IsRelayInjectedTx(tx []byte) bool {
+	return bytes.HasPrefix(tx, []byte(RelayInjectedTxPrefix))
+}
+
+// DecodeRelayInjectedTx decodes the relay data injected by the block proposer. It returns false if the tx is not an
+// injected tx.
+func DecodeRelayInjectedTx(tx []byte) (*RelayInjectedData, bool, error) {
+	if !IsRelayInjectedTx(tx) {
+		return nil, false, nil
+	}
+
+	var data RelayInjectedData
+	if err := proto.Unmarshal(tx[len(RelayInjectedTxPrefix):], &data); err != nil {
+		return nil, true, err
+	}
+	return &data, true, nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// RelaySignPrefix is prepended to every typed message signed through the RelaySigner so that signatures over typed
+// messages can never be confused with signatures over other relay messages.
+const RelaySignPrefix = "\x19Symbiotic Cosmos Signed Message:\n"
+
+// RelaySigner allows modules to request relay signatures over domain-separated typed messages. The signature
+// requests are persisted, submitted by every node to its relay once the block is committed, and completed with the
+// aggregation proof once the relay has produced it, or expire after the signature request timeout. The aggregation
+// proofs are verified against the relay validator set of the request epoch, out of the box for the validator key tag
+// only, see Keeper.SetAggregationProofVerifier.
+type RelaySigner interface {
+	// RequestSignature requests a relay signature of msg with the given key tag and returns the signature request id.
+	RequestSignature(ctx context.Context, msg TypedMessage, keyTag uint32) (string, error)
+	// GetSignatureRequest returns a persisted signature request, including its aggregation proof once completed.
+	GetSignatureRequest(ctx context.Context, requestID string) (SignatureRequest, error)
+	// ValidateKeyTag returns an error if RequestSignature rejects the key tag, as no aggregation proof of the messages
+	// signed with it can be verified.
+	ValidateKeyTag(ctx context.Context, keyTag uint32) error
+}
+
+// SignatureRequestID returns the id of the request to sign a message with a key tag in a relay epoch: the hex encoded
+// sha256 hash of the big endian key tag and epoch followed by the message.
+func SignatureRequestID(keyTag uint32, epoch uint64, message []byte) string {
+	hasher := sha256.New()
+	hasher.Write(binary.BigEndian.AppendUint32(nil, keyTag))
+	hasher.Write(binary.BigEndian.AppendUint64(nil, epoch))
+	hasher.Write(message)
+	return hexutil.Encode(hasher.Sum(nil))
+}
+
+// TypedMessage is a module defined message to be signed by the relay.
+type TypedMessage struct {
+	// Module is the name of the requesting module.
+	Module string
+	// Type identifies the message type within the module, e.g. "withdrawal".
+	Type string
+	// Payload is the encoded message.
+	Payload []byte
+}
+
+// SignBytes returns the domain-separated bytes signed by the relay for the message on the given chain. Every field is
+// prefixed by its big endian uint32 length so the encoding is unambiguous and cheap to reproduce in EVM contracts:
+//
+//	RelaySignPrefix || len(chainID) || chainID || len(module) || module || len(type) || type || len(payload) || payload
+func (m TypedMessage) SignBytes(chainID string) []byte {
+	fields := [][]byte{[]byte(chainID), []byte(m.Module), []byte(m.Type), m.Payload}
+
+	size := len(RelaySignPrefix)
+	for _, f := range fields {
+		size += 4 + len(f)
+	}
+
+	bz := make([]byte, 0, size)
+	bz = append(bz, RelaySignPrefix...)
+	for _, f := range fields {
+		bz = binary.BigEndian.AppendUint32(bz, uint32(len(f)))
+		bz = append(bz, f...)
+	}
+	return bz
+}
+
+// Validate performs a stateless validation of the typed message.
+func (m TypedMessage) Validate() error {
+	if m.Module == "" {
+		return ErrInvalidTypedMessage.Wrap("module cannot be empty")
+	}
+	if m.Type == "" {
+		return ErrInvalidTypedMessage.Wrap("type cannot be empty")
+	}
+	if len(m.Payload) == 0 {
+		return ErrInvalidTypedMessage.Wrap("payload cannot be empty")
+	}
+	return nil
+}
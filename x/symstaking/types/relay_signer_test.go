@@ -0,0 +1,32 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func TestTypedMessage_SignBytes(t *testing.T) {
+	msg := types.TypedMessage{Module: "bridge", Type: "withdrawal", Payload: []byte{0xde, 0xad}}
+
+	expected := append([]byte(types.RelaySignPrefix),
+		0, 0, 0, 5, 'c', 'h', 'a', 'i', 'n',
+		0, 0, 0, 6, 'b', 'r', 'i', 'd', 'g', 'e',
+		0, 0, 0, 10, 'w', 'i', 't', 'h', 'd', 'r', 'a', 'w', 'a', 'l',
+		0, 0, 0, 2, 0xde, 0xad,
+	)
+	require.Equal(t, expected, msg.SignBytes("chain"))
+
+	// the same payload under another domain never yields the same bytes
+	require.NotEqual(t, msg.SignBytes("chain"), msg.SignBytes("other-chain"))
+	require.NotEqual(t, msg.SignBytes("chain"), types.TypedMessage{Module: "bridgew", Type: "ithdrawal", Payload: msg.Payload}.SignBytes("chain"))
+}
+
+func TestTypedMessage_Validate(t *testing.T) {
+	require.NoError(t, types.TypedMessage{Module: "oracle", Type: "attestation", Payload: []byte{1}}.Validate())
+	require.ErrorIs(t, types.TypedMessage{Type: "attestation", Payload: []byte{1}}.Validate(), types.ErrInvalidTypedMessage)
+	require.ErrorIs(t, types.TypedMessage{Module: "oracle", Payload: []byte{1}}.Validate(), types.ErrInvalidTypedMessage)
+	require.ErrorIs(t, types.TypedMessage{Module: "oracle", Type: "attestation"}.Validate(), types.ErrInvalidTypedMessage)
+}
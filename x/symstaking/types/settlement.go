@@ -0,0 +1,84 @@
+package types
+
+import (
+	"slices"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// SettlementStatus computes the status of every settlement chain from their last committed epochs, keyed by
+// settlement chain id, and the epoch selected from them by the configured epoch selection policy.
+func (p Params) SettlementStatus(committed map[uint64]uint64) (*QuerySettlementStatusResponse, error) {
+	chainIDs := make([]uint64, 0, len(committed))
+	for id := range committed {
+		chainIDs = append(chainIDs, id)
+	}
+	slices.Sort(chainIDs)
+
+	considered := slices.Clone(chainIDs)
+	if p.EpochSelectionPolicy == EpochSelectionPolicy_EPOCH_SELECTION_POLICY_ALLOW_LIST ||
+		(p.EpochSelectionPolicy == EpochSelectionPolicy_EPOCH_SELECTION_POLICY_QUORUM && len(p.SettlementChainIds) > 0) {
+		considered = make([]uint64, 0, len(p.SettlementChainIds))
+		for _, id := range p.SettlementChainIds {
+			if _, ok := committed[id]; !ok {
+				if p.EpochSelectionPolicy == EpochSelectionPolicy_EPOCH_SELECTION_POLICY_ALLOW_LIST {
+					return nil, errorsmod.Wrapf(ErrSettlementChainNotFound, "allow-listed settlement chain %d is not known to the relay", id)
+				}
+				continue
+			}
+			considered = append(considered, id)
+		}
+		slices.Sort(considered)
+	}
+
+	resp := &QuerySettlementStatusResponse{
+		Policy: p.EpochSelectionPolicy,
+		Chains: make([]SettlementChainStatus, len(chainIDs)),
+	}
+	for i, id := range chainIDs {
+		resp.Chains[i] = SettlementChainStatus{
+			ChainId:            id,
+			LastCommittedEpoch: committed[id],
+			Considered:         slices.Contains(considered, id),
+		}
+	}
+	if len(considered) == 0 {
+		if p.EpochSelectionPolicy == EpochSelectionPolicy_EPOCH_SELECTION_POLICY_QUORUM {
+			return nil, errorsmod.Wrapf(ErrSettlementQuorumNotMet, "no settlement chain available, quorum is %d", p.SettlementQuorum)
+		}
+		return resp, nil
+	}
+
+	// order the considered chains by committed epoch, highest first; ties are broken by chain id so the gating
+	// chain is deterministic
+	sort.SliceStable(considered, func(i, j int) bool {
+		return committed[considered[i]] > committed[considered[j]]
+	})
+
+	gatingIdx := len(considered) - 1
+	if p.EpochSelectionPolicy == EpochSelectionPolicy_EPOCH_SELECTION_POLICY_QUORUM {
+		if int(p.SettlementQuorum) > len(considered) {
+			return nil, errorsmod.Wrapf(ErrSettlementQuorumNotMet, "quorum is %d but only %d settlement chains are available", p.SettlementQuorum, len(considered))
+		}
+		// the quorum-th highest epoch is the highest epoch committed by at least quorum chains
+		gatingIdx = int(p.SettlementQuorum) - 1
+	}
+
+	resp.GatingChainId = considered[gatingIdx]
+	resp.SelectedEpoch = committed[resp.GatingChainId]
+	for i := range resp.Chains {
+		resp.Chains[i].Gating = resp.Chains[i].ChainId == resp.GatingChainId
+	}
+	return resp, nil
+}
+
+// SelectEpoch returns the epoch selected by the configured epoch selection policy from the last committed epochs of
+// the settlement chains, keyed by settlement chain id.
+func (p Params) SelectEpoch(committed map[uint64]uint64) (uint64, error) {
+	status, err := p.SettlementStatus(committed)
+	if err != nil {
+		return 0, err
+	}
+	return status.SelectedEpoch, nil
+}
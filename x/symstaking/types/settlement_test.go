@@ -0,0 +1,195 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func TestParams_SettlementStatus(t *testing.T) {
+	committed := map[uint64]uint64{1: 10, 2: 7, 3: 12}
+
+	tests := []struct {
+		desc       string
+		policy     types.EpochSelectionPolicy
+		chainIDs   []uint64
+		quorum     uint32
+		committed  map[uint64]uint64
+		expEpoch   uint64
+		expGating  uint64
+		expErr     error
+		considered []uint64
+	}{
+		{
+			desc:       "minimum over all chains",
+			policy:     types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_MINIMUM,
+			committed:  committed,
+			expEpoch:   7,
+			expGating:  2,
+			considered: []uint64{1, 2, 3},
+		},
+		{
+			desc:       "minimum with a chain that never committed",
+			policy:     types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_MINIMUM,
+			committed:  map[uint64]uint64{1: 10, 2: 0},
+			expEpoch:   0,
+			expGating:  2,
+			considered: []uint64{1, 2},
+		},
+		{
+			desc:      "no settlement chains",
+			policy:    types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_MINIMUM,
+			committed: map[uint64]uint64{},
+		},
+		{
+			desc:       "allow list ignores lagging chain",
+			policy:     types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_ALLOW_LIST,
+			chainIDs:   []uint64{3, 1},
+			committed:  committed,
+			expEpoch:   10,
+			expGating:  1,
+			considered: []uint64{1, 3},
+		},
+		{
+			desc:      "allow list with unknown chain",
+			policy:    types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_ALLOW_LIST,
+			chainIDs:  []uint64{1, 4},
+			committed: committed,
+			expErr:    types.ErrSettlementChainNotFound,
+		},
+		{
+			desc:       "quorum of two over all chains",
+			policy:     types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_QUORUM,
+			quorum:     2,
+			committed:  committed,
+			expEpoch:   10,
+			expGating:  1,
+			considered: []uint64{1, 2, 3},
+		},
+		{
+			desc:       "quorum of one selects the highest epoch",
+			policy:     types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_QUORUM,
+			quorum:     1,
+			committed:  committed,
+			expEpoch:   12,
+			expGating:  3,
+			considered: []uint64{1, 2, 3},
+		},
+		{
+			desc:       "quorum restricted to listed chains",
+			policy:     types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_QUORUM,
+			chainIDs:   []uint64{2, 3},
+			quorum:     2,
+			committed:  committed,
+			expEpoch:   7,
+			expGating:  2,
+			considered: []uint64{2, 3},
+		},
+		{
+			desc:      "quorum not met",
+			policy:    types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_QUORUM,
+			quorum:    4,
+			committed: committed,
+			expErr:    types.ErrSettlementQuorumNotMet,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.EpochSelectionPolicy = tc.policy
+			params.SettlementChainIds = tc.chainIDs
+			params.SettlementQuorum = tc.quorum
+
+			status, err := params.SettlementStatus(tc.committed)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expEpoch, status.SelectedEpoch)
+			require.Equal(t, tc.expGating, status.GatingChainId)
+			require.Len(t, status.Chains, len(tc.committed))
+
+			var considered []uint64
+			for _, chain := range status.Chains {
+				require.Equal(t, tc.committed[chain.ChainId], chain.LastCommittedEpoch)
+				require.Equal(t, chain.ChainId == tc.expGating && len(tc.considered) > 0, chain.Gating)
+				if chain.Considered {
+					considered = append(considered, chain.ChainId)
+				}
+			}
+			require.Equal(t, tc.considered, considered)
+		})
+	}
+}
+
+func TestParams_ValidateEpochSelection(t *testing.T) {
+	tests := []struct {
+		desc     string
+		policy   types.EpochSelectionPolicy
+		chainIDs []uint64
+		quorum   uint32
+		valid    bool
+	}{
+		{"minimum", types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_MINIMUM, nil, 0, true},
+		{"allow list", types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_ALLOW_LIST, []uint64{1}, 0, true},
+		{"empty allow list", types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_ALLOW_LIST, nil, 0, false},
+		{"duplicate chain ids", types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_ALLOW_LIST, []uint64{1, 1}, 0, false},
+		{"quorum", types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_QUORUM, nil, 2, true},
+		{"zero quorum", types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_QUORUM, nil, 0, false},
+		{"quorum above listed chains", types.EpochSelectionPolicy_EPOCH_SELECTION_POLICY_QUORUM, []uint64{1, 2}, 3, false},
+		{"unknown policy", types.EpochSelectionPolicy(7), nil, 0, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.EpochSelectionPolicy = tc.policy
+			params.SettlementChainIds = tc.chainIDs
+			params.SettlementQuorum = tc.quorum
+
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidEpochSelection)
+			}
+		})
+	}
+}
+
+func TestParams_ValidatePollSchedule(t *testing.T) {
+	tests := []struct {
+		desc       string
+		schedule   types.PollSchedule
+		interval   int64
+		identifier string
+		grace      int64
+		valid      bool
+	}{
+		{"height", types.PollSchedule_POLL_SCHEDULE_HEIGHT, 10, "", 0, true},
+		{"height with grace blocks", types.PollSchedule_POLL_SCHEDULE_HEIGHT, 10, "", 3, true},
+		{"height without interval", types.PollSchedule_POLL_SCHEDULE_HEIGHT, 0, "", 0, false},
+		{"negative grace blocks", types.PollSchedule_POLL_SCHEDULE_HEIGHT, 10, "", -1, false},
+		{"time", types.PollSchedule_POLL_SCHEDULE_TIME, 0, "minute", 5, true},
+		{"time without identifier", types.PollSchedule_POLL_SCHEDULE_TIME, 10, "", 0, false},
+		{"unknown schedule", types.PollSchedule(7), 10, "minute", 0, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.PollSchedule = tc.schedule
+			params.EpochCheckInterval = tc.interval
+			params.PollEpochIdentifier = tc.identifier
+			params.PollGraceBlocks = tc.grace
+
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidPollSchedule)
+			}
+		})
+	}
+}
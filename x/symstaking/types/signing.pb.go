@@ -0,0 +1,1420 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/symstaking/v1/signing.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SignatureStatus defines the status of a relay signature request.
+type SignatureStatus int32
+
+const (
+	// SIGNATURE_STATUS_UNSPECIFIED defines an unknown status.
+	SignatureStatus_SIGNATURE_STATUS_UNSPECIFIED SignatureStatus = 0
+	// SIGNATURE_STATUS_PENDING defines a request the relay has not aggregated a proof for yet.
+	SignatureStatus_SIGNATURE_STATUS_PENDING SignatureStatus = 1
+	// SIGNATURE_STATUS_COMPLETED defines a request whose aggregation proof has been stored.
+	SignatureStatus_SIGNATURE_STATUS_COMPLETED SignatureStatus = 2
+	// SIGNATURE_STATUS_EXPIRED defines a request whose aggregation proof was not stored within the signature request
+	// timeout.
+	SignatureStatus_SIGNATURE_STATUS_EXPIRED SignatureStatus = 3
+)
+
+var SignatureStatus_name = map[int32]string{
+	0: "SIGNATURE_STATUS_UNSPECIFIED",
+	1: "SIGNATURE_STATUS_PENDING",
+	2: "SIGNATURE_STATUS_COMPLETED",
+	3: "SIGNATURE_STATUS_EXPIRED",
+}
+
+var SignatureStatus_value = map[string]int32{
+	"SIGNATURE_STATUS_UNSPECIFIED": 0,
+	"SIGNATURE_STATUS_PENDING":     1,
+	"SIGNATURE_STATUS_COMPLETED":   2,
+	"SIGNATURE_STATUS_EXPIRED":     3,
+}
+
+func (x SignatureStatus) String() string {
+	return proto.EnumName(SignatureStatus_name, int32(x))
+}
+
+func (SignatureStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ed71ac80baffa261, []int{0}
+}
+
+// AggregationProof is the aggregated signature produced by the relay for a signature request.
+type AggregationProof struct {
+	// message_hash is the hash of the signed message as computed by the relay.
+	MessageHash []byte `protobuf:"bytes,1,opt,name=message_hash,json=messageHash,proto3" json:"message_hash,omitempty"`
+	// proof is the aggregated signature proof verifiable on the settlement chains. The proof of a message signed with
+	// the validator key tag is the concatenation of the 32 bytes ed25519 public key and 64 bytes signature of the
+	// message hash of each signer.
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *AggregationProof) Reset()         { *m = AggregationProof{} }
+func (m *AggregationProof) String() string { return proto.CompactTextString(m) }
+func (*AggregationProof) ProtoMessage()    {}
+func (*AggregationProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed71ac80baffa261, []int{0}
+}
+func (m *AggregationProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregationProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregationProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregationProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregationProof.Merge(m, src)
+}
+func (m *AggregationProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregationProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregationProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregationProof proto.InternalMessageInfo
+
+func (m *AggregationProof) GetMessageHash() []byte {
+	if m != nil {
+		return m.MessageHash
+	}
+	return nil
+}
+
+func (m *AggregationProof) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// SignatureRequest is a request made to the relay to sign a message on behalf of a module.
+type SignatureRequest struct {
+	// request_id is the identifier of the request, derived from the key tag, epoch and message.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// module is the name of the module that requested the signature.
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// message_type is the module defined type of the signed message.
+	MessageType string `protobuf:"bytes,3,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
+	// key_tag is the relay key tag used to sign the message.
+	KeyTag uint32 `protobuf:"varint,4,opt,name=key_tag,json=keyTag,proto3" json:"key_tag,omitempty"`
+	// message is the exact byte string sent to the relay for signing.
+	Message []byte `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// epoch is the relay epoch the message is signed in.
+	Epoch uint64 `protobuf:"varint,6,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// height is the block height at which the request was made.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// status is the status of the request.
+	Status SignatureStatus `protobuf:"varint,8,opt,name=status,proto3,enum=cosmos.symstaking.v1.SignatureStatus" json:"status,omitempty"`
+	// proof is the aggregation proof, set once the request is completed.
+	Proof *AggregationProof `protobuf:"bytes,9,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *SignatureRequest) Reset()         { *m = SignatureRequest{} }
+func (m *SignatureRequest) String() string { return proto.CompactTextString(m) }
+func (*SignatureRequest) ProtoMessage()    {}
+func (*SignatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed71ac80baffa261, []int{1}
+}
+func (m *SignatureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignatureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureRequest.Merge(m, src)
+}
+func (m *SignatureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignatureRequest proto.InternalMessageInfo
+
+func (m *SignatureRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *SignatureRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *SignatureRequest) GetMessageType() string {
+	if m != nil {
+		return m.MessageType
+	}
+	return ""
+}
+
+func (m *SignatureRequest) GetKeyTag() uint32 {
+	if m != nil {
+		return m.KeyTag
+	}
+	return 0
+}
+
+func (m *SignatureRequest) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *SignatureRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *SignatureRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SignatureRequest) GetStatus() SignatureStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SignatureStatus_SIGNATURE_STATUS_UNSPECIFIED
+}
+
+func (m *SignatureRequest) GetProof() *AggregationProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// SignatureProof pairs a signature request with the aggregation proof fetched from the relay.
+type SignatureProof struct {
+	RequestId string           `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Proof     AggregationProof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof"`
+}
+
+func (m *SignatureProof) Reset()         { *m = SignatureProof{} }
+func (m *SignatureProof) String() string { return proto.CompactTextString(m) }
+func (*SignatureProof) ProtoMessage()    {}
+func (*SignatureProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed71ac80baffa261, []int{2}
+}
+func (m *SignatureProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignatureProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignatureProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignatureProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureProof.Merge(m, src)
+}
+func (m *SignatureProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignatureProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignatureProof proto.InternalMessageInfo
+
+func (m *SignatureProof) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *SignatureProof) GetProof() AggregationProof {
+	if m != nil {
+		return m.Proof
+	}
+	return AggregationProof{}
+}
+
+// RelayInjectedData is the data injected by the block proposer as the first transaction of a block, prefixed
+// with RelayInjectedTxPrefix. The proposer omits it if it could not reach its relay.
+type RelayInjectedData struct {
+	// epoch is the relay epoch selected by the proposer.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// proofs are the aggregation proofs of pending signature requests fetched by the proposer.
+	Proofs []SignatureProof `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs"`
+}
+
+func (m *RelayInjectedData) Reset()         { *m = RelayInjectedData{} }
+func (m *RelayInjectedData) String() string { return proto.CompactTextString(m) }
+func (*RelayInjectedData) ProtoMessage()    {}
+func (*RelayInjectedData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed71ac80baffa261, []int{3}
+}
+func (m *RelayInjectedData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayInjectedData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayInjectedData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayInjectedData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayInjectedData.Merge(m, src)
+}
+func (m *RelayInjectedData) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayInjectedData) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayInjectedData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayInjectedData proto.InternalMessageInfo
+
+func (m *RelayInjectedData) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *RelayInjectedData) GetProofs() []SignatureProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.symstaking.v1.SignatureStatus", SignatureStatus_name, SignatureStatus_value)
+	proto.RegisterType((*AggregationProof)(nil), "cosmos.symstaking.v1.AggregationProof")
+	proto.RegisterType((*SignatureRequest)(nil), "cosmos.symstaking.v1.SignatureRequest")
+	proto.RegisterType((*SignatureProof)(nil), "cosmos.symstaking.v1.SignatureProof")
+	proto.RegisterType((*RelayInjectedData)(nil), "cosmos.symstaking.v1.RelayInjectedData")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/symstaking/v1/signing.proto", fileDescriptor_ed71ac80baffa261)
+}
+
+var fileDescriptor_ed71ac80baffa261 = []byte{
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xcd, 0x24, 0xad, 0xfb, 0x65, 0xda, 0xaf, 0xb8, 0xa3, 0x0a, 0xac, 0xaa, 0x18, 0x13, 0x01,
+	0xb2, 0x2a, 0x61, 0xab, 0x61, 0x0b, 0x8b, 0xa4, 0x31, 0xc1, 0x02, 0x42, 0x34, 0x76, 0x24, 0xc4,
+	0x26, 0x9a, 0x26, 0xc3, 0xd8, 0xa4, 0xf6, 0x84, 0xcc, 0xa4, 0xaa, 0x9f, 0x02, 0x1e, 0x83, 0x25,
+	0x8f, 0x51, 0xb1, 0xea, 0x92, 0x15, 0x42, 0xc9, 0x82, 0xd7, 0x40, 0xfe, 0x49, 0x1b, 0xb5, 0x91,
+	0xca, 0xc6, 0x9e, 0x7b, 0xef, 0x39, 0xf7, 0x1e, 0x9f, 0xf1, 0x85, 0xb5, 0x01, 0x17, 0x11, 0x17,
+	0xb6, 0x48, 0x22, 0x21, 0xc9, 0x28, 0x8c, 0x99, 0x7d, 0x7a, 0x68, 0x8b, 0x90, 0xc5, 0x61, 0xcc,
+	0xac, 0xf1, 0x84, 0x4b, 0x8e, 0x76, 0x73, 0x8c, 0x75, 0x85, 0xb1, 0x4e, 0x0f, 0xf7, 0x76, 0x48,
+	0x14, 0xc6, 0xdc, 0xce, 0x9e, 0x39, 0x70, 0x6f, 0x97, 0x71, 0xc6, 0xb3, 0xa3, 0x9d, 0x9e, 0xf2,
+	0x6c, 0xed, 0x35, 0x54, 0x1b, 0x8c, 0x4d, 0x28, 0x23, 0x32, 0xe4, 0x71, 0x77, 0xc2, 0xf9, 0x47,
+	0xf4, 0x10, 0x6e, 0x45, 0x54, 0x08, 0xc2, 0x68, 0x3f, 0x20, 0x22, 0xd0, 0x80, 0x01, 0xcc, 0x2d,
+	0xbc, 0x59, 0xe4, 0x5e, 0x11, 0x11, 0xa0, 0x5d, 0xb8, 0x3e, 0x4e, 0xb1, 0x5a, 0x39, 0xab, 0xe5,
+	0x41, 0xed, 0x47, 0x19, 0xaa, 0x5e, 0xc8, 0x62, 0x22, 0xa7, 0x13, 0x8a, 0xe9, 0xe7, 0x29, 0x15,
+	0x12, 0xdd, 0x87, 0x70, 0x92, 0x1f, 0xfb, 0xe1, 0x30, 0xeb, 0x55, 0xc5, 0xd5, 0x22, 0xe3, 0x0e,
+	0xd1, 0x5d, 0xa8, 0x44, 0x7c, 0x38, 0x3d, 0xa1, 0x59, 0xab, 0x2a, 0x2e, 0xa2, 0x65, 0x11, 0x32,
+	0x19, 0x53, 0xad, 0x92, 0x55, 0x17, 0x22, 0xfc, 0x64, 0x4c, 0xd1, 0x3d, 0xb8, 0x31, 0xa2, 0x49,
+	0x5f, 0x12, 0xa6, 0xad, 0x19, 0xc0, 0xfc, 0x1f, 0x2b, 0x23, 0x9a, 0xf8, 0x84, 0x21, 0x0d, 0x6e,
+	0x14, 0x38, 0x6d, 0x3d, 0xd3, 0xb7, 0x08, 0x53, 0xdd, 0x74, 0xcc, 0x07, 0x81, 0xa6, 0x18, 0xc0,
+	0x5c, 0xc3, 0x79, 0x90, 0x6a, 0x08, 0x68, 0xc8, 0x02, 0xa9, 0x6d, 0x18, 0xc0, 0xac, 0xe0, 0x22,
+	0x42, 0x2f, 0xa0, 0x22, 0x24, 0x91, 0x53, 0xa1, 0xfd, 0x67, 0x00, 0x73, 0xbb, 0xfe, 0xd8, 0x5a,
+	0x65, 0xb6, 0x75, 0xf9, 0xc9, 0x5e, 0x06, 0xc6, 0x05, 0x09, 0x3d, 0x5f, 0x98, 0x54, 0x35, 0x80,
+	0xb9, 0x59, 0x7f, 0xb2, 0x9a, 0x7d, 0xdd, 0xfe, 0x85, 0x99, 0x67, 0x70, 0xfb, 0xb2, 0x71, 0x7e,
+	0x2f, 0xb7, 0x38, 0xd9, 0x5e, 0xbe, 0x93, 0x7f, 0x1e, 0xd7, 0xac, 0x9e, 0xff, 0x7a, 0x50, 0xfa,
+	0xf6, 0xe7, 0xfb, 0x01, 0x58, 0x4c, 0x8e, 0xe0, 0x0e, 0xa6, 0x27, 0x24, 0x71, 0xe3, 0x4f, 0x74,
+	0x20, 0xe9, 0xb0, 0x45, 0x24, 0xb9, 0x72, 0x0e, 0x2c, 0x3b, 0xd7, 0x84, 0x4a, 0xc6, 0x11, 0x5a,
+	0xd9, 0xa8, 0x98, 0x9b, 0xf5, 0x47, 0xb7, 0x38, 0x94, 0x8f, 0x5c, 0x4b, 0x47, 0xe2, 0x82, 0x79,
+	0xf0, 0x05, 0xc0, 0x3b, 0xd7, 0x2c, 0x44, 0x06, 0xdc, 0xf7, 0xdc, 0x76, 0xa7, 0xe1, 0xf7, 0xb0,
+	0xd3, 0xf7, 0xfc, 0x86, 0xdf, 0xf3, 0xfa, 0xbd, 0x8e, 0xd7, 0x75, 0x8e, 0xdc, 0x97, 0xae, 0xd3,
+	0x52, 0x4b, 0x68, 0x1f, 0x6a, 0x37, 0x10, 0x5d, 0xa7, 0xd3, 0x72, 0x3b, 0x6d, 0x15, 0x20, 0x1d,
+	0xee, 0xdd, 0xa8, 0x1e, 0xbd, 0x7b, 0xdb, 0x7d, 0xe3, 0xf8, 0x4e, 0x4b, 0x2d, 0xaf, 0x64, 0x3b,
+	0xef, 0xbb, 0x2e, 0x76, 0x5a, 0x6a, 0xa5, 0xe9, 0x9e, 0xcf, 0x74, 0x70, 0x31, 0xd3, 0xc1, 0xef,
+	0x99, 0x0e, 0xbe, 0xce, 0xf5, 0xd2, 0xc5, 0x5c, 0x2f, 0xfd, 0x9c, 0xeb, 0xa5, 0x0f, 0x36, 0x0b,
+	0x65, 0x30, 0x3d, 0xb6, 0x06, 0x3c, 0xb2, 0x8b, 0xe5, 0xcc, 0x5f, 0x4f, 0xc5, 0x70, 0x64, 0x9f,
+	0x2d, 0x6f, 0x6a, 0xfa, 0xd7, 0x8a, 0x63, 0x25, 0x5b, 0xb3, 0x67, 0x7f, 0x07, 0x00, 0x10, 0x7e,
+	0xab, 0x0f, 0xcb, 0x03, 0x00, 0x00,
+}
+
+func (m *AggregationProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregationProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregationProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MessageHash) > 0 {
+		i -= len(m.MessageHash)
+		copy(dAtA[i:], m.MessageHash)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.MessageHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignatureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignatureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignatureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSigning(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Status != 0 {
+		i = encodeVarintSigning(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Height != 0 {
+		i = encodeVarintSigning(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintSigning(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.KeyTag != 0 {
+		i = encodeVarintSigning(dAtA, i, uint64(m.KeyTag))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MessageType) > 0 {
+		i -= len(m.MessageType)
+		copy(dAtA[i:], m.MessageType)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.MessageType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignatureProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignatureProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignatureProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSigning(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayInjectedData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayInjectedData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayInjectedData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSigning(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintSigning(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSigning(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSigning(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AggregationProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageHash)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	return n
+}
+
+func (m *SignatureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	l = len(m.MessageType)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	if m.KeyTag != 0 {
+		n += 1 + sovSigning(uint64(m.KeyTag))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovSigning(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSigning(uint64(m.Height))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSigning(uint64(m.Status))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	return n
+}
+
+func (m *SignatureProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovSigning(uint64(l))
+	return n
+}
+
+func (m *RelayInjectedData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovSigning(uint64(m.Epoch))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovSigning(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSigning(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSigning(x uint64) (n int) {
+	return sovSigning(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AggregationProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregationProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregationProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageHash = append(m.MessageHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageHash == nil {
+				m.MessageHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignatureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignatureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyTag", wireType)
+			}
+			m.KeyTag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyTag |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SignatureStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &AggregationProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignatureProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignatureProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignatureProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayInjectedData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayInjectedData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayInjectedData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, SignatureProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSigning(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSigning
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSigning
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSigning
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSigning
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSigning        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSigning          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSigning = fmt.Errorf("proto: unexpected end of group")
+)
@@ -154,10 +154,232 @@ func (m *LastValidatorSet) GetUpdates() []types.ValidatorUpdate {
 	return nil
 }
 
+// ValidatorSetTransition is a relay validator set being applied over several blocks.
+type ValidatorSetTransition struct {
+	// epoch is the relay epoch of the target validator set.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// target is the relay validator set of the epoch.
+	Target []types.ValidatorUpdate `protobuf:"bytes,2,rep,name=target,proto3" json:"target"`
+}
+
+func (m *ValidatorSetTransition) Reset()         { *m = ValidatorSetTransition{} }
+func (m *ValidatorSetTransition) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetTransition) ProtoMessage()    {}
+func (*ValidatorSetTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdb2d52f09028236, []int{2}
+}
+func (m *ValidatorSetTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetTransition.Merge(m, src)
+}
+func (m *ValidatorSetTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetTransition proto.InternalMessageInfo
+
+func (m *ValidatorSetTransition) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorSetTransition) GetTarget() []types.ValidatorUpdate {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+// RelaySyncState is the state of the synchronization of the validator set with the relay.
+type RelaySyncState struct {
+	// paused reports whether relay polling and relay validator set updates are paused.
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// paused_height is the height at which the relay sync was paused.
+	PausedHeight int64 `protobuf:"varint,2,opt,name=paused_height,json=pausedHeight,proto3" json:"paused_height,omitempty"`
+	// reason is the reason given by the authority for the last override.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// forced reports whether the validator set is pinned by MsgForceValidatorSet instead of taken from the relay.
+	Forced bool `protobuf:"varint,4,opt,name=forced,proto3" json:"forced,omitempty"`
+	// forced_height is the height of the last MsgForceValidatorSet.
+	ForcedHeight int64 `protobuf:"varint,5,opt,name=forced_height,json=forcedHeight,proto3" json:"forced_height,omitempty"`
+	// pending_validator_set is the validator set pinned by MsgForceValidatorSet, applied in the next EndBlock.
+	PendingValidatorSet []types.ValidatorUpdate `protobuf:"bytes,6,rep,name=pending_validator_set,json=pendingValidatorSet,proto3" json:"pending_validator_set"`
+}
+
+func (m *RelaySyncState) Reset()         { *m = RelaySyncState{} }
+func (m *RelaySyncState) String() string { return proto.CompactTextString(m) }
+func (*RelaySyncState) ProtoMessage()    {}
+func (*RelaySyncState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdb2d52f09028236, []int{3}
+}
+func (m *RelaySyncState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelaySyncState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelaySyncState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelaySyncState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelaySyncState.Merge(m, src)
+}
+func (m *RelaySyncState) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelaySyncState) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelaySyncState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelaySyncState proto.InternalMessageInfo
+
+func (m *RelaySyncState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *RelaySyncState) GetPausedHeight() int64 {
+	if m != nil {
+		return m.PausedHeight
+	}
+	return 0
+}
+
+func (m *RelaySyncState) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RelaySyncState) GetForced() bool {
+	if m != nil {
+		return m.Forced
+	}
+	return false
+}
+
+func (m *RelaySyncState) GetForcedHeight() int64 {
+	if m != nil {
+		return m.ForcedHeight
+	}
+	return 0
+}
+
+func (m *RelaySyncState) GetPendingValidatorSet() []types.ValidatorUpdate {
+	if m != nil {
+		return m.PendingValidatorSet
+	}
+	return nil
+}
+
+// SettlementChainStatus describes the committed epoch of a single settlement chain.
+type SettlementChainStatus struct {
+	// chain_id is the settlement chain identifier as reported by the relay.
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// last_committed_epoch is the last epoch committed on the settlement chain.
+	LastCommittedEpoch uint64 `protobuf:"varint,2,opt,name=last_committed_epoch,json=lastCommittedEpoch,proto3" json:"last_committed_epoch,omitempty"`
+	// considered reports whether the chain is taken into account by the epoch selection policy.
+	Considered bool `protobuf:"varint,3,opt,name=considered,proto3" json:"considered,omitempty"`
+	// gating reports whether the chain is the one holding back the selected epoch.
+	Gating bool `protobuf:"varint,4,opt,name=gating,proto3" json:"gating,omitempty"`
+}
+
+func (m *SettlementChainStatus) Reset()         { *m = SettlementChainStatus{} }
+func (m *SettlementChainStatus) String() string { return proto.CompactTextString(m) }
+func (*SettlementChainStatus) ProtoMessage()    {}
+func (*SettlementChainStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdb2d52f09028236, []int{4}
+}
+func (m *SettlementChainStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettlementChainStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettlementChainStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettlementChainStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementChainStatus.Merge(m, src)
+}
+func (m *SettlementChainStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettlementChainStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementChainStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettlementChainStatus proto.InternalMessageInfo
+
+func (m *SettlementChainStatus) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *SettlementChainStatus) GetLastCommittedEpoch() uint64 {
+	if m != nil {
+		return m.LastCommittedEpoch
+	}
+	return 0
+}
+
+func (m *SettlementChainStatus) GetConsidered() bool {
+	if m != nil {
+		return m.Considered
+	}
+	return false
+}
+
+func (m *SettlementChainStatus) GetGating() bool {
+	if m != nil {
+		return m.Gating
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.symstaking.v1.Infraction", Infraction_name, Infraction_value)
 	proto.RegisterType((*StoreEpoch)(nil), "cosmos.symstaking.v1.StoreEpoch")
 	proto.RegisterType((*LastValidatorSet)(nil), "cosmos.symstaking.v1.LastValidatorSet")
+	proto.RegisterType((*ValidatorSetTransition)(nil), "cosmos.symstaking.v1.ValidatorSetTransition")
+	proto.RegisterType((*RelaySyncState)(nil), "cosmos.symstaking.v1.RelaySyncState")
+	proto.RegisterType((*SettlementChainStatus)(nil), "cosmos.symstaking.v1.SettlementChainStatus")
 }
 
 func init() {
@@ -165,31 +387,44 @@ func init() {
 }
 
 var fileDescriptor_fdb2d52f09028236 = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x4d, 0x8e, 0xda, 0x30,
-	0x1c, 0xc5, 0x13, 0xfa, 0xa5, 0xba, 0x9b, 0x34, 0x45, 0x2d, 0xa2, 0x55, 0x4a, 0xb3, 0x42, 0x48,
-	0x8d, 0x45, 0x7b, 0x82, 0x02, 0xa1, 0xb2, 0x44, 0x43, 0x45, 0xa0, 0x95, 0x2a, 0x55, 0xc8, 0x24,
-	0x6e, 0xb0, 0x20, 0xfe, 0x47, 0xb1, 0x41, 0xe5, 0x16, 0x3d, 0x46, 0x97, 0x3d, 0x06, 0x4b, 0x96,
-	0x5d, 0x8d, 0x46, 0xb0, 0x98, 0x6b, 0x8c, 0xf2, 0xc1, 0x30, 0x33, 0x62, 0x63, 0x3f, 0xfb, 0xfd,
-	0xec, 0x67, 0x3f, 0x64, 0x07, 0x20, 0x63, 0x90, 0x58, 0x6e, 0x62, 0xa9, 0xe8, 0x82, 0x8b, 0x08,
-	0xaf, 0xdb, 0xb8, 0x94, 0x4e, 0x92, 0x82, 0x02, 0xb3, 0x5a, 0x30, 0xce, 0x89, 0x71, 0xd6, 0xed,
-	0xfa, 0x73, 0x1a, 0x73, 0x01, 0x38, 0x1f, 0x0b, 0xb0, 0x5e, 0x8d, 0x20, 0x82, 0x5c, 0xe2, 0x4c,
-	0x95, 0xbb, 0x6f, 0x22, 0x80, 0x68, 0xc9, 0x30, 0x4d, 0x38, 0xa6, 0x42, 0x80, 0xa2, 0x8a, 0x83,
-	0x90, 0xa5, 0xfb, 0xee, 0xec, 0x03, 0x12, 0x9a, 0xd2, 0xf8, 0x88, 0xbc, 0x56, 0x4c, 0x84, 0x2c,
-	0x8d, 0xb9, 0x50, 0x98, 0xce, 0x02, 0x8e, 0xd5, 0x26, 0x61, 0xa5, 0x69, 0xdb, 0x08, 0xf9, 0x0a,
-	0x52, 0xe6, 0x26, 0x10, 0xcc, 0xcd, 0x2a, 0x7a, 0xc4, 0x32, 0x51, 0xd3, 0x1b, 0x7a, 0xf3, 0xe1,
-	0xa8, 0x58, 0xd8, 0x80, 0x8c, 0x01, 0x95, 0xea, 0x1b, 0x5d, 0xf2, 0x90, 0x2a, 0x48, 0x7d, 0xa6,
-	0xce, 0x93, 0xa6, 0x8b, 0x9e, 0xac, 0x92, 0x90, 0x2a, 0x26, 0x6b, 0x95, 0xc6, 0x83, 0xe6, 0xb3,
-	0x0f, 0x0d, 0xe7, 0x14, 0xee, 0x64, 0xe1, 0xce, 0xcd, 0x2d, 0x93, 0x1c, 0xec, 0x3c, 0xdd, 0x5e,
-	0xbc, 0xd5, 0xfe, 0x5e, 0xfd, 0x6b, 0xe9, 0xa3, 0xe3, 0xd9, 0xd6, 0x4f, 0x84, 0x88, 0xf8, 0x95,
-	0xd2, 0x20, 0xfb, 0xa9, 0x59, 0x47, 0x2f, 0x89, 0xd7, 0x1f, 0x7d, 0xea, 0x8e, 0xc9, 0xd0, 0x9b,
-	0x4e, 0x3c, 0xff, 0xab, 0xdb, 0x25, 0x7d, 0xe2, 0xf6, 0x0c, 0xed, 0x9e, 0xd7, 0x1b, 0x4e, 0x3a,
-	0x03, 0x77, 0xea, 0x93, 0xcf, 0x9e, 0xa1, 0x9b, 0xaf, 0xd0, 0x8b, 0x3b, 0xde, 0x77, 0x6f, 0x4c,
-	0xbe, 0xb8, 0x46, 0xa5, 0x43, 0xb6, 0x7b, 0x4b, 0xdf, 0xed, 0x2d, 0xfd, 0x72, 0x6f, 0xe9, 0x7f,
-	0x0e, 0x96, 0xb6, 0x3b, 0x58, 0xda, 0xff, 0x83, 0xa5, 0xfd, 0xc0, 0x11, 0x57, 0xf3, 0xd5, 0xcc,
-	0x09, 0x20, 0xc6, 0x65, 0xb1, 0xc5, 0xf4, 0x5e, 0x86, 0x0b, 0xfc, 0xfb, 0x76, 0xcb, 0x79, 0x89,
-	0xb3, 0xc7, 0x79, 0x8b, 0x1f, 0xaf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x94, 0x58, 0x13, 0x93, 0x08,
-	0x02, 0x00, 0x00,
+	// 591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xd3, 0x36, 0x6d, 0x97, 0x1f, 0x05, 0x37, 0x2d, 0xa1, 0x20, 0x13, 0xcc, 0x25, 0xaa,
+	0x84, 0x97, 0xc2, 0x13, 0xd0, 0xd4, 0x05, 0x4b, 0x25, 0x45, 0x76, 0x0b, 0x12, 0x12, 0xb2, 0xb6,
+	0xde, 0xad, 0xb3, 0x6a, 0xbc, 0x6b, 0x79, 0x27, 0x15, 0x39, 0xf3, 0x02, 0x3c, 0x00, 0x0f, 0xc0,
+	0x91, 0xc7, 0xe8, 0xb1, 0x47, 0x4e, 0x08, 0xb5, 0x07, 0x5e, 0x03, 0xad, 0xd7, 0xa6, 0x01, 0x95,
+	0x03, 0x5c, 0xec, 0xf9, 0x66, 0xbe, 0xfd, 0x3e, 0xcf, 0x78, 0x07, 0xb9, 0x89, 0x54, 0x99, 0x54,
+	0x58, 0x4d, 0x33, 0x05, 0xe4, 0x98, 0x8b, 0x14, 0x9f, 0x6c, 0xe2, 0x2a, 0xf4, 0xf2, 0x42, 0x82,
+	0xb4, 0x3b, 0x86, 0xe3, 0x5d, 0x72, 0xbc, 0x93, 0xcd, 0xf5, 0x5b, 0x24, 0xe3, 0x42, 0xe2, 0xf2,
+	0x69, 0x88, 0xeb, 0x9d, 0x54, 0xa6, 0xb2, 0x0c, 0xb1, 0x8e, 0xaa, 0xec, 0xbd, 0x54, 0xca, 0x74,
+	0xcc, 0x30, 0xc9, 0x39, 0x26, 0x42, 0x48, 0x20, 0xc0, 0xa5, 0x50, 0x55, 0xf5, 0xc1, 0x95, 0x1f,
+	0x90, 0x93, 0x82, 0x64, 0x35, 0xe5, 0x2e, 0x30, 0x41, 0x59, 0x91, 0x71, 0x01, 0x98, 0x1c, 0x26,
+	0x1c, 0xc3, 0x34, 0x67, 0x55, 0xd1, 0x75, 0x11, 0x8a, 0x40, 0x16, 0xcc, 0xcf, 0x65, 0x32, 0xb2,
+	0x3b, 0x68, 0x81, 0xe9, 0xa0, 0x6b, 0xf5, 0xac, 0xfe, 0x7c, 0x68, 0x80, 0x2b, 0x51, 0x7b, 0x97,
+	0x28, 0x78, 0x4d, 0xc6, 0x9c, 0x12, 0x90, 0x45, 0xc4, 0xe0, 0x6a, 0xa6, 0xed, 0xa3, 0xc5, 0x49,
+	0x4e, 0x09, 0x30, 0xd5, 0x6d, 0xf6, 0xe6, 0xfa, 0xd7, 0x9e, 0xf4, 0xbc, 0x4b, 0x73, 0x4f, 0x9b,
+	0x7b, 0xbf, 0x54, 0x0e, 0x4a, 0xe2, 0xd6, 0xf2, 0xe9, 0xb7, 0xfb, 0x8d, 0xcf, 0x3f, 0xbe, 0x6c,
+	0x58, 0x61, 0x7d, 0xd6, 0x55, 0x68, 0x6d, 0xd6, 0x6c, 0xbf, 0x20, 0x42, 0x71, 0xdd, 0xf5, 0x5f,
+	0x6c, 0x07, 0xa8, 0x05, 0xa4, 0x48, 0x19, 0xfc, 0x8f, 0x6b, 0x75, 0xd4, 0xfd, 0xd0, 0x44, 0x37,
+	0x43, 0x36, 0x26, 0xd3, 0x68, 0x2a, 0x92, 0x08, 0x08, 0x30, 0x7b, 0x0d, 0xb5, 0x72, 0x32, 0x51,
+	0x8c, 0x96, 0x76, 0x4b, 0x61, 0x85, 0xec, 0x87, 0xe8, 0x86, 0x89, 0xe2, 0x11, 0xe3, 0xe9, 0x48,
+	0xdb, 0x5a, 0xfd, 0xb9, 0xf0, 0xba, 0x49, 0xbe, 0x28, 0x73, 0xfa, 0x70, 0xc1, 0x88, 0x92, 0xa2,
+	0x3b, 0xd7, 0xb3, 0xfa, 0xcb, 0x61, 0x85, 0x74, 0xfe, 0x48, 0x16, 0x09, 0xa3, 0xdd, 0x79, 0x23,
+	0x6a, 0x90, 0x16, 0x35, 0x51, 0x2d, 0xba, 0x60, 0x44, 0x4d, 0xb2, 0x12, 0x8d, 0xd1, 0x6a, 0xce,
+	0x04, 0xe5, 0x22, 0x8d, 0x4f, 0xea, 0x96, 0x62, 0xc5, 0xa0, 0xdb, 0xfa, 0xf7, 0xc6, 0x57, 0x2a,
+	0xa5, 0xd9, 0x51, 0xbb, 0x9f, 0x2c, 0xb4, 0x1a, 0x31, 0x80, 0x31, 0xcb, 0x98, 0x80, 0xc1, 0x88,
+	0x70, 0xa1, 0x67, 0x31, 0x51, 0xf6, 0x1d, 0xb4, 0x94, 0x68, 0x18, 0x73, 0x5a, 0x4d, 0x7f, 0xb1,
+	0xc4, 0x01, 0xb5, 0x1f, 0xa3, 0xce, 0x98, 0x28, 0x88, 0x13, 0x99, 0x65, 0x1c, 0x80, 0xd1, 0xd8,
+	0xfc, 0xa4, 0x66, 0x49, 0xb3, 0x75, 0x6d, 0x50, 0x97, 0xcc, 0x45, 0x73, 0x10, 0x4a, 0xa4, 0x50,
+	0x9c, 0xb2, 0x82, 0xd1, 0x72, 0x40, 0x4b, 0xe1, 0x4c, 0x46, 0x0f, 0x29, 0x25, 0xc0, 0x45, 0x5a,
+	0x0f, 0xc9, 0xa0, 0x8d, 0x77, 0x08, 0x05, 0xe2, 0xa8, 0x20, 0x49, 0x79, 0x1b, 0xd6, 0xd1, 0x5a,
+	0x30, 0xdc, 0x09, 0x9f, 0x0d, 0xf6, 0x83, 0xbd, 0x61, 0x7c, 0x30, 0x8c, 0x5e, 0xf9, 0x83, 0x60,
+	0x27, 0xf0, 0xb7, 0xdb, 0x8d, 0x3f, 0x6a, 0xdb, 0x7b, 0x07, 0x5b, 0xbb, 0x7e, 0x1c, 0x05, 0xcf,
+	0x87, 0x6d, 0xcb, 0xbe, 0x8d, 0x56, 0x7e, 0xab, 0xbd, 0x19, 0xee, 0x07, 0x2f, 0xfd, 0x76, 0x73,
+	0x2b, 0x38, 0x3d, 0x77, 0xac, 0xb3, 0x73, 0xc7, 0xfa, 0x7e, 0xee, 0x58, 0x1f, 0x2f, 0x9c, 0xc6,
+	0xd9, 0x85, 0xd3, 0xf8, 0x7a, 0xe1, 0x34, 0xde, 0xe2, 0x94, 0xc3, 0x68, 0x72, 0xe8, 0x25, 0x32,
+	0xc3, 0xd5, 0xca, 0x99, 0xd7, 0x23, 0x45, 0x8f, 0xf1, 0xfb, 0xd9, 0xfd, 0x2b, 0xd7, 0xeb, 0xb0,
+	0x55, 0xee, 0xd7, 0xd3, 0x9f, 0x03, 0x00, 0x5c, 0x81, 0x48, 0xd7, 0x22, 0x04, 0x00, 0x00,
 }
 
 func (m *StoreEpoch) Marshal() (dAtA []byte, err error) {
@@ -262,6 +497,175 @@ func (m *LastValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorSetTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		for iNdEx := len(m.Target) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Target[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelaySyncState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelaySyncState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelaySyncState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingValidatorSet) > 0 {
+		for iNdEx := len(m.PendingValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingValidatorSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ForcedHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ForcedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Forced {
+		i--
+		if m.Forced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PausedHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.PausedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettlementChainStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlementChainStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlementChainStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gating {
+		i--
+		if m.Gating {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Considered {
+		i--
+		if m.Considered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastCommittedEpoch != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.LastCommittedEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -300,16 +704,258 @@ func (m *LastValidatorSet) Size() (n int) {
 			n += 1 + l + sovStaking(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *ValidatorSetTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovStaking(uint64(m.Epoch))
+	}
+	if len(m.Target) > 0 {
+		for _, e := range m.Target {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RelaySyncState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	if m.PausedHeight != 0 {
+		n += 1 + sovStaking(uint64(m.PausedHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Forced {
+		n += 2
+	}
+	if m.ForcedHeight != 0 {
+		n += 1 + sovStaking(uint64(m.ForcedHeight))
+	}
+	if len(m.PendingValidatorSet) > 0 {
+		for _, e := range m.PendingValidatorSet {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SettlementChainStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovStaking(uint64(m.ChainId))
+	}
+	if m.LastCommittedEpoch != 0 {
+		n += 1 + sovStaking(uint64(m.LastCommittedEpoch))
+	}
+	if m.Considered {
+		n += 2
+	}
+	if m.Gating {
+		n += 2
+	}
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StoreEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, types.ValidatorUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *StoreEpoch) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSetTransition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -332,10 +978,10 @@ func (m *StoreEpoch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoreEpoch: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSetTransition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSetTransition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -357,6 +1003,40 @@ func (m *StoreEpoch) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = append(m.Target, types.ValidatorUpdate{})
+			if err := m.Target[len(m.Target)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -378,7 +1058,7 @@ func (m *StoreEpoch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastValidatorSet) Unmarshal(dAtA []byte) error {
+func (m *RelaySyncState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -401,17 +1081,17 @@ func (m *LastValidatorSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastValidatorSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelaySyncState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelaySyncState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			m.Epoch = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -421,14 +1101,105 @@ func (m *LastValidatorSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Paused = bool(v != 0)
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedHeight", wireType)
+			}
+			m.PausedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Forced = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForcedHeight", wireType)
+			}
+			m.ForcedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForcedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingValidatorSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -455,8 +1226,8 @@ func (m *LastValidatorSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updates = append(m.Updates, types.ValidatorUpdate{})
-			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingValidatorSet = append(m.PendingValidatorSet, types.ValidatorUpdate{})
+			if err := m.PendingValidatorSet[len(m.PendingValidatorSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -481,6 +1252,134 @@ func (m *LastValidatorSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SettlementChainStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettlementChainStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettlementChainStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommittedEpoch", wireType)
+			}
+			m.LastCommittedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCommittedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Considered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Considered = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gating", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Gating = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
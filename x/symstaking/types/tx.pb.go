@@ -125,37 +125,513 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRegisterVoter is the Msg/RegisterVoter request type.
+type MsgRegisterVoter struct {
+	// voter is the account voting in governance on behalf of the validator.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// consensus_pubkey is the ed25519 consensus public key of the validator.
+	ConsensusPubkey []byte `protobuf:"bytes,2,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// signature is the signature of the voter registration sign bytes by the consensus key.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// nonce is the number of previous voter registrations of the validator, so that a registration cannot be replayed.
+	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *MsgRegisterVoter) Reset()         { *m = MsgRegisterVoter{} }
+func (m *MsgRegisterVoter) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterVoter) ProtoMessage()    {}
+func (*MsgRegisterVoter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4656c607a06adcf3, []int{2}
+}
+func (m *MsgRegisterVoter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterVoter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterVoter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterVoter.Merge(m, src)
+}
+func (m *MsgRegisterVoter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterVoter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterVoter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterVoter proto.InternalMessageInfo
+
+func (m *MsgRegisterVoter) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *MsgRegisterVoter) GetConsensusPubkey() []byte {
+	if m != nil {
+		return m.ConsensusPubkey
+	}
+	return nil
+}
+
+func (m *MsgRegisterVoter) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *MsgRegisterVoter) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// MsgRegisterVoterResponse defines the response structure for executing a
+// MsgRegisterVoter message.
+type MsgRegisterVoterResponse struct {
+}
+
+func (m *MsgRegisterVoterResponse) Reset()         { *m = MsgRegisterVoterResponse{} }
+func (m *MsgRegisterVoterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterVoterResponse) ProtoMessage()    {}
+func (*MsgRegisterVoterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4656c607a06adcf3, []int{3}
+}
+func (m *MsgRegisterVoterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterVoterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterVoterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterVoterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterVoterResponse.Merge(m, src)
+}
+func (m *MsgRegisterVoterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterVoterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterVoterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterVoterResponse proto.InternalMessageInfo
+
+// ForcedValidator is a validator of a validator set pinned by MsgForceValidatorSet.
+type ForcedValidator struct {
+	// consensus_pubkey is the ed25519 consensus public key of the validator.
+	ConsensusPubkey []byte `protobuf:"bytes,1,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// power is the voting power of the validator.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *ForcedValidator) Reset()         { *m = ForcedValidator{} }
+func (m *ForcedValidator) String() string { return proto.CompactTextString(m) }
+func (*ForcedValidator) ProtoMessage()    {}
+func (*ForcedValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4656c607a06adcf3, []int{4}
+}
+func (m *ForcedValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForcedValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForcedValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForcedValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForcedValidator.Merge(m, src)
+}
+func (m *ForcedValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForcedValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForcedValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForcedValidator proto.InternalMessageInfo
+
+func (m *ForcedValidator) GetConsensusPubkey() []byte {
+	if m != nil {
+		return m.ConsensusPubkey
+	}
+	return nil
+}
+
+func (m *ForcedValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// MsgForceValidatorSet is the Msg/ForceValidatorSet request type.
+type MsgForceValidatorSet struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// validators is the validator set to apply. It replaces the current validator set entirely.
+	Validators []ForcedValidator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+	// reason describes why the validator set is overridden.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgForceValidatorSet) Reset()         { *m = MsgForceValidatorSet{} }
+func (m *MsgForceValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*MsgForceValidatorSet) ProtoMessage()    {}
+func (*MsgForceValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4656c607a06adcf3, []int{5}
+}
+func (m *MsgForceValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceValidatorSet.Merge(m, src)
+}
+func (m *MsgForceValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceValidatorSet proto.InternalMessageInfo
+
+func (m *MsgForceValidatorSet) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgForceValidatorSet) GetValidators() []ForcedValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *MsgForceValidatorSet) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgForceValidatorSetResponse defines the response structure for executing a
+// MsgForceValidatorSet message.
+type MsgForceValidatorSetResponse struct {
+}
+
+func (m *MsgForceValidatorSetResponse) Reset()         { *m = MsgForceValidatorSetResponse{} }
+func (m *MsgForceValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceValidatorSetResponse) ProtoMessage()    {}
+func (*MsgForceValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4656c607a06adcf3, []int{6}
+}
+func (m *MsgForceValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceValidatorSetResponse.Merge(m, src)
+}
+func (m *MsgForceValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceValidatorSetResponse proto.InternalMessageInfo
+
+// MsgPauseRelaySync is the Msg/PauseRelaySync request type.
+type MsgPauseRelaySync struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// reason describes why the relay sync is paused.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgPauseRelaySync) Reset()         { *m = MsgPauseRelaySync{} }
+func (m *MsgPauseRelaySync) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseRelaySync) ProtoMessage()    {}
+func (*MsgPauseRelaySync) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4656c607a06adcf3, []int{7}
+}
+func (m *MsgPauseRelaySync) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseRelaySync) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseRelaySync.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseRelaySync) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseRelaySync.Merge(m, src)
+}
+func (m *MsgPauseRelaySync) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseRelaySync) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseRelaySync.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseRelaySync proto.InternalMessageInfo
+
+func (m *MsgPauseRelaySync) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgPauseRelaySync) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgPauseRelaySyncResponse defines the response structure for executing a
+// MsgPauseRelaySync message.
+type MsgPauseRelaySyncResponse struct {
+}
+
+func (m *MsgPauseRelaySyncResponse) Reset()         { *m = MsgPauseRelaySyncResponse{} }
+func (m *MsgPauseRelaySyncResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseRelaySyncResponse) ProtoMessage()    {}
+func (*MsgPauseRelaySyncResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4656c607a06adcf3, []int{8}
+}
+func (m *MsgPauseRelaySyncResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseRelaySyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseRelaySyncResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseRelaySyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseRelaySyncResponse.Merge(m, src)
+}
+func (m *MsgPauseRelaySyncResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseRelaySyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseRelaySyncResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseRelaySyncResponse proto.InternalMessageInfo
+
+// MsgResumeRelaySync is the Msg/ResumeRelaySync request type.
+type MsgResumeRelaySync struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgResumeRelaySync) Reset()         { *m = MsgResumeRelaySync{} }
+func (m *MsgResumeRelaySync) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeRelaySync) ProtoMessage()    {}
+func (*MsgResumeRelaySync) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4656c607a06adcf3, []int{9}
+}
+func (m *MsgResumeRelaySync) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeRelaySync) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeRelaySync.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeRelaySync) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeRelaySync.Merge(m, src)
+}
+func (m *MsgResumeRelaySync) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeRelaySync) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeRelaySync.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeRelaySync proto.InternalMessageInfo
+
+func (m *MsgResumeRelaySync) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgResumeRelaySyncResponse defines the response structure for executing a
+// MsgResumeRelaySync message.
+type MsgResumeRelaySyncResponse struct {
+}
+
+func (m *MsgResumeRelaySyncResponse) Reset()         { *m = MsgResumeRelaySyncResponse{} }
+func (m *MsgResumeRelaySyncResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeRelaySyncResponse) ProtoMessage()    {}
+func (*MsgResumeRelaySyncResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4656c607a06adcf3, []int{10}
+}
+func (m *MsgResumeRelaySyncResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeRelaySyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeRelaySyncResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeRelaySyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeRelaySyncResponse.Merge(m, src)
+}
+func (m *MsgResumeRelaySyncResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeRelaySyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeRelaySyncResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeRelaySyncResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.symstaking.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.symstaking.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterVoter)(nil), "cosmos.symstaking.v1.MsgRegisterVoter")
+	proto.RegisterType((*MsgRegisterVoterResponse)(nil), "cosmos.symstaking.v1.MsgRegisterVoterResponse")
+	proto.RegisterType((*ForcedValidator)(nil), "cosmos.symstaking.v1.ForcedValidator")
+	proto.RegisterType((*MsgForceValidatorSet)(nil), "cosmos.symstaking.v1.MsgForceValidatorSet")
+	proto.RegisterType((*MsgForceValidatorSetResponse)(nil), "cosmos.symstaking.v1.MsgForceValidatorSetResponse")
+	proto.RegisterType((*MsgPauseRelaySync)(nil), "cosmos.symstaking.v1.MsgPauseRelaySync")
+	proto.RegisterType((*MsgPauseRelaySyncResponse)(nil), "cosmos.symstaking.v1.MsgPauseRelaySyncResponse")
+	proto.RegisterType((*MsgResumeRelaySync)(nil), "cosmos.symstaking.v1.MsgResumeRelaySync")
+	proto.RegisterType((*MsgResumeRelaySyncResponse)(nil), "cosmos.symstaking.v1.MsgResumeRelaySyncResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/symstaking/v1/tx.proto", fileDescriptor_4656c607a06adcf3) }
 
 var fileDescriptor_4656c607a06adcf3 = []byte{
-	// 342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xae, 0xcc, 0x2d, 0x2e, 0x49, 0xcc, 0xce, 0xcc, 0x4b, 0xd7, 0x2f, 0x33,
-	0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81, 0x48, 0xeb, 0x21, 0xa4,
-	0xf5, 0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0xa1, 0x94,
-	0x38, 0xd4, 0x9c, 0xdc, 0x62, 0xb0, 0x01, 0xb9, 0xc5, 0xe9, 0x50, 0x09, 0x49, 0x88, 0x44, 0x3c,
-	0x98, 0xa7, 0x0f, 0x35, 0x0e, 0x22, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x11, 0x07, 0xb1, 0xa0,
-	0xa2, 0x8a, 0x58, 0x5d, 0x54, 0x90, 0x58, 0x94, 0x98, 0x0b, 0xd5, 0xa8, 0x74, 0x83, 0x91, 0x8b,
-	0xdf, 0xb7, 0x38, 0x3d, 0xb4, 0x20, 0x25, 0xb1, 0x24, 0x35, 0x00, 0x2c, 0x23, 0x64, 0xc6, 0xc5,
-	0x99, 0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59, 0x52, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9,
-	0x24, 0x71, 0x69, 0x8b, 0x2e, 0xcc, 0x03, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xc1, 0x25,
-	0x45, 0x99, 0x79, 0xe9, 0x41, 0x08, 0xa5, 0x42, 0xf6, 0x5c, 0x6c, 0x10, 0xb3, 0x25, 0x98, 0x14,
-	0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf4, 0xb0, 0x79, 0x59, 0x0f, 0x62, 0x8b, 0x13, 0xe7, 0x89, 0x7b,
-	0xf2, 0x0c, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0x82, 0x6a, 0xb3, 0xf2, 0x69, 0x7a, 0xbe, 0x41,
-	0x0b, 0x61, 0x60, 0xd7, 0xf3, 0x0d, 0x5a, 0x96, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9,
-	0xf9, 0xb9, 0x50, 0x1f, 0x43, 0x29, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x0a, 0x64, 0xaf, 0xa1, 0x79,
-	0x43, 0x49, 0x92, 0x4b, 0x1c, 0x4d, 0x28, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0xd5, 0xa8,
-	0x88, 0x8b, 0xd9, 0xb7, 0x38, 0x5d, 0x28, 0x85, 0x8b, 0x07, 0xc5, 0xe3, 0xaa, 0xd8, 0x1d, 0x8c,
-	0x66, 0x8a, 0x94, 0x2e, 0x51, 0xca, 0x60, 0x96, 0x49, 0xb1, 0x36, 0x80, 0x3c, 0xe9, 0xe4, 0x79,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xfa, 0xc4, 0xfb, 0xb1, 0xa4, 0xb2,
-	0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x77, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x42,
-	0x29, 0x10, 0x72, 0x02, 0x00, 0x00,
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xcd, 0x34, 0x4d, 0xa5, 0xdc, 0xf6, 0xfb, 0xd2, 0x5a, 0x11, 0x4d, 0x4d, 0x30, 0xc5, 0x12,
+	0x10, 0x22, 0xd5, 0xa6, 0x41, 0x42, 0x22, 0x20, 0x10, 0x5d, 0x20, 0x55, 0x22, 0x10, 0x5c, 0xd1,
+	0x05, 0x9b, 0xca, 0x8d, 0x47, 0x53, 0xd3, 0xda, 0x63, 0x79, 0xc6, 0xa1, 0xd9, 0x21, 0x96, 0xb0,
+	0xe1, 0x05, 0xd8, 0x23, 0xb1, 0xa9, 0x04, 0x0f, 0xd1, 0x65, 0xc5, 0xaa, 0x2b, 0x84, 0xda, 0x45,
+	0x37, 0x3c, 0x04, 0xf2, 0x4f, 0x9c, 0xda, 0x4e, 0x20, 0x29, 0x9b, 0xd6, 0x73, 0xee, 0x99, 0x73,
+	0xe7, 0x5c, 0x1f, 0x4f, 0xe0, 0x4a, 0x87, 0x32, 0x8b, 0x32, 0x95, 0xf5, 0x2c, 0xc6, 0xf5, 0x5d,
+	0xd3, 0x26, 0x6a, 0x77, 0x55, 0xe5, 0xfb, 0x8a, 0xe3, 0x52, 0x4e, 0x85, 0x72, 0x58, 0x56, 0x06,
+	0x65, 0xa5, 0xbb, 0x2a, 0x2e, 0xe8, 0x96, 0x69, 0x53, 0x35, 0xf8, 0x1b, 0x12, 0xc5, 0xc5, 0x48,
+	0xc7, 0x62, 0x81, 0x80, 0xc5, 0x48, 0x54, 0x58, 0x0a, 0x0b, 0x5b, 0xc1, 0x4a, 0x8d, 0xe4, 0xc2,
+	0x52, 0x99, 0x50, 0x42, 0x43, 0xdc, 0x7f, 0x8a, 0xd0, 0x6b, 0x43, 0x4f, 0xe4, 0xe8, 0xae, 0x6e,
+	0x45, 0x1b, 0xe5, 0x63, 0x04, 0xa5, 0x16, 0x23, 0x2f, 0x1d, 0x43, 0xe7, 0xb8, 0x1d, 0x54, 0x84,
+	0xbb, 0x50, 0xd4, 0x3d, 0xbe, 0x43, 0x5d, 0x93, 0xf7, 0x2a, 0x68, 0x19, 0xd5, 0x8a, 0x6b, 0x95,
+	0xef, 0xdf, 0x56, 0xfa, 0x06, 0x1e, 0x1b, 0x86, 0x8b, 0x19, 0xdb, 0xe0, 0xae, 0x69, 0x13, 0x6d,
+	0x40, 0x15, 0x1e, 0xc1, 0x4c, 0xa8, 0x5d, 0x99, 0x5a, 0x46, 0xb5, 0xd9, 0x46, 0x55, 0x19, 0x66,
+	0x59, 0x09, 0xbb, 0xac, 0x15, 0x0f, 0x7f, 0x5c, 0xcd, 0x7d, 0x3e, 0x3b, 0xa8, 0x23, 0x2d, 0xda,
+	0xd6, 0x7c, 0xfa, 0xee, 0xec, 0xa0, 0x3e, 0x10, 0x7c, 0x7f, 0x76, 0x50, 0xbf, 0x47, 0x4c, 0xbe,
+	0xe3, 0x6d, 0x2b, 0x1d, 0x6a, 0x45, 0x8e, 0xa3, 0x7f, 0x2b, 0xcc, 0xd8, 0x55, 0xf7, 0xcf, 0x5b,
+	0x4b, 0xd9, 0x90, 0x97, 0x60, 0x31, 0x05, 0x69, 0x98, 0x39, 0xd4, 0x66, 0x58, 0xfe, 0x85, 0x60,
+	0xbe, 0xc5, 0x88, 0x86, 0x89, 0xc9, 0x38, 0x76, 0x37, 0x29, 0xc7, 0xae, 0xa0, 0x40, 0xa1, 0xeb,
+	0x3f, 0xfc, 0xd5, 0x72, 0x48, 0x13, 0x6e, 0xc1, 0x7c, 0xc7, 0x57, 0xb3, 0x99, 0xc7, 0xb6, 0x1c,
+	0x6f, 0x7b, 0x17, 0xf7, 0x02, 0xe3, 0x73, 0x5a, 0x29, 0xc6, 0xdb, 0x01, 0x2c, 0x54, 0xa1, 0xc8,
+	0x4c, 0x62, 0xeb, 0xdc, 0x73, 0x71, 0x25, 0x1f, 0x70, 0x06, 0x80, 0x50, 0x86, 0x82, 0x4d, 0xed,
+	0x0e, 0xae, 0x4c, 0x2f, 0xa3, 0xda, 0xb4, 0x16, 0x2e, 0x9a, 0xeb, 0xfe, 0x30, 0xc2, 0x56, 0xfe,
+	0x20, 0x9a, 0x13, 0x0d, 0x22, 0xe1, 0x4c, 0x16, 0xa1, 0x92, 0xc6, 0xe2, 0x51, 0x68, 0x50, 0x7a,
+	0x42, 0xdd, 0x0e, 0x36, 0x36, 0xf5, 0x3d, 0xd3, 0xd0, 0x39, 0x1d, 0x6e, 0x0c, 0x0d, 0x37, 0x56,
+	0x86, 0x82, 0x43, 0xdf, 0x60, 0x37, 0x30, 0x9e, 0xd7, 0xc2, 0x85, 0xfc, 0x61, 0x0a, 0xca, 0x2d,
+	0x46, 0x02, 0xdd, 0x58, 0x76, 0x03, 0xf3, 0x0b, 0x27, 0xab, 0x0d, 0xd0, 0xed, 0xeb, 0xf8, 0xe9,
+	0xca, 0xd7, 0x66, 0x1b, 0xd7, 0x87, 0xa7, 0x2b, 0x65, 0xe6, 0x7c, 0xcc, 0xce, 0x69, 0x08, 0x97,
+	0x60, 0xc6, 0xc5, 0x3a, 0xa3, 0x76, 0xf0, 0x3a, 0x8a, 0x5a, 0xb4, 0x6a, 0xbe, 0xc8, 0x46, 0xf0,
+	0xe1, 0x44, 0x93, 0xcf, 0x98, 0x96, 0x25, 0xa8, 0x0e, 0xc3, 0xe3, 0x37, 0xf0, 0x15, 0xc1, 0x42,
+	0x8b, 0x91, 0xb6, 0xee, 0x31, 0xac, 0xe1, 0x3d, 0xbd, 0xb7, 0xd1, 0xb3, 0x3b, 0x17, 0x1e, 0xd5,
+	0xc0, 0xd8, 0x54, 0xc2, 0xd8, 0xb3, 0xac, 0xb1, 0xfb, 0x13, 0x19, 0x4b, 0x9e, 0x4f, 0xbe, 0x0c,
+	0x4b, 0x19, 0x30, 0xb6, 0xf4, 0x09, 0x81, 0x10, 0x24, 0x8e, 0x79, 0xd6, 0xbf, 0x7b, 0x6a, 0x3e,
+	0xcf, 0x9e, 0xfd, 0xc1, 0x84, 0x9f, 0x43, 0xe2, 0x20, 0x72, 0x15, 0xc4, 0x2c, 0xda, 0x3f, 0x7d,
+	0xe3, 0xcb, 0x34, 0xe4, 0x5b, 0x8c, 0x08, 0x06, 0xcc, 0x25, 0xee, 0xc5, 0x11, 0x89, 0x4b, 0x5d,
+	0x32, 0xe2, 0xca, 0x58, 0xb4, 0x7e, 0x37, 0x81, 0xc0, 0x7f, 0xc9, 0x7b, 0xe8, 0xc6, 0xc8, 0xfd,
+	0x09, 0x9e, 0xa8, 0x8c, 0xc7, 0x8b, 0x1b, 0x31, 0x58, 0xc8, 0x7e, 0x91, 0xf5, 0x91, 0x22, 0x19,
+	0xae, 0xd8, 0x18, 0x9f, 0x1b, 0x37, 0x7d, 0x0d, 0xff, 0xa7, 0x82, 0x7d, 0x73, 0xa4, 0x4a, 0x92,
+	0x28, 0xaa, 0x63, 0x12, 0xe3, 0x5e, 0x16, 0x94, 0xd2, 0x89, 0xab, 0xfd, 0x61, 0x46, 0x09, 0xa6,
+	0x78, 0x7b, 0x5c, 0x66, 0xbf, 0x9d, 0x58, 0x78, 0xeb, 0xdf, 0x2a, 0x6b, 0xeb, 0x87, 0x27, 0x12,
+	0x3a, 0x3a, 0x91, 0xd0, 0xcf, 0x13, 0x09, 0x7d, 0x3c, 0x95, 0x72, 0x47, 0xa7, 0x52, 0xee, 0xf8,
+	0x54, 0xca, 0xbd, 0x52, 0xc7, 0xcf, 0x28, 0xef, 0x39, 0x98, 0x6d, 0xcf, 0x04, 0xbf, 0xc9, 0x77,
+	0x7e, 0x0f, 0x00, 0x47, 0xf2, 0xc7, 0x97, 0x4a, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -173,6 +649,17 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterVoter registers the account voting in governance on behalf of a validator. The registration must be
+	// signed by the validator consensus key.
+	RegisterVoter(ctx context.Context, in *MsgRegisterVoter, opts ...grpc.CallOption) (*MsgRegisterVoterResponse, error)
+	// ForceValidatorSet defines a (governance) operation pinning the validator set and pausing the relay sync, for
+	// emergency recovery when the relay or the settlement chains are unavailable.
+	ForceValidatorSet(ctx context.Context, in *MsgForceValidatorSet, opts ...grpc.CallOption) (*MsgForceValidatorSetResponse, error)
+	// PauseRelaySync defines a (governance) operation pausing relay polling and relay validator set updates.
+	PauseRelaySync(ctx context.Context, in *MsgPauseRelaySync, opts ...grpc.CallOption) (*MsgPauseRelaySyncResponse, error)
+	// ResumeRelaySync defines a (governance) operation resuming relay polling. A validator set pinned by
+	// ForceValidatorSet is replaced by the relay validator set of the current epoch.
+	ResumeRelaySync(ctx context.Context, in *MsgResumeRelaySync, opts ...grpc.CallOption) (*MsgResumeRelaySyncResponse, error)
 }
 
 type msgClient struct {
@@ -192,11 +679,58 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterVoter(ctx context.Context, in *MsgRegisterVoter, opts ...grpc.CallOption) (*MsgRegisterVoterResponse, error) {
+	out := new(MsgRegisterVoterResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Msg/RegisterVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceValidatorSet(ctx context.Context, in *MsgForceValidatorSet, opts ...grpc.CallOption) (*MsgForceValidatorSetResponse, error) {
+	out := new(MsgForceValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Msg/ForceValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseRelaySync(ctx context.Context, in *MsgPauseRelaySync, opts ...grpc.CallOption) (*MsgPauseRelaySyncResponse, error) {
+	out := new(MsgPauseRelaySyncResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Msg/PauseRelaySync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeRelaySync(ctx context.Context, in *MsgResumeRelaySync, opts ...grpc.CallOption) (*MsgResumeRelaySyncResponse, error) {
+	out := new(MsgResumeRelaySyncResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symstaking.v1.Msg/ResumeRelaySync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterVoter registers the account voting in governance on behalf of a validator. The registration must be
+	// signed by the validator consensus key.
+	RegisterVoter(context.Context, *MsgRegisterVoter) (*MsgRegisterVoterResponse, error)
+	// ForceValidatorSet defines a (governance) operation pinning the validator set and pausing the relay sync, for
+	// emergency recovery when the relay or the settlement chains are unavailable.
+	ForceValidatorSet(context.Context, *MsgForceValidatorSet) (*MsgForceValidatorSetResponse, error)
+	// PauseRelaySync defines a (governance) operation pausing relay polling and relay validator set updates.
+	PauseRelaySync(context.Context, *MsgPauseRelaySync) (*MsgPauseRelaySyncResponse, error)
+	// ResumeRelaySync defines a (governance) operation resuming relay polling. A validator set pinned by
+	// ForceValidatorSet is replaced by the relay validator set of the current epoch.
+	ResumeRelaySync(context.Context, *MsgResumeRelaySync) (*MsgResumeRelaySyncResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -206,6 +740,18 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RegisterVoter(ctx context.Context, req *MsgRegisterVoter) (*MsgRegisterVoterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterVoter not implemented")
+}
+func (*UnimplementedMsgServer) ForceValidatorSet(ctx context.Context, req *MsgForceValidatorSet) (*MsgForceValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceValidatorSet not implemented")
+}
+func (*UnimplementedMsgServer) PauseRelaySync(ctx context.Context, req *MsgPauseRelaySync) (*MsgPauseRelaySyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseRelaySync not implemented")
+}
+func (*UnimplementedMsgServer) ResumeRelaySync(ctx context.Context, req *MsgResumeRelaySync) (*MsgResumeRelaySyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeRelaySync not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -229,6 +775,78 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterVoter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symstaking.v1.Msg/RegisterVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterVoter(ctx, req.(*MsgRegisterVoter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceValidatorSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symstaking.v1.Msg/ForceValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceValidatorSet(ctx, req.(*MsgForceValidatorSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseRelaySync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseRelaySync)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseRelaySync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symstaking.v1.Msg/PauseRelaySync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseRelaySync(ctx, req.(*MsgPauseRelaySync))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeRelaySync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeRelaySync)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeRelaySync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symstaking.v1.Msg/ResumeRelaySync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeRelaySync(ctx, req.(*MsgResumeRelaySync))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.symstaking.v1.Msg",
@@ -238,6 +856,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterVoter",
+			Handler:    _Msg_RegisterVoter_Handler,
+		},
+		{
+			MethodName: "ForceValidatorSet",
+			Handler:    _Msg_ForceValidatorSet_Handler,
+		},
+		{
+			MethodName: "PauseRelaySync",
+			Handler:    _Msg_PauseRelaySync_Handler,
+		},
+		{
+			MethodName: "ResumeRelaySync",
+			Handler:    _Msg_ResumeRelaySync_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symstaking/v1/tx.proto",
@@ -290,64 +924,1172 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterVoter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterVoter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterVoterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterVoterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ForcedValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForcedValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForcedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseRelaySync) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseRelaySync) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseRelaySync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseRelaySyncResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseRelaySyncResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseRelaySyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeRelaySync) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeRelaySync) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeRelaySync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeRelaySyncResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeRelaySyncResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeRelaySyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterVoter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgRegisterVoterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ForcedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovTx(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *MsgForceValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgForceValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseRelaySync) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseRelaySyncResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeRelaySync) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeRelaySyncResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterVoter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterVoter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterVoter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = append(m.ConsensusPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterVoterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterVoterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForcedValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForcedValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForcedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = append(m.ConsensusPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ForcedValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgForceValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseRelaySync) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -370,10 +2112,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseRelaySync: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseRelaySync: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -410,9 +2152,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -422,24 +2164,155 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseRelaySyncResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseRelaySyncResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseRelaySyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeRelaySync) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeRelaySync: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeRelaySync: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -462,7 +2335,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeRelaySyncResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -485,10 +2358,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeRelaySyncResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeRelaySyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
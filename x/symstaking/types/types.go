@@ -16,4 +16,8 @@ type RelayClient interface {
 	GetValidatorSet(ctx context.Context, in *v1.GetValidatorSetRequest, opts ...grpc.CallOption) (*v1.GetValidatorSetResponse, error)
 	// Sign Message
 	SignMessage(ctx context.Context, in *v1.SignMessageRequest, opts ...grpc.CallOption) (*v1.SignMessageResponse, error)
+	// Get aggregation proof of a sign message request
+	GetAggregationProof(ctx context.Context, in *v1.GetAggregationProofRequest, opts ...grpc.CallOption) (*v1.GetAggregationProofResponse, error)
+	// Get aggregation status of a sign message request
+	GetAggregationStatus(ctx context.Context, in *v1.GetAggregationStatusRequest, opts ...grpc.CallOption) (*v1.GetAggregationStatusResponse, error)
 }
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// GuardValidatorSetChange checks the change from the current validator set to a relay validator set against the
+// validator set change limits and returns the validator set to apply. If the change exceeds max_power_change in the
+// gradual mode, the returned set is a step towards the target changing at most max_power_change of the current
+// voting power, and complete is false. Unsafe changes return ErrUnsafeValidatorSetChange.
+func (p Params) GuardValidatorSetChange(current, target []abci.ValidatorUpdate) (next []abci.ValidatorUpdate, complete bool, err error) {
+	targetPowers := validatorPowers(target)
+	if len(targetPowers) < int(p.MinValidators) {
+		return nil, false, errorsmod.Wrapf(ErrUnsafeValidatorSetChange, "validator set of %d validators is below the minimum of %d", len(targetPowers), p.MinValidators)
+	}
+
+	currentPowers := validatorPowers(current)
+	var currentTotal, overlap, change int64
+	for key, power := range currentPowers {
+		currentTotal += power
+		if _, ok := targetPowers[key]; ok {
+			overlap += power
+		}
+		change += absInt64(targetPowers[key] - power)
+	}
+	if currentTotal == 0 {
+		// nothing to protect, eg. the first validator set
+		return target, true, nil
+	}
+	for key, power := range targetPowers {
+		if _, ok := currentPowers[key]; !ok {
+			change += power
+		}
+	}
+
+	if !p.MinOverlap.IsNil() && math.LegacyNewDec(overlap).LT(p.MinOverlap.MulInt64(currentTotal)) {
+		return nil, false, errorsmod.Wrapf(ErrUnsafeValidatorSetChange, "validators remaining in the set hold %d of %d voting power, below the minimum overlap of %s", overlap, currentTotal, p.MinOverlap)
+	}
+
+	if p.MaxPowerChange.IsNil() || p.MaxPowerChange.IsZero() {
+		return target, true, nil
+	}
+	budget := p.MaxPowerChange.MulInt64(currentTotal).TruncateInt64()
+	if change <= budget {
+		return target, true, nil
+	}
+	if p.ValidatorSetChangeMode == ValidatorSetChangeMode_VALIDATOR_SET_CHANGE_MODE_REJECT {
+		return nil, false, errorsmod.Wrapf(ErrUnsafeValidatorSetChange, "voting power change of %d exceeds the maximum of %d", change, budget)
+	}
+
+	return stepValidatorSet(current, target, currentPowers, targetPowers, max(budget, 1)), false, nil
+}
+
+// stepValidatorSet moves the current validator set towards the target changing at most budget voting power. Power
+// increases are applied before decreases so the validators of the current set keep their power the longest.
+func stepValidatorSet(current, target []abci.ValidatorUpdate, currentPowers, targetPowers map[string]int64, budget int64) []abci.ValidatorUpdate {
+	vals := make(map[string]abci.ValidatorUpdate, len(current)+len(target))
+	for _, val := range current {
+		vals[val.PubKey.String()] = val
+	}
+	for _, val := range target {
+		vals[val.PubKey.String()] = val
+	}
+	keys := make([]string, 0, len(vals))
+	for key := range vals {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	powers := make(map[string]int64, len(keys))
+	for _, key := range keys {
+		powers[key] = currentPowers[key]
+	}
+	for _, increase := range []bool{true, false} {
+		for _, key := range keys {
+			delta := targetPowers[key] - powers[key]
+			if delta == 0 || (delta > 0) != increase {
+				continue
+			}
+			step := min(absInt64(delta), budget)
+			if delta < 0 {
+				step = -step
+			}
+			powers[key] += step
+			budget -= absInt64(step)
+		}
+	}
+
+	next := make([]abci.ValidatorUpdate, 0, len(keys))
+	for _, key := range keys {
+		if powers[key] > 0 {
+			val := vals[key]
+			val.Power = powers[key]
+			next = append(next, val)
+		}
+	}
+	return next
+}
+
+// validatorPowers returns the voting power of the validators of a set with a positive power.
+func validatorPowers(vals []abci.ValidatorUpdate) map[string]int64 {
+	powers := make(map[string]int64, len(vals))
+	for _, val := range vals {
+		if val.Power > 0 {
+			powers[val.PubKey.String()] = val.Power
+		}
+	}
+	return powers
+}
+
+func absInt64(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
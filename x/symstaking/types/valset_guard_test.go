@@ -0,0 +1,197 @@
+package types_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func valUpdate(key byte, power int64) abci.ValidatorUpdate {
+	pk := make([]byte, 32)
+	pk[0] = key
+	return abci.ValidatorUpdate{
+		PubKey: cmtprotocrypto.PublicKey{Sum: &cmtprotocrypto.PublicKey_Ed25519{Ed25519: pk}},
+		Power:  power,
+	}
+}
+
+func totalPower(vals []abci.ValidatorUpdate) (total int64) {
+	for _, val := range vals {
+		total += val.Power
+	}
+	return total
+}
+
+func TestParams_GuardValidatorSetChange(t *testing.T) {
+	current := []abci.ValidatorUpdate{valUpdate(1, 10), valUpdate(2, 10), valUpdate(3, 10), valUpdate(4, 10)}
+
+	tests := []struct {
+		desc        string
+		maxChange   math.LegacyDec
+		minOverlap  math.LegacyDec
+		mode        types.ValidatorSetChangeMode
+		current     []abci.ValidatorUpdate
+		target      []abci.ValidatorUpdate
+		expNext     []abci.ValidatorUpdate
+		expComplete bool
+		expErr      string
+	}{
+		{
+			desc:        "first validator set",
+			current:     nil,
+			target:      []abci.ValidatorUpdate{valUpdate(9, 10)},
+			expNext:     []abci.ValidatorUpdate{valUpdate(9, 10)},
+			expComplete: true,
+		},
+		{
+			desc:    "empty validator set",
+			current: current,
+			target:  nil,
+			expErr:  "validator set of 0 validators is below the minimum of 1",
+		},
+		{
+			desc:    "zero power validator set",
+			current: current,
+			target:  []abci.ValidatorUpdate{valUpdate(1, 0)},
+			expErr:  "validator set of 0 validators is below the minimum of 1",
+		},
+		{
+			desc:    "no overlap with the current set",
+			current: current,
+			target:  []abci.ValidatorUpdate{valUpdate(5, 10), valUpdate(6, 10), valUpdate(7, 10), valUpdate(8, 10)},
+			expErr:  "hold 0 of 40 voting power",
+		},
+		{
+			desc:        "overlap at the minimum",
+			minOverlap:  math.LegacyNewDecWithPrec(5, 1),
+			current:     current,
+			target:      []abci.ValidatorUpdate{valUpdate(1, 10), valUpdate(2, 10), valUpdate(5, 10)},
+			expNext:     []abci.ValidatorUpdate{valUpdate(1, 10), valUpdate(2, 10), valUpdate(5, 10)},
+			expComplete: true,
+		},
+		{
+			desc:       "overlap below the minimum",
+			minOverlap: math.LegacyNewDecWithPrec(6, 1),
+			current:    current,
+			target:     []abci.ValidatorUpdate{valUpdate(1, 10), valUpdate(2, 10), valUpdate(5, 10)},
+			expErr:     "hold 20 of 40 voting power, below the minimum overlap",
+		},
+		{
+			desc:        "change within the limit",
+			maxChange:   math.LegacyNewDecWithPrec(25, 2),
+			current:     current,
+			target:      []abci.ValidatorUpdate{valUpdate(1, 10), valUpdate(2, 10), valUpdate(3, 10), valUpdate(4, 20)},
+			expNext:     []abci.ValidatorUpdate{valUpdate(1, 10), valUpdate(2, 10), valUpdate(3, 10), valUpdate(4, 20)},
+			expComplete: true,
+		},
+		{
+			desc:      "change over the limit is rejected",
+			maxChange: math.LegacyNewDecWithPrec(25, 2),
+			mode:      types.ValidatorSetChangeMode_VALIDATOR_SET_CHANGE_MODE_REJECT,
+			current:   current,
+			target:    []abci.ValidatorUpdate{valUpdate(1, 10), valUpdate(2, 10), valUpdate(5, 10), valUpdate(6, 10)},
+			expErr:    "voting power change of 40 exceeds the maximum of 10",
+		},
+		{
+			desc:      "change over the limit is applied gradually, increases first",
+			maxChange: math.LegacyNewDecWithPrec(25, 2),
+			current:   current,
+			target:    []abci.ValidatorUpdate{valUpdate(1, 10), valUpdate(2, 10), valUpdate(5, 10), valUpdate(6, 10)},
+			expNext: []abci.ValidatorUpdate{
+				valUpdate(1, 10), valUpdate(2, 10), valUpdate(3, 10), valUpdate(4, 10), valUpdate(5, 10),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.ValidatorSetChangeMode = tc.mode
+			if !tc.minOverlap.IsNil() {
+				params.MinOverlap = tc.minOverlap
+			}
+			if !tc.maxChange.IsNil() {
+				params.MaxPowerChange = tc.maxChange
+			}
+
+			next, complete, err := params.GuardValidatorSetChange(tc.current, tc.target)
+			if tc.expErr != "" {
+				require.ErrorIs(t, err, types.ErrUnsafeValidatorSetChange)
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expComplete, complete)
+			require.ElementsMatch(t, tc.expNext, next)
+		})
+	}
+}
+
+func TestParams_GuardValidatorSetChange_Gradual(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxPowerChange = math.LegacyNewDecWithPrec(2, 1)
+
+	current := []abci.ValidatorUpdate{valUpdate(1, 100), valUpdate(2, 100), valUpdate(3, 100)}
+	target := []abci.ValidatorUpdate{valUpdate(1, 100), valUpdate(2, 50), valUpdate(4, 200)}
+
+	for steps := 1; ; steps++ {
+		require.Less(t, steps, 10, "validator set transition does not converge")
+
+		next, complete, err := params.GuardValidatorSetChange(current, target)
+		require.NoError(t, err)
+
+		// every step stays within the limit of the set it is applied to
+		var change int64
+		powers := map[string]int64{}
+		for _, val := range current {
+			powers[val.PubKey.String()] = val.Power
+		}
+		for _, val := range next {
+			change += abs(val.Power - powers[val.PubKey.String()])
+			delete(powers, val.PubKey.String())
+		}
+		for _, power := range powers {
+			change += power
+		}
+		require.LessOrEqual(t, change, params.MaxPowerChange.MulInt64(totalPower(current)).TruncateInt64())
+
+		current = next
+		if complete {
+			require.Equal(t, target, next)
+			break
+		}
+	}
+}
+
+func abs(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func TestParams_ValidateValidatorSetGuard(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.MinValidators = 0
+	require.ErrorIs(t, params.Validate(), types.ErrInvalidValidatorSetGuard)
+
+	params = types.DefaultParams()
+	params.MinOverlap = math.LegacyNewDecWithPrec(11, 1)
+	require.ErrorIs(t, params.Validate(), types.ErrInvalidValidatorSetGuard)
+
+	params = types.DefaultParams()
+	params.MaxPowerChange = math.LegacyNewDec(-1)
+	require.ErrorIs(t, params.Validate(), types.ErrInvalidValidatorSetGuard)
+
+	params = types.DefaultParams()
+	params.ValidatorSetChangeMode = 5
+	require.ErrorIs(t, params.Validate(), types.ErrInvalidValidatorSetGuard)
+}
@@ -0,0 +1,19 @@
+package types
+
+import "encoding/binary"
+
+// VoterRegistrationMessageType is the typed message type signed by a validator consensus key to register its
+// governance voter.
+const VoterRegistrationMessageType = "register_voter"
+
+// VoterRegistrationSignBytes returns the bytes a validator consensus key signs to register voter as the account
+// voting in governance on its behalf on the given chain, with the nonce of the registration:
+// nonce | voter
+// where nonce is a big endian uint64.
+func VoterRegistrationSignBytes(chainID, voter string, nonce uint64) []byte {
+	return TypedMessage{
+		Module:  ModuleName,
+		Type:    VoterRegistrationMessageType,
+		Payload: append(binary.BigEndian.AppendUint64(nil, nonce), voter...),
+	}.SignBytes(chainID)
+}
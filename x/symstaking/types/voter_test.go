@@ -0,0 +1,34 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/symstaking/types"
+)
+
+func TestVoterRegistrationSignBytes(t *testing.T) {
+	bz := types.VoterRegistrationSignBytes("chain-1", "cosmos1voter", 1)
+	require.Equal(t, types.TypedMessage{
+		Module:  types.ModuleName,
+		Type:    types.VoterRegistrationMessageType,
+		Payload: append([]byte{0, 0, 0, 0, 0, 0, 0, 1}, "cosmos1voter"...),
+	}.SignBytes("chain-1"), bz)
+
+	// registrations cannot be replayed for another voter, on another chain or with another nonce
+	require.NotEqual(t, bz, types.VoterRegistrationSignBytes("chain-1", "cosmos1other", 1))
+	require.NotEqual(t, bz, types.VoterRegistrationSignBytes("chain-2", "cosmos1voter", 1))
+	require.NotEqual(t, bz, types.VoterRegistrationSignBytes("chain-1", "cosmos1voter", 0))
+}
+
+func TestParams_ValidateGovernanceTallyMode(t *testing.T) {
+	params := types.DefaultParams()
+	require.Equal(t, types.GovernanceTallyMode_GOVERNANCE_TALLY_MODE_STAKING, params.GovernanceTallyMode)
+
+	params.GovernanceTallyMode = types.GovernanceTallyMode_GOVERNANCE_TALLY_MODE_SYMSTAKING
+	require.NoError(t, params.Validate())
+
+	params.GovernanceTallyMode = types.GovernanceTallyMode(7)
+	require.ErrorIs(t, params.Validate(), types.ErrInvalidTallyMode)
+}
@@ -0,0 +1,313 @@
+package eip712
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/api/amino"
+	"cosmossdk.io/x/tx/signing"
+)
+
+// maxDepth bounds the nesting of messages, to guard against recursive message types.
+const maxDepth = 32
+
+const (
+	timestampFullName = "google.protobuf.Timestamp"
+	durationFullName  = "google.protobuf.Duration"
+	anyFullName       = "google.protobuf.Any"
+)
+
+// typesBuilder derives EIP-712 struct types from protobuf message descriptors, and completes their amino JSON
+// encoding into EIP-712 values. Amino JSON omits empty fields which EIP-712 requires, these are set to their zero
+// value.
+type typesBuilder struct {
+	fileResolver signing.ProtoFileResolver
+	typeResolver protoregistry.MessageTypeResolver
+	types        Types
+}
+
+// message returns the struct type of a message and its EIP-712 value from its amino JSON value, nil when omitted.
+func (b *typesBuilder) message(msg protoreflect.Message, jsonValue any, depth int) (string, map[string]any, error) {
+	if depth > maxDepth {
+		return "", nil, fmt.Errorf("max depth exceeded at %s", msg.Descriptor().FullName())
+	}
+	msg, err := b.resolvePlaceholder(msg)
+	if err != nil {
+		return "", nil, err
+	}
+	desc := msg.Descriptor()
+	obj, ok := jsonValue.(map[string]any)
+	if !ok && jsonValue != nil {
+		return "", nil, fmt.Errorf("%s: expected a JSON object, got %T", desc.FullName(), jsonValue)
+	}
+
+	fieldDescs := desc.Fields()
+	fields := make([]Type, 0, fieldDescs.Len())
+	value := make(map[string]any, fieldDescs.Len())
+	for i := 0; i < fieldDescs.Len(); i++ {
+		fd := fieldDescs.Get(i)
+		if fd.ContainingOneof() != nil {
+			return "", nil, fmt.Errorf("%s: oneof fields are not supported", fd.FullName())
+		}
+
+		name := aminoFieldName(fd)
+		typ, fieldValue, ok, err := b.field(fd, msg.Get(fd), obj[name], depth)
+		if err != nil {
+			return "", nil, err
+		}
+		if !ok {
+			continue
+		}
+		fields = append(fields, Type{Name: name, Type: typ})
+		value[name] = fieldValue
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Name < fields[j].Name })
+
+	typeName := structTypeName(desc.FullName())
+	if err := b.define(typeName, fields); err != nil {
+		return "", nil, err
+	}
+	return typeName, value, nil
+}
+
+// anyMessage returns the struct type of a packed message, {type, value} as in amino JSON, and its EIP-712 value.
+func (b *typesBuilder) anyMessage(msg protoreflect.Message, jsonValue any, depth int) (string, map[string]any, error) {
+	obj, ok := jsonValue.(map[string]any)
+	if !ok {
+		return "", nil, fmt.Errorf("%s: expected a JSON object, got %T", msg.Descriptor().FullName(), jsonValue)
+	}
+
+	valueType, value, err := b.value(msg, obj["value"], depth+1)
+	if err != nil {
+		return "", nil, err
+	}
+	typeName := "Any" + structTypeName(msg.Descriptor().FullName())
+	if err := b.define(typeName, []Type{{Name: "type", Type: "string"}, {Name: "value", Type: valueType}}); err != nil {
+		return "", nil, err
+	}
+	return typeName, map[string]any{"type": obj["type"], "value": value}, nil
+}
+
+// value returns the type and EIP-712 value of a message, which is a string for key_field encoded messages.
+func (b *typesBuilder) value(msg protoreflect.Message, jsonValue any, depth int) (string, any, error) {
+	opts := msg.Descriptor().Options()
+	if proto.HasExtension(opts, amino.E_MessageEncoding) {
+		encoding := proto.GetExtension(opts, amino.E_MessageEncoding).(string)
+		if encoding != "key_field" {
+			return "", nil, fmt.Errorf("%s: amino message encoding %s is not supported", msg.Descriptor().FullName(), encoding)
+		}
+		return "string", stringOrEmpty(jsonValue), nil
+	}
+
+	switch msg.Descriptor().FullName() {
+	case timestampFullName, durationFullName:
+		return "string", stringOrEmpty(jsonValue), nil
+	}
+
+	return b.message(msg, jsonValue, depth)
+}
+
+// field returns the type and EIP-712 value of a message field. Empty google.protobuf.Any fields, whose type is
+// unknown, are left out of the struct type and ok is false.
+func (b *typesBuilder) field(fd protoreflect.FieldDescriptor, value protoreflect.Value, jsonValue any, depth int) (typ string, fieldValue any, ok bool, err error) {
+	opts := fd.Options()
+	if proto.HasExtension(opts, amino.E_Encoding) {
+		if encoding := proto.GetExtension(opts, amino.E_Encoding).(string); encoding != "legacy_coins" {
+			return "", nil, false, fmt.Errorf("%s: amino encoding %s is not supported", fd.FullName(), encoding)
+		}
+	}
+	if fd.IsMap() {
+		return "", nil, false, fmt.Errorf("%s: maps are not supported", fd.FullName())
+	}
+
+	if !fd.IsList() {
+		return b.element(fd, value, jsonValue, depth)
+	}
+
+	list := value.List()
+	items, _ := jsonValue.([]any)
+	if jsonValue != nil && items == nil {
+		return "", nil, false, fmt.Errorf("%s: expected a JSON array, got %T", fd.FullName(), jsonValue)
+	}
+	if len(items) != list.Len() {
+		return "", nil, false, fmt.Errorf("%s: amino JSON has %d elements, expected %d", fd.FullName(), len(items), list.Len())
+	}
+
+	if list.Len() == 0 {
+		if fd.Kind() == protoreflect.MessageKind {
+			if fd.Message().FullName() == anyFullName {
+				return "", nil, false, nil
+			}
+			// define the element type from an empty message
+			value = protoreflect.ValueOfMessage(dynamicpb.NewMessage(fd.Message()))
+		}
+		elemType, _, ok, err := b.element(fd, value, nil, depth)
+		return elemType + "[]", []any{}, ok, err
+	}
+
+	var elemType string
+	elems := make([]any, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		itemType, item, ok, err := b.element(fd, list.Get(i), items[i], depth)
+		if err != nil || !ok {
+			return "", nil, ok, err
+		}
+		if i > 0 && itemType != elemType {
+			return "", nil, false, fmt.Errorf("%s: arrays of different types %s and %s are not supported", fd.FullName(), elemType, itemType)
+		}
+		elemType, elems[i] = itemType, item
+	}
+	return elemType + "[]", elems, true, nil
+}
+
+// element returns the type and EIP-712 value of a singular field value.
+func (b *typesBuilder) element(fd protoreflect.FieldDescriptor, value protoreflect.Value, jsonValue any, depth int) (string, any, bool, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		if jsonValue == nil {
+			return "bool", false, true, nil
+		}
+		return "bool", jsonValue, true, nil
+
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		// bytes are base64 strings in amino JSON, or decimal strings for cosmos.Dec and cosmos.Int scalars
+		return "string", stringOrEmpty(jsonValue), true, nil
+
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind, protoreflect.EnumKind:
+		return "int32", numberOrZero(jsonValue), true, nil
+
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32", numberOrZero(jsonValue), true, nil
+
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64", numberOrZero(jsonValue), true, nil
+
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64", numberOrZero(jsonValue), true, nil
+
+	case protoreflect.MessageKind:
+		msg := value.Message()
+		if fd.Message().FullName() != anyFullName {
+			typ, v, err := b.value(msg, jsonValue, depth+1)
+			return typ, v, true, err
+		}
+		if jsonValue == nil {
+			return "", nil, false, nil
+		}
+		packed, err := b.unpack(msg)
+		if err != nil {
+			return "", nil, false, err
+		}
+		typ, v, err := b.anyMessage(packed, jsonValue, depth+1)
+		return typ, v, true, err
+
+	default:
+		return "", nil, false, fmt.Errorf("%s: %s fields are not supported", fd.FullName(), fd.Kind())
+	}
+}
+
+// unpack returns the message packed in a google.protobuf.Any message.
+func (b *typesBuilder) unpack(anyMsg protoreflect.Message) (protoreflect.Message, error) {
+	fields := anyMsg.Descriptor().Fields()
+	typeURL := anyMsg.Get(fields.ByName("type_url")).String()
+	bz := anyMsg.Get(fields.ByName("value")).Bytes()
+
+	var msg protoreflect.Message
+	if typ, err := b.typeResolver.FindMessageByURL(typeURL); err == nil {
+		msg = typ.New()
+	} else {
+		desc, err := b.fileResolver.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(typeURL, "/")))
+		if err != nil {
+			return nil, fmt.Errorf("can't resolve type URL %s: %w", typeURL, err)
+		}
+		msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("type URL %s is not a message", typeURL)
+		}
+		msg = dynamicpb.NewMessage(msgDesc)
+	}
+	if err := proto.Unmarshal(bz, msg.Interface()); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// resolvePlaceholder returns the message decoded with its resolved descriptor when its descriptor is a placeholder,
+// as for message types of other files in gogoproto descriptors, whose fields are then unknown fields.
+func (b *typesBuilder) resolvePlaceholder(msg protoreflect.Message) (protoreflect.Message, error) {
+	if !msg.Descriptor().IsPlaceholder() {
+		return msg, nil
+	}
+	desc, err := b.fileResolver.FindDescriptorByName(msg.Descriptor().FullName())
+	if err != nil {
+		return nil, fmt.Errorf("can't resolve message %s: %w", msg.Descriptor().FullName(), err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", msg.Descriptor().FullName())
+	}
+	bz, err := proto.Marshal(msg.Interface())
+	if err != nil {
+		return nil, err
+	}
+	resolved := dynamicpb.NewMessage(msgDesc)
+	if err := proto.Unmarshal(bz, resolved); err != nil {
+		return nil, err
+	}
+	return resolved, nil
+}
+
+// define adds a struct type, which must be identical to a struct type of the same name already defined.
+func (b *typesBuilder) define(typeName string, fields []Type) error {
+	if existing, ok := b.types[typeName]; ok {
+		if !reflect.DeepEqual(existing, fields) {
+			return fmt.Errorf("conflicting definitions of the %s struct type", typeName)
+		}
+		return nil
+	}
+	b.types[typeName] = fields
+	return nil
+}
+
+func aminoFieldName(fd protoreflect.FieldDescriptor) string {
+	opts := fd.Options()
+	if proto.HasExtension(opts, amino.E_FieldName) {
+		return proto.GetExtension(opts, amino.E_FieldName).(string)
+	}
+	return string(fd.Name())
+}
+
+// structTypeName returns the EIP-712 struct type name of a protobuf message, its full name in pascal case, e.g.
+// CosmosBankV1beta1MsgSend for cosmos.bank.v1beta1.MsgSend.
+func structTypeName(fullName protoreflect.FullName) string {
+	parts := strings.Split(string(fullName), ".")
+	for i, part := range parts {
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		parts[i] = strings.ReplaceAll(string(runes), "_", "")
+	}
+	return strings.Join(parts, "")
+}
+
+func stringOrEmpty(jsonValue any) any {
+	if jsonValue == nil {
+		return ""
+	}
+	return jsonValue
+}
+
+func numberOrZero(jsonValue any) any {
+	if jsonValue == nil {
+		return json.Number("0")
+	}
+	return jsonValue
+}
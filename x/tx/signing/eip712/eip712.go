@@ -0,0 +1,235 @@
+package eip712
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/aminojson"
+)
+
+// SignMode is SIGN_MODE_EIP_712 of cosmos.tx.signing.v1beta1.SignMode.
+const SignMode = signingv1beta1.SignMode(712)
+
+const (
+	// DomainName is the name of the EIP-712 domain of Cosmos transactions.
+	DomainName = "Cosmos Web3"
+	// DomainVersion is the version of the EIP-712 domain of Cosmos transactions.
+	DomainVersion = "1.0.0"
+
+	txType  = "Tx"
+	feeType = "Fee"
+)
+
+// SignModeHandler implements the SIGN_MODE_EIP_712 signing mode. It signs the EIP-712 encoding of typed data built
+// from the SIGN_MODE_LEGACY_AMINO_JSON sign document, so transactions can be signed with the eth_signTypedData_v4
+// method of Ethereum wallets.
+type SignModeHandler struct {
+	fileResolver  signing.ProtoFileResolver
+	typeResolver  protoregistry.MessageTypeResolver
+	aminoJSON     *aminojson.SignModeHandler
+	eip155ChainID func(chainID string) (*big.Int, error)
+}
+
+// SignModeHandlerOptions are the options for the SignModeHandler.
+type SignModeHandlerOptions struct {
+	FileResolver signing.ProtoFileResolver
+	TypeResolver signing.TypeResolver
+	// EIP155ChainID returns the EIP-155 chain ID of the EIP-712 domain for a chain ID. It defaults to
+	// ParseEIP155ChainID.
+	EIP155ChainID func(chainID string) (*big.Int, error)
+}
+
+// NewSignModeHandler returns a new SignModeHandler.
+func NewSignModeHandler(options SignModeHandlerOptions) *SignModeHandler {
+	h := &SignModeHandler{
+		fileResolver:  options.FileResolver,
+		typeResolver:  options.TypeResolver,
+		eip155ChainID: options.EIP155ChainID,
+	}
+	if h.fileResolver == nil {
+		h.fileResolver = gogoproto.HybridResolver
+	}
+	if h.typeResolver == nil {
+		h.typeResolver = protoregistry.GlobalTypes
+	}
+	if h.eip155ChainID == nil {
+		h.eip155ChainID = ParseEIP155ChainID
+	}
+	h.aminoJSON = aminojson.NewSignModeHandler(aminojson.SignModeHandlerOptions{
+		FileResolver: options.FileResolver,
+		TypeResolver: options.TypeResolver,
+	})
+	return h
+}
+
+// Mode implements the Mode method of the SignModeHandler interface.
+func (h SignModeHandler) Mode() signingv1beta1.SignMode {
+	return SignMode
+}
+
+// GetSignBytes implements the GetSignBytes method of the SignModeHandler interface. The sign bytes are the EIP-712
+// encoding of the transaction typed data, whose Keccak256 hash is signed by eth_secp256k1 keys.
+func (h SignModeHandler) GetSignBytes(ctx context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	typedData, err := h.GetTypedData(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+	return typedData.SignBytes()
+}
+
+// GetTypedData returns the EIP-712 typed data of a transaction. The message is the amino JSON sign document with its
+// messages as msg0, msg1, ... fields, and the struct types are derived from the protobuf descriptors of the messages.
+func (h SignModeHandler) GetTypedData(ctx context.Context, signerData signing.SignerData, txData signing.TxData) (TypedData, error) {
+	eip155ChainID, err := h.eip155ChainID(signerData.ChainID)
+	if err != nil {
+		return TypedData{}, err
+	}
+
+	aminoJSONBytes, err := h.aminoJSON.GetSignBytes(ctx, signerData, txData)
+	if err != nil {
+		return TypedData{}, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(aminoJSONBytes))
+	decoder.UseNumber()
+	var signDoc map[string]any
+	if err := decoder.Decode(&signDoc); err != nil {
+		return TypedData{}, err
+	}
+
+	b := &typesBuilder{
+		fileResolver: h.fileResolver,
+		typeResolver: h.typeResolver,
+		types: Types{
+			domainType: {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "string"},
+				{Name: "salt", Type: "string"},
+			},
+		},
+	}
+
+	fee, err := b.fee(txData.AuthInfo.Fee.Amount, signDoc["fee"])
+	if err != nil {
+		return TypedData{}, err
+	}
+
+	txFields := []Type{
+		{Name: "account_number", Type: "uint64"},
+		{Name: "chain_id", Type: "string"},
+		{Name: "fee", Type: feeType},
+		{Name: "memo", Type: "string"},
+	}
+	message := map[string]any{
+		"account_number": signDoc["account_number"],
+		"chain_id":       signDoc["chain_id"],
+		"fee":            fee,
+		"memo":           signDoc["memo"],
+		"sequence":       signDoc["sequence"],
+		"timeout_height": numberOrZero(signDoc["timeout_height"]),
+		// the timeout timestamp is an RFC 3339 string in amino JSON
+		"timeout_timestamp": stringOrEmpty(signDoc["timeout_timestamp"]),
+		"unordered":         signDoc["unordered"] == true,
+	}
+
+	msgs, _ := signDoc["msgs"].([]any)
+	if len(msgs) != len(txData.Body.Messages) {
+		return TypedData{}, fmt.Errorf("amino JSON sign document has %d messages, expected %d", len(msgs), len(txData.Body.Messages))
+	}
+	for i, anyMsg := range txData.Body.Messages {
+		msg, err := b.unpack(anyMsg.ProtoReflect())
+		if err != nil {
+			return TypedData{}, err
+		}
+		msgType, msgValue, err := b.anyMessage(msg, msgs[i], 0)
+		if err != nil {
+			return TypedData{}, err
+		}
+		name := fmt.Sprintf("msg%d", i)
+		txFields = append(txFields, Type{Name: name, Type: msgType})
+		message[name] = msgValue
+	}
+
+	txFields = append(txFields,
+		Type{Name: "sequence", Type: "uint64"},
+		Type{Name: "timeout_height", Type: "uint64"},
+		Type{Name: "timeout_timestamp", Type: "string"},
+		Type{Name: "unordered", Type: "bool"},
+	)
+	b.types[txType] = txFields
+
+	return TypedData{
+		Types:       b.types,
+		PrimaryType: txType,
+		Domain: map[string]any{
+			"name":              DomainName,
+			"version":           DomainVersion,
+			"chainId":           eip155ChainID,
+			"verifyingContract": "cosmos",
+			"salt":              "0",
+		},
+		Message: message,
+	}, nil
+}
+
+// fee returns the EIP-712 value of the amino JSON fee of the sign document.
+func (b *typesBuilder) fee(amount []*basev1beta1.Coin, jsonValue any) (map[string]any, error) {
+	obj, ok := jsonValue.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("fee: expected a JSON object, got %T", jsonValue)
+	}
+	coins, _ := obj["amount"].([]any)
+	if len(coins) != len(amount) {
+		return nil, fmt.Errorf("fee: amino JSON has %d coins, expected %d", len(coins), len(amount))
+	}
+
+	coinType, _, err := b.message((&basev1beta1.Coin{}).ProtoReflect(), nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]any, len(amount))
+	for i, coin := range amount {
+		if _, values[i], err = b.message(coin.ProtoReflect(), coins[i], 0); err != nil {
+			return nil, err
+		}
+	}
+
+	b.types[feeType] = []Type{
+		{Name: "amount", Type: coinType + "[]"},
+		{Name: "gas", Type: "uint64"},
+		{Name: "granter", Type: "string"},
+		{Name: "payer", Type: "string"},
+	}
+	return map[string]any{
+		"amount":  values,
+		"gas":     obj["gas"],
+		"granter": stringOrEmpty(obj["granter"]),
+		"payer":   stringOrEmpty(obj["payer"]),
+	}, nil
+}
+
+var chainIDRegexp = regexp.MustCompile(`^(?:[a-z][a-z0-9]*_)?([1-9][0-9]*)(?:-[1-9][0-9]*)?$`)
+
+// ParseEIP155ChainID returns the EIP-155 chain ID of a chain ID, which is either a decimal number or of the form
+// {identifier}_{EIP155}-{version}, e.g. 9000 for cosmos_9000-1.
+func ParseEIP155ChainID(chainID string) (*big.Int, error) {
+	matches := chainIDRegexp.FindStringSubmatch(chainID)
+	if matches == nil {
+		return nil, fmt.Errorf("chain ID %q does not contain an EIP-155 chain ID, expected {identifier}_{EIP155}-{version}", chainID)
+	}
+	eip155ChainID, _ := new(big.Int).SetString(matches[1], 10)
+	return eip155ChainID, nil
+}
+
+var _ signing.SignModeHandler = (*SignModeHandler)(nil)
@@ -0,0 +1,290 @@
+package eip712
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Type is a field of an EIP-712 struct type.
+type Type struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Types are the EIP-712 struct types of a TypedData by name.
+type Types map[string][]Type
+
+// TypedData is an EIP-712 typed data document in the format expected by the eth_signTypedData_v4 JSON-RPC method
+// of Ethereum wallets.
+type TypedData struct {
+	Types       Types          `json:"types"`
+	PrimaryType string         `json:"primaryType"`
+	Domain      map[string]any `json:"domain"`
+	Message     map[string]any `json:"message"`
+}
+
+const domainType = "EIP712Domain"
+
+// SignBytes returns the EIP-712 encoding of the typed data, "\x19\x01" ‖ domainSeparator ‖ hashStruct(message).
+// Its Keccak256 hash is the digest signed by Ethereum wallets.
+func (td TypedData) SignBytes() ([]byte, error) {
+	domainSeparator, err := td.HashStruct(domainType, td.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash the EIP-712 domain: %w", err)
+	}
+	messageHash, err := td.HashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash the EIP-712 message: %w", err)
+	}
+
+	signBytes := make([]byte, 0, 2+len(domainSeparator)+len(messageHash))
+	signBytes = append(signBytes, 0x19, 0x01)
+	signBytes = append(signBytes, domainSeparator...)
+	return append(signBytes, messageHash...), nil
+}
+
+// Hash returns the EIP-712 digest of the typed data, the Keccak256 hash of its sign bytes.
+func (td TypedData) Hash() ([]byte, error) {
+	signBytes, err := td.SignBytes()
+	if err != nil {
+		return nil, err
+	}
+	return keccak256(signBytes), nil
+}
+
+// HashStruct returns the EIP-712 hashStruct of a value of the given struct type.
+func (td TypedData) HashStruct(typeName string, data map[string]any) ([]byte, error) {
+	encoded, err := td.encodeData(typeName, data, 0)
+	if err != nil {
+		return nil, err
+	}
+	return keccak256(encoded), nil
+}
+
+// EncodeType returns the EIP-712 encoding of a struct type, followed by the sorted struct types it references.
+func (td TypedData) EncodeType(typeName string) string {
+	deps := td.dependencies(typeName, map[string]bool{})
+	sort.Strings(deps[1:])
+
+	var buf strings.Builder
+	for _, dep := range deps {
+		buf.WriteString(dep)
+		buf.WriteString("(")
+		for i, field := range td.Types[dep] {
+			if i > 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString(field.Type)
+			buf.WriteString(" ")
+			buf.WriteString(field.Name)
+		}
+		buf.WriteString(")")
+	}
+	return buf.String()
+}
+
+// dependencies returns the struct type and all the struct types it references, the struct type first.
+func (td TypedData) dependencies(typeName string, found map[string]bool) []string {
+	typeName = baseType(typeName)
+	if found[typeName] {
+		return nil
+	}
+	if _, ok := td.Types[typeName]; !ok {
+		return nil
+	}
+	found[typeName] = true
+
+	deps := []string{typeName}
+	for _, field := range td.Types[typeName] {
+		deps = append(deps, td.dependencies(field.Type, found)...)
+	}
+	return deps
+}
+
+func (td TypedData) encodeData(typeName string, data map[string]any, depth int) ([]byte, error) {
+	if depth > maxDepth {
+		return nil, errors.New("max depth exceeded")
+	}
+	fields, ok := td.Types[typeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown struct type %s", typeName)
+	}
+	if len(data) > len(fields) {
+		return nil, fmt.Errorf("%s has undeclared fields", typeName)
+	}
+
+	typeHash := keccak256([]byte(td.EncodeType(typeName)))
+	buf := bytes.NewBuffer(typeHash)
+	for _, field := range fields {
+		value, ok := data[field.Name]
+		if !ok {
+			return nil, fmt.Errorf("%s is missing field %s", typeName, field.Name)
+		}
+		encoded, err := td.encodeValue(field.Type, value, depth+1)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", typeName, field.Name, err)
+		}
+		buf.Write(encoded)
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeValue returns the 32 bytes EIP-712 encoding of a value of the given type.
+func (td TypedData) encodeValue(typ string, value any, depth int) ([]byte, error) {
+	if strings.HasSuffix(typ, "]") {
+		elemType := typ[:strings.LastIndex(typ, "[")]
+		items, ok := value.([]any)
+		if !ok {
+			return nil, fmt.Errorf("expected an array, got %T", value)
+		}
+		var buf bytes.Buffer
+		for _, item := range items {
+			encoded, err := td.encodeValue(elemType, item, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(encoded)
+		}
+		return keccak256(buf.Bytes()), nil
+	}
+
+	if _, ok := td.Types[typ]; ok {
+		data, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("expected a %s struct, got %T", typ, value)
+		}
+		encoded, err := td.encodeData(typ, data, depth)
+		if err != nil {
+			return nil, err
+		}
+		return keccak256(encoded), nil
+	}
+
+	switch {
+	case typ == "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %T", value)
+		}
+		return keccak256([]byte(s)), nil
+
+	case typ == "bytes":
+		bz, err := hexBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return keccak256(bz), nil
+
+	case typ == "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected a bool, got %T", value)
+		}
+		word := make([]byte, 32)
+		if b {
+			word[31] = 1
+		}
+		return word, nil
+
+	case typ == "address":
+		bz, err := hexBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) != 20 {
+			return nil, fmt.Errorf("invalid address length %d", len(bz))
+		}
+		return leftPad(bz), nil
+
+	case strings.HasPrefix(typ, "uint"), strings.HasPrefix(typ, "int"):
+		return encodeInteger(typ, value)
+
+	default:
+		return nil, fmt.Errorf("unsupported type %s", typ)
+	}
+}
+
+// encodeInteger returns the 32 bytes two's complement encoding of an intN or uintN value.
+func encodeInteger(typ string, value any) ([]byte, error) {
+	signed := strings.HasPrefix(typ, "int")
+	bits, err := strconv.Atoi(strings.TrimPrefix(strings.TrimPrefix(typ, "u"), "int"))
+	if err != nil || bits <= 0 || bits > 256 || bits%8 != 0 {
+		return nil, fmt.Errorf("unsupported type %s", typ)
+	}
+
+	var n *big.Int
+	switch v := value.(type) {
+	case *big.Int:
+		n = v
+	case json.Number:
+		n, _ = new(big.Int).SetString(v.String(), 10)
+	case string:
+		if strings.HasPrefix(v, "0x") {
+			n, _ = new(big.Int).SetString(v[2:], 16)
+		} else {
+			n, _ = new(big.Int).SetString(v, 10)
+		}
+	case int64:
+		n = big.NewInt(v)
+	case uint64:
+		n = new(big.Int).SetUint64(v)
+	case int:
+		n = big.NewInt(int64(v))
+	}
+	if n == nil {
+		return nil, fmt.Errorf("invalid %s value %v", typ, value)
+	}
+
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	if signed {
+		limit.Rsh(limit, 1)
+		if n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+			return nil, fmt.Errorf("%s overflows %s", n, typ)
+		}
+	} else if n.Sign() < 0 || n.Cmp(limit) >= 0 {
+		return nil, fmt.Errorf("%s overflows %s", n, typ)
+	}
+
+	if n.Sign() < 0 {
+		// two's complement over 256 bits
+		n = new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+	return n.FillBytes(make([]byte, 32)), nil
+}
+
+func hexBytes(value any) ([]byte, error) {
+	s, ok := value.(string)
+	if !ok || !strings.HasPrefix(s, "0x") {
+		return nil, fmt.Errorf("expected a 0x prefixed hex string, got %v", value)
+	}
+	return hex.DecodeString(s[2:])
+}
+
+func leftPad(bz []byte) []byte {
+	word := make([]byte, 32)
+	copy(word[32-len(bz):], bz)
+	return word
+}
+
+// baseType returns the type of the elements of an array type, or the type itself.
+func baseType(typ string) string {
+	if i := strings.Index(typ, "["); i >= 0 {
+		return typ[:i]
+	}
+	return typ
+}
+
+func keccak256(data []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(data)
+	return hasher.Sum(nil)
+}
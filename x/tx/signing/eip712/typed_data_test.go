@@ -0,0 +1,107 @@
+package eip712_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/tx/signing/eip712"
+)
+
+// mailTypedData is the example of the EIP-712 specification.
+var mailTypedData = eip712.TypedData{
+	Types: eip712.Types{
+		"EIP712Domain": {
+			{Name: "name", Type: "string"},
+			{Name: "version", Type: "string"},
+			{Name: "chainId", Type: "uint256"},
+			{Name: "verifyingContract", Type: "address"},
+		},
+		"Person": {
+			{Name: "name", Type: "string"},
+			{Name: "wallet", Type: "address"},
+		},
+		"Mail": {
+			{Name: "from", Type: "Person"},
+			{Name: "to", Type: "Person"},
+			{Name: "contents", Type: "string"},
+		},
+	},
+	PrimaryType: "Mail",
+	Domain: map[string]any{
+		"name":              "Ether Mail",
+		"version":           "1",
+		"chainId":           big.NewInt(1),
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+	},
+	Message: map[string]any{
+		"from": map[string]any{
+			"name":   "Cow",
+			"wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+		},
+		"to": map[string]any{
+			"name":   "Bob",
+			"wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+		},
+		"contents": "Hello, Bob!",
+	},
+}
+
+func TestTypedData_Hash(t *testing.T) {
+	require.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", mailTypedData.EncodeType("Mail"))
+
+	domainSeparator, err := mailTypedData.HashStruct("EIP712Domain", mailTypedData.Domain)
+	require.NoError(t, err)
+	require.Equal(t, "f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", hex.EncodeToString(domainSeparator))
+
+	messageHash, err := mailTypedData.HashStruct("Mail", mailTypedData.Message)
+	require.NoError(t, err)
+	require.Equal(t, "c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", hex.EncodeToString(messageHash))
+
+	hash, err := mailTypedData.Hash()
+	require.NoError(t, err)
+	require.Equal(t, "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hex.EncodeToString(hash))
+}
+
+func TestTypedData_HashErrors(t *testing.T) {
+	td := eip712.TypedData{
+		Types: eip712.Types{
+			"Values": {{Name: "small", Type: "uint8"}, {Name: "signed", Type: "int64"}},
+		},
+	}
+
+	_, err := td.HashStruct("Values", map[string]any{"small": "255", "signed": "-9223372036854775808"})
+	require.NoError(t, err)
+
+	_, err = td.HashStruct("Values", map[string]any{"small": "256", "signed": "0"})
+	require.ErrorContains(t, err, "256 overflows uint8")
+
+	_, err = td.HashStruct("Values", map[string]any{"small": "0"})
+	require.ErrorContains(t, err, "missing field signed")
+
+	_, err = td.HashStruct("Values", map[string]any{"small": "0", "signed": "0", "other": "0"})
+	require.ErrorContains(t, err, "undeclared fields")
+
+	_, err = td.HashStruct("Unknown", map[string]any{})
+	require.ErrorContains(t, err, "unknown struct type")
+}
+
+func TestParseEIP155ChainID(t *testing.T) {
+	for chainID, expected := range map[string]int64{
+		"9000":          9000,
+		"cosmos_9000-1": 9000,
+		"evmos_9001-2":  9001,
+		"chain_1":       1,
+	} {
+		eip155ChainID, err := eip712.ParseEIP155ChainID(chainID)
+		require.NoError(t, err, chainID)
+		require.Equal(t, big.NewInt(expected), eip155ChainID, chainID)
+	}
+
+	for _, chainID := range []string{"", "chain-xyz", "cosmos_0-1", "cosmos-9000"} {
+		_, err := eip712.ParseEIP155ChainID(chainID)
+		require.Error(t, err, chainID)
+	}
+}